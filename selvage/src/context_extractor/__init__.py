@@ -1,7 +1,121 @@
 """최적화된 Tree-sitter 기반 컨텍스트 추출기 패키지."""
 
+from .anchor_query_registry import AnchorQueryRegistry
+from .code_owner_annotator import CodeOwnerAnnotator
+from .context_block import ContextBlock
 from .context_extractor import ContextExtractor
+from .context_renderer import ContextRenderer, render_context
+from .diff_line_changes import DiffLineChanges
+from .directory_context_extractor import DirectoryContextExtractor, extract_tree
+from .document_block import DocumentBlock
+from .document_context_extractor import DocumentContextExtractor
+from .document_section import DocumentSection
+from .duplicate_symbol_detector import DuplicateSymbolDetector
+from .duplicate_symbol_pair import DuplicateSymbolPair
+from .extracted_file_context import ExtractedFileContext
+from .extraction_options import ExtractionOptions
+from .extraction_summary import ExtractionSummary
+from .file_rename import FileRename
 from .fallback_context_extractor import FallbackContextExtractor
+from .indent_style import IndentStyle
+from .language_extraction_summary import LanguageExtractionSummary
+from .language_filter import LanguageFilter
 from .line_range import LineRange
+from .metrics import ExtractionMetrics, ExtractionMetricsSummary
+from .position_convention import PositionConvention
+from .query_validation import QueryIssue, QueryValidationResult, validate_query
+from .render_options import RenderOptions
+from .resolved_symbol import ResolvedSymbol
+from .sarif_location_renderer import SarifLocationRenderer, render_sarif_locations
+from .section_banner_detector import SectionBannerDetector
+from .semantic_token import SemanticToken
+from .semantic_token_extractor import (
+    SemanticTokenExtractor,
+    semantic_tokens,
+    tokenize_symbol,
+)
+from .semantic_token_kind import SemanticTokenKind
+from .signature_parameter import SignatureParameter
+from .struct_field import StructField
+from .symbol_change_classifier import SymbolChangeClassifier
+from .symbol_change_status import SymbolChangeStatus
+from .symbol_cost import SymbolCost
+from .symbol_cost_weights import SymbolCostWeights
+from .symbol_index_renderer import SymbolIndexRenderer, render_symbol_index
+from .symbol_line_metrics import SymbolLineMetrics
+from .symbol_name_formatter import SymbolNameFormatter
+from .symbol_name_parts import SymbolNameParts
+from .symbol_resolver import SymbolResolver
+from .symbol_revision_pair import SymbolRevisionPair
+from .svelte_context_extractor import SvelteContextExtractor
+from .svelte_section import SvelteSection
+from .symbol_signature import SymbolSignature
+from .symbol_token import SymbolToken
+from .table_test_case import TableTestCase
+from .tagged_context_renderer import TaggedContextRenderer, render_tagged_context
+from .template_block import TemplateBlock
+from .template_context_extractor import TemplateContextExtractor
 
-__all__ = ["LineRange", "ContextExtractor", "FallbackContextExtractor"]
+__all__ = [
+    "LineRange",
+    "ContextBlock",
+    "ContextExtractor",
+    "ContextRenderer",
+    "DiffLineChanges",
+    "DirectoryContextExtractor",
+    "DocumentBlock",
+    "DocumentContextExtractor",
+    "DocumentSection",
+    "DuplicateSymbolDetector",
+    "DuplicateSymbolPair",
+    "ExtractedFileContext",
+    "ExtractionMetrics",
+    "ExtractionMetricsSummary",
+    "ExtractionOptions",
+    "AnchorQueryRegistry",
+    "ExtractionSummary",
+    "FallbackContextExtractor",
+    "FileRename",
+    "IndentStyle",
+    "LanguageExtractionSummary",
+    "LanguageFilter",
+    "PositionConvention",
+    "QueryIssue",
+    "QueryValidationResult",
+    "RenderOptions",
+    "ResolvedSymbol",
+    "SarifLocationRenderer",
+    "SectionBannerDetector",
+    "SemanticToken",
+    "SemanticTokenExtractor",
+    "SemanticTokenKind",
+    "SignatureParameter",
+    "StructField",
+    "SymbolChangeClassifier",
+    "CodeOwnerAnnotator",
+    "SymbolChangeStatus",
+    "SymbolIndexRenderer",
+    "SymbolCost",
+    "SymbolCostWeights",
+    "SymbolLineMetrics",
+    "SymbolNameFormatter",
+    "SymbolNameParts",
+    "SymbolResolver",
+    "SymbolRevisionPair",
+    "SvelteContextExtractor",
+    "SvelteSection",
+    "SymbolSignature",
+    "SymbolToken",
+    "TableTestCase",
+    "TaggedContextRenderer",
+    "TemplateBlock",
+    "TemplateContextExtractor",
+    "extract_tree",
+    "render_context",
+    "render_sarif_locations",
+    "render_symbol_index",
+    "render_tagged_context",
+    "semantic_tokens",
+    "tokenize_symbol",
+    "validate_query",
+]
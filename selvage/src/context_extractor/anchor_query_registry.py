@@ -0,0 +1,85 @@
+"""AnchorQueryRegistry: 언어별 사용자 정의 앵커 쿼리 레지스트리."""
+
+from __future__ import annotations
+
+from tree_sitter import Node, Query, QueryCursor
+from tree_sitter_language_pack import get_language
+
+from .context_extractor import ContextExtractor
+from .node_order import node_order_key
+from .query_validation import QueryIssue, QueryValidator
+
+
+class AnchorQueryRegistry:
+    """변경이 안에 있으면 항상 컨텍스트로 포함할 앵커 노드 쿼리를 언어별로 보관한다.
+
+    HTTP 핸들러나 DB 트랜잭션 블록처럼 팀마다 다른 도메인 단위를 tree-sitter
+    쿼리로 등록하면, 변경 라인이 `@anchor`로 캡처된 노드 안에 있을 때 심볼
+    단위와 관계없이 그 노드 전체를 변경된 블록으로 포함한다. 쿼리는 등록 시
+    QueryValidator로 검증하고 컴파일해 두며, ExtractionOptions.anchor_queries로
+    추출기에 전달한다.
+
+    Example:
+        registry = AnchorQueryRegistry()
+        registry.register(
+            "go",
+            '(block (defer_statement (call_expression function: '
+            '(selector_expression field: (field_identifier) @method))) '
+            '(#eq? @method "Rollback")) @anchor',
+        )
+    """
+
+    # 앵커 노드를 가리키는 캡처 이름
+    ANCHOR_CAPTURE = "anchor"
+
+    def __init__(self) -> None:
+        """빈 레지스트리를 만든다."""
+        self._queries: dict[str, list[Query]] = {}
+
+    def register(self, language: str, query_text: str) -> None:
+        """언어의 앵커 쿼리를 검증한 뒤 추가한다.
+
+        Args:
+            language: ContextExtractor 언어 이름
+            query_text: `@anchor` 캡처를 포함한 tree-sitter 쿼리
+
+        Raises:
+            ValueError: 지원하지 않는 언어이거나, 쿼리가 올바르지 않거나,
+                `@anchor` 캡처가 없는 경우
+        """
+        if language not in ContextExtractor.SUPPORTED_LANGUAGES:
+            raise ValueError(f"지원하지 않는 언어의 앵커 쿼리입니다: {language}")
+        result = QueryValidator().validate(
+            language, query_text, (self.ANCHOR_CAPTURE,)
+        )
+        issues = result.errors or [
+            issue
+            for issue in result.warnings
+            if issue.kind == QueryIssue.MISSING_CAPTURE
+        ]
+        if issues:
+            details = "; ".join(str(issue) for issue in issues)
+            raise ValueError(f"앵커 쿼리가 올바르지 않습니다 ({language}): {details}")
+        grammar_name = ContextExtractor.LANGUAGE_GRAMMAR_NAMES.get(language, language)
+        query = Query(get_language(grammar_name), query_text)
+        self._queries.setdefault(language, []).append(query)
+
+    def languages(self) -> list[str]:
+        """앵커 쿼리가 등록된 언어 이름들을 반환한다."""
+        return sorted(self._queries)
+
+    def find_anchors(self, language: str, root: Node) -> list[Node]:
+        """파일에서 언어의 앵커 쿼리에 캡처된 노드들을 찾는다.
+
+        Args:
+            language: ContextExtractor 언어 이름
+            root: AST 루트 노드
+
+        Returns:
+            `@anchor`로 캡처된 노드 리스트 (위치 순, 중복 제거)
+        """
+        anchors: set[Node] = set()
+        for query in self._queries.get(language, ()):
+            captures = QueryCursor(query).captures(root)
+            anchors.update(captures.get(self.ANCHOR_CAPTURE, ()))
+        return sorted(anchors, key=node_order_key)
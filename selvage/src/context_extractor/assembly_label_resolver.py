@@ -0,0 +1,248 @@
+"""AssemblyLabelResolver: 어셈블리 전역 레이블 블록과 섹션 지시어를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class AssemblyLabelResolver:
+    """어셈블리 AST에서 변경 라인을 감싸는 전역 레이블 블록을 계산한다.
+
+    어셈블리 문법은 레이블, 지시어, 명령어를 모두 루트의 형제 노드로 나열하므로
+    한 전역 레이블부터 다음 전역 레이블(또는 섹션 지시어) 직전까지를 함수에
+    해당하는 블록으로 본다. `.`으로 시작하는 레이블(GAS `.L2`, NASM `.loop`)과
+    숫자 레이블(`1:`)은 지역 레이블이므로 블록을 나누지 않는다. 레이블 바로
+    위에서 레이블을 선언하는 지시어(`.globl main`, `global _start`)는 레이블
+    블록의 헤더로 본다. 섹션 지시어는 AT&T(GAS) 문법의 `.text`,
+    `.section .rodata`와 Intel(NASM) 문법의 `section .text`를 모두 인식한다.
+    """
+
+    # 레이블 노드 타입
+    LABEL_TYPES = frozenset({"label"})
+
+    # 주석 노드 타입
+    COMMENT_TYPES = frozenset({"line_comment", "block_comment"})
+
+    # 섹션을 바꾸는 AT&T(GAS) 지시어
+    SECTION_DIRECTIVES = frozenset({".text", ".data", ".bss", ".rodata", ".section"})
+
+    # 섹션을 바꾸는 Intel(NASM) 키워드 (대소문자 무시)
+    SECTION_KEYWORDS = frozenset({"section", "segment"})
+
+    # 레이블 심볼의 가시성/타입을 선언하는 지시어 (대소문자 무시)
+    DECLARATION_DIRECTIVES = frozenset(
+        {".globl", ".global", ".type", ".hidden", ".weak", "global", "public"}
+    )
+
+    # 섹션 지시어 블록의 포함 사유
+    CONTAINER_REASON = "enclosing-section"
+
+    def find_block(self, root: Node, line_no: int) -> Node | None:
+        """라인이 속한 블록의 시작 노드(전역 레이블 또는 섹션 지시어)를 찾는다.
+
+        레이블 위의 선언 지시어와 주석 라인은 그 레이블의 블록에 속한다.
+
+        Args:
+            root: AST 루트 노드
+            line_no: 1-based 라인 번호
+
+        Returns:
+            블록 시작 노드 (첫 블록보다 앞선 라인이면 None)
+        """
+        found: Node | None = None
+        for item in root.named_children:
+            if not self.is_boundary(item):
+                continue
+            if self.first_line(root, item) > line_no:
+                break
+            found = item
+        return found
+
+    def is_boundary(self, node: Node) -> bool:
+        """블록을 나누는 전역 레이블 또는 섹션 지시어인지 확인한다."""
+        return self.is_global_label(node) or self.is_section_directive(node)
+
+    def is_global_label(self, node: Node) -> bool:
+        """지역 레이블이 아닌 레이블인지 확인한다."""
+        if node.type not in self.LABEL_TYPES:
+            return False
+        name = self._label_name(node)
+        return bool(name) and not name.startswith(".") and not name.isdigit()
+
+    def is_section_directive(self, node: Node) -> bool:
+        """`.text`, `section .data` 같은 섹션 지시어인지 확인한다."""
+        keyword = self._keyword(node)
+        return keyword in self.SECTION_DIRECTIVES or keyword in self.SECTION_KEYWORDS
+
+    def header_line(self, root: Node, node: Node) -> int:
+        """레이블 선언 지시어를 포함한 블록 헤더의 첫 라인(1-based)을 반환한다."""
+        declarations = self._declarations(root, node)
+        start = declarations[0] if declarations else node
+        return start.start_point[0] + 1
+
+    def first_line(self, root: Node, node: Node) -> int:
+        """레이블 위쪽 주석까지 포함한 블록의 첫 라인(1-based)을 반환한다."""
+        comments = self.leading_comments(root, node)
+        if comments:
+            return comments[0].start_point[0] + 1
+        return self.header_line(root, node)
+
+    def leading_comments(self, root: Node, node: Node) -> list[Node]:
+        """레이블 헤더 바로 위에 빈 줄 없이 이어진 주석 노드들을 반환한다.
+
+        앞선 명령어 뒤의 같은 라인 주석(`ret  # done`)은 포함하지 않는다.
+
+        Args:
+            root: AST 루트 노드
+            node: 블록 시작 노드
+
+        Returns:
+            위치 순 주석 노드 리스트 (레이블이 아니거나 주석이 없으면 빈 리스트)
+        """
+        if node.type not in self.LABEL_TYPES:
+            return []
+        declarations = self._declarations(root, node)
+        anchor = declarations[0] if declarations else node
+        return self._collect_above(
+            root, anchor, lambda item: item.type in self.COMMENT_TYPES
+        )
+
+    def last_line(self, root: Node, node: Node) -> int:
+        """블록의 마지막 라인(1-based)을 반환한다.
+
+        다음 블록의 헤더와 위쪽 주석, 블록 끝의 빈 줄은 포함하지 않는다.
+
+        Args:
+            root: AST 루트 노드
+            node: 블록 시작 노드
+
+        Returns:
+            블록의 마지막 라인 번호
+        """
+        items = root.named_children
+        following = items[self._index(items, node) + 1 :]
+        # 다음 블록의 첫 라인 (0-based)
+        next_first_row: int | None = None
+        for item in following:
+            if self.is_boundary(item):
+                next_first_row = self.first_line(root, item) - 1
+                break
+
+        last_row = self._last_row(node)
+        for item in following:
+            if next_first_row is not None and item.start_point[0] >= next_first_row:
+                break
+            last_row = max(last_row, self._last_row(item))
+        return last_row + 1
+
+    def find_section(self, root: Node, node: Node) -> Node | None:
+        """블록 시작 노드 앞에서 가장 가까운 섹션 지시어를 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            node: 블록 시작 노드
+
+        Returns:
+            섹션 지시어 노드 (노드 자체가 섹션 지시어이거나 없으면 None)
+        """
+        if self.is_section_directive(node):
+            return None
+        items = root.named_children
+        for item in reversed(items[: self._index(items, node)]):
+            if self.is_section_directive(item):
+                return item
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """레이블 이름 또는 섹션 이름(`.text`, `.rodata` 등)을 반환한다."""
+        if node.type in self.LABEL_TYPES:
+            return self._label_name(node) or None
+        if not self.is_section_directive(node):
+            return None
+        words = self._first_line(node).split()
+        if words[0].lower() in (".section", *self.SECTION_KEYWORDS) and len(words) > 1:
+            # `.section .rodata,"a"`처럼 플래그가 붙은 경우 이름만 사용
+            return words[1].split(",", 1)[0]
+        return words[0]
+
+    def _declarations(self, root: Node, node: Node) -> list[Node]:
+        """레이블 바로 위에서 레이블 이름을 선언하는 지시어 노드들을 반환한다."""
+        if node.type not in self.LABEL_TYPES:
+            return []
+        name = self._label_name(node)
+
+        def declares_label(item: Node) -> bool:
+            if self._keyword(item) not in self.DECLARATION_DIRECTIVES:
+                return False
+            words = self._first_line(item).replace(",", " ").split()
+            return name in words[1:]
+
+        return self._collect_above(root, node, declares_label)
+
+    def _collect_above(
+        self, root: Node, node: Node, predicate: Callable[[Node], bool]
+    ) -> list[Node]:
+        """노드 바로 위에 빈 줄 없이 이어지며 조건을 만족하는 형제 노드들을 반환한다.
+
+        다른 노드 뒤에 같은 라인으로 이어진 노드에서는 멈춘다.
+
+        Args:
+            root: AST 루트 노드
+            node: 기준 노드
+            predicate: 포함할 노드 조건
+
+        Returns:
+            위치 순 노드 리스트
+        """
+        items = root.named_children
+        collected: list[Node] = []
+        current = node
+        for position in range(self._index(items, node) - 1, -1, -1):
+            item = items[position]
+            if not predicate(item):
+                break
+            if self._last_row(item) != current.start_point[0] - 1:
+                break
+            if position > 0 and self._last_row(items[position - 1]) == (
+                item.start_point[0]
+            ):
+                break
+            collected.insert(0, item)
+            current = item
+        return collected
+
+    def _keyword(self, node: Node) -> str:
+        """노드 첫 라인의 첫 단어를 소문자로 반환한다 (레이블과 주석은 빈 문자열)."""
+        if node.type in self.LABEL_TYPES or node.type in self.COMMENT_TYPES:
+            return ""
+        words = self._first_line(node).split()
+        return words[0].lower() if words else ""
+
+    def _label_name(self, node: Node) -> str:
+        """레이블 텍스트에서 `:`를 제외한 이름을 반환한다."""
+        return self._first_line(node).split(":", 1)[0].strip()
+
+    @staticmethod
+    def _first_line(node: Node) -> str:
+        """노드 텍스트의 첫 라인을 반환한다."""
+        return node_text(node).split("\n", 1)[0]
+
+    @staticmethod
+    def _last_row(node: Node) -> int:
+        """노드의 마지막 라인(0-based)을 반환한다 (끝의 줄바꿈은 제외)."""
+        end_row, end_column = node.end_point
+        if end_column == 0 and end_row > node.start_point[0]:
+            return end_row - 1
+        return end_row
+
+    @staticmethod
+    def _index(items: list[Node], node: Node) -> int:
+        """형제 노드 목록에서 노드의 위치를 반환한다."""
+        for position, item in enumerate(items):
+            if item.start_byte == node.start_byte and item.type == node.type:
+                return position
+        return -1
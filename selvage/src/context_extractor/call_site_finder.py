@@ -0,0 +1,109 @@
+"""CallSiteFinder: 같은 파일 안에서 함수를 호출하는 위치를 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Collection, Sequence
+
+from tree_sitter import Node
+
+from .recursive_call_detector import RecursiveCallDetector
+
+
+class CallSiteFinder:
+    """이름 비교로 같은 파일 안의 함수 호출 위치와 이를 감싸는 문장을 찾는다.
+
+    시그니처가 바뀐 함수의 호출부 중 깨질 수 있는 곳을 보여주기 위한 것으로,
+    타입이나 import를 해석하지 않고 호출 대상의 마지막 이름만 비교한다
+    (RecursiveCallDetector와 같은 규칙). 호출을 감싸는 가장 가까운 문장을
+    호출 위치의 문맥으로 사용한다.
+    """
+
+    # `_statement`로 끝나지 않지만 문장으로 취급하는 선언 노드 타입
+    STATEMENT_TYPES = frozenset(
+        {
+            "short_var_declaration",
+            "var_declaration",
+            "lexical_declaration",
+            "variable_declaration",
+            "local_variable_declaration",
+            "property_declaration",
+            "assignment",
+        }
+    )
+
+    def __init__(self, language: str) -> None:
+        """CallSiteFinder를 초기화한다.
+
+        Args:
+            language: 언어 이름
+        """
+        self._detector = RecursiveCallDetector(language)
+
+    def is_function(self, node: Node) -> bool:
+        """노드가 호출부를 찾을 수 있는 함수/메서드 선언 노드인지 확인한다."""
+        return self._detector.is_function(node)
+
+    def find(
+        self,
+        root: Node,
+        names: Collection[str],
+        excluded: Sequence[Node],
+        limit: int,
+    ) -> list[tuple[Node, Node]]:
+        """이름이 일치하는 호출들과 이를 감싸는 문장을 위치 순으로 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            names: 호출부를 찾을 함수 이름들
+            excluded: 호출부를 찾지 않을 노드들 (이미 포함된 블록 등)
+            limit: 반환할 최대 호출 위치 수
+
+        Returns:
+            (감싸는 문장 노드, 호출 노드) 튜플 리스트. 같은 문장 안의 여러
+            호출은 첫 호출 하나로 센다.
+        """
+        if not names or limit <= 0:
+            return []
+        sites: list[tuple[Node, Node]] = []
+        seen_statements: set[int] = set()
+        for call in self._iter_calls(root):
+            if self._detector.callee_name(call) not in names:
+                continue
+            if any(self._contains(node, call) for node in excluded):
+                continue
+            statement = self._enclosing_statement(call)
+            if statement.id in seen_statements:
+                continue
+            seen_statements.add(statement.id)
+            sites.append((statement, call))
+            if len(sites) >= limit:
+                break
+        return sites
+
+    def _iter_calls(self, root: Node) -> list[Node]:
+        """파일 안의 모든 호출 노드를 위치 순으로 반환한다."""
+        calls: list[Node] = []
+        stack = [root]
+        while stack:
+            current = stack.pop()
+            if self._detector.is_call(current):
+                calls.append(current)
+            stack.extend(current.children)
+        return sorted(calls, key=lambda call: call.start_byte)
+
+    def _enclosing_statement(self, call: Node) -> Node:
+        """호출을 감싸는 가장 가까운 문장 노드를 반환한다 (없으면 호출 자체)."""
+        current: Node | None = call.parent
+        while current is not None:
+            if (
+                current.type.endswith("_statement")
+                or current.type in self.STATEMENT_TYPES
+            ):
+                return current
+            current = current.parent
+        return call
+
+    @staticmethod
+    def _contains(outer: Node, inner: Node) -> bool:
+        """outer 노드가 inner 노드를 바이트 범위로 포함하는지 확인한다."""
+        return outer.start_byte <= inner.start_byte and inner.end_byte <= outer.end_byte
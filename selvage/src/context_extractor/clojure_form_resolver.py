@@ -0,0 +1,138 @@
+"""ClojureFormResolver: Clojure 정의 form과 namespace form을 판별하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class ClojureFormResolver(ScopeResolver):
+    """Clojure AST에서 변경을 감싸는 정의 form을 찾고 이름을 계산한다.
+
+    Clojure는 모든 코드가 list 형태(`(defn name ...)`)이므로 노드 타입이 아니라
+    list의 첫 심볼로 정의 form을 구분한다. 변경을 감싸는 가장 바깥쪽 정의 form
+    전체를 블록으로 사용하며, 그 안의 `let`/`fn`/`letfn`/`#(...)` 같은 form은
+    별도 블록이 아닌 정의의 내부 스코프로 본다. 정의 밖의 변경은 감싸는
+    최상위 form을 반환한다.
+
+    심볼 앞의 메타데이터(`^:private`, `^{:doc ...}`)와 `#_` 주석 처리된 form은
+    이름 계산에서 건너뛰며, 파일의 `ns` form과 최상위 `require`/`import`는
+    의존성 form으로 취급한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 의존성 노드는 노드 타입 대신 is_dependency()로 판별
+    OWNS_DEPENDENCIES = True
+
+    # 이름을 정의하는 form의 첫 심볼
+    DEFINITION_FORMS = frozenset(
+        {
+            "def",
+            "defonce",
+            "defn",
+            "defn-",
+            "defmacro",
+            "defmulti",
+            "defmethod",
+            "defprotocol",
+            "defrecord",
+            "deftype",
+        }
+    )
+
+    # dispatch 값까지 이름에 포함하는 form (`(defmethod area :circle ...)`)
+    DISPATCH_FORMS = frozenset({"defmethod"})
+
+    # 최상위에서 의존성을 선언하는 form의 첫 심볼
+    DEPENDENCY_FORMS = frozenset({"ns", "in-ns", "require", "import", "use"})
+
+    # form 요소를 셀 때 건너뛰는 노드 타입 (메타데이터, 주석, `#_` form)
+    IGNORED_TYPES = frozenset({"meta_lit", "old_meta_lit", "comment", "dis_expr"})
+
+    # list form 노드 타입과 파일 루트 노드 타입
+    LIST_TYPE = "list_lit"
+    ROOT_TYPE = "source"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 바깥쪽 정의 form 또는 최상위 form을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            정의 form 또는 최상위 form 노드 (루트 노드면 None)
+        """
+        definition: Node | None = None
+        top_level: Node | None = None
+        current: Node | None = node
+        while current is not None and current.type != self.ROOT_TYPE:
+            if self._head_symbol(current) in self.DEFINITION_FORMS:
+                definition = current
+            top_level = current
+            current = current.parent
+        return definition or top_level
+
+    def is_dependency(self, node: Node) -> bool:
+        """노드가 최상위 `ns`/`require`/`import` 같은 의존성 form인지 확인한다.
+
+        Args:
+            node: 확인할 노드
+
+        Returns:
+            의존성 form 여부
+        """
+        return (
+            node.parent is not None
+            and node.parent.type == self.ROOT_TYPE
+            and self._head_symbol(node) in self.DEPENDENCY_FORMS
+        )
+
+    def name(self, node: Node) -> str | None:
+        """정의 form의 표시용 이름을 반환한다.
+
+        Args:
+            node: find_scope가 반환한 form 노드
+
+        Returns:
+            정의된 이름 (`defmethod`는 `area :circle`처럼 dispatch 값 포함),
+            정의 form이 아니면 None
+        """
+        head = self._head_symbol(node)
+        if head not in self.DEFINITION_FORMS:
+            return None
+        elements = self._elements(node)
+        if len(elements) < 2 or elements[1].type != "sym_lit":
+            return None
+        name = self._symbol_name(elements[1])
+        if head in self.DISPATCH_FORMS and len(elements) > 2:
+            return f"{name} {node_text(elements[2])}"
+        return name
+
+    def _head_symbol(self, node: Node) -> str | None:
+        """list form의 첫 심볼 이름을 반환한다 (`clojure.core/defn`은 defn)."""
+        if node.type != self.LIST_TYPE:
+            return None
+        elements = self._elements(node)
+        if not elements or elements[0].type != "sym_lit":
+            return None
+        return self._symbol_name(elements[0])
+
+    def _elements(self, form: Node) -> list[Node]:
+        """form의 요소 노드들을 메타데이터와 주석을 제외하고 반환한다."""
+        return [
+            child
+            for child in form.named_children
+            if child.type not in self.IGNORED_TYPES
+        ]
+
+    def _symbol_name(self, symbol: Node) -> str:
+        """심볼 노드에서 메타데이터와 namespace를 제외한 이름을 반환한다."""
+        for child in symbol.named_children:
+            if child.type == "sym_name":
+                return node_text(child)
+        return node_text(symbol).rsplit("/", 1)[-1]
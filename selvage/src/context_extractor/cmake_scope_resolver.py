@@ -0,0 +1,117 @@
+"""CMakeScopeResolver: CMake 함수/매크로/제어 블록과 타깃 정의 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class CMakeScopeResolver(ScopeResolver):
+    """CMake AST에서 변경을 감싸는 블록이나 명령을 찾고 이름을 계산한다.
+
+    변경 라인을 감싸는 `function()`/`macro()` 정의가 있으면 정의 전체를 (안의
+    타깃 정의 변경 포함), 없으면 `add_executable()`/`add_library()` 같은 타깃
+    정의 명령을, 그것도 아니면 감싸는 `if()`/`foreach()`/`while()`/`block()`
+    블록을 반환한다. 블록 밖의 `set(VAR ...)` 같은 최상위 명령은 명령 하나를
+    반환하며, 명령 이름은 CMake 규칙대로 대소문자를 구분하지 않는다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 정의 전체를 반환하는 함수/매크로 정의 노드 타입
+    DEFINITION_TYPES = frozenset({"function_def", "macro_def"})
+
+    # 감싸는 블록으로 반환하는 제어 블록 노드 타입
+    CONTROL_BLOCK_TYPES = frozenset(
+        {"if_condition", "foreach_loop", "while_loop", "block_def"}
+    )
+
+    # 일반 명령 노드 타입
+    COMMAND_TYPE = "normal_command"
+
+    # 타깃을 정의하는 명령 이름 (소문자)
+    TARGET_COMMANDS = frozenset({"add_executable", "add_library", "add_custom_target"})
+
+    # 변수를 정의하는 명령 이름 (소문자, 첫 인자가 변수 이름)
+    ASSIGNMENT_COMMANDS = frozenset({"set", "option"})
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 함수/매크로 정의, 타깃 정의, 제어 블록 또는 최상위 명령을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            위 우선순위에 따른 노드 (루트 노드면 None)
+        """
+        target: Node | None = None
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.DEFINITION_TYPES:
+                return current
+            if (
+                target is None
+                and current.type == self.COMMAND_TYPE
+                and self.command_name(current) in self.TARGET_COMMANDS
+            ):
+                target = current
+            if current.type in self.CONTROL_BLOCK_TYPES:
+                # 함수/매크로 밖 블록 안의 타깃 정의는 블록 대신 타깃 정의만 반환
+                return self._enclosing_definition(current) or target or current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None:
+                # 블록 밖 최상위 명령
+                return target or current
+            current = parent
+        return None
+
+    def command_name(self, command: Node) -> str | None:
+        """명령 노드의 소문자 명령 이름을 반환한다."""
+        name_node = next(
+            (child for child in command.named_children if child.type == "identifier"),
+            None,
+        )
+        if name_node is None:
+            return None
+        return node_text(name_node).lower() or None
+
+    def name(self, node: Node) -> str | None:
+        """함수/매크로, 타깃, 변수 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            함수/매크로 정의는 정의 이름, 타깃 정의 명령은 타깃 이름,
+            `set()`/`option()`은 변수 이름 (그 밖의 노드는 None)
+        """
+        if node.type in self.DEFINITION_TYPES:
+            header = node.named_children[0] if node.named_children else None
+            return self._first_argument(header) if header is not None else None
+        if node.type == self.COMMAND_TYPE and self.command_name(node) in (
+            self.TARGET_COMMANDS | self.ASSIGNMENT_COMMANDS
+        ):
+            return self._first_argument(node)
+        return None
+
+    def _enclosing_definition(self, node: Node) -> Node | None:
+        """제어 블록을 감싸는 함수/매크로 정의를 찾는다."""
+        current = node.parent
+        while current is not None:
+            if current.type in self.DEFINITION_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def _first_argument(self, command: Node) -> str | None:
+        """명령의 첫 번째 인자 텍스트를 반환한다."""
+        for child in command.named_children:
+            if child.type == "argument_list" and child.named_children:
+                return node_text(child.named_children[0]) or None
+        return None
@@ -0,0 +1,35 @@
+"""CodeOwnerAnnotator: 파일 단위 추출 결과에 CODEOWNERS 소유자를 기록하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+
+from selvage.src.utils.code_owners import CodeOwners
+
+from .extracted_file_context import ExtractedFileContext
+
+
+class CodeOwnerAnnotator:
+    """추출된 심볼이 있는 파일마다 CODEOWNERS로 찾은 소유자를 기록한다.
+
+    리뷰어 지정 등 후속 도구가 변경된 코드의 소유자를 멘션할 수 있게 한다.
+    블록이 없는(건너뛴) 파일에는 기록하지 않는다.
+    """
+
+    def __init__(self, code_owners: CodeOwners) -> None:
+        """기록기 초기화.
+
+        Args:
+            code_owners: 저장소의 CODEOWNERS 해석기
+        """
+        self._code_owners = code_owners
+
+    def annotate(self, contexts: Iterable[ExtractedFileContext]) -> None:
+        """블록이 있는 파일 결과들의 owners에 소유자를 기록한다.
+
+        Args:
+            contexts: 저장소 루트 기준 경로를 file_path로 가진 파일 단위 결과들
+        """
+        for context in contexts:
+            if context.blocks:
+                context.owners = self._code_owners.owners_for(context.file_path)
@@ -0,0 +1,17 @@
+"""언어별 주석 연결(comment association) 전략 패키지."""
+
+from .associated_comment import AssociatedComment
+from .comment_association_strategy import CommentAssociationStrategy
+from .comment_strategy_registry import CommentStrategyRegistry
+from .leading_comment_strategy import LeadingCommentStrategy
+from .python_docstring_strategy import PythonDocstringStrategy
+from .toml_comment_strategy import TomlCommentStrategy
+
+__all__ = [
+    "AssociatedComment",
+    "CommentAssociationStrategy",
+    "CommentStrategyRegistry",
+    "LeadingCommentStrategy",
+    "PythonDocstringStrategy",
+    "TomlCommentStrategy",
+]
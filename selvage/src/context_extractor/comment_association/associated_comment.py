@@ -0,0 +1,25 @@
+"""AssociatedComment: 심볼에 연결된 주석 정보."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from ..line_range import LineRange
+
+
+@dataclass(frozen=True)
+class AssociatedComment:
+    """심볼 노드에 연결된 문서 주석.
+
+    Attributes:
+        text: 주석 원문 (여러 주석 노드가 연결된 경우 원본 그대로 이어붙인 텍스트)
+        line_range: 주석이 위치한 라인 범위 (1-based)
+        start_byte: 주석 시작 바이트 오프셋
+        is_leading: 선언 위쪽에 위치한 주석인지 여부
+            (False면 Python docstring처럼 심볼 본문 내부에 위치)
+    """
+
+    text: str
+    line_range: LineRange
+    start_byte: int
+    is_leading: bool
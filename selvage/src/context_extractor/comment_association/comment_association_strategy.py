@@ -0,0 +1,57 @@
+"""CommentAssociationStrategy: 주석 연결 전략 인터페이스."""
+
+from __future__ import annotations
+
+from abc import ABC, abstractmethod
+
+from tree_sitter import Node
+
+from ..line_range import LineRange
+from .associated_comment import AssociatedComment
+
+
+class CommentAssociationStrategy(ABC):
+    """심볼 노드와 그 문서 주석을 연결하는 전략의 기본 클래스."""
+
+    @abstractmethod
+    def find_comment(self, node: Node, source: bytes) -> AssociatedComment | None:
+        """심볼 노드에 연결된 주석을 찾는다.
+
+        Args:
+            node: 심볼(함수, 클래스 등) 노드
+            source: 파일 전체 바이트
+
+        Returns:
+            연결된 주석 (없으면 None)
+        """
+
+    def find_trailing_comment(
+        self, node: Node, source: bytes
+    ) -> AssociatedComment | None:
+        """심볼의 닫는 구분자 뒤에 이어지는 줄 주석을 찾는다.
+
+        기본 구현은 뒤쪽 주석을 연결하지 않는다.
+
+        Args:
+            node: 심볼(함수, 클래스 등) 노드
+            source: 파일 전체 바이트
+
+        Returns:
+            뒤쪽 주석 (없으면 None)
+        """
+        return None
+
+    @staticmethod
+    def _create_comment(
+        comment_nodes: list[Node], source: bytes, is_leading: bool
+    ) -> AssociatedComment:
+        """연속된 주석 노드들로부터 AssociatedComment를 생성한다."""
+        first, last = comment_nodes[0], comment_nodes[-1]
+        return AssociatedComment(
+            text=source[first.start_byte : last.end_byte].decode(
+                "utf-8", errors="replace"
+            ),
+            line_range=LineRange(first.start_point[0] + 1, last.end_point[0] + 1),
+            start_byte=first.start_byte,
+            is_leading=is_leading,
+        )
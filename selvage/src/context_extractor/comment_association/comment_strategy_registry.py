@@ -0,0 +1,83 @@
+"""CommentStrategyRegistry: 언어별 주석 연결 전략 레지스트리."""
+
+from __future__ import annotations
+
+from typing import ClassVar
+
+from .comment_association_strategy import CommentAssociationStrategy
+from .leading_comment_strategy import LeadingCommentStrategy
+from .python_docstring_strategy import PythonDocstringStrategy
+from .toml_comment_strategy import TomlCommentStrategy
+
+
+class CommentStrategyRegistry:
+    """언어 이름으로 주석 연결 전략을 등록/조회한다.
+
+    기본 전략은 각 언어의 주석 컨벤션을 따르며, register()로 교체할 수 있다.
+    """
+
+    _strategies: ClassVar[dict[str, CommentAssociationStrategy]] = {
+        "python": PythonDocstringStrategy(),
+        "javascript": LeadingCommentStrategy(frozenset({"comment"})),
+        "typescript": LeadingCommentStrategy(frozenset({"comment"})),
+        "java": LeadingCommentStrategy(frozenset({"line_comment", "block_comment"})),
+        "kotlin": LeadingCommentStrategy(
+            frozenset({"line_comment", "multiline_comment"})
+        ),
+        "go": LeadingCommentStrategy(
+            frozenset({"comment"}), fallback_to_body_comment=True
+        ),
+        "toml": TomlCommentStrategy(),
+        "shell": LeadingCommentStrategy(frozenset({"comment"})),
+        "objc": LeadingCommentStrategy(frozenset({"comment"})),
+        "css": LeadingCommentStrategy(frozenset({"comment"})),
+        "scss": LeadingCommentStrategy(
+            frozenset({"comment", "single_line_comment", "js_comment"})
+        ),
+        "perl": LeadingCommentStrategy(frozenset({"comment"})),
+        "r": LeadingCommentStrategy(frozenset({"comment"})),
+        "clojure": LeadingCommentStrategy(frozenset({"comment"})),
+        "fortran": LeadingCommentStrategy(frozenset({"comment"})),
+        "solidity": LeadingCommentStrategy(frozenset({"comment"})),
+        "verilog": LeadingCommentStrategy(frozenset({"comment"})),
+        "julia": LeadingCommentStrategy(frozenset({"line_comment", "block_comment"})),
+        "cmake": LeadingCommentStrategy(frozenset({"line_comment", "bracket_comment"})),
+        "makefile": LeadingCommentStrategy(frozenset({"comment"})),
+        "githubactions": LeadingCommentStrategy(frozenset({"comment"})),
+        "erlang": LeadingCommentStrategy(frozenset({"comment"})),
+        "sql": LeadingCommentStrategy(frozenset({"comment", "marginalia"})),
+        "starlark": LeadingCommentStrategy(frozenset({"comment"})),
+        "tcl": LeadingCommentStrategy(frozenset({"comment"})),
+        "pascal": LeadingCommentStrategy(frozenset({"comment"})),
+        "haxe": LeadingCommentStrategy(frozenset({"comment"})),
+        "gleam": LeadingCommentStrategy(
+            frozenset({"comment", "statement_comment", "module_comment"})
+        ),
+        # Nim 문서 주석(`##`)은 Python docstring처럼 본문 첫 줄에 위치
+        "nim": LeadingCommentStrategy(
+            frozenset(
+                {
+                    "comment",
+                    "block_comment",
+                    "documentation_comment",
+                    "block_documentation_comment",
+                }
+            ),
+            fallback_to_body_comment=True,
+        ),
+    }
+
+    @classmethod
+    def register(cls, language: str, strategy: CommentAssociationStrategy) -> None:
+        """언어의 주석 연결 전략을 등록(교체)한다.
+
+        Args:
+            language: 언어 이름
+            strategy: 등록할 전략
+        """
+        cls._strategies[language] = strategy
+
+    @classmethod
+    def get(cls, language: str) -> CommentAssociationStrategy | None:
+        """언어의 주석 연결 전략을 반환한다 (없으면 None)."""
+        return cls._strategies.get(language)
@@ -0,0 +1,142 @@
+"""LeadingCommentStrategy: 선언 위쪽 주석을 연결하는 전략."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .associated_comment import AssociatedComment
+from .comment_association_strategy import CommentAssociationStrategy
+
+
+class LeadingCommentStrategy(CommentAssociationStrategy):
+    """선언 바로 위에 연속으로 위치한 주석들을 연결한다 (Go, Java, JS/TS 등).
+
+    주석과 선언 사이(또는 주석끼리)의 빈 줄 수가 max_blank_lines 이하일 때만
+    같은 주석 블록으로 간주한다. 닫는 구분자 뒤의 줄 주석(`} // end of loop`)과
+    그 아래에 빈 줄 없이 이어지는 줄 주석들은 뒤쪽 주석으로 연결한다.
+    """
+
+    # 본문 내부 첫 주석을 찾을 때 건너뛸 래퍼 노드 타입
+    BODY_WRAPPER_TYPES = frozenset({"statement_list"})
+
+    # 선언을 감싸는 노드 타입 (주석은 래퍼 노드 위쪽에 위치)
+    DECLARATION_WRAPPER_TYPES = frozenset({"export_statement"})
+
+    def __init__(
+        self,
+        comment_types: frozenset[str],
+        max_blank_lines: int = 0,
+        fallback_to_body_comment: bool = False,
+    ) -> None:
+        """전략 초기화.
+
+        Args:
+            comment_types: 주석 노드 타입들
+            max_blank_lines: 주석과 선언 사이에 허용하는 최대 빈 줄 수
+            fallback_to_body_comment: 위쪽 주석이 없을 때 본문 시작 주석을 연결할지
+                여부 (본문 첫 줄에 문서 주석을 두는 컨벤션용)
+        """
+        if max_blank_lines < 0:
+            raise ValueError("max_blank_lines는 0 이상이어야 합니다")
+        self._comment_types = comment_types
+        self._max_blank_lines = max_blank_lines
+        self._fallback_to_body_comment = fallback_to_body_comment
+
+    def find_comment(self, node: Node, source: bytes) -> AssociatedComment | None:
+        """선언 위쪽 주석을 찾고, 없으면 옵션에 따라 본문 시작 주석을 찾는다."""
+        leading = self._collect_leading_comments(node)
+        if leading:
+            return self._create_comment(leading, source, is_leading=True)
+
+        if self._fallback_to_body_comment:
+            inner = self._collect_body_comments(node)
+            if inner:
+                return self._create_comment(inner, source, is_leading=False)
+        return None
+
+    def find_trailing_comment(
+        self, node: Node, source: bytes
+    ) -> AssociatedComment | None:
+        """닫는 구분자 뒤에 이어지는 줄 주석들을 찾는다.
+
+        첫 빈 줄이나 주석이 아닌 노드에서 멈춘다. 아래 줄의 주석들이 빈 줄 없이
+        다음 선언/문장에 붙어 있으면 그 문장의 선행 주석으로 보고, 닫는 구분자와
+        같은 라인의 주석만 연결한다.
+        """
+        anchor = node
+        if node.parent is not None and node.parent.type in (
+            self.DECLARATION_WRAPPER_TYPES
+        ):
+            anchor = node.parent
+
+        comments: list[Node] = []
+        current = anchor
+        sibling = anchor.next_sibling
+        while (
+            sibling is not None
+            and sibling.type in self._comment_types
+            and sibling.start_point[0] == sibling.end_point[0]
+            and sibling.start_point[0] - current.end_point[0] <= 1
+        ):
+            comments.append(sibling)
+            current = sibling
+            sibling = sibling.next_sibling
+
+        if (
+            sibling is not None
+            and sibling.is_named
+            and sibling.start_point[0] - current.end_point[0] == 1
+        ):
+            comments = [
+                comment
+                for comment in comments
+                if comment.start_point[0] == anchor.end_point[0]
+            ]
+        if not comments:
+            return None
+        return self._create_comment(comments, source, is_leading=False)
+
+    def _collect_leading_comments(self, node: Node) -> list[Node]:
+        """선언 바로 위의 연속된 주석 노드들을 위치 순으로 반환한다."""
+        anchor = node
+        if node.parent is not None and node.parent.type in (
+            self.DECLARATION_WRAPPER_TYPES
+        ):
+            anchor = node.parent
+
+        comments: list[Node] = []
+        current = anchor
+        sibling = anchor.prev_sibling
+        while sibling is not None and sibling.type in self._comment_types:
+            if not self._is_within_distance(sibling, current):
+                break
+            comments.insert(0, sibling)
+            current = sibling
+            sibling = sibling.prev_sibling
+        return comments
+
+    def _collect_body_comments(self, node: Node) -> list[Node]:
+        """본문 시작 부분의 연속된 주석 노드들을 반환한다."""
+        body = node.child_by_field_name("body")
+        if body is None:
+            return []
+
+        container = body
+        children = container.named_children
+        while children and children[0].type in self.BODY_WRAPPER_TYPES:
+            container = children[0]
+            children = container.named_children
+
+        comments: list[Node] = []
+        for child in children:
+            if child.type not in self._comment_types:
+                break
+            if comments and not self._is_within_distance(comments[-1], child):
+                break
+            comments.append(child)
+        return comments
+
+    def _is_within_distance(self, upper: Node, lower: Node) -> bool:
+        """두 노드 사이의 빈 줄 수가 허용 범위 이내인지 확인한다."""
+        blank_lines = lower.start_point[0] - upper.end_point[0] - 1
+        return blank_lines <= self._max_blank_lines
@@ -0,0 +1,34 @@
+"""PythonDocstringStrategy: Python docstring을 연결하는 전략."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .associated_comment import AssociatedComment
+from .comment_association_strategy import CommentAssociationStrategy
+
+
+class PythonDocstringStrategy(CommentAssociationStrategy):
+    """함수/클래스 본문의 첫 번째 문자열 리터럴을 docstring으로 연결한다."""
+
+    def find_comment(self, node: Node, source: bytes) -> AssociatedComment | None:
+        """심볼 본문 첫 문장이 문자열 리터럴이면 docstring으로 반환한다."""
+        definition = node
+        if node.type == "decorated_definition":
+            definition = node.child_by_field_name("definition") or node
+
+        body = definition.child_by_field_name("body")
+        if body is None or not body.named_children:
+            return None
+
+        first_statement = body.named_children[0]
+        if (
+            first_statement.type != "expression_statement"
+            or not first_statement.named_children
+            or first_statement.named_children[0].type != "string"
+        ):
+            return None
+
+        return self._create_comment(
+            [first_statement.named_children[0]], source, is_leading=False
+        )
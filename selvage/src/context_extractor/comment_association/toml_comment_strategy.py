@@ -0,0 +1,46 @@
+"""TomlCommentStrategy: TOML 섹션/키 위쪽 주석을 연결하는 전략."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .leading_comment_strategy import LeadingCommentStrategy
+
+
+class TomlCommentStrategy(LeadingCommentStrategy):
+    """TOML의 `#` 주석을 섹션 헤더나 최상위 키에 연결한다.
+
+    TOML 문법에서 `[table]` 헤더 바로 위의 주석은 이전 섹션의 마지막 자식으로
+    파싱되므로, 형제 노드에서 주석을 찾지 못하면 이전 섹션의 끝에 붙은
+    주석들을 확인한다.
+    """
+
+    SECTION_TYPES = frozenset({"table", "table_array_element"})
+
+    def __init__(self, max_blank_lines: int = 0) -> None:
+        """전략 초기화.
+
+        Args:
+            max_blank_lines: 주석과 선언 사이에 허용하는 최대 빈 줄 수
+        """
+        super().__init__(frozenset({"comment"}), max_blank_lines=max_blank_lines)
+
+    def _collect_leading_comments(self, node: Node) -> list[Node]:
+        """형제 주석이 없으면 이전 섹션 끝의 주석들을 반환한다."""
+        comments = super()._collect_leading_comments(node)
+        if comments or node.type not in self.SECTION_TYPES:
+            return comments
+
+        previous = node.prev_sibling
+        if previous is None or previous.type not in self.SECTION_TYPES:
+            return []
+
+        current = node
+        for child in reversed(previous.named_children):
+            if child.type not in self._comment_types:
+                break
+            if not self._is_within_distance(child, current):
+                break
+            comments.insert(0, child)
+            current = child
+        return comments
@@ -0,0 +1,259 @@
+"""ContextBlock: 추출된 컨텍스트 블록 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import dataclass
+
+from .line_range import LineRange
+from .position_convention import PositionConvention
+from .struct_field import StructField
+from .symbol_change_status import SymbolChangeStatus
+from .symbol_cost import SymbolCost
+from .symbol_line_metrics import SymbolLineMetrics
+from .symbol_signature import SymbolSignature
+from .table_test_case import TableTestCase
+from .text_lines import split_lines
+
+
+@dataclass
+class ContextBlock:
+    """추출기가 반환하는 구조화된 컨텍스트 블록.
+
+    문자열로 포맷팅되기 전의 블록 정보를 보존하여
+    렌더링/후처리 단계에서 라인 범위와 심볼 정보를 활용할 수 있게 한다.
+
+    line_range와 changed_lines는 항상 1-based inclusive이며, 다른 표기(0-based,
+    exclusive 끝)가 필요한 도구에는 line_span()/changed_line_numbers()/
+    byte_span()에 PositionConvention을 넘겨 변환한 값을 전달한다.
+
+    Attributes:
+        text: 블록 텍스트 (dedent 옵션이 켜지면 공통 선행 공백을 제거한 텍스트)
+        line_range: 원본 파일(cross-file 블록은 출처 파일) 기준 블록 라인 범위
+        is_dependency: import 등 의존성 블록인지 여부
+        block_type: 블록 AST 노드 타입
+        name: 언어별 SymbolNameFormatter로 만든 표시용 심볼 이름
+        reason: 참고용으로 함께 포함된 블록의 포함 사유 (변경된 블록은 None)
+        doc_comment: 주석 연결 옵션으로 심볼에 연결된 문서 주석
+        changed_lines: 블록 안에서 실제 변경된 라인 번호들 (헤더에 표시)
+        key_paths: 설정 파일(TOML 등) 블록에서 변경된 키들의 점 구분 전체 경로
+        change_status: diff 추가/삭제 라인으로 판단한 변경 상태 (헤더에 표시)
+        added_line_count: diff 라인 정보가 있을 때 블록 안의 추가된 라인 수
+        deleted_line_count: diff 라인 정보가 있을 때 블록 안의 삭제된 라인 수
+        has_additions: 추가된 라인을 하나라도 포함하는지 여부
+        has_deletions: 삭제된 라인을 하나라도 포함하는지 여부 (리팩터링/제거 구분)
+        signature: 시그니처 파싱 옵션으로 구조화한 파라미터/반환 타입
+        scope_path: 언어별 resolver가 계산한 감싸는 선언/섹션 이름들 (바깥쪽부터)
+        package_declaration: package 선언 옵션으로 블록 앞에 표시할 package 선언
+        source_path: 외부 SymbolResolver가 다른 파일에서 찾은 정의의 출처 경로
+        anonymized_identifier_count: 익명화로 토큰이 된 서로 다른 식별자 수
+        line_metrics: 라인 지표 옵션으로 센 코드/주석/빈 라인 수 (헤더에 표시)
+        changed_fields: Go 구조체 블록에서 변경 라인이 속한 필드들 (헤더에 표시)
+        changed_cases: Go 테스트 함수에서 변경 라인이 속한 테이블 테스트 케이스들
+        recursive: 함수 본문이 자기 이름을 직접 호출하는지 여부 (상호 재귀 제외)
+        qualified_name: SymbolNameFormatter로 만든 감싸는 선언/package 한정 이름
+        depth_limited: max_nesting_depth를 넘어 scope_path를 자른 경우 True
+        uses_goroutine: Go 동시성 표시 옵션에서 블록에 `go` 문이 있는지 여부
+        uses_channel: Go 동시성 표시 옵션에서 블록이 채널을 쓰는지 여부
+        uses_mutex: Go 동시성 표시 옵션에서 블록이 뮤텍스를 쓰는지 여부
+        undedented_text: dedent 옵션으로 공통 선행 공백을 제거하기 전 텍스트
+        cost: 비용 옵션으로 계산한 바이트/토큰/복잡도 지표와 가중합 비용
+        size_capped: 심볼 크기 상한을 넘어 시그니처와 변경 주변만 남겼는지 여부
+        unit_section: Pascal unit에서 블록이 속한 섹션 (interface/implementation)
+        section: 구역 이름 옵션으로 찾은 가장 가까운 배너 주석의 구역 이름
+    """
+
+    text: str
+    line_range: LineRange
+    is_dependency: bool = False
+    block_type: str | None = None
+    name: str | None = None
+    reason: str | None = None
+    doc_comment: str | None = None
+    changed_lines: tuple[int, ...] = ()
+    key_paths: tuple[str, ...] = ()
+    change_status: SymbolChangeStatus | None = None
+    added_line_count: int = 0
+    deleted_line_count: int = 0
+    has_additions: bool = False
+    has_deletions: bool = False
+    signature: SymbolSignature | None = None
+    scope_path: tuple[str, ...] = ()
+    package_declaration: str | None = None
+    source_path: str | None = None
+    anonymized_identifier_count: int = 0
+    line_metrics: SymbolLineMetrics | None = None
+    changed_fields: tuple[StructField, ...] = ()
+    changed_cases: tuple[TableTestCase, ...] = ()
+    recursive: bool = False
+    qualified_name: str | None = None
+    depth_limited: bool = False
+    uses_goroutine: bool = False
+    uses_channel: bool = False
+    uses_mutex: bool = False
+    undedented_text: str | None = None
+    cost: SymbolCost | None = None
+    size_capped: bool = False
+    unit_section: str | None = None
+    section: str | None = None
+
+    def format(self, block_number: int) -> str:
+        """extract_contexts 출력 형식(구분선 헤더 + 본문)으로 블록을 포맷팅한다.
+
+        헤더 형식은 header()를 따르며, 변경 라인이나 Go 필드/케이스 같은 표시가
+        기록된 블록은 옵션 도입 이전 출력과 헤더가 다를 수 있다.
+
+        Args:
+            block_number: 컨텍스트 블록 번호 (의존성 블록에서는 무시됨)
+
+        Returns:
+            구분선 헤더가 포함된 블록 문자열
+        """
+        return f"{self.header(block_number)}\n{self.body()}"
+
+    def body(self) -> str:
+        """package 선언(있는 경우)을 앞에 붙인 블록 본문을 반환한다."""
+        if self.package_declaration is None or self.is_dependency:
+            return self.text
+        return f"{self.package_declaration}\n{self.text}"
+
+    def line_span(
+        self, convention: PositionConvention | None = None
+    ) -> tuple[int, int]:
+        """블록의 라인 범위를 표기 규칙에 맞춘 (시작 라인, 끝 라인)으로 반환한다.
+
+        Args:
+            convention: 라인 번호 표기 규칙 (None이면 1-based inclusive)
+
+        Returns:
+            (시작 라인, 끝 라인) 튜플
+        """
+        return (convention or PositionConvention()).line_span(self.line_range)
+
+    def changed_line_numbers(
+        self, convention: PositionConvention | None = None
+    ) -> tuple[int, ...]:
+        """변경 라인 번호들을 표기 규칙의 라인 기준으로 바꿔 반환한다."""
+        convention = convention or PositionConvention()
+        return tuple(convention.line(line) for line in self.changed_lines)
+
+    def byte_span(
+        self, content: str, convention: PositionConvention | None = None
+    ) -> tuple[int, int]:
+        """블록이 걸친 라인들의 UTF-8 바이트 범위를 표기 규칙에 맞춰 반환한다.
+
+        라인 단위로 계산하므로 블록이 라인 중간에서 시작/끝나도 시작 라인의
+        처음부터 끝 라인의 줄바꿈 앞까지를 범위로 본다.
+
+        Args:
+            content: 블록을 추출한 파일 내용 (source_path가 있으면 그 파일)
+            convention: 바이트 오프셋 표기 규칙 (None이면 0-based exclusive)
+
+        Returns:
+            (시작 오프셋, 끝 오프셋) 튜플
+
+        Raises:
+            ValueError: 파일 내용이 블록의 끝 라인보다 짧은 경우
+        """
+        lines = split_lines(content)
+        if self.line_range.end_line > len(lines):
+            raise ValueError("파일 내용이 블록의 라인 범위보다 짧습니다")
+        start_byte = sum(
+            len(line.encode("utf-8")) + 1
+            for line in lines[: self.line_range.start_line - 1]
+        )
+        end_byte = start_byte + len(
+            "\n".join(
+                lines[self.line_range.start_line - 1 : self.line_range.end_line]
+            ).encode("utf-8")
+        )
+        return (convention or PositionConvention()).byte_span(start_byte, end_byte)
+
+    def header(self, block_number: int, include_name: bool = False) -> str:
+        """블록의 구분선 헤더를 만든다.
+
+        Args:
+            block_number: 컨텍스트 블록 번호 (의존성 블록에서는 무시됨)
+            include_name: 심볼 이름을 헤더에 표시할지 여부
+
+        Returns:
+            구분선 헤더 문자열
+        """
+        if self.is_dependency:
+            return "---- Dependencies/Imports ----"
+
+        header = (
+            f"---- Context Block {block_number} "
+            f"(Lines {self.line_range.start_line}-{self.line_range.end_line})"
+        )
+        if include_name and self.name:
+            header += f": {self.name}"
+        if self.source_path is not None:
+            header += f" [from {self.source_path}]"
+        if self.section is not None:
+            header += f" [section: {self.section}]"
+        if self.changed_lines:
+            header += f" [changed: {self._format_changed_lines()}]"
+        if self.changed_fields:
+            field_names = ", ".join(field.name for field in self.changed_fields)
+            header += f" [fields: {field_names}]"
+        if self.changed_cases:
+            case_labels = ", ".join(case.label for case in self.changed_cases)
+            header += f" [cases: {case_labels}]"
+        if self.change_status is not None:
+            header += (
+                f" [{self.change_status.value}: +{self.added_line_count}"
+                f"/-{self.deleted_line_count}]"
+            )
+        if self.line_metrics is not None:
+            header += (
+                f" [loc: {self.line_metrics.code_lines}, "
+                f"comments: {self.line_metrics.comment_ratio:.0%}]"
+            )
+        if self.depth_limited:
+            header += " [depth-limited]"
+        if self.size_capped:
+            header += " [size-capped]"
+        concurrency = self._concurrency_labels()
+        if concurrency:
+            header += f" [concurrency: {', '.join(concurrency)}]"
+        return f"{header} ----"
+
+    def _concurrency_labels(self) -> list[str]:
+        """블록에서 사용하는 동시성 구문의 표시 이름들을 반환한다."""
+        flags = (
+            ("goroutine", self.uses_goroutine),
+            ("channel", self.uses_channel),
+            ("mutex", self.uses_mutex),
+        )
+        return [label for label, used in flags if used]
+
+    def _format_changed_lines(self) -> str:
+        """변경 라인 번호들을 연속 구간으로 묶어 표시한다 (예: "3-5, 9")."""
+        spans: list[str] = []
+        lines = sorted(set(self.changed_lines))
+        start = previous = lines[0]
+        for line in [*lines[1:], None]:
+            if line is not None and line == previous + 1:
+                previous = line
+                continue
+            spans.append(str(start) if start == previous else f"{start}-{previous}")
+            if line is not None:
+                start = previous = line
+        return ", ".join(spans)
+
+    @staticmethod
+    def format_blocks(blocks: Sequence[ContextBlock]) -> list[str]:
+        """블록들을 extract_contexts 문자열 리스트 형식으로 포맷팅한다.
+
+        Args:
+            blocks: 포맷팅할 블록들
+
+        Returns:
+            의존성 블록 다음에 번호가 매겨진 컨텍스트 블록들이 오는 문자열 리스트
+        """
+        contexts = [block.format(0) for block in blocks if block.is_dependency]
+        context_blocks = [block for block in blocks if not block.is_dependency]
+        for block_number, block in enumerate(context_blocks, 1):
+            contexts.append(block.format(block_number))
+        return contexts
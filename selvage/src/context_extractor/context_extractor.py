@@ -4,15 +4,63 @@ from __future__ import annotations
 
 import logging
 import re
-from collections.abc import Generator, Sequence
+import textwrap
+from collections.abc import Callable, Generator, Mapping, Sequence
+from dataclasses import replace
 
-from tree_sitter import Language, Node, Parser
+from tree_sitter import Language, Node, Parser, Tree
 from tree_sitter_language_pack import get_language, get_parser
 
-from selvage.src.exceptions import UnsupportedLanguageError
-
+from selvage.src.exceptions import (
+    AmbiguousSymbolError,
+    ParseTimeoutError,
+    StrictParseError,
+    SymbolNotFoundError,
+    UnsupportedLanguageError,
+)
+
+from .assembly_label_resolver import AssemblyLabelResolver
+from .call_graph_orderer import CallGraphOrderer
+from .call_site_finder import CallSiteFinder
+from .comment_association import AssociatedComment, CommentStrategyRegistry
+from .context_block import ContextBlock
+from .diff_line_changes import DiffLineChanges
+from .dockerfile_stage_resolver import DockerfileStageResolver
+from .embedded_sql_resolver import EmbeddedSqlResolver
+from .extracted_file_context import ExtractedFileContext
+from .extraction_options import ExtractionOptions
+from .file_rename import FileRename
+from .github_actions_step_resolver import GitHubActionsStepResolver
+from .identifier_anonymizer import IdentifierAnonymizer
+from .indent_style import IndentStyle
+from .julia_scope_resolver import JuliaScopeResolver
 from .line_range import LineRange
+from .markdown_section_resolver import MarkdownSectionResolver
 from .meaningless_change_filter import MeaninglessChangeFilter
+from .metrics import ExtractionMetrics, ExtractionMetricsRecorder
+from .node_order import node_order_key
+from .overridden_method_resolver import OverriddenMethodResolver
+from .parse_deadline import ParseDeadline
+from .recursive_call_detector import RecursiveCallDetector
+from .resolved_symbol import ResolvedSymbol
+from .scope_resolver import ScopeResolver
+from .scope_resolver_registry import ScopeResolverRegistry
+from .section_banner_detector import SectionBannerDetector
+from .signature_parser import SignatureParser
+from .signature_type_collector import SignatureTypeCollector
+from .struct_field import StructField
+from .symbol_change_classifier import SymbolChangeClassifier
+from .symbol_cost import SymbolCost
+from .symbol_line_metrics import SymbolLineMetrics
+from .symbol_name_formatter import SymbolNameFormatter
+from .symbol_name_parts import SymbolNameParts
+from .symbol_revision_matcher import SymbolRevisionMatcher
+from .symbol_revision_pair import SymbolRevisionPair
+from .symbol_signature import SymbolSignature
+from .symbol_visibility_resolver import SymbolVisibilityResolver
+from .table_test_case import TableTestCase
+from .text_lines import node_text, split_lines
+from .token_estimate import estimate_tokens
 
 logger = logging.getLogger(__name__)
 
@@ -24,10 +72,56 @@ class ContextExtractor:
     - 파일을 한 번만 읽어서 처리
     - node.text로 직접 코드 추출
     - 강화된 타입 안전성과 에러 핸들링
+    - 변경 범위(hunk)마다 모든 변경 라인을 감싸는 가장 작은 이름 있는 심볼을
+      선택하고, 그런 심볼이 없을 때만 라인별 블록으로 넓힘
+      (ExtractionOptions.ancestor_depth로 바깥쪽 심볼 선택)
     """
 
     # 지원 프로그래밍 언어 목록
-    SUPPORTED_LANGUAGES = ["python", "javascript", "typescript", "java", "kotlin"]
+    # PromptGenerator는 이 목록의 언어를 FallbackContextExtractor 대신 AST 기반
+    # 추출로 처리한다 (Go도 이 목록에 추가되면서 텍스트 기반 추출에서 전환됨)
+    SUPPORTED_LANGUAGES = [
+        "python",
+        "javascript",
+        "typescript",
+        "java",
+        "kotlin",
+        "go",
+        "toml",
+        "shell",
+        "objc",
+        "css",
+        "scss",
+        "perl",
+        "r",
+        "markdown",
+        "nim",
+        "assembly",
+        "dockerfile",
+        "clojure",
+        "fortran",
+        "solidity",
+        "verilog",
+        "julia",
+        "cmake",
+        "makefile",
+        "githubactions",
+        "erlang",
+        "sql",
+        "starlark",
+        "tcl",
+        "pascal",
+        "haxe",
+        "gleam",
+    ]
+
+    # tree-sitter 문법 이름이 언어 이름과 다른 경우의 매핑
+    LANGUAGE_GRAMMAR_NAMES = {
+        "shell": "bash",
+        "assembly": "asm",
+        "makefile": "make",
+        "githubactions": "yaml",
+    }
 
     # 언어별 블록 타입 매핑
     LANGUAGE_BLOCK_TYPES = {
@@ -67,6 +161,8 @@ class ContextExtractor:
                 "interface_declaration",
                 "type_alias_declaration",
                 "namespace_declaration",
+                "internal_module",  # namespace Foo { ... }
+                "module",  # module Foo { ... }, declare module "foo" { ... }
                 "enum_declaration",
                 "arrow_function",
                 "program",
@@ -108,6 +204,239 @@ class ContextExtractor:
                 "package_header",
             }
         ),
+        "go": frozenset(
+            {
+                "function_declaration",
+                "method_declaration",
+                "func_literal",
+                "type_declaration",
+                "import_declaration",
+                "package_clause",
+            }
+        ),
+        "toml": frozenset(
+            {
+                "document",
+                "table",
+                "table_array_element",
+            }
+        ),
+        "shell": frozenset(
+            {
+                "program",
+                "function_definition",
+            }
+        ),
+        "objc": frozenset(
+            {
+                "translation_unit",
+                "class_interface",
+                "class_implementation",
+                "category_interface",
+                "category_implementation",
+                "protocol_declaration",
+                "method_definition",
+                "method_declaration",
+                "property_declaration",
+                "function_definition",
+                "preproc_include",
+                "module_import",
+            }
+        ),
+        "css": frozenset(
+            {
+                "stylesheet",
+                "rule_set",
+                "media_statement",
+                "supports_statement",
+                "keyframes_statement",
+                "at_rule",
+                "import_statement",
+            }
+        ),
+        "scss": frozenset(
+            {
+                "stylesheet",
+                "rule_set",
+                "media_statement",
+                "supports_statement",
+                "keyframes_statement",
+                "at_rule",
+                "mixin_statement",
+                "function_statement",
+                "import_statement",
+                "use_statement",
+                "forward_statement",
+            }
+        ),
+        "perl": frozenset(
+            {
+                "source_file",
+                "package_statement",
+                "subroutine_declaration_statement",
+                "method_declaration_statement",
+                "anonymous_subroutine_expression",
+                "anonymous_method_expression",
+                "phaser_statement",
+                "use_statement",
+            }
+        ),
+        "r": frozenset({"program", "function_definition"}),
+        "markdown": frozenset(
+            {
+                "atx_heading",
+                "setext_heading",
+                "paragraph",
+                "list_item",
+                "fenced_code_block",
+                "indented_code_block",
+                "pipe_table",
+                "html_block",
+            }
+        ),
+        "nim": frozenset(
+            {
+                "source_file",
+                "proc_declaration",
+                "func_declaration",
+                "method_declaration",
+                "iterator_declaration",
+                "converter_declaration",
+                "template_declaration",
+                "macro_declaration",
+                "type_declaration",
+            }
+        ),
+        # 레이블 블록은 AssemblyLabelResolver가 라인 범위로 계산
+        "assembly": frozenset({"label"}),
+        # 스테이지 블록은 DockerfileStageResolver가 명령어 범위로 계산
+        "dockerfile": frozenset({"from_instruction"}),
+        # job/step 블록은 GitHubActionsStepResolver가 매핑 키로 계산
+        "githubactions": frozenset({"block_mapping_pair", "block_sequence_item"}),
+        # 정의 form은 ClojureFormResolver가 list의 첫 심볼로 판별
+        "clojure": frozenset({"list_lit"}),
+        "fortran": frozenset(
+            {
+                "translation_unit",
+                "program",
+                "module",
+                "submodule",
+                "subroutine",
+                "function",
+                "module_procedure",
+                "derived_type_definition",
+            }
+        ),
+        "solidity": frozenset(
+            {
+                "contract_declaration",
+                "interface_declaration",
+                "library_declaration",
+                "function_definition",
+                "constructor_definition",
+                "fallback_receive_definition",
+                "modifier_definition",
+                "event_definition",
+                "error_declaration",
+                "struct_declaration",
+                "enum_declaration",
+                "state_variable_declaration",
+                "pragma_directive",
+                "import_directive",
+            }
+        ),
+        # always/function/task 밖의 모듈 본문 변경은 VerilogModuleResolver가
+        # 모듈 바로 아래 항목 단위로 반환
+        "verilog": frozenset(
+            {
+                "module_declaration",
+                "function_declaration",
+                "task_declaration",
+                "always_construct",
+                "include_compiler_directive",
+                "package_import_declaration",
+            }
+        ),
+        # 함수 선언(같은 이름/arity의 절 묶음) 밖 변경은 ErlangFormResolver가
+        # 최상위 form 단위로 반환
+        "erlang": frozenset(
+            {
+                "fun_decl",
+                "anonymous_fun",
+                "module_attribute",
+                "export_attribute",
+                "record_decl",
+                "type_alias",
+                "opaque",
+                "spec",
+                "callback",
+                "pp_define",
+                "pp_include",
+                "pp_include_lib",
+            }
+        ),
+        # SQL은 `;`로 끝나는 문장 하나를 블록으로 반환
+        "sql": frozenset({"statement"}),
+        # 정의 밖 모듈 본문 변경과 do 블록은 JuliaScopeResolver가 처리
+        "julia": frozenset(
+            {
+                "module_definition",
+                "function_definition",
+                "short_function_definition",
+                "macro_definition",
+                "struct_definition",
+                "abstract_definition",
+                "import_statement",
+                "using_statement",
+            }
+        ),
+        # 타깃 정의 명령과 최상위 명령은 CMakeScopeResolver가 처리
+        "cmake": frozenset(
+            {
+                "function_def",
+                "macro_def",
+                "if_condition",
+                "foreach_loop",
+                "while_loop",
+                "block_def",
+            }
+        ),
+        "makefile": frozenset(
+            {
+                "rule",
+                "variable_assignment",
+                "shell_assignment",
+                "define_directive",
+                "include_directive",
+            }
+        ),
+        # 규칙 호출과 최상위 대입은 StarlarkRuleResolver가 최상위 문장 단위로 처리
+        "starlark": frozenset({"function_definition", "expression_statement"}),
+        # apply 람다 호출과 namespace 본문의 문장은 TclScopeResolver가 처리
+        "tcl": frozenset({"procedure", "namespace", "command"}),
+        # 메서드 본문(`TStockList.Add`)과 클래스/레코드 타입 선언
+        "pascal": frozenset({"defProc", "declType"}),
+        "haxe": frozenset(
+            {
+                "function_declaration",
+                "class_declaration",
+                "interface_declaration",
+                "enum_declaration",
+                "abstract_declaration",
+                "typedef_declaration",
+            }
+        ),
+        # `pub fn`의 pub(visibility_modifier)과 타입 시그니처는 function 노드에 포함
+        "gleam": frozenset(
+            {
+                "function",
+                "external_function",
+                "type_definition",
+                "type_alias",
+                "external_type",
+                "constant",
+            }
+        ),
     }
 
     # 언어별 의존성 관련 노드 타입들 (import, require 등)
@@ -152,176 +481,3464 @@ class ContextExtractor:
                 "import_list",
             }
         ),
+        "go": frozenset(
+            {
+                "package_clause",
+                "import_declaration",
+            }
+        ),
+        "objc": frozenset(
+            {
+                "preproc_include",
+                "module_import",
+            }
+        ),
+        "css": frozenset({"import_statement"}),
+        "scss": frozenset(
+            {
+                "import_statement",
+                "use_statement",
+                "forward_statement",
+            }
+        ),
+        "perl": frozenset({"use_statement"}),
+        "nim": frozenset(
+            {"import_statement", "import_from_statement", "include_statement"}
+        ),
+        "fortran": frozenset({"use_statement", "include_statement"}),
+        "solidity": frozenset({"pragma_directive", "import_directive"}),
+        "verilog": frozenset(
+            {"include_compiler_directive", "package_import_declaration"}
+        ),
+        "julia": frozenset({"import_statement", "using_statement"}),
+        "makefile": frozenset({"include_directive"}),
+        "erlang": frozenset({"pp_include", "pp_include_lib", "import_attribute"}),
+        "pascal": frozenset({"declUses"}),
+        "haxe": frozenset({"import_statement", "using_statement"}),
+        "gleam": frozenset({"import"}),
+    }
+
+    # 언어별 파일 package 선언 노드 타입
+    LANGUAGE_PACKAGE_TYPES = {
+        "go": "package_clause",
+        "java": "package_declaration",
+        "kotlin": "package_header",
+        "haxe": "package_statement",
+    }
+
+    # minimal_block 모드에서 변경을 감싸는 구분자 블록 노드 타입
+    LANGUAGE_MINIMAL_BLOCK_TYPES = {
+        "python": frozenset({"block"}),
+        "javascript": frozenset(
+            {"statement_block", "class_body", "switch_body", "object"}
+        ),
+        "typescript": frozenset(
+            {"statement_block", "class_body", "switch_body", "object", "object_type"}
+        ),
+        "java": frozenset(
+            {
+                "block",
+                "class_body",
+                "interface_body",
+                "enum_body",
+                "constructor_body",
+                "switch_block",
+            }
+        ),
+        "kotlin": frozenset(
+            {"function_body", "class_body", "enum_class_body", "lambda_literal"}
+        ),
+        "go": frozenset({"block", "field_declaration_list"}),
+        "shell": frozenset(
+            {"compound_statement", "do_group", "if_statement", "case_statement"}
+        ),
+        "objc": frozenset({"compound_statement"}),
+        "css": frozenset({"block"}),
+        "scss": frozenset({"block"}),
+        "perl": frozenset({"block"}),
+        "r": frozenset({"braced_expression"}),
+        "nim": frozenset({"statement_list", "field_declaration_list"}),
+        # case 절(`Ok(x) -> ...`)은 구분자가 없지만 변경을 감싸는 arm 단위로 반환
+        "gleam": frozenset(
+            {"block", "function_body", "case_clause", "data_constructors"}
+        ),
+    }
+
+    # 블록이 헤더 라인 없이 들여쓰기로만 구분되어 부모 노드의 헤더부터 포함할 언어
+    INDENT_BLOCK_LANGUAGES = frozenset({"python", "nim"})
+
+    # 데코레이터 노드 타입 (TypeScript 메서드 데코레이터는 정의의 앞 형제 노드)
+    DECORATOR_TYPE = "decorator"
+
+    # 익명 함수를 이름에 바인딩하는 선언 노드 타입
+    # (Go `f := func() {}`, `var f = func() {}`, JS/TS `const f = () => {}`)
+    NAME_BINDING_TYPES = frozenset(
+        {
+            "short_var_declaration",
+            "var_spec",
+            "assignment_statement",
+            "variable_declarator",
+            "assignment_expression",
+        }
+    )
+
+    # 순환 복잡도를 1씩 늘리는 분기 노드 타입 (조건문, 반복문, case, 예외 처리,
+    # 삼항 연산자, 단락 평가 논리 연산자)
+    DECISION_NODE_TYPES = frozenset(
+        {
+            "if_statement",
+            "elif_clause",
+            "if_expression",
+            "for_statement",
+            "for_in_statement",
+            "enhanced_for_statement",
+            "for_expression",
+            "while_statement",
+            "while_expression",
+            "do_statement",
+            "repeat_while_statement",
+            "guard_statement",
+            "case_clause",
+            "case_statement",
+            "switch_case",
+            "switch_label",
+            "expression_case",
+            "type_case",
+            "communication_case",
+            "when_entry",
+            "catch_clause",
+            "except_clause",
+            "conditional_expression",
+            "ternary_expression",
+            "boolean_operator",
+            "&&",
+            "||",
+        }
+    )
+
+    # adaptive_detail 옵션에서 긴 함수의 생략된 연속 라인을 대신하는 표시
+    ADAPTIVE_OMISSION_MARKER = "... [lines {start}-{end} omitted]"
+
+    # table_case_only 옵션에서 테스트 테이블 케이스 항목 블록의 block_type
+    TABLE_CASE_BLOCK_TYPE = "table_case"
+
+    # 파일 전체 모드에서 반환되는 블록의 block_type
+    WHOLE_FILE_BLOCK_TYPE = "whole_file"
+
+    # include_file_outline 옵션에서 최상위 심볼 개요 블록의 block_type과 포함 사유
+    FILE_OUTLINE_BLOCK_TYPE = "file_outline"
+    FILE_OUTLINE_REASON = "file-outline"
+
+    # 이름 변경 전 파일에서 삭제/이동된 코드의 심볼 블록 포함 사유
+    PRE_RENAME_REASON = "pre-rename"
+
+    # 주석만 바뀐 변경에서 그 주석이 연결된 선언 블록의 포함 사유
+    COMMENT_CHANGE_REASON = "comment-change"
+
+    # 변경된 함수를 같은 파일에서 호출하는 위치 블록의 포함 사유
+    CALL_SITE_REASON = "call-site"
+
+    # Solidity 함수에 적용된 modifier 정의 블록의 포함 사유
+    APPLIED_MODIFIER_REASON = "applied-modifier"
+
+    # 변경된 함수와 이름이 같은 다른 오버로드 블록의 포함 사유
+    SIBLING_OVERLOAD_REASON = "sibling-overload"
+
+    # 변경된 심볼과 소스 위치가 가까운 앞뒤 심볼 블록의 포함 사유
+    NEIGHBOR_SYMBOL_REASON = "neighbor-symbol"
+
+    # 변경된 메서드가 재정의하는 상위 타입 메서드 시그니처 블록의 포함 사유
+    OVERRIDDEN_METHOD_REASON = "overridden-method"
+
+    # 호스트 언어 문자열 리터럴 안에서 찾은 SQL 문장 블록의 포함 사유
+    EMBEDDED_SQL_REASON = "embedded-sql"
+
+    # 언어별로 같은 이름의 오버로드/메서드를 여러 개 선언할 수 있는 함수 노드 타입
+    LANGUAGE_OVERLOAD_TYPES = {
+        "java": frozenset({"method_declaration", "constructor_declaration"}),
+        "kotlin": frozenset({"function_declaration", "secondary_constructor"}),
+        "typescript": frozenset(
+            {
+                "function_declaration",
+                "function_signature",
+                "method_definition",
+                "method_signature",
+                "abstract_method_signature",
+            }
+        ),
+        "solidity": frozenset({"function_definition"}),
+        "julia": JuliaScopeResolver.FUNCTION_TYPES,
+    }
+
+    # 오버로드 선언을 감싸는 노드 타입 (TypeScript `export function f(): void;`)
+    OVERLOAD_WRAPPER_TYPES = frozenset({"export_statement"})
+
+    # 외부 SymbolResolver로 다른 파일에서 찾은 정의 블록의 포함 사유
+    CROSS_FILE_REASON = "cross-file-reference"
+
+    # find_symbol의 정규화된 이름에서 선언 이름들을 잇는 구분자
+    QUALIFIED_NAME_SEPARATOR = "."
+
+    # 언어별 루트 노드 타입 매핑
+    LANGUAGE_ROOT_TYPES = {
+        "python": "module",
+        "java": "program",
+        "javascript": "program",
+        "typescript": "program",
+        "kotlin": "source_file",
+        "go": "source_file",
+        "toml": "document",
+        "shell": "program",
+        "objc": "translation_unit",
+        "css": "stylesheet",
+        "scss": "stylesheet",
+        "perl": "source_file",
+        "r": "program",
+        "markdown": "document",
+        "nim": "source_file",
+        "assembly": "program",
+        "dockerfile": "source_file",
+        "clojure": "source",
+        "fortran": "translation_unit",
+        "solidity": "source_file",
+        "verilog": "source_file",
+        "julia": "source_file",
+        "cmake": "source_file",
+        "makefile": "makefile",
+        "githubactions": "stream",
+        "erlang": "source_file",
+        "sql": "program",
+        "starlark": "module",
+        "tcl": "source_file",
+        "pascal": "root",
+        "haxe": "module",
+        "gleam": "source_file",
+    }
+
+    # 언어별 최상위(스크립트) 코드에서 변경을 감싸는 문장으로 반환할 노드 타입
+    LANGUAGE_TOP_LEVEL_STATEMENT_TYPES = {
+        "python": frozenset(
+            {
+                "for_statement",
+                "while_statement",
+                "if_statement",
+                "try_statement",
+                "with_statement",
+                "match_statement",
+            }
+        ),
+        "javascript": frozenset(
+            {
+                "for_statement",
+                "for_in_statement",
+                "while_statement",
+                "do_statement",
+                "if_statement",
+                "try_statement",
+                "switch_statement",
+            }
+        ),
+        "typescript": frozenset(
+            {
+                "for_statement",
+                "for_in_statement",
+                "while_statement",
+                "do_statement",
+                "if_statement",
+                "try_statement",
+                "switch_statement",
+            }
+        ),
+        "shell": frozenset(
+            {
+                "for_statement",
+                "c_style_for_statement",
+                "while_statement",
+                "if_statement",
+                "case_statement",
+                "command",
+                "pipeline",
+                "list",
+                "variable_assignment",
+                "declaration_command",
+            }
+        ),
     }
 
-    # 언어별 루트 노드 타입 매핑
-    LANGUAGE_ROOT_TYPES = {
-        "python": "module",
-        "java": "program",
-        "javascript": "program",
-        "typescript": "program",
-        "kotlin": "source_file",
-    }
+    # 최상위 문장 탐색 시 함수/클래스 블록으로 취급하지 않는 블록 타입
+    STATEMENT_TRANSPARENT_BLOCK_TYPES = frozenset({"call_expression"})
+
+    def __init__(
+        self, language: str, options: ExtractionOptions | None = None
+    ) -> None:
+        """추출기 초기화.
+
+        Args:
+            language: 지원 언어 (기본값: python)
+            options: 추출 옵션 (기본값: ExtractionOptions())
+
+        Raises:
+            UnsupportedLanguageError: 지원하지 않는 언어인 경우
+        """
+        if language not in self.SUPPORTED_LANGUAGES:
+            raise UnsupportedLanguageError(language)
+
+        try:
+            grammar_name = self.LANGUAGE_GRAMMAR_NAMES.get(language, language)
+            self._language: Language = get_language(grammar_name)
+            self._parser: Parser = get_parser(grammar_name)
+            self._language_name = language
+            self._block_types = self.LANGUAGE_BLOCK_TYPES[language]
+            self._dependency_types = self.LANGUAGE_DEPENDENCY_TYPES.get(
+                language, frozenset()
+            )
+            self._top_level_statement_types = (
+                self.LANGUAGE_TOP_LEVEL_STATEMENT_TYPES.get(language, frozenset())
+            )
+            # 무의미한 변경 필터링 객체
+            # Markdown은 `#`, `*`, `--`로 시작하는 라인도 본문이므로 주석으로 보지 않음
+            self._filter = MeaninglessChangeFilter(
+                detect_comments=language != "markdown"
+            )
+            self._options = options or ExtractionOptions()
+            name_formatters = self._options.name_formatters or {}
+            self._name_formatter = name_formatters.get(language, SymbolNameFormatter())
+            self._signature_type_collector = SignatureTypeCollector(language)
+            self._signature_parser = SignatureParser(language)
+            self._recursive_call_detector = RecursiveCallDetector(language)
+            self._call_site_finder = CallSiteFinder(language)
+            self._call_graph_orderer = CallGraphOrderer()
+            self._section_banner_detector = SectionBannerDetector(
+                self._options.section_banner_patterns
+            )
+            self._overridden_method_resolver = OverriddenMethodResolver(language)
+            self._embedded_sql_resolver = EmbeddedSqlResolver(language)
+            # 문자열 안의 SQL을 추출할 때 처음 필요해지면 만들어 재사용
+            self._embedded_sql_extractor: ContextExtractor | None = None
+            self._identifier_anonymizer = IdentifierAnonymizer(
+                language, self._options.preserve_public_names
+            )
+            self._comment_strategy = CommentStrategyRegistry.get(language)
+            self._visibility_resolver = SymbolVisibilityResolver(language)
+            self._last_metrics: ExtractionMetrics | None = None
+            # 변경을 감싸는 블록, 심볼 이름, scope_path를 언어 규칙으로 계산
+            self._scope_resolver = (
+                ScopeResolverRegistry.get(language) or ScopeResolver()
+            )
+        except Exception as e:
+            raise ValueError(f"언어 '{language}' 초기화 실패: {e}") from e
+
+    @classmethod
+    def get_supported_languages(cls) -> list[str]:
+        """지원하는 언어 목록을 반환한다."""
+        return cls.SUPPORTED_LANGUAGES
+
+    @classmethod
+    def get_block_types_for_language(cls, language: str) -> frozenset[str]:
+        """특정 언어의 블록 타입들을 반환한다."""
+        return cls.LANGUAGE_BLOCK_TYPES.get(language, frozenset())
+
+    @property
+    def last_metrics(self) -> ExtractionMetrics | None:
+        """마지막 추출 호출의 계측 결과 (계측이 꺼져 있으면 None)."""
+        return self._last_metrics
+
+    def _is_root_node(self, node: Node) -> bool:
+        """노드가 루트(전체 파일) 노드인지 확인한다.
+
+        Args:
+            node: 확인할 노드
+
+        Returns:
+            루트 노드 여부
+        """
+        root_type = self.LANGUAGE_ROOT_TYPES.get(self._language_name)
+        return root_type and node.type == root_type
+
+    def extract_contexts(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[str]:
+        """변경된 라인 범위들을 기반으로 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            추출된 컨텍스트 코드 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없거나 파싱 오류
+        """
+        blocks = self.extract_context_blocks(file_content, changed_ranges)
+        return ContextBlock.format_blocks(blocks)
+
+    def extract_file_context(
+        self,
+        file_path: str,
+        file_content: str,
+        changed_ranges: Sequence[LineRange],
+        line_changes: DiffLineChanges | None = None,
+    ) -> ExtractedFileContext:
+        """파일 하나의 컨텍스트를 추출 모드 메타데이터와 함께 반환한다.
+
+        Args:
+            file_path: 파일 경로 (렌더링 헤더에 사용)
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+            line_changes: diff 추가/삭제 라인 정보. 주어지면 각 블록에
+                added/modified/context 변경 상태를 기록한다.
+
+        Returns:
+            파일 단위 추출 결과 (파싱이 제한 시간을 넘기면 블록 없이
+            status가 "timeout"인 결과, strict 옵션에서 변경된 심볼에 구문 오류가
+            있으면 오류 위치가 기록된 status가 "parse-error"인 결과)
+
+        Raises:
+            ValueError: 파일 내용이 없거나 파싱 오류
+        """
+        try:
+            blocks = self._extract_with_metrics(file_content, changed_ranges, file_path)
+        except ParseTimeoutError as e:
+            logger.warning(f"{file_path}: {e.message}, 컨텍스트 추출을 건너뜁니다")
+            return ExtractedFileContext(
+                file_path=file_path,
+                language=self._language_name,
+                metrics=self._last_metrics,
+                status=ExtractedFileContext.TIMEOUT_STATUS,
+            )
+        except StrictParseError as e:
+            logger.warning(f"{file_path}: {e.message}")
+            return ExtractedFileContext(
+                file_path=file_path,
+                language=self._language_name,
+                metrics=self._last_metrics,
+                status=ExtractedFileContext.PARSE_ERROR_STATUS,
+                parse_error_locations=e.locations,
+            )
+        if line_changes is not None:
+            SymbolChangeClassifier(line_changes).annotate(blocks)
+        is_whole_file = any(
+            block.block_type == self.WHOLE_FILE_BLOCK_TYPE for block in blocks
+        )
+        return ExtractedFileContext(
+            file_path=file_path,
+            language=self._language_name,
+            blocks=blocks,
+            extraction_mode=(
+                ExtractedFileContext.WHOLE_FILE_MODE
+                if is_whole_file
+                else ExtractedFileContext.SYMBOL_MODE
+            ),
+            metrics=self._last_metrics,
+            indent_style=IndentStyle.detect(file_content),
+        )
+
+    def extract_renamed_file_context(
+        self,
+        rename: FileRename,
+        old_content: str,
+        new_content: str,
+        changed_ranges: Sequence[LineRange],
+        deleted_ranges: Sequence[LineRange] = (),
+        line_changes: DiffLineChanges | None = None,
+    ) -> ExtractedFileContext:
+        """이름이 바뀐 파일을 하나의 논리적 파일로 보고 컨텍스트를 추출한다.
+
+        변경은 새 경로의 내용에서 심볼 단위로 추출하며, 이전 파일에서 삭제되거나
+        이동된 코드는 이전 내용에서 감싸는 심볼을 찾아 previous_blocks로 함께
+        반환한다. 새 내용에서 같은 이름으로 이미 추출된 심볼은 previous_blocks에서
+        제외하며, 변경되지 않은 심볼은 어느 쪽에서도 추출하지 않는다.
+
+        Args:
+            rename: 이전/새 경로와 유사도
+            old_content: 이름 변경 전 파일 내용
+            new_content: 이름 변경 후 파일 내용
+            changed_ranges: 새 파일 기준 변경 라인 범위들
+            deleted_ranges: 이전 파일 기준 삭제 라인 범위들
+            line_changes: diff 추가/삭제 라인 정보 (새 파일 기준)
+
+        Returns:
+            rename이 기록된 파일 단위 추출 결과 (file_path는 새 경로)
+
+        Raises:
+            ValueError: 파일 내용이 없거나 파싱 오류
+        """
+        result = self.extract_file_context(
+            rename.new_path, new_content, changed_ranges, line_changes
+        )
+        result.rename = rename
+        if result.timed_out or not old_content or not deleted_ranges:
+            return result
+
+        try:
+            old_blocks = self.extract_context_blocks(old_content, deleted_ranges)
+        except ParseTimeoutError as e:
+            logger.warning(f"{rename.old_path}: {e.message}, 이전 심볼 추출을 건너뜁니다")
+            return result
+        # 익명화된 이름은 블록마다 매핑이 달라 비교할 수 없으므로 중복 제거하지 않음
+        new_names = (
+            set()
+            if self._options.anonymize_identifiers
+            else {block.name for block in result.blocks if block.name is not None}
+        )
+        for block in old_blocks:
+            if block.is_dependency or block.reason is not None:
+                continue
+            if block.name is not None and block.name in new_names:
+                continue
+            block.reason = self.PRE_RENAME_REASON
+            result.previous_blocks.append(block)
+        return result
+
+    def extract_context_blocks(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 라인 범위들을 기반으로 구조화된 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            의존성 블록(있는 경우)과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없거나 파싱 오류
+            StrictParseError: strict 옵션에서 변경된 심볼에 구문 오류가 있는 경우
+        """
+        return self._extract_with_metrics(file_content, changed_ranges, None)
+
+    def extract_symbol_revisions(
+        self,
+        old_content: str,
+        new_content: str,
+        symbol_name: str,
+        line_hint: int | None = None,
+        rename_threshold: float = SymbolRevisionMatcher.DEFAULT_RENAME_THRESHOLD,
+    ) -> SymbolRevisionPair:
+        """같은 심볼의 이전 리비전과 새 리비전 텍스트를 함께 추출한다.
+
+        파일 전체 diff 대신 변경된 함수 하나의 전/후 버전만 비교할 때 사용한다.
+        이전 리비전에서 같은 이름을 찾지 못하면 위치와 내용 유사도로 이름이
+        바뀐 심볼을 찾으며, 그래도 없으면 old_block이 None인 결과를 반환한다.
+
+        Args:
+            old_content: 이전 리비전의 파일 내용 (새 파일이면 빈 문자열)
+            new_content: 새 리비전의 파일 내용
+            symbol_name: 새 리비전 기준 심볼 이름
+            line_hint: 같은 이름의 심볼이 여러 개일 때 선택할 새 리비전의
+                라인 번호 (1-based)
+            rename_threshold: 이름이 바뀐 심볼로 인정하는 최소 텍스트 유사도
+
+        Returns:
+            이전/이후 심볼 블록과 매칭 방식을 담은 SymbolRevisionPair
+
+        Raises:
+            ValueError: 새 리비전에서 심볼을 찾지 못한 경우
+            ParseTimeoutError: 파싱이 parse_timeout_seconds를 넘긴 경우
+        """
+        new_blocks = self._collect_symbol_blocks(new_content)
+        candidates = [block for block in new_blocks if block.name == symbol_name]
+        if not candidates:
+            raise ValueError(f"심볼 '{symbol_name}'을(를) 찾을 수 없습니다")
+
+        new_block = candidates[0]
+        if line_hint is not None:
+            new_block = min(
+                candidates,
+                key=lambda block: (
+                    not block.line_range.contains(line_hint),
+                    abs(block.line_range.start_line - line_hint),
+                ),
+            )
+
+        old_blocks = self._collect_symbol_blocks(old_content) if old_content else []
+        new_names = {block.name for block in new_blocks if block.name is not None}
+        return SymbolRevisionMatcher(rename_threshold).match(
+            new_block, old_blocks, new_names
+        )
+
+    def find_symbol(self, file_content: str, qualified_name: str) -> ContextBlock:
+        """정규화된 이름(`SampleCalculator.add_numbers`)으로 심볼 하나를 찾는다.
+
+        정규화된 이름은 감싸는 선언 이름들과 심볼 이름을 바깥쪽부터 `.`으로
+        이은 것이며, Go 메서드처럼 scope_path가 있는 언어는 scope_path를
+        사용한다. 정확히 일치하는 심볼이 없으면 이름 끝부분이 일치하는
+        심볼(`add_numbers`로 `SampleCalculator.add_numbers` 조회)을 찾는다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            qualified_name: 찾을 심볼의 정규화된 이름
+
+        Returns:
+            scope_path가 기록된 심볼 블록
+
+        Raises:
+            SymbolNotFoundError: 이름에 해당하는 심볼이 없는 경우
+            AmbiguousSymbolError: 이름에 해당하는 심볼이 여러 개인 경우
+            ParseTimeoutError: 파싱이 parse_timeout_seconds를 넘긴 경우
+        """
+        tree = self._parse(file_content.encode("utf-8"))
+        symbols = self._collect_qualified_symbols(tree.root_node)
+        matches = [
+            (node, parts)
+            for node, parts in symbols
+            if self.QUALIFIED_NAME_SEPARATOR.join(parts) == qualified_name
+        ]
+        if not matches:
+            suffix = f"{self.QUALIFIED_NAME_SEPARATOR}{qualified_name}"
+            matches = [
+                (node, parts)
+                for node, parts in symbols
+                if self.QUALIFIED_NAME_SEPARATOR.join(parts).endswith(suffix)
+            ]
+        if not matches:
+            raise SymbolNotFoundError(qualified_name)
+        if len(matches) > 1:
+            raise AmbiguousSymbolError(
+                qualified_name,
+                [
+                    f"{self.QUALIFIED_NAME_SEPARATOR.join(parts)}"
+                    f"@{node.start_point[0] + 1}"
+                    for node, parts in matches
+                ],
+            )
+
+        node, parts = matches[0]
+        if node.type == "decorated_definition":
+            text = self._extract_lines_from_original(node, file_content)
+        else:
+            text = node_text(node)
+        block_type, name = self._get_block_kind(node, parts[-1])
+        name, qualified_name = self._format_symbol_names(node, name, parts[:-1])
+        return ContextBlock(
+            text=text,
+            line_range=LineRange(node.start_point[0] + 1, node.end_point[0] + 1),
+            block_type=block_type,
+            name=name,
+            scope_path=parts[:-1],
+            qualified_name=qualified_name,
+        )
+
+    def extract_symbol_context(
+        self, file_path: str, file_content: str, qualified_name: str
+    ) -> ExtractedFileContext:
+        """정규화된 이름의 심볼 하나만 변경된 것으로 보고 컨텍스트를 추출한다.
+
+        리뷰어가 코멘트를 단 심볼의 주변 컨텍스트를 다시 뽑을 때 사용하며,
+        diff 추출과 같은 옵션(주석, 시그니처 타입, 의존성, 예산 등)을 따른다.
+
+        Args:
+            file_path: 파일 경로 (렌더링 헤더에 사용)
+            file_content: 분석할 파일의 (새 리비전) 내용
+            qualified_name: 추출할 심볼의 정규화된 이름
+
+        Returns:
+            심볼의 라인 범위 전체를 변경 범위로 추출한 파일 단위 결과
+
+        Raises:
+            SymbolNotFoundError: 이름에 해당하는 심볼이 없는 경우
+            AmbiguousSymbolError: 이름에 해당하는 심볼이 여러 개인 경우
+            ValueError: 파일 내용이 없거나 파싱 오류
+        """
+        symbol = self.find_symbol(file_content, qualified_name)
+        return self.extract_file_context(file_path, file_content, [symbol.line_range])
+
+    def extract_symbol_outline(
+        self, file_path: str, file_content: str
+    ) -> ExtractedFileContext:
+        """변경 범위와 관계없이 파일의 모든 이름 있는 심볼 블록을 수집한다.
+
+        디렉토리 인덱싱처럼 diff 없이 파일의 심볼 구조가 필요할 때 사용한다.
+
+        Args:
+            file_path: 파일 경로
+            file_content: 분석할 파일의 내용
+
+        Returns:
+            extraction_mode가 "outline"인 파일 단위 결과 (파싱이 제한 시간을
+            넘기면 블록 없이 status가 "timeout"인 결과)
+        """
+        try:
+            blocks = self._collect_symbol_blocks(file_content)
+        except ParseTimeoutError as e:
+            logger.warning(f"{file_path}: {e.message}, 심볼 수집을 건너뜁니다")
+            return ExtractedFileContext(
+                file_path=file_path,
+                language=self._language_name,
+                status=ExtractedFileContext.TIMEOUT_STATUS,
+            )
+        return ExtractedFileContext(
+            file_path=file_path,
+            language=self._language_name,
+            blocks=blocks,
+            extraction_mode=ExtractedFileContext.OUTLINE_MODE,
+        )
+
+    def token_stream(self, text: str) -> tuple[str, ...]:
+        """코드 조각을 파싱해 주석을 제외한 토큰(리프 노드 텍스트)들을 반환한다.
+
+        심볼 간 유사도 비교처럼 공백/주석 차이를 무시하고 코드 구조만
+        비교할 때 사용한다.
+
+        Args:
+            text: 토큰화할 코드 조각 (예: 추출된 블록 텍스트)
+
+        Returns:
+            위치 순의 토큰 문자열 튜플
+
+        Raises:
+            ParseTimeoutError: 파싱이 parse_timeout_seconds를 넘긴 경우
+        """
+        tree = self._parse(text.encode("utf-8"))
+        tokens: list[str] = []
+        for node in self._iter_nodes(tree.root_node):
+            if node.child_count or "comment" in node.type or not node.text:
+                continue
+            tokens.append(node_text(node))
+        return tuple(tokens)
+
+    def _collect_qualified_symbols(
+        self, root: Node
+    ) -> list[tuple[Node, tuple[str, ...]]]:
+        """이름 있는 심볼 노드들을 정규화된 이름 조각과 함께 수집한다.
+
+        Args:
+            root: AST 루트 노드
+
+        Returns:
+            위치 순의 (심볼 노드, 바깥쪽부터의 이름 조각 튜플) 리스트
+        """
+        symbols: list[tuple[Node, tuple[str, ...]]] = []
+        names: dict[int, str] = {}
+        for node in self._iter_nodes(root):
+            if not self._is_symbol_node(node):
+                continue
+            name = self._get_node_name(node)
+            if name is None:
+                continue
+            names[node.id] = name
+            scope_path = self._get_scope_path(node)
+            if not scope_path:
+                # 중첩 깊이 제한을 넘는 바깥쪽 조상은 보지 않는다
+                ancestors: list[str] = []
+                parent = node.parent
+                while (
+                    parent is not None
+                    and len(ancestors) < self._options.max_nesting_depth
+                ):
+                    if parent.id in names:
+                        ancestors.append(names[parent.id])
+                    parent = parent.parent
+                scope_path = tuple(reversed(ancestors))
+            symbols.append((node, (*scope_path, name)))
+        return symbols
+
+    def _is_symbol_node(self, node: Node) -> bool:
+        """노드가 심볼 블록으로 수집할 선언 노드인지 확인한다.
+
+        의존성/루트 노드와 decorated_definition 안쪽의 정의는 제외한다.
+        """
+        excluded_types = self._dependency_types | self.STATEMENT_TRANSPARENT_BLOCK_TYPES
+        if (
+            node.type not in self._block_types
+            or node.type in excluded_types
+            or self._is_root_node(node)
+        ):
+            return False
+        # decorated_definition 안의 정의는 바깥 노드로 한 번만 수집
+        return node.parent is None or node.parent.type != "decorated_definition"
+
+    def _collect_symbol_blocks(self, file_content: str) -> list[ContextBlock]:
+        """파일 안의 이름 있는 심볼 선언을 모두 ContextBlock으로 수집한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+
+        Returns:
+            위치 순으로 정렬된 심볼 블록들의 리스트 (의존성/루트 노드 제외)
+        """
+        tree = self._parse(file_content.encode("utf-8"))
+        return self._symbol_blocks(tree.root_node, file_content)
+
+    def _symbol_blocks(
+        self, root: Node, file_content: str, top_level_only: bool = False
+    ) -> list[ContextBlock]:
+        """AST에서 이름 있는 심볼 선언들을 ContextBlock으로 만든다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 내용
+            top_level_only: 다른 심볼 안에 선언된 심볼(메서드, 중첩 함수 등)을
+                제외할지 여부
+
+        Returns:
+            위치 순으로 정렬된 심볼 블록들의 리스트 (의존성/루트 노드 제외)
+        """
+        blocks: list[ContextBlock] = []
+        for node in self._iter_nodes(root):
+            if not self._is_symbol_node(node):
+                continue
+            if top_level_only and self._has_enclosing_symbol(node):
+                continue
+            name = self._get_node_name(node)
+            if name is None:
+                continue
+
+            if node.type == "decorated_definition":
+                text = self._extract_lines_from_original(node, file_content)
+            else:
+                text = node_text(node)
+            block_type, name = self._get_block_kind(node, name)
+            display_name, qualified_name = self._format_symbol_names(
+                node, name, self._get_scope_path(node)
+            )
+            blocks.append(
+                ContextBlock(
+                    text=text,
+                    line_range=LineRange(
+                        node.start_point[0] + 1, node.end_point[0] + 1
+                    ),
+                    block_type=block_type,
+                    name=display_name,
+                    qualified_name=qualified_name,
+                )
+            )
+        return blocks
+
+    def _has_enclosing_symbol(self, node: Node) -> bool:
+        """노드를 감싸는 다른 심볼 선언이 있는지 확인한다."""
+        parent = node.parent
+        while parent is not None:
+            if self._is_symbol_node(parent):
+                return True
+            parent = parent.parent
+        return False
+
+    def _create_file_outline_block(
+        self, root: Node, file_content: str
+    ) -> ContextBlock | None:
+        """파일의 최상위 심볼들을 한 줄씩 나열한 개요 블록을 만든다.
+
+        각 라인은 `종류 이름 (line N)` 형식이며, 변경된 심볼 수와 관계없이
+        파일마다 하나만 만든다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 내용
+
+        Returns:
+            개요 블록 (최상위 심볼이 없으면 None)
+        """
+        symbols = self._symbol_blocks(root, file_content, top_level_only=True)
+        if not symbols:
+            return None
+        return ContextBlock(
+            text="\n".join(
+                f"{symbol.block_type} {symbol.name} "
+                f"(line {symbol.line_range.start_line})"
+                for symbol in symbols
+            ),
+            line_range=LineRange(
+                symbols[0].line_range.start_line,
+                max(symbol.line_range.end_line for symbol in symbols),
+            ),
+            block_type=self.FILE_OUTLINE_BLOCK_TYPE,
+            reason=self.FILE_OUTLINE_REASON,
+        )
+
+    def _extract_with_metrics(
+        self,
+        file_content: str,
+        changed_ranges: Sequence[LineRange],
+        file_path: str | None,
+    ) -> list[ContextBlock]:
+        """옵션에 따라 계측을 곁들여 컨텍스트 블록들을 추출한다.
+
+        계측이 꺼져 있으면 기록기를 만들지 않고 바로 추출한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들
+            file_path: 계측 결과에 기록할 파일 경로
+
+        Returns:
+            추출된 컨텍스트 블록들의 리스트
+        """
+        self._last_metrics = None
+        if not self._options.metrics_enabled:
+            return self._extract_context_blocks(file_content, changed_ranges, None)
+
+        recorder = ExtractionMetricsRecorder(file_content)
+        try:
+            blocks = self._extract_context_blocks(
+                file_content, changed_ranges, recorder
+            )
+        except (ParseTimeoutError, StrictParseError) as e:
+            self._last_metrics = recorder.finish(
+                [], file_path, timed_out=isinstance(e, ParseTimeoutError)
+            )
+            if self._options.metrics_callback is not None:
+                self._options.metrics_callback(self._last_metrics)
+            raise
+        self._last_metrics = recorder.finish(blocks, file_path)
+        if self._options.metrics_callback is not None:
+            self._options.metrics_callback(self._last_metrics)
+        return blocks
+
+    def _extract_context_blocks(
+        self,
+        file_content: str,
+        changed_ranges: Sequence[LineRange],
+        recorder: ExtractionMetricsRecorder | None,
+    ) -> list[ContextBlock]:
+        """extract_context_blocks의 실제 구현.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들
+            recorder: 계측 기록기 (계측이 꺼져 있으면 None)
+
+        Returns:
+            의존성 블록(있는 경우)과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없거나 파싱 오류
+        """
+        if not changed_ranges:
+            return []
+
+        # 1. file_content 인코딩 처리
+        try:
+            code_bytes = file_content.encode("utf-8")
+        except UnicodeEncodeError as e:
+            raise ValueError(f"파일 인코딩 오류: {e}") from e
+
+        # 2. 1줄 무의미 변경 필터링
+        meaningful_ranges = self._filter.filter_meaningful_ranges_with_file_content(
+            file_content, changed_ranges
+        )
+
+        # 의미있는 변경이 없으면 빈 결과 반환
+        # (옵션: 주석만 바뀐 변경은 파싱 후 연결된 선언으로 해석)
+        resolve_comment_changes = (
+            self._options.include_comment_changes
+            and self._comment_strategy is not None
+        )
+        if not meaningful_ranges and not resolve_comment_changes:
+            return []
+
+        # 옵션: 작은 파일은 심볼 추출 없이 파일 전체를 반환
+        line_count = len(split_lines(file_content))
+        if self._options.allows_whole_file(line_count, len(code_bytes)):
+            return self._anonymize_blocks(
+                [self._create_whole_file_block(file_content, meaningful_ranges)]
+            )
+
+        # 3. AST 파싱
+        parse_started = recorder.now() if recorder is not None else 0.0
+        try:
+            tree = self._parse(code_bytes)
+            if tree.root_node.has_error:
+                logger.warning("파싱 경고: 구문 오류 감지됨")
+        except ParseTimeoutError:
+            raise
+        except Exception as e:
+            raise ValueError(f"파싱 실패: {e}") from e
+        if recorder is not None:
+            recorder.record_parse(parse_started, tree.root_node)
+        query_started = recorder.now() if recorder is not None else 0.0
+
+        # 문서형 언어와 headers_only/minimal_block 옵션은 심볼 탐색 대신 전용
+        # 블록 생성 규칙을 사용
+        create_blocks = self._get_block_builder()
+        if create_blocks is not None:
+            blocks = create_blocks(tree.root_node, file_content, meaningful_ranges)
+            if recorder is not None:
+                recorder.record_query(query_started)
+            return self._finish_blocks(
+                tree.root_node, file_content, blocks, meaningful_ranges
+            )
+
+        # 옵션: 주석 라인만 바뀐 범위는 심볼 탐색 대신 주석 연결 규칙으로 해석
+        comment_only_ranges: list[LineRange] = []
+        if resolve_comment_changes:
+            comment_only_ranges = self._find_comment_only_ranges(
+                tree.root_node, file_content, changed_ranges
+            )
+            meaningful_ranges = [
+                changed_range
+                for changed_range in meaningful_ranges
+                if changed_range not in comment_only_ranges
+            ]
+
+        # 4. 변경 범위의 각 라인에 대해 최소 블록들 찾기
+        context_blocks: set[Node] = set()
+        for changed_range in meaningful_ranges:
+            # 각 LineRange에 대해 최소 노드들 찾기
+            minimal_nodes = self._find_minimal_nodes_for_range(
+                tree.root_node, changed_range
+            )
+            range_blocks: set[Node] = set()
+            for node in minimal_nodes:
+                block = self._get_appropriate_context_for_node(node)
+                if block is not None:
+                    range_blocks.add(block)
+            # 범위 전체를 감싸는 가장 작은 이름 있는 심볼로 좁힘
+            context_blocks.update(
+                self._prefer_enclosing_symbol(
+                    tree.root_node, changed_range, range_blocks
+                )
+            )
+
+        # 4. 의존성 노드들 수집
+        dependency_nodes = self._collect_dependency_nodes(tree.root_node)
+
+        # 5. 포함 관계 중복 블록 제거
+        filtered_blocks = self._filter_nested_blocks(context_blocks)
+
+        # 6. dependency 노드와 겹치는 context 블록 제거
+        filtered_blocks = self._remove_context_dependency_overlap(
+            filtered_blocks, dependency_nodes
+        )
+
+        # 옵션: 변경을 감싸는 사용자 정의 앵커 노드는 심볼 단위와 관계없이 포함
+        anchor_nodes = self._collect_anchor_nodes(tree.root_node, meaningful_ranges)
+        if anchor_nodes:
+            filtered_blocks = self._filter_nested_blocks(
+                filtered_blocks.union(anchor_nodes)
+            )
+
+        # TypeScript: 변경된 선언과 병합되는 같은 이름의 다른 선언들 수집
+        merged_declaration_groups = self._collect_merged_declaration_groups(
+            filtered_blocks
+        )
+        if merged_declaration_groups:
+            filtered_blocks = self._filter_nested_blocks(
+                filtered_blocks.union(*merged_declaration_groups)
+            )
+
+        # 옵션: 바뀐 주석이 연결된 선언 수집 (이미 포함된 블록 안의 선언은 제외)
+        comment_change_nodes = self._collect_comment_change_nodes(
+            tree.root_node, code_bytes, comment_only_ranges, filtered_blocks
+        )
+        if not meaningful_ranges and not comment_change_nodes:
+            return []
+
+        # 7. 옵션: 시그니처가 참조하는 타입 선언 수집 (파일 밖 선언은 resolver 조회)
+        referenced_type_nodes, missing_type_names = self._collect_signature_type_nodes(
+            tree.root_node, filtered_blocks
+        )
+        cross_file_blocks = self._resolve_cross_file_types(
+            missing_type_names,
+            self._options.max_signature_types - len(referenced_type_nodes),
+        )
+
+        # Solidity: 변경된 함수에 적용된 같은 파일의 modifier 정의 수집
+        applied_modifier_nodes = self._collect_applied_modifier_nodes(
+            tree.root_node, filtered_blocks
+        )
+
+        # 옵션: 변경된 함수와 같은 범위에 있는 같은 이름의 오버로드 수집
+        sibling_overload_nodes = self._collect_sibling_overload_nodes(filtered_blocks)
+
+        # 옵션: 변경된 메서드가 재정의하는 상위 타입 메서드 수집 (파일 밖은 resolver)
+        overridden_nodes, overridden_names = self._collect_overridden_method_nodes(
+            tree.root_node, filtered_blocks
+        )
+        cross_file_blocks.extend(
+            self._resolve_overridden_methods(overridden_names, cross_file_blocks)
+        )
+
+        # 멤버 블록을 감싸는 컨테이너 헤더 수집 (컨테이너 resolver가 있는 언어)
+        container_nodes = self._collect_container_nodes(
+            filtered_blocks | set(comment_change_nodes)
+        )
+
+        # 8. 모든 노드들을 합치고 위치 순으로 정렬
+        all_nodes = list(filtered_blocks) + dependency_nodes
+        sorted_nodes = sorted(all_nodes, key=node_order_key)
+        if recorder is not None:
+            recorder.record_query(query_started)
+
+        # 9. 텍스트 추출
+        # 의존성 노드들과 컨텍스트 노드들 분리
+        blocks: list[ContextBlock] = []
+        dependency_texts = []
+        dependency_lines: list[int] = []
+        node_blocks = []
+        comments: dict[Node, AssociatedComment] = {}
+        trailing_comments: dict[Node, AssociatedComment] = {}
+        capped_nodes: list[Node] = []
+        for node in sorted_nodes:
+            block_text = node_text(node)
+
+            # 코틀린 import_header 노드인 경우 주석 제거
+            block_text = self._clean_kotlin_import(
+                node, block_text, set(dependency_nodes)
+            )
+
+            # Python decorated_definition의 원본 파일 직접 추출
+            if (
+                self._language_name == "python"
+                and node.type == "decorated_definition"
+            ):
+                block_text = self._extract_lines_from_original(node, file_content)
+
+            # 의존성 노드인지 컨텍스트 노드인지 구분
+            if self._is_dependency_node(node):
+                dependency_texts.append(block_text)
+                dependency_lines.extend(
+                    (node.start_point[0] + 1, node.end_point[0] + 1)
+                )
+            else:
+                # 형제로 붙은 데코레이터는 인자까지 원문 그대로 포함
+                decorators = self._leading_decorators(node)
+                if decorators:
+                    block_text = code_bytes[
+                        decorators[0].start_byte : node.end_byte
+                    ].decode("utf-8", errors="replace")
+                comment = self._find_associated_comment(node, code_bytes)
+                if comment is not None:
+                    comments[node] = comment
+                    if comment.is_leading:
+                        block_text = code_bytes[
+                            comment.start_byte : node.end_byte
+                        ].decode("utf-8", errors="replace")
+                if self._options.adaptive_detail:
+                    block_text = self._summarize_long_function(
+                        node, block_text, meaningful_ranges
+                    )
+                # 옵션: 크기 상한을 넘는 심볼은 예산과 관계없이 개별로 줄임
+                if self._exceeds_symbol_cap(block_text):
+                    summary = self._summarize_block_text(
+                        node, block_text, meaningful_ranges
+                    )
+                    if len(split_lines(summary)) < len(split_lines(block_text)):
+                        block_text = summary
+                        capped_nodes.append(node)
+                trailing = self._find_trailing_comment(node, code_bytes)
+                if trailing is not None:
+                    trailing_comments[node] = trailing
+                    gap = code_bytes[node.end_byte : trailing.start_byte]
+                    block_text += gap.decode("utf-8", errors="replace") + trailing.text
+                node_blocks.append((block_text, node))
+
+        # 의존성 블록 구성
+        if dependency_texts:
+            blocks.append(
+                ContextBlock(
+                    text=self._merge_dependency_lines(dependency_texts),
+                    line_range=LineRange(min(dependency_lines), max(dependency_lines)),
+                    is_dependency=True,
+                )
+            )
+
+        # 연속 블록 병합 후 참조 블록과 함께 라인 순 정렬
+        context_blocks = self._merge_adjacent_context_blocks(
+            node_blocks, comments, trailing_comments
+        )
+        if capped_nodes:
+            context_blocks = [
+                replace(block, size_capped=True)
+                if any(
+                    block.line_range.contains(node.end_point[0] + 1)
+                    for node in capped_nodes
+                )
+                else block
+                for block in context_blocks
+            ]
+        # 같은 함수의 여러 메서드(Julia 다중 디스패치)를 하나의 블록으로 묶음
+        context_blocks = self._group_dispatch_methods(context_blocks, file_content)
+        # 병합되는 선언(TypeScript interface, namespace 등)들을 하나의 블록으로 묶음
+        if merged_declaration_groups:
+            context_blocks = self._group_merged_declarations(
+                context_blocks, file_content, merged_declaration_groups
+            )
+        context_blocks.extend(
+            self._create_reference_block(node, "referenced-type")
+            for node in referenced_type_nodes
+        )
+        context_blocks.extend(
+            self._create_reference_block(node, self.APPLIED_MODIFIER_REASON)
+            for node in applied_modifier_nodes
+        )
+        context_blocks.extend(
+            self._create_reference_block(node, self.SIBLING_OVERLOAD_REASON)
+            for node in sibling_overload_nodes
+        )
+        context_blocks.extend(
+            self._create_signature_block(node, self.OVERRIDDEN_METHOD_REASON)
+            for node in overridden_nodes
+        )
+        # 옵션: 변경된 심볼과 소스 위치가 가까운 앞뒤 심볼
+        context_blocks.extend(self._create_neighbor_symbol_blocks(filtered_blocks))
+        # 옵션: 변경된 함수를 같은 파일에서 호출하는 위치
+        if self._options.include_call_sites:
+            context_blocks.extend(
+                self._create_call_site_blocks(
+                    tree.root_node, code_bytes, filtered_blocks
+                )
+            )
+        # 옵션: 변경된 문자열 리터럴 안의 SQL 문장
+        if self._options.extract_embedded_sql:
+            context_blocks.extend(
+                self._create_embedded_sql_blocks(tree.root_node, meaningful_ranges)
+            )
+        context_blocks.extend(
+            self._create_comment_change_block(node, comment, code_bytes)
+            for node, comment in comment_change_nodes.items()
+        )
+        context_blocks.extend(
+            self._create_container_header_block(node) for node in container_nodes
+        )
+        context_blocks.sort(key=lambda block: block.line_range.start_line)
+        # 옵션: 변경된 심볼 블록들을 호출 관계 순서(호출하는 쪽이 먼저)로 재배치
+        if self._options.order_by_call_graph:
+            context_blocks = self._order_by_call_graph(context_blocks, filtered_blocks)
+        blocks.extend(context_blocks)
+        # 다른 파일의 정의는 라인 번호 기준이 다르므로 이 파일의 블록들 뒤에 배치
+        blocks.extend(cross_file_blocks)
+
+        # 블록별로 변경된 키의 전체 경로 기록 (TOML)
+        self._annotate_key_paths(tree.root_node, blocks, meaningful_ranges)
+
+        # 구조체 블록별로 변경된 필드와 필드 라인 기록 (Go)
+        self._annotate_struct_fields(tree.root_node, blocks, meaningful_ranges)
+
+        # 테스트 함수 블록별로 변경된 테이블 테스트 케이스 기록 (Go)
+        blocks = self._annotate_table_cases(tree.root_node, blocks, meaningful_ranges)
+
+        # 옵션: 심볼 블록별로 goroutine/채널/뮤텍스 사용 여부 기록 (Go)
+        if self._options.include_concurrency_flags:
+            self._annotate_concurrency(tree.root_node, blocks)
+
+        # 옵션: 각 심볼 블록에 파일의 package 선언 기록
+        if self._options.include_package_declaration:
+            self._annotate_package_declaration(tree.root_node, blocks)
+
+        # 옵션: 심볼 블록별로 앞에서 가장 가까운 배너 주석의 구역 이름 기록
+        if self._options.include_section_labels:
+            self._annotate_sections(tree.root_node, blocks)
+        return self._finish_blocks(
+            tree.root_node, file_content, blocks, meaningful_ranges
+        )
+
+    def _finish_blocks(
+        self,
+        root: Node,
+        file_content: str,
+        blocks: list[ContextBlock],
+        changed_ranges: Sequence[LineRange],
+    ) -> list[ContextBlock]:
+        """AST로 추출한 블록들에 공통 후처리를 적용한다.
+
+        익명화 옵션이 켜진 경우 다른 후처리보다 먼저 식별자를 익명화해 빈 라인
+        제거, dedent, 비용 계산이 익명화된 텍스트를 기준으로 하게 한다. 라인
+        지표 옵션이 켜진 경우 블록별 라인 지표를 기록하고, strict 옵션이 켜진
+        경우 변경된 심볼 안의 구문 오류를 확인한 뒤, 파일 개요
+        옵션이 켜진 경우 최상위 심볼 개요 블록을 맨 앞에 한 번 붙이고, 빈 라인
+        제거 옵션이 켜진 경우 블록 앞뒤의 빈 라인을, dedent 옵션이 켜진 경우
+        블록의 공통 들여쓰기를 제거한다. 비용 옵션이 켜진 경우 마지막으로
+        블록별 비용을 기록한다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 내용
+            blocks: 추출된 블록들
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            후처리된 블록들
+
+        Raises:
+            StrictParseError: strict 옵션에서 변경된 심볼 안에 구문 오류가 있는 경우
+        """
+        blocks = self._anonymize_blocks(blocks)
+        if self._options.include_line_metrics:
+            self._annotate_line_metrics(root, file_content, blocks)
+        self._raise_for_parse_errors(root, blocks, changed_ranges)
+        # 개요는 코드가 아니라 이름 목록이므로 익명화 옵션과 함께 쓰지 않음
+        if (
+            self._options.include_file_outline
+            and not self._options.anonymize_identifiers
+            and blocks
+        ):
+            outline = self._create_file_outline_block(root, file_content)
+            if outline is not None:
+                blocks.insert(0, outline)
+        if self._options.trim_blank_lines:
+            self._trim_blank_lines(blocks)
+        if self._options.dedent_blocks:
+            self._dedent_blocks(file_content, blocks)
+        if self._options.include_cost:
+            self._annotate_costs(root, blocks)
+        return blocks
+
+    def _trim_blank_lines(self, blocks: Sequence[ContextBlock]) -> None:
+        """블록 텍스트 앞뒤의 빈 라인을 제거하고 라인 범위를 맞춘다.
+
+        안쪽의 빈 라인은 유지하며, 빈 라인만 있는 블록과 의존성 블록은 바꾸지
+        않는다. keep_untrimmed_line_ranges 옵션이 꺼져 있으면 line_range를
+        제거한 라인만큼 줄이고, 범위를 벗어난 changed_lines도 뺀다.
+
+        Args:
+            blocks: 빈 라인을 제거할 블록들
+        """
+        for block in blocks:
+            if block.is_dependency:
+                continue
+            # 마지막 빈 라인도 세도록 split_lines 대신 `\n`으로 그대로 나눔
+            lines = block.text.split("\n")
+            kept = [index for index, line in enumerate(lines) if line.strip()]
+            if not kept:
+                continue
+            leading = kept[0]
+            trailing = len(lines) - 1 - kept[-1]
+            if not leading and not trailing:
+                continue
+            block.text = "\n".join(lines[leading : kept[-1] + 1])
+            if self._options.keep_untrimmed_line_ranges:
+                continue
+            block.line_range = LineRange(
+                block.line_range.start_line + leading,
+                max(
+                    block.line_range.start_line + leading,
+                    block.line_range.end_line - trailing,
+                ),
+            )
+            block.changed_lines = tuple(
+                line for line in block.changed_lines if block.line_range.contains(line)
+            )
+
+    def _dedent_blocks(self, file_content: str, blocks: Sequence[ContextBlock]) -> None:
+        """블록 라인들이 공유하는 선행 공백을 제거하고 원본을 따로 기록한다.
+
+        블록 텍스트의 첫 라인은 노드 시작 위치부터라 들여쓰기가 없으므로,
+        파일에서 시작 라인의 들여쓰기를 붙여 계산한다. 공백 문자열이 정확히
+        같은 부분만 지우므로 탭과 공백이 섞이면 공유하는 부분까지만 지운다.
+        다른 파일에서 가져온 블록과 의존성 블록은 바꾸지 않는다.
+
+        Args:
+            file_content: 파일 내용
+            blocks: dedent할 블록들
+        """
+        lines = split_lines(file_content)
+        for block in blocks:
+            if block.is_dependency or block.source_path is not None:
+                continue
+            start_line = block.line_range.start_line
+            file_line = lines[start_line - 1] if start_line <= len(lines) else ""
+            indent = file_line[: len(file_line) - len(file_line.lstrip(" \t"))]
+            if block.text.startswith(indent):
+                indent = ""
+            dedented = textwrap.dedent(indent + block.text)
+            if dedented != block.text:
+                block.undedented_text = block.text
+                block.text = dedented
+
+    def _annotate_costs(self, root: Node, blocks: Sequence[ContextBlock]) -> None:
+        """의존성 블록과 다른 파일의 블록을 제외한 각 블록에 비용 지표를 기록한다.
+
+        바이트 수와 추정 토큰 수는 최종 블록 본문 기준으로 세고, 복잡도는
+        가중치가 있는 경우에만 블록 라인 범위 안에서 시작하는 분기 노드 수에
+        1을 더해 계산한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+        """
+        weights = self._options.cost_weights
+        decision_lines: list[int] = []
+        if weights.includes_complexity:
+            decision_lines = [
+                node.start_point[0] + 1
+                for node in self._iter_nodes(root)
+                if node.type in self.DECISION_NODE_TYPES
+            ]
+
+        for block in blocks:
+            if block.is_dependency or block.source_path is not None:
+                continue
+            body = block.body()
+            byte_size = len(body.encode("utf-8"))
+            token_estimate = estimate_tokens(body)
+            complexity = None
+            if weights.includes_complexity:
+                complexity = 1 + sum(
+                    1 for line in decision_lines if block.line_range.contains(line)
+                )
+            block.cost = SymbolCost(
+                byte_size=byte_size,
+                token_estimate=token_estimate,
+                complexity=complexity,
+                value=weights.combine(byte_size, token_estimate, complexity),
+            )
+
+    def _annotate_line_metrics(
+        self, root: Node, file_content: str, blocks: Sequence[ContextBlock]
+    ) -> None:
+        """의존성/참고용 블록을 제외한 각 블록에 코드/주석/빈 라인 수를 기록한다.
+
+        주석 노드가 걸친 라인과 주석이 아닌 리프 노드가 걸친 라인을 한 번만
+        모아 두고 블록의 라인 범위마다 분류한다. 공백만 있는 라인은 빈 라인,
+        주석이 아닌 토큰이 있으면 코드 라인, 나머지는 주석 라인으로 센다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 내용
+            blocks: 추출된 블록들
+        """
+        lines = split_lines(file_content)
+        code_lines: set[int] = set()
+        for node in self._iter_nodes(root):
+            if node.child_count or "comment" in node.type:
+                continue
+            if node.end_byte == node.start_byte:
+                continue
+            end_row, end_column = node.end_point
+            # 다음 라인 첫 컬럼에서 끝나는 토큰(줄바꿈 등)은 그 라인에 포함하지 않음
+            if end_column == 0 and end_row > node.start_point[0]:
+                end_row -= 1
+            code_lines.update(range(node.start_point[0] + 1, end_row + 2))
+
+        for block in blocks:
+            if (
+                block.is_dependency
+                or block.reason is not None
+                or block.source_path is not None
+            ):
+                continue
+            code_count = comment_count = blank_count = 0
+            line_range = block.line_range
+            for line_no in range(line_range.start_line, line_range.end_line + 1):
+                if line_no > len(lines) or not lines[line_no - 1].strip():
+                    blank_count += 1
+                elif line_no in code_lines:
+                    code_count += 1
+                else:
+                    comment_count += 1
+            block.line_metrics = SymbolLineMetrics(
+                code_lines=code_count,
+                comment_lines=comment_count,
+                blank_lines=blank_count,
+            )
+
+    def _raise_for_parse_errors(
+        self,
+        root: Node,
+        blocks: Sequence[ContextBlock],
+        changed_ranges: Sequence[LineRange],
+    ) -> None:
+        """strict 옵션이 켜진 경우 변경된 심볼 안의 구문 오류를 예외로 알린다.
+
+        변경된 라인이나 변경과 겹쳐 추출된 블록(참고용 블록과 의존성 블록 제외)의
+        라인 범위 안에 있는 가장 바깥 ERROR/MISSING 노드들의 위치를 모은다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Raises:
+            StrictParseError: 변경된 심볼 안에 구문 오류가 있는 경우
+        """
+        if not self._options.strict or not root.has_error:
+            return
+
+        checked_ranges = [
+            *changed_ranges,
+            *(
+                block.line_range
+                for block in blocks
+                if not block.is_dependency
+                and block.reason is None
+                and block.source_path is None
+            ),
+        ]
+        locations: list[tuple[int, int]] = []
+        for node in self._iter_error_nodes(root):
+            start_line = node.start_point[0] + 1
+            end_line = node.end_point[0] + 1
+            if any(
+                line_range.start_line <= end_line and start_line <= line_range.end_line
+                for line_range in checked_ranges
+            ):
+                locations.append((start_line, node.start_point[1] + 1))
+        if locations:
+            raise StrictParseError(locations)
+
+    def _iter_error_nodes(self, node: Node) -> Generator[Node, None, None]:
+        """구문 오류를 포함한 하위 트리에서 가장 바깥 ERROR/MISSING 노드들을 반환한다."""
+        if node.is_error or node.is_missing:
+            yield node
+            return
+        for child in node.children:
+            if child.has_error or child.is_missing:
+                yield from self._iter_error_nodes(child)
+
+    def _anonymize_blocks(self, blocks: list[ContextBlock]) -> list[ContextBlock]:
+        """옵션이 켜진 경우 의존성 블록을 제외한 블록들의 식별자를 익명화한다.
+
+        블록 텍스트를 다시 파싱해 블록마다 독립된 매핑으로 식별자를 바꾸며,
+        원래 이름이 드러나는 블록 이름, 조상 경로, 한정 이름, 문서 주석, dedent
+        전 텍스트, Go 필드/테이블 케이스 이름도 같은 매핑으로 바꾼다.
+        파라미터 이름이 담긴 signature는 제거한다.
+
+        Args:
+            blocks: 추출된 블록들
+
+        Returns:
+            익명화된 블록들 (옵션이 꺼져 있거나 지원하지 않는 언어면 그대로)
+        """
+        anonymizer = self._identifier_anonymizer
+        if not self._options.anonymize_identifiers or not anonymizer.is_supported():
+            return blocks
+
+        for block in blocks:
+            if block.is_dependency:
+                continue
+            code_bytes = block.text.encode("utf-8")
+            tree = self._parse(code_bytes)
+            text, mapping = anonymizer.anonymize(tree.root_node, code_bytes)
+            block.text = text
+            if block.name is not None:
+                block.name = mapping.get(block.name, block.name)
+            block.scope_path = tuple(
+                mapping.get(name, name) for name in block.scope_path
+            )
+            if block.qualified_name is not None:
+                block.qualified_name = self._remap_identifiers(
+                    block.qualified_name, mapping
+                )
+            if block.doc_comment is not None:
+                block.doc_comment = self._remap_identifiers(block.doc_comment, mapping)
+            if block.undedented_text is not None:
+                block.undedented_text = self._remap_identifiers(
+                    block.undedented_text, mapping
+                )
+            block.changed_fields = tuple(
+                replace(field, name=mapping.get(field.name, field.name))
+                for field in block.changed_fields
+            )
+            block.changed_cases = tuple(
+                replace(
+                    case,
+                    name=(
+                        self._remap_identifiers(case.name, mapping)
+                        if case.name is not None
+                        else None
+                    ),
+                    table_name=(
+                        mapping.get(case.table_name, case.table_name)
+                        if case.table_name is not None
+                        else None
+                    ),
+                )
+                for case in block.changed_cases
+            )
+            block.signature = None
+            block.anonymized_identifier_count = len(mapping)
+        return blocks
+
+    @staticmethod
+    def _remap_identifiers(text: str, mapping: Mapping[str, str]) -> str:
+        """텍스트 안에서 단어 단위로 매핑에 있는 이름을 토큰으로 바꾼다.
+
+        Args:
+            text: 바꿀 텍스트 (한정 이름, 주석, 케이스 이름 등)
+            mapping: 원래 이름 → 토큰 매핑
+
+        Returns:
+            매핑된 이름을 모두 토큰으로 바꾼 텍스트
+        """
+        return re.sub(r"\w+", lambda match: mapping.get(match[0], match[0]), text)
+
+    def _parse(self, code_bytes: bytes) -> Tree:
+        """옵션의 시간 제한을 적용해 코드를 파싱한다.
+
+        제한 시간이 지나면 진행 콜백이 tree-sitter 파싱을 중단시키고,
+        다음 파싱이 중단된 지점부터 이어지지 않도록 파서를 초기화한다.
+
+        Args:
+            code_bytes: UTF-8로 인코딩된 코드
+
+        Returns:
+            파싱된 트리
+
+        Raises:
+            ParseTimeoutError: 파싱이 parse_timeout_seconds를 넘긴 경우
+        """
+        timeout_seconds = self._options.parse_timeout_seconds
+        if timeout_seconds is None:
+            return self._parser.parse(code_bytes)
+
+        deadline = ParseDeadline(timeout_seconds)
+        try:
+            tree = self._parser.parse(code_bytes, progress_callback=deadline)
+        except Exception:
+            if not deadline.expired:
+                raise
+            tree = None
+        if tree is None or deadline.expired:
+            self._parser.reset()
+            raise ParseTimeoutError(timeout_seconds)
+        return tree
+
+    def _annotate_key_paths(
+        self,
+        root: Node,
+        blocks: list[ContextBlock],
+        changed_ranges: Sequence[LineRange],
+    ) -> None:
+        """변경 라인이 속한 키의 점 구분 경로를 해당 블록의 key_paths에 기록한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+            changed_ranges: 의미있는 변경 라인 범위들
+        """
+        resolver = self._scope_resolver
+        for block in blocks:
+            if block.is_dependency:
+                continue
+            key_paths: list[str] = []
+            for changed_range in changed_ranges:
+                if not changed_range.overlaps(block.line_range):
+                    continue
+                start_line = max(changed_range.start_line, block.line_range.start_line)
+                end_line = min(changed_range.end_line, block.line_range.end_line)
+                for line_no in range(start_line, end_line + 1):
+                    node = self._find_node_by_line(root, line_no)
+                    if self._is_root_node(node):
+                        continue
+                    key_path = resolver.key_path(node)
+                    if key_path and key_path not in key_paths:
+                        key_paths.append(key_path)
+            block.key_paths = tuple(key_paths)
+
+    def _annotate_struct_fields(
+        self,
+        root: Node,
+        blocks: list[ContextBlock],
+        changed_ranges: Sequence[LineRange],
+    ) -> None:
+        """변경 라인이 속한 구조체 필드를 해당 블록의 changed_fields에 기록한다.
+
+        변경된 필드가 있는 블록은 changed_lines에도 블록 안의 변경 라인을
+        기록해 구조체 안에서 어떤 필드 라인이 바뀌었는지 표시한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+            changed_ranges: 의미있는 변경 라인 범위들
+        """
+        resolver = self._scope_resolver
+        for block in blocks:
+            if block.is_dependency or block.reason is not None:
+                continue
+            fields: dict[str, StructField] = {}
+            for line_no in self._changed_lines_in(block.line_range, changed_ranges):
+                node = self._find_node_by_line(root, line_no)
+                for field in resolver.struct_fields(node):
+                    fields.setdefault(field.name, field)
+            if fields:
+                block.changed_fields = tuple(fields.values())
+                block.changed_lines = self._changed_lines_in(
+                    block.line_range, changed_ranges
+                )
+
+    def _annotate_concurrency(self, root: Node, blocks: list[ContextBlock]) -> None:
+        """각 심볼 블록에 goroutine/채널/뮤텍스 사용 여부를 기록한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+        """
+        concurrency_lines = self._scope_resolver.concurrency_lines(root)
+        if concurrency_lines is None:
+            return
+        goroutine_lines, channel_lines, mutex_lines = concurrency_lines
+        for block in blocks:
+            if block.is_dependency or block.source_path is not None:
+                continue
+            line_range = block.line_range
+            block.uses_goroutine = any(map(line_range.contains, goroutine_lines))
+            block.uses_channel = any(map(line_range.contains, channel_lines))
+            block.uses_mutex = any(map(line_range.contains, mutex_lines))
+
+    def _annotate_table_cases(
+        self,
+        root: Node,
+        blocks: list[ContextBlock],
+        changed_ranges: Sequence[LineRange],
+    ) -> list[ContextBlock]:
+        """변경 라인이 속한 테이블 테스트 케이스를 해당 블록의 changed_cases에 기록한다.
+
+        table_case_only 옵션이 켜져 있고 블록의 변경이 모두 케이스 안에 있으면
+        블록을 변경된 케이스 항목 블록들로 바꾼다. 케이스 블록의 scope_path는 감싸는 함수 이름과
+        테이블 변수 이름이다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            케이스가 기록되거나 케이스 블록으로 바뀐 블록들
+        """
+        resolver = self._scope_resolver
+        annotated: list[ContextBlock] = []
+        for block in blocks:
+            if block.is_dependency or block.reason is not None:
+                annotated.append(block)
+                continue
+            cases: dict[int, tuple[Node, TableTestCase]] = {}
+            changed_outside_cases = False
+            for line_no in self._changed_lines_in(block.line_range, changed_ranges):
+                node = self._find_node_by_line(root, line_no)
+                found = resolver.table_case(node)
+                if found is None:
+                    changed_outside_cases = True
+                else:
+                    cases.setdefault(found[0].id, found)
+            if not cases:
+                annotated.append(block)
+                continue
+            # 케이스 밖의 변경도 있으면 함수 전체가 필요하므로 케이스만 표시
+            if not self._options.table_case_only or changed_outside_cases:
+                block.changed_cases = tuple(case for _, case in cases.values())
+                block.changed_lines = self._changed_lines_in(
+                    block.line_range, changed_ranges
+                )
+                annotated.append(block)
+                continue
+            scope_path = (*block.scope_path, block.name) if block.name else ()
+            annotated.extend(
+                ContextBlock(
+                    text=node_text(node),
+                    line_range=case.line_range,
+                    block_type=self.TABLE_CASE_BLOCK_TYPE,
+                    name=case.label,
+                    scope_path=(
+                        (*scope_path, case.table_name)
+                        if case.table_name
+                        else scope_path
+                    ),
+                    changed_lines=self._changed_lines_in(
+                        case.line_range, changed_ranges
+                    ),
+                )
+                for node, case in cases.values()
+            )
+        return annotated
+
+    def _annotate_package_declaration(
+        self, root: Node, blocks: list[ContextBlock]
+    ) -> None:
+        """파일의 package 선언 라인을 심볼 블록들의 package_declaration에 기록한다.
+
+        package 선언이 없는 언어/파일이면 아무것도 하지 않으며, 의존성 블록과
+        참고용으로 포함된 블록(reason이 있는 블록)은 제외한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+        """
+        package_node = self._find_package_node(root)
+        if package_node is None or package_node.text is None:
+            return
+
+        declaration = node_text(package_node).strip()
+        declaration = declaration.split("\n", 1)[0].rstrip()
+        for block in blocks:
+            if not block.is_dependency and block.reason is None:
+                block.package_declaration = declaration
+
+    def _annotate_sections(self, root: Node, blocks: list[ContextBlock]) -> None:
+        """블록 앞에서 가장 가까운 배너 주석의 구역 이름을 section에 기록한다.
+
+        의존성 블록과 다른 파일에서 가져온 블록은 제외한다.
+
+        Args:
+            root: AST 루트 노드
+            blocks: 추출된 블록들
+        """
+        banners = self._section_banner_detector.find_banners(root)
+        if not banners:
+            return
+        for block in blocks:
+            if block.is_dependency or block.source_path is not None:
+                continue
+            block.section = self._section_banner_detector.section_for(
+                banners, block.line_range.start_line
+            )
+
+    def _find_package_node(self, root: Node) -> Node | None:
+        """파일의 package 선언 노드를 반환한다 (없는 언어/파일이면 None)."""
+        package_type = self.LANGUAGE_PACKAGE_TYPES.get(self._language_name)
+        return next(
+            (child for child in root.named_children if child.type == package_type),
+            None,
+        )
+
+    def _get_block_kind(self, node: Node, name: str | None) -> tuple[str, str | None]:
+        """심볼 블록의 block_type과 블록 이름을 결정한다.
+
+        언어별 resolver가 정한 block_type과 이름을 우선한다. 예를 들어 Go
+        `init` 함수는 한 파일에 여러 개 선언할 수 있으므로 별도 block_type과
+        파일 안의 선언 순서를 붙인 이름(`init#1`)으로 구분하고, Starlark 최상위
+        문장은 규칙 호출("rule")과 대입("assignment")으로 구분한다.
+
+        Args:
+            node: 심볼 노드
+            name: 선언 이름 (없으면 None)
+
+        Returns:
+            (block_type, name) 튜플 (그 밖의 노드는 노드 타입과 선언 이름)
+        """
+        block_type = self._scope_resolver.block_type(node) or node.type
+        return block_type, self._scope_resolver.block_name(node, name)
+
+    def _format_symbol_names(
+        self,
+        node: Node,
+        name: str,
+        scope_path: tuple[str, ...],
+        signature: SymbolSignature | None = None,
+    ) -> tuple[str, str]:
+        """언어별 이름 포맷터로 심볼 블록의 표시 이름과 한정 이름을 만든다.
+
+        Args:
+            node: 심볼 노드 (package 선언을 찾는 데 사용)
+            name: 선언 이름
+            scope_path: 감싸는 선언 이름들
+            signature: 구조화된 시그니처 (없으면 None)
+
+        Returns:
+            (name, qualified_name) 튜플
+        """
+        root = node
+        while root.parent is not None:
+            root = root.parent
+        package = None
+        package_node = self._find_package_node(root)
+        if package_node is not None and package_node.text is not None:
+            # `package main`, `package com.example;`에서 키워드를 뺀 이름
+            declaration = node_text(package_node)
+            words = declaration.split("\n", 1)[0].split(maxsplit=1)
+            package = words[1].rstrip("; ") if len(words) > 1 else None
+        parts = SymbolNameParts(
+            name=name,
+            package=package,
+            owner_types=scope_path,
+            signature=signature,
+        )
+        return (
+            self._name_formatter.display_name(parts),
+            self._name_formatter.qualified_name(parts),
+        )
+
+    def _get_block_builder(
+        self,
+    ) -> Callable[[Node, str, Sequence[LineRange]], list[ContextBlock]] | None:
+        """심볼 탐색 대신 사용할 블록 생성 메서드를 반환한다.
+
+        Markdown, 어셈블리, Dockerfile, GitHub Actions 워크플로는 심볼이 아닌
+        문서 구조 단위로 블록을 만들고, 그 밖의 언어는 headers_only와
+        minimal_block 옵션이 켜진 경우에만 전용 규칙을 사용한다.
+
+        Returns:
+            (루트 노드, 파일 내용, 변경 범위)를 받아 블록 리스트를 만드는 메서드
+            (심볼 단위로 추출하면 None)
+        """
+        document_builders = {
+            # 변경을 감싸는 본문 블록과 섹션 헤딩 경로
+            "markdown": self._create_markdown_blocks,
+            # 변경을 감싸는 전역 레이블 블록과 섹션 지시어
+            "assembly": self._create_assembly_blocks,
+            # 변경된 명령어 주변 명령어와 감싸는 스테이지 헤더
+            "dockerfile": self._create_dockerfile_blocks,
+            # 변경을 감싸는 step과 job 헤더
+            "githubactions": self._create_workflow_blocks,
+        }
+        document_builder = document_builders.get(self._language_name)
+        if document_builder is not None:
+            return document_builder
+        # 옵션: 변경된 심볼과 감싸는 선언들의 여는 라인만 반환
+        if self._options.headers_only:
+            return self._create_header_blocks
+        # 옵션: 심볼 대신 변경을 감싸는 가장 작은 구분자 블록만 반환
+        if self._options.minimal_block:
+            return self._create_minimal_blocks
+        return None
+
+    def _create_minimal_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경 라인마다 감싸는 가장 작은 구분자 블록을 찾아 ContextBlock으로 만든다.
+
+        다른 블록에 포함되는 블록은 제거하며, 블록 텍스트는 여는/닫는 구분자가
+        있는 라인 전체를 원본에서 잘라 만든다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 ContextBlock 리스트
+        """
+        # LineRange는 해시할 수 없으므로 (시작, 끝) 라인 튜플을 키로 사용
+        spans: dict[tuple[int, int], str] = {}
+        for changed_range in changed_ranges:
+            for node in self._find_minimal_nodes_for_range(root, changed_range):
+                span = self._find_minimal_block_span(node)
+                if span is not None:
+                    line_range, block_type = span
+                    key = (line_range.start_line, line_range.end_line)
+                    spans.setdefault(key, block_type)
+
+        outermost = [
+            (start, end)
+            for start, end in spans
+            if not any(
+                (other_start, other_end) != (start, end)
+                and other_start <= start
+                and end <= other_end
+                for other_start, other_end in spans
+            )
+        ]
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        for start, end in sorted(outermost):
+            line_range = LineRange(start, end)
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(lines[start - 1 : end]),
+                    line_range=line_range,
+                    block_type=spans[(start, end)],
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                )
+            )
+        return blocks
+
+    def _create_header_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경 라인을 감싸는 심볼들의 여는 라인만 담은 블록들을 만든다.
+
+        변경 라인마다 가장 작은 노드에서 조상을 따라가며 심볼 선언을 모으고,
+        Go 메서드는 같은 파일의 receiver 타입 선언도 앞에 더한다. 여러 변경
+        라인이 같은 선언을 공유하면 한 번만 포함한다. 블록 텍스트는 선언이
+        시작하는 라인 전체이므로 `x := func() {`처럼 라인 중간에서 시작하는
+        선언도 앞부분을 함께 보여준다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 헤더 ContextBlock 리스트
+        """
+        scopes: dict[int, Node] = {}
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                current: Node | None = self._find_node_by_line(root, line_no)
+                while current is not None and not self._is_root_node(current):
+                    if self._is_header_scope(current):
+                        scopes.setdefault(current.id, current)
+                        receiver_spec = self._find_receiver_type_spec(root, current)
+                        if receiver_spec is not None:
+                            scopes.setdefault(receiver_spec.id, receiver_spec)
+                    current = current.parent
+
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        seen_lines: set[int] = set()
+        for node in sorted(scopes.values(), key=lambda n: n.start_byte):
+            line_range = self._header_line_range(node)
+            # Go `type X struct`의 선언과 명세처럼 같은 라인에서 여는 선언은 한 번만
+            if line_range.start_line in seen_lines:
+                continue
+            seen_lines.add(line_range.start_line)
+            name = self._get_node_name(node)
+            if node.type in self._scope_resolver.TYPE_SPEC_TYPES:
+                name_node = node.child_by_field_name("name")
+                name = (
+                    node_text(name_node)
+                    if name_node is not None and name_node.text is not None
+                    else None
+                )
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(
+                        lines[line_range.start_line - 1 : line_range.end_line]
+                    ).rstrip(),
+                    line_range=line_range,
+                    block_type=node.type,
+                    name=name,
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                )
+            )
+        return blocks
+
+    def _is_header_scope(self, node: Node) -> bool:
+        """headers_only 모드에서 여는 라인을 포함할 심볼 선언인지 확인한다.
+
+        decorated_definition은 데코레이터 대신 안쪽 정의의 여는 라인을 쓰도록
+        제외한다.
+        """
+        excluded_types = self._dependency_types | self.STATEMENT_TRANSPARENT_BLOCK_TYPES
+        return (
+            node.type in self._block_types
+            and node.type not in excluded_types
+            and node.type != "decorated_definition"
+        )
+
+    def _find_receiver_type_spec(self, root: Node, node: Node) -> Node | None:
+        """메서드면 같은 파일의 receiver 타입 명세 노드를 반환한다 (Go)."""
+        resolver = self._scope_resolver
+        receiver_type = resolver.receiver_type(node)
+        if receiver_type is None:
+            return None
+        return resolver.find_type_spec(root, receiver_type)
+
+    def _header_line_range(self, node: Node) -> LineRange:
+        """선언 노드의 시작 라인부터 본문이 열리는 라인까지의 범위를 반환한다.
+
+        본문이 다음 라인에서 시작하는 들여쓰기 언어(Python 등)는 본문 앞
+        라인까지, 본문이 선언과 같은 라인의 `{`로 시작하면 그 라인까지이다.
+        본문 필드가 없는 선언은 시작 라인 하나이다.
+        """
+        start_line = node.start_point[0] + 1
+        body = self._overridden_method_resolver.signature_end(node)
+        if body is None or body.start_byte <= node.start_byte:
+            return LineRange(start_line, start_line)
+        signature = (node.text or b"")[: body.start_byte - node.start_byte]
+        body_row = body.start_point[0]
+        # 본문 앞에 같은 라인의 코드(`{` 앞의 시그니처)가 있으면 그 라인까지 포함
+        opens_on_line = signature.rsplit(b"\n", 1)[-1].strip() != b""
+        end_line = body_row + 1 if opens_on_line else body_row
+        return LineRange(start_line, max(start_line, end_line))
+
+    def _create_markdown_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """Markdown 변경 라인을 감싸는 본문 블록과 섹션 헤딩 경로 블록을 만든다.
+
+        변경 라인마다 가장 안쪽 목록 항목 또는 문단(헤딩, 표 등)을 찾고,
+        다른 블록에 포함되는 블록은 제거한다. 코드 펜스는 본문과 섞지 않고
+        펜스 전체를 별도 블록으로 반환한다. 블록이 속한 섹션의 헤딩 계층은
+        scope_path에 기록하고, 가장 가까운 헤딩 라인에 `# Guide > ## Install`
+        형태의 경로 블록을 함께 포함한다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 ContextBlock 리스트
+        """
+        resolver = MarkdownSectionResolver()
+        # LineRange는 해시할 수 없으므로 (시작, 끝) 라인 튜플을 키로 사용
+        spans: dict[tuple[int, int], Node] = {}
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                node = resolver.find_block(root, line_no)
+                if node is not None:
+                    spans.setdefault(resolver.line_span(node), node)
+
+        outermost = [
+            (start, end)
+            for start, end in spans
+            if not any(
+                (other_start, other_end) != (start, end)
+                and other_start <= start
+                and end <= other_end
+                for other_start, other_end in spans
+            )
+        ]
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        headings: list[Node] = []
+        for start, end in sorted(outermost):
+            node = spans[(start, end)]
+            line_range = LineRange(start, end)
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(lines[start - 1 : end]),
+                    line_range=line_range,
+                    block_type=node.type,
+                    name=resolver.name(node),
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                    scope_path=resolver.scope_path(node),
+                )
+            )
+            path = resolver.heading_path(node)
+            if path and path[-1] not in headings:
+                headings.append(path[-1])
+
+        for heading in headings:
+            heading_span = resolver.line_span(heading)
+            if heading_span in outermost:
+                # 헤딩 자체가 변경 블록이면 경로 블록을 따로 만들지 않음
+                continue
+            path = (*resolver.scope_path(heading), resolver.label(heading))
+            blocks.append(
+                ContextBlock(
+                    text=" > ".join(path),
+                    line_range=LineRange(heading_span[0], heading_span[0]),
+                    block_type=heading.type,
+                    name=resolver.name(heading),
+                    reason=resolver.CONTAINER_REASON,
+                    scope_path=path[:-1],
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _create_assembly_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """어셈블리 변경 라인을 감싸는 레이블 블록과 섹션 지시어 블록을 만든다.
+
+        변경 라인마다 한 전역 레이블부터 다음 전역 레이블 직전까지의 블록을
+        찾고, 블록이 속한 섹션 지시어(`.text`, `section .data` 등) 라인을
+        함께 포함한다. 섹션 이름은 레이블 블록의 scope_path에 기록한다.
+        레이블 헤더(선언 지시어 포함) 바로 위의 주석은 주석 연결 옵션이 켜졌거나 주석 자체가
+        변경된 경우에만 블록에 포함한다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 ContextBlock 리스트
+        """
+        resolver = AssemblyLabelResolver()
+        starts: dict[int, Node] = {}
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                node = resolver.find_block(root, line_no)
+                if node is not None:
+                    starts.setdefault(node.start_byte, node)
+
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        sections: dict[int, Node] = {}
+        for node in starts.values():
+            label_line = resolver.header_line(root, node)
+            start_line = label_line
+            doc_comment = None
+            comment_line = resolver.first_line(root, node)
+            if comment_line < label_line:
+                if self._options.include_comments:
+                    doc_comment = "\n".join(lines[comment_line - 1 : label_line - 1])
+                comment_range = LineRange(comment_line, label_line - 1)
+                if doc_comment is not None or self._changed_lines_in(
+                    comment_range, changed_ranges
+                ):
+                    start_line = comment_line
+
+            section = resolver.find_section(root, node)
+            section_name = None
+            if section is not None:
+                sections.setdefault(section.start_byte, section)
+                section_name = resolver.name(section)
+            line_range = LineRange(start_line, resolver.last_line(root, node))
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(lines[start_line - 1 : line_range.end_line]),
+                    line_range=line_range,
+                    block_type=node.type,
+                    name=resolver.name(node),
+                    doc_comment=doc_comment,
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                    scope_path=(section_name,) if section_name else (),
+                )
+            )
+
+        for start_byte, section in sections.items():
+            if start_byte in starts:
+                # 섹션 지시어 자체가 변경 블록이면 따로 포함하지 않음
+                continue
+            section_line = section.start_point[0] + 1
+            blocks.append(
+                ContextBlock(
+                    text=lines[section_line - 1].strip(),
+                    line_range=LineRange(section_line, section_line),
+                    block_type=section.type,
+                    name=resolver.name(section),
+                    reason=resolver.CONTAINER_REASON,
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _create_dockerfile_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """Dockerfile 변경 명령어 주변 블록과 스테이지 헤더 블록을 만든다.
+
+        스테이지(`FROM`부터 다음 `FROM` 직전까지)마다 변경된 명령어들을 감싸는
+        범위를 max_top_level_statement_lines 이내에서 앞뒤 명령어로 넓혀 하나의
+        블록으로 반환한다. 블록에 `FROM` 라인이 포함되지 않으면 스테이지 헤더
+        블록을 함께 포함한다. 블록의 block_type은 첫 번째 변경 명령어의 타입
+        (`run_instruction` 등)이며, 스테이지 이름은 name과 scope_path에 기록한다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 ContextBlock 리스트
+        """
+        resolver = DockerfileStageResolver()
+        # 스테이지 `FROM`의 시작 바이트 → 변경된 명령어들 (첫 `FROM` 앞은 -1)
+        changed_by_stage: dict[int, list[Node]] = {}
+        stages: dict[int, Node | None] = {}
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                instruction = resolver.find_instruction(root, line_no)
+                if instruction is None:
+                    continue
+                stage = resolver.find_stage(root, instruction)
+                key = stage.start_byte if stage is not None else -1
+                stages[key] = stage
+                changed = changed_by_stage.setdefault(key, [])
+                if instruction not in changed:
+                    changed.append(instruction)
+
+        max_lines = self._options.max_top_level_statement_lines
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        for key, changed in changed_by_stage.items():
+            stage = stages[key]
+            instructions = resolver.instructions(root, stage)
+            positions = [instructions.index(node) for node in changed]
+            first, last = min(positions), max(positions)
+            grew = True
+            while grew:
+                # 앞뒤 명령어를 번갈아 붙이며 라인 수 제한 안에서 범위를 넓힘
+                grew = False
+                for candidate in (first - 1, last + 1):
+                    if not 0 <= candidate < len(instructions):
+                        continue
+                    new_first, new_last = min(first, candidate), max(last, candidate)
+                    line_count = (
+                        resolver.last_line(instructions[new_last])
+                        - instructions[new_first].start_point[0]
+                    )
+                    if line_count <= max_lines:
+                        first, last = new_first, new_last
+                        grew = True
+
+            stage_name = (
+                resolver.stage_name(root, stage) if stage is not None else None
+            )
+            line_range = LineRange(
+                instructions[first].start_point[0] + 1,
+                resolver.last_line(instructions[last]),
+            )
+            first_changed = instructions[min(positions)]
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(
+                        lines[line_range.start_line - 1 : line_range.end_line]
+                    ),
+                    line_range=line_range,
+                    block_type=first_changed.type,
+                    name=stage_name,
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                    scope_path=(stage_name,) if stage_name is not None else (),
+                )
+            )
+            if stage is not None and first > 0:
+                stage_range = LineRange(
+                    stage.start_point[0] + 1, resolver.last_line(stage)
+                )
+                blocks.append(
+                    ContextBlock(
+                        text="\n".join(
+                            lines[stage_range.start_line - 1 : stage_range.end_line]
+                        ),
+                        line_range=stage_range,
+                        block_type=stage.type,
+                        name=stage_name,
+                        reason=resolver.CONTAINER_REASON,
+                    )
+                )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _create_workflow_blocks(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """GitHub Actions 워크플로의 변경 step/job 헤더/설정 블록을 만든다.
+
+        step 안의 변경은 step 전체를 (block_type `step`, name은 step 이름,
+        scope_path는 job id) 반환하고, 그 job의 헤더가 반환 블록에 없으면
+        참고용 job 헤더 블록을 함께 포함한다. step 밖 job 설정의 변경은 job
+        헤더를 (block_type `job`, name은 job id), `steps:` 뒤의 job 설정이나
+        job 밖의 최상위 설정 변경은 해당 키 하나를 (block_type `setting`)
+        반환한다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            라인 순으로 정렬된 ContextBlock 리스트
+        """
+        resolver = GitHubActionsStepResolver()
+        # (시작 라인, 끝 라인) → (block_type, name, scope_path)
+        scopes: dict[tuple[int, int], tuple[str, str, tuple[str, ...]]] = {}
+        # 변경된 step을 감싸는 job 헤더 (시작 라인, 끝 라인) → job id
+        headers: dict[tuple[int, int], str] = {}
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                job = resolver.find_job(root, line_no)
+                if job is None:
+                    setting = resolver.find_setting(root, line_no)
+                    if setting is not None:
+                        scopes.setdefault(
+                            (setting.start_point[0] + 1, resolver.last_line(setting)),
+                            (resolver.SETTING_TYPE, resolver.key(setting), ()),
+                        )
+                    continue
+
+                job_id = resolver.key(job)
+                header = (job.start_point[0] + 1, resolver.header_last_line(job))
+                step = resolver.find_step(job, line_no)
+                if step is not None:
+                    scopes.setdefault(
+                        (step.start_point[0] + 1, resolver.last_line(step)),
+                        (resolver.STEP_TYPE, resolver.step_name(job, step), (job_id,)),
+                    )
+                    headers[header] = job_id
+                elif line_no <= header[1]:
+                    scopes.setdefault(header, (resolver.JOB_TYPE, job_id, ()))
+                else:
+                    setting = resolver.find_setting(root, line_no, job)
+                    if setting is not None:
+                        scopes.setdefault(
+                            (setting.start_point[0] + 1, resolver.last_line(setting)),
+                            (resolver.SETTING_TYPE, resolver.key(setting), (job_id,)),
+                        )
+
+        lines = split_lines(file_content)
+        blocks: list[ContextBlock] = []
+        for (start_line, end_line), (block_type, name, scope_path) in scopes.items():
+            line_range = LineRange(start_line, end_line)
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(lines[start_line - 1 : end_line]),
+                    line_range=line_range,
+                    block_type=block_type,
+                    name=name,
+                    changed_lines=self._changed_lines_in(line_range, changed_ranges),
+                    scope_path=scope_path,
+                )
+            )
+        for (start_line, end_line), job_id in headers.items():
+            if (start_line, end_line) in scopes:
+                continue
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(lines[start_line - 1 : end_line]),
+                    line_range=LineRange(start_line, end_line),
+                    block_type=resolver.JOB_TYPE,
+                    name=job_id,
+                    reason=resolver.CONTAINER_REASON,
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _changed_lines_in(
+        self, line_range: LineRange, changed_ranges: Sequence[LineRange]
+    ) -> tuple[int, ...]:
+        """블록 라인 범위 안에 있는 변경 라인 번호들을 정렬해 반환한다."""
+        return tuple(
+            sorted(
+                {
+                    line
+                    for changed_range in changed_ranges
+                    for line in range(
+                        changed_range.start_line, changed_range.end_line + 1
+                    )
+                    if line_range.contains(line)
+                }
+            )
+        )
+
+    def _find_minimal_block_span(self, node: Node) -> tuple[LineRange, str] | None:
+        """노드를 감싸는 가장 작은 구분자 블록의 라인 범위와 노드 타입을 반환한다.
+
+        들여쓰기 언어는 블록을 여는 헤더(`if x:`, `def f():` 등)부터 포함한다.
+        감싸는 블록이 없는 최상위 코드는 노드를 감싸는 최상위 문장을 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            (라인 범위, 노드 타입) 튜플 (찾지 못하면 None)
+        """
+        block_types = self.LANGUAGE_MINIMAL_BLOCK_TYPES.get(
+            self._language_name, frozenset()
+        )
+        current: Node | None = node
+        while current is not None and not self._is_root_node(current):
+            if current.type in block_types:
+                start_node = current
+                if (
+                    self._language_name in self.INDENT_BLOCK_LANGUAGES
+                    and current.parent is not None
+                    and not self._is_root_node(current.parent)
+                ):
+                    start_node = current.parent
+                return (
+                    LineRange(start_node.start_point[0] + 1, current.end_point[0] + 1),
+                    current.type,
+                )
+            if current.parent is not None and self._is_root_node(current.parent):
+                # 구분자 블록 밖의 최상위 문장
+                return (
+                    LineRange(current.start_point[0] + 1, current.end_point[0] + 1),
+                    current.type,
+                )
+            current = current.parent
+        return None
+
+    def _create_whole_file_block(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> ContextBlock:
+        """파일 전체를 변경 라인 표시와 함께 하나의 ContextBlock으로 만든다.
+
+        Args:
+            file_content: 파일 전체 내용
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            파일 전체 범위의 ContextBlock
+        """
+        line_count = max(len(split_lines(file_content)), 1)
+        changed_lines = sorted(
+            {
+                line
+                for changed_range in changed_ranges
+                for line in range(
+                    changed_range.start_line, changed_range.end_line + 1
+                )
+                if line <= line_count
+            }
+        )
+        return ContextBlock(
+            text=file_content.removesuffix("\n"),
+            line_range=LineRange(1, line_count),
+            block_type=self.WHOLE_FILE_BLOCK_TYPE,
+            changed_lines=tuple(changed_lines),
+        )
+
+    def _summarize_long_function(
+        self, node: Node, block_text: str, changed_ranges: Sequence[LineRange]
+    ) -> str:
+        """긴 함수 블록을 시그니처와 변경 라인 주변 윈도우로 줄인다.
+
+        adaptive_detail_max_lines 이하인 함수와 함수가 아닌 블록은 그대로 반환한다.
+        시그니처(본문 시작 전까지, 중괄호 언어는 본문을 여는 라인까지), 변경 라인
+        앞뒤 adaptive_detail_window_lines 라인, 중괄호 언어의 닫는 라인만 남기고
+        나머지 연속 라인은 생략 표시 한 줄로 바꾼다.
+
+        Args:
+            node: 컨텍스트 노드
+            block_text: 노드 텍스트 (선행 주석 포함 가능)
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            원본 또는 요약된 블록 텍스트
+        """
+        function = node
+        if node.type == "decorated_definition":
+            function = node.child_by_field_name("definition") or node
+        function_types = SignatureParser.LANGUAGE_FUNCTION_TYPES.get(
+            self._language_name, frozenset()
+        )
+        if (
+            function.type not in function_types
+            or len(split_lines(block_text)) <= self._options.adaptive_detail_max_lines
+        ):
+            return block_text
+        return self._summarize_block_text(node, block_text, changed_ranges)
+
+    def _exceeds_symbol_cap(self, block_text: str) -> bool:
+        """블록 텍스트가 max_symbol_lines/max_symbol_bytes 상한을 넘는지 확인한다."""
+        max_lines = self._options.max_symbol_lines
+        max_bytes = self._options.max_symbol_bytes
+        return (max_lines is not None and len(split_lines(block_text)) > max_lines) or (
+            max_bytes is not None and len(block_text.encode("utf-8")) > max_bytes
+        )
+
+    def _summarize_block_text(
+        self, node: Node, block_text: str, changed_ranges: Sequence[LineRange]
+    ) -> str:
+        """블록을 시그니처와 변경 라인 주변 윈도우로 줄인다.
+
+        adaptive_detail과 심볼 크기 상한(max_symbol_lines/max_symbol_bytes)이
+        함께 쓴다. 본문 필드가 없는 선언은 첫 라인을 시그니처로 본다.
+
+        Args:
+            node: 컨텍스트 노드
+            block_text: 노드 텍스트 (선행 주석 포함 가능)
+            changed_ranges: 의미있는 변경 라인 범위들
+
+        Returns:
+            요약된 블록 텍스트 (생략할 라인이 없으면 원본 텍스트)
+        """
+        function = node
+        if node.type == "decorated_definition":
+            function = node.child_by_field_name("definition") or node
+        lines = split_lines(block_text)
+
+        # 선행 주석이 붙은 텍스트도 노드 끝 라인에서 끝나므로 끝에서 시작 라인 계산
+        end_line = node.end_point[0] + 1
+        start_line = end_line - len(lines) + 1
+        indent_block = self._language_name in self.INDENT_BLOCK_LANGUAGES
+        signature_end = start_line
+        body = function.child_by_field_name("body")
+        if body is not None:
+            body_line = body.start_point[0] + 1
+            # 들여쓰기 언어는 본문이 헤더 다음 라인에서 시작
+            signature_end = max(body_line - int(indent_block), start_line)
+
+        kept_lines = set(range(start_line, signature_end + 1))
+        window = self._options.adaptive_detail_window_lines
+        for changed_range in changed_ranges:
+            kept_lines.update(
+                range(
+                    max(changed_range.start_line - window, start_line),
+                    min(changed_range.end_line + window, end_line) + 1,
+                )
+            )
+        if not indent_block:
+            kept_lines.add(end_line)
+
+        summarized: list[str] = []
+        omitted_start: int | None = None
+        for line_no in range(start_line, end_line + 2):
+            if line_no <= end_line and line_no not in kept_lines:
+                if omitted_start is None:
+                    omitted_start = line_no
+                continue
+            if omitted_start is not None:
+                first_omitted = lines[omitted_start - start_line]
+                indent_width = len(first_omitted) - len(first_omitted.lstrip())
+                indent = first_omitted[:indent_width]
+                summarized.append(
+                    indent
+                    + self.ADAPTIVE_OMISSION_MARKER.format(
+                        start=omitted_start, end=line_no - 1
+                    )
+                )
+                omitted_start = None
+            if line_no <= end_line:
+                summarized.append(lines[line_no - start_line])
+        return "\n".join(summarized)
+
+    def _find_associated_comment(
+        self, node: Node, code_bytes: bytes
+    ) -> AssociatedComment | None:
+        """옵션이 켜진 경우 언어별 전략으로 노드에 연결된 주석을 찾는다.
+
+        include_comments가 꺼져 있어도 include_exported_doc_comments가 켜져 있으면
+        공개(export) 심볼의 문서 주석은 API 계약으로 보고 연결한다.
+
+        Args:
+            node: 컨텍스트 노드
+            code_bytes: 파일 전체 바이트
+
+        Returns:
+            연결된 주석 (옵션이 꺼졌거나 없으면 None)
+        """
+        if self._comment_strategy is None:
+            return None
+        if not self._options.include_comments and not (
+            self._options.include_exported_doc_comments
+            and self._visibility_resolver.is_exported(node, self._get_node_name(node))
+        ):
+            return None
+        return self._comment_strategy.find_comment(node, code_bytes)
+
+    def _find_trailing_comment(
+        self, node: Node, code_bytes: bytes
+    ) -> AssociatedComment | None:
+        """옵션이 켜진 경우 노드의 닫는 구분자 뒤에 이어지는 주석을 찾는다.
+
+        Args:
+            node: 컨텍스트 노드
+            code_bytes: 파일 전체 바이트
+
+        Returns:
+            뒤쪽 주석 (옵션이 꺼졌거나 언어 전략이 없거나 주석이 없으면 None)
+        """
+        if not self._options.include_trailing_comments:
+            return None
+        if self._comment_strategy is None:
+            return None
+        return self._comment_strategy.find_trailing_comment(node, code_bytes)
+
+    def _find_comment_only_ranges(
+        self, root: Node, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[LineRange]:
+        """주석 라인(과 빈 줄)만으로 이루어진 변경 범위들을 반환한다.
+
+        주석 노드가 라인의 첫 비공백 문자에서 시작하는 라인과 여러 줄 주석의
+        이어지는 라인을 주석 라인으로 보며, 코드 뒤에 붙은 주석 라인은
+        코드 변경으로 본다.
+
+        Args:
+            root: AST 루트 노드
+            file_content: 파일 내용
+            changed_ranges: 변경된 라인 범위들
+
+        Returns:
+            주석 라인이 하나 이상 있고 나머지는 빈 줄인 변경 범위 리스트
+        """
+        lines = split_lines(file_content)
+        comment_lines: set[int] = set()
+        for node in self._iter_nodes(root):
+            if "comment" not in node.type:
+                continue
+            start_row, start_column = node.start_point
+            line = lines[start_row] if start_row < len(lines) else ""
+            if start_column == len(line) - len(line.lstrip()):
+                comment_lines.add(start_row + 1)
+            comment_lines.update(range(start_row + 2, node.end_point[0] + 2))
+
+        comment_only_ranges = []
+        for changed_range in changed_ranges:
+            line_numbers = range(changed_range.start_line, changed_range.end_line + 1)
+            if not any(number in comment_lines for number in line_numbers):
+                continue
+            if all(
+                number in comment_lines
+                or number > len(lines)
+                or not lines[number - 1].strip()
+                for number in line_numbers
+            ):
+                comment_only_ranges.append(changed_range)
+        return comment_only_ranges
+
+    def _collect_comment_change_nodes(
+        self,
+        root: Node,
+        code_bytes: bytes,
+        comment_only_ranges: Sequence[LineRange],
+        context_nodes: set[Node],
+    ) -> dict[Node, AssociatedComment]:
+        """바뀐 주석이 언어별 주석 연결 규칙으로 연결된 선언 노드들을 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            code_bytes: 파일 전체 바이트
+            comment_only_ranges: 주석 라인만 바뀐 변경 범위들
+            context_nodes: 코드 변경으로 이미 포함된 컨텍스트 노드들
+
+        Returns:
+            위치 순의 {선언 노드: 연결된 주석} 딕셔너리
+        """
+        if not comment_only_ranges or self._comment_strategy is None:
+            return {}
+        changed_lines = {
+            line
+            for changed_range in comment_only_ranges
+            for line in range(changed_range.start_line, changed_range.end_line + 1)
+        }
+        nodes: dict[Node, AssociatedComment] = {}
+        for node in self._iter_nodes(root):
+            if not self._is_symbol_node(node) or any(
+                context.start_byte <= node.start_byte
+                and node.end_byte <= context.end_byte
+                for context in context_nodes
+            ):
+                continue
+            comment = self._comment_strategy.find_comment(node, code_bytes)
+            if comment is None:
+                continue
+            comment_range = comment.line_range
+            if any(
+                comment_range.start_line <= line <= comment_range.end_line
+                for line in changed_lines
+            ):
+                nodes[node] = comment
+        return nodes
+
+    def _create_comment_change_block(
+        self, node: Node, comment: AssociatedComment, code_bytes: bytes
+    ) -> ContextBlock:
+        """바뀐 주석과 그 주석이 연결된 선언을 담은 ContextBlock을 생성한다.
+
+        Args:
+            node: 주석이 연결된 선언 노드
+            comment: 연결된 주석
+            code_bytes: 파일 전체 바이트
+
+        Returns:
+            reason이 COMMENT_CHANGE_REASON이고 doc_comment가 기록된 블록
+            (선언 위쪽 주석이면 텍스트와 라인 범위가 주석부터 시작)
+        """
+        block = self._create_reference_block(node, self.COMMENT_CHANGE_REASON)
+        if not comment.is_leading:
+            block.doc_comment = comment.text
+            return block
+        return ContextBlock(
+            text=code_bytes[comment.start_byte : node.end_byte].decode(
+                "utf-8", errors="replace"
+            ),
+            line_range=LineRange(
+                comment.line_range.start_line, block.line_range.end_line
+            ),
+            block_type=block.block_type,
+            name=block.name,
+            reason=block.reason,
+            doc_comment=comment.text,
+            qualified_name=block.qualified_name,
+        )
+
+    def _collect_signature_type_nodes(
+        self, root: Node, context_nodes: set[Node]
+    ) -> tuple[list[Node], list[str]]:
+        """변경된 함수 시그니처가 참조하는 타입 선언 노드들을 수집한다.
+
+        이미 컨텍스트 블록에 포함된 선언은 제외한다.
+
+        Args:
+            root: AST 루트 노드
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            (추가로 포함할 타입 선언 노드들, 파일 안에서 선언을 찾지 못한
+            타입 이름들) 튜플
+        """
+        if not self._options.include_signature_types:
+            return [], []
+
+        type_nodes, missing_names = self._signature_type_collector.collect_with_missing(
+            root, context_nodes, self._options.max_signature_types
+        )
+        referenced_nodes = [
+            node
+            for node in type_nodes
+            if not any(
+                node == context_node or self._is_node_contained_in(node, context_node)
+                for context_node in context_nodes
+            )
+        ]
+        return referenced_nodes, missing_names
+
+    def _resolve_cross_file_types(
+        self, type_names: Sequence[str], budget: int
+    ) -> list[ContextBlock]:
+        """파일 안에서 찾지 못한 타입을 외부 resolver로 조회해 블록으로 만든다.
+
+        같은 정의(파일 경로와 라인 범위)를 가리키는 이름은 한 번만 포함하며,
+        반환된 정의 안의 참조는 다시 따라가지 않는다.
+
+        Args:
+            type_names: 등장 순서대로의 찾지 못한 타입 이름들
+            budget: 포함할 수 있는 최대 블록 수 (max_signature_types 잔여분)
+
+        Returns:
+            reason이 CROSS_FILE_REASON이고 source_path가 기록된 블록들
+        """
+        resolver = self._options.symbol_resolver
+        if resolver is None or budget <= 0:
+            return []
+
+        blocks: list[ContextBlock] = []
+        seen: set[tuple[str, int, int]] = set()
+        for type_name in dict.fromkeys(type_names):
+            if len(blocks) >= budget:
+                break
+            try:
+                symbol = resolver.resolve(type_name, self._language_name)
+            except Exception as e:
+                logger.warning(f"심볼 resolver 조회 실패 ({type_name}): {e}")
+                continue
+            if symbol is None:
+                continue
+            line_range = symbol.line_range
+            key = (symbol.file_path, line_range.start_line, line_range.end_line)
+            if key in seen:
+                continue
+            seen.add(key)
+            blocks.append(self._create_cross_file_block(symbol))
+        return blocks
+
+    def _create_cross_file_block(
+        self, symbol: ResolvedSymbol, reason: str | None = None
+    ) -> ContextBlock:
+        """resolver가 찾은 다른 파일의 정의로 참조 블록을 만든다.
+
+        Args:
+            symbol: resolver가 반환한 심볼 정의
+            reason: 포함 사유 (기본값: CROSS_FILE_REASON)
+
+        Returns:
+            출처 파일 경로가 기록된 ContextBlock
+        """
+        return ContextBlock(
+            text=symbol.content,
+            line_range=symbol.line_range,
+            name=symbol.qualified_name,
+            reason=reason or self.CROSS_FILE_REASON,
+            source_path=symbol.file_path,
+        )
+
+    def _collect_overridden_method_nodes(
+        self, root: Node, context_nodes: set[Node]
+    ) -> tuple[list[Node], list[str]]:
+        """변경된 메서드들이 재정의하는 상위 타입 메서드들을 수집한다.
+
+        재정의 대상 메서드 자체가 이미 컨텍스트 블록이면 제외한다.
+
+        Args:
+            root: AST 루트 노드
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            (위치 순의 같은 파일 메서드 노드들, 외부 resolver로 조회할
+            `상위타입.메서드` 이름들) 튜플 (옵션이 꺼져 있거나 지원하지 않는
+            언어면 빈 리스트들)
+        """
+        resolver = self._overridden_method_resolver
+        if not self._options.include_overridden_methods or not resolver.is_supported():
+            return [], []
+        nodes: list[Node] = []
+        names: list[str] = []
+        for node in sorted(context_nodes, key=node_order_key):
+            if not resolver.is_method(node):
+                continue
+            overridden, unresolved = resolver.find_overridden(root, node)
+            nodes.extend(
+                method
+                for method in overridden
+                if method not in context_nodes and method not in nodes
+            )
+            names.extend(name for name in unresolved if name not in names)
+        return sorted(nodes, key=lambda node: node.start_byte), names
+
+    def _resolve_overridden_methods(
+        self, qualified_names: Sequence[str], existing: Sequence[ContextBlock]
+    ) -> list[ContextBlock]:
+        """파일 밖 상위 타입의 재정의 대상 메서드를 외부 resolver로 조회한다.
+
+        Args:
+            qualified_names: `상위타입.메서드` 형태의 이름들
+            existing: 이미 포함된 다른 파일의 블록들 (같은 정의는 제외)
+
+        Returns:
+            reason이 OVERRIDDEN_METHOD_REASON이고 source_path가 기록된 블록들
+        """
+        resolver = self._options.symbol_resolver
+        if resolver is None:
+            return []
+        seen = {
+            (block.source_path, block.line_range.start_line, block.line_range.end_line)
+            for block in existing
+        }
+        blocks: list[ContextBlock] = []
+        for qualified_name in qualified_names:
+            try:
+                symbol = resolver.resolve(qualified_name, self._language_name)
+            except Exception as e:
+                logger.warning(f"심볼 resolver 조회 실패 ({qualified_name}): {e}")
+                continue
+            if symbol is None:
+                continue
+            line_range = symbol.line_range
+            key = (symbol.file_path, line_range.start_line, line_range.end_line)
+            if key in seen:
+                continue
+            seen.add(key)
+            blocks.append(
+                self._create_cross_file_block(symbol, self.OVERRIDDEN_METHOD_REASON)
+            )
+        return blocks
+
+    def _create_signature_block(self, node: Node, reason: str) -> ContextBlock:
+        """함수/메서드의 본문을 뺀 시그니처만 담은 참고용 ContextBlock을 생성한다.
+
+        Args:
+            node: 함수/메서드 노드 (본문이 없는 추상/인터페이스 메서드는 전체)
+            reason: 포함 사유
+
+        Returns:
+            reason이 지정된 ContextBlock
+        """
+        block = self._create_reference_block(node, reason)
+        body = self._overridden_method_resolver.signature_end(node)
+        if body is None:
+            return block
+        source = node.text or b""
+        block.text = (
+            source[: body.start_byte - node.start_byte]
+            .decode("utf-8", errors="replace")
+            .rstrip()
+        )
+        start_line = node.start_point[0] + 1
+        block.line_range = LineRange(start_line, start_line + block.text.count("\n"))
+        return block
+
+    def _collect_anchor_nodes(
+        self, root: Node, changed_ranges: Sequence[LineRange]
+    ) -> list[Node]:
+        """변경 라인을 감싸는 사용자 정의 앵커 노드들을 수집한다.
+
+        Args:
+            root: AST 루트 노드
+            changed_ranges: 의미 있는 변경 범위들
+
+        Returns:
+            변경 라인을 하나라도 포함하는 앵커 노드 리스트
+            (anchor_queries 옵션이 없으면 빈 리스트)
+        """
+        registry = self._options.anchor_queries
+        if registry is None:
+            return []
+        return [
+            node
+            for node in registry.find_anchors(self._language_name, root)
+            if any(
+                node.start_point[0] + 1 <= line_no <= node.end_point[0] + 1
+                for changed_range in changed_ranges
+                for line_no in range(
+                    changed_range.start_line, changed_range.end_line + 1
+                )
+            )
+        ]
+
+    def _collect_container_nodes(self, context_nodes: set[Node]) -> list[Node]:
+        """메서드/프로퍼티 블록을 감싸는 컨테이너 노드들을 중복 없이 수집한다.
+
+        컨테이너 자체가 이미 컨텍스트 블록이면 제외한다. Julia do 블록과 Haxe
+        익명 함수처럼 컨테이너(함수)가 다시 모듈/타입 멤버인 경우 바깥
+        컨테이너까지 모두 수집한다.
+
+        Args:
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            헤더를 포함할 컨테이너 노드들의 리스트
+        """
+        resolver = self._scope_resolver
+        if not resolver.MEMBER_TYPES:
+            return []
+
+        containers: list[Node] = []
+        for node in sorted(context_nodes, key=node_order_key):
+            if node.type not in resolver.MEMBER_TYPES:
+                continue
+            container = resolver.find_container(node)
+            while (
+                container is not None
+                and container not in context_nodes
+                and container not in containers
+            ):
+                containers.append(container)
+                if not resolver.NESTED_CONTAINERS:
+                    break
+                container = resolver.find_container(container)
+        return containers
+
+    def _collect_applied_modifier_nodes(
+        self, root: Node, context_nodes: set[Node]
+    ) -> list[Node]:
+        """변경된 Solidity 함수에 적용된 modifier의 정의 노드들을 수집한다.
+
+        modifier 정의 자체가 이미 컨텍스트 블록이면 제외한다.
+
+        Args:
+            root: AST 루트 노드
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            위치 순의 modifier_definition 노드 리스트 (modifier가 없는 언어면 빈
+            리스트)
+        """
+        resolver = self._scope_resolver
+        names: set[str] = set()
+        for node in context_nodes:
+            names.update(resolver.applied_modifiers(node))
+        if not names:
+            return []
+        return [
+            definition
+            for definition in resolver.find_modifier_definitions(root, names)
+            if definition not in context_nodes
+        ]
+
+    def _collect_sibling_overload_nodes(self, context_nodes: set[Node]) -> list[Node]:
+        """변경된 함수와 같은 선언 범위에 있는 같은 이름의 오버로드들을 수집한다.
+
+        오버로드는 변경된 함수의 부모 노드(클래스 본문, 파일 등)의 자식 중
+        이름이 같은 함수 선언이며, 이미 컨텍스트 블록인 선언은 제외한다.
+        max_sibling_overloads개와 총 max_sibling_overload_lines 라인 안에서
+        위치 순으로 포함하고, 남은 라인 수보다 긴 오버로드는 건너뛴다.
+
+        Args:
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            위치 순의 오버로드 선언 노드 리스트 (옵션이 꺼져 있거나 오버로드를
+            지원하지 않는 언어면 빈 리스트)
+        """
+        overload_types = self.LANGUAGE_OVERLOAD_TYPES.get(self._language_name)
+        if not self._options.include_sibling_overloads or overload_types is None:
+            return []
+        declarations = {
+            declaration
+            for node in context_nodes
+            if (declaration := self._overload_declaration(node, overload_types))
+            is not None
+        }
+        remaining_lines = self._options.max_sibling_overload_lines
+        siblings: list[Node] = []
+        for declaration in sorted(declarations, key=node_order_key):
+            name = self._get_node_name(declaration)
+            scope = declaration.parent
+            if scope is not None and scope.type in self.OVERLOAD_WRAPPER_TYPES:
+                scope = scope.parent
+            if name is None or scope is None:
+                continue
+            for child in scope.named_children:
+                sibling = self._overload_declaration(child, overload_types)
+                if (
+                    sibling is None
+                    or sibling in declarations
+                    or sibling in siblings
+                    or child in context_nodes
+                    or self._get_node_name(sibling) != name
+                ):
+                    continue
+                if len(siblings) >= self._options.max_sibling_overloads:
+                    return sorted(siblings, key=lambda node: node.start_byte)
+                line_count = sibling.end_point[0] - sibling.start_point[0] + 1
+                if line_count > remaining_lines:
+                    continue
+                remaining_lines -= line_count
+                siblings.append(sibling)
+        return sorted(siblings, key=lambda node: node.start_byte)
+
+    def _order_by_call_graph(
+        self, context_blocks: list[ContextBlock], context_nodes: set[Node]
+    ) -> list[ContextBlock]:
+        """변경된 심볼 블록들을 같은 파일 호출 관계 순서로 재배치한다.
+
+        라인 순 블록들 중 변경된 심볼 블록(reason 없이 이름이 있는 블록)이
+        차지한 자리만 CallGraphOrderer 순서로 다시 채우고, 컨테이너 헤더와
+        참고용 블록 등 나머지 블록은 제자리에 둔다.
+
+        Args:
+            context_blocks: 라인 순으로 정렬된 컨텍스트 블록들
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            재배치된 블록들
+        """
+        positions = [
+            index
+            for index, block in enumerate(context_blocks)
+            if block.reason is None and block.name is not None
+        ]
+        symbols: list[tuple[set[str], set[str]]] = []
+        for position in positions:
+            line_range = context_blocks[position].line_range
+            nodes = [
+                node
+                for node in context_nodes
+                if line_range.contains(node.start_point[0] + 1)
+            ]
+            names = {self._get_node_name(node) for node in nodes}
+            symbols.append(
+                (
+                    {name for name in names if name},
+                    self._recursive_call_detector.callee_names(nodes),
+                )
+            )
+        ordered = list(context_blocks)
+        for position, index in zip(positions, self._call_graph_orderer.order(symbols)):
+            ordered[position] = context_blocks[positions[index]]
+        return ordered
+
+    def _create_neighbor_symbol_blocks(
+        self, context_nodes: set[Node]
+    ) -> list[ContextBlock]:
+        """변경된 심볼과 같은 선언 범위에서 가까운 앞뒤 심볼 블록을 만든다.
+
+        변경된 심볼의 부모 노드(클래스 본문, 파일 등)의 자식 심볼들 중 거리
+        1의 앞, 뒤, 거리 2의 앞, 뒤 순서로 symbol_radius 거리까지 고르며,
+        이미 컨텍스트 블록인 심볼은 제외한다. 블록들의 총 라인 수가
+        max_symbol_radius_lines를 넘지 않도록 남은 라인 수보다 긴 블록은
+        건너뛴다.
+
+        Args:
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            reason이 NEIGHBOR_SYMBOL_REASON인 블록들 (옵션이 꺼져 있으면 빈
+            리스트)
+        """
+        radius = self._options.symbol_radius
+        if radius == 0:
+            return []
+        create_block = (
+            self._create_reference_block
+            if self._options.symbol_radius_bodies
+            else self._create_signature_block
+        )
+        remaining_lines = self._options.max_symbol_radius_lines
+        neighbors: set[Node] = set()
+        blocks: list[ContextBlock] = []
+        for node in sorted(context_nodes, key=node_order_key):
+            anchor = node
+            if node.parent is not None and node.parent.type == "decorated_definition":
+                anchor = node.parent
+            scope = anchor.parent
+            if scope is None:
+                continue
+            symbols = [
+                child for child in scope.named_children if self._is_symbol_node(child)
+            ]
+            if anchor not in symbols:
+                continue
+            index = symbols.index(anchor)
+            for distance in range(1, radius + 1):
+                for neighbor_index in (index - distance, index + distance):
+                    if not 0 <= neighbor_index < len(symbols):
+                        continue
+                    neighbor = symbols[neighbor_index]
+                    if neighbor in context_nodes or neighbor in neighbors:
+                        continue
+                    block = create_block(neighbor, self.NEIGHBOR_SYMBOL_REASON)
+                    line_count = block.line_range.line_count()
+                    if line_count > remaining_lines:
+                        continue
+                    remaining_lines -= line_count
+                    neighbors.add(neighbor)
+                    blocks.append(block)
+        return blocks
+
+    def _overload_declaration(
+        self, node: Node, overload_types: frozenset[str]
+    ) -> Node | None:
+        """노드가 (export로 감싼) 오버로드 가능한 함수 선언이면 그 선언을 반환한다."""
+        if node.type in overload_types:
+            return node
+        if node.type in self.OVERLOAD_WRAPPER_TYPES:
+            return next(
+                (
+                    child
+                    for child in node.named_children
+                    if child.type in overload_types
+                ),
+                None,
+            )
+        return None
+
+    def _create_call_site_blocks(
+        self, root: Node, code_bytes: bytes, context_nodes: set[Node]
+    ) -> list[ContextBlock]:
+        """변경된 함수들을 같은 파일에서 호출하는 위치의 블록들을 만든다.
 
-    def __init__(self, language: str) -> None:
-        """추출기 초기화.
+        블록 텍스트는 호출을 감싸는 문장의 시작부터 호출이 끝나는 라인까지이며,
+        name은 호출 위치를 감싸는 이름 있는 심볼(호출자)이다.
 
         Args:
-            language: 지원 언어 (기본값: python)
+            root: AST 루트 노드
+            code_bytes: 파일 내용 바이트
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
 
-        Raises:
-            UnsupportedLanguageError: 지원하지 않는 언어인 경우
+        Returns:
+            reason이 CALL_SITE_REASON인 블록 리스트 (최대 max_call_sites개)
         """
-        if language not in self.SUPPORTED_LANGUAGES:
-            raise UnsupportedLanguageError(language)
-
-        try:
-            self._language: Language = get_language(language)
-            self._parser: Parser = get_parser(language)
-            self._language_name = language
-            self._block_types = self.LANGUAGE_BLOCK_TYPES[language]
-            self._dependency_types = self.LANGUAGE_DEPENDENCY_TYPES.get(
-                language, frozenset()
+        names = {
+            name
+            for node in context_nodes
+            if self._call_site_finder.is_function(node)
+            and (name := self._get_node_name(node)) is not None
+        }
+        sites = self._call_site_finder.find(
+            root,
+            names,
+            sorted(context_nodes, key=node_order_key),
+            self._options.max_call_sites,
+        )
+        blocks: list[ContextBlock] = []
+        for statement, call in sites:
+            line_end = code_bytes.find(b"\n", call.end_byte)
+            if line_end == -1:
+                line_end = len(code_bytes)
+            blocks.append(
+                ContextBlock(
+                    text=code_bytes[statement.start_byte : line_end]
+                    .decode("utf-8", errors="replace")
+                    .rstrip(),
+                    line_range=LineRange(
+                        statement.start_point[0] + 1, call.end_point[0] + 1
+                    ),
+                    block_type=statement.type,
+                    name=self._find_caller_name(statement),
+                    reason=self.CALL_SITE_REASON,
+                )
             )
-            # 무의미한 변경 필터링 객체
-            self._filter = MeaninglessChangeFilter()
-        except Exception as e:
-            raise ValueError(f"언어 '{language}' 초기화 실패: {e}") from e
-
-    @classmethod
-    def get_supported_languages(cls) -> list[str]:
-        """지원하는 언어 목록을 반환한다."""
-        return cls.SUPPORTED_LANGUAGES
+        return blocks
 
-    @classmethod
-    def get_block_types_for_language(cls, language: str) -> frozenset[str]:
-        """특정 언어의 블록 타입들을 반환한다."""
-        return cls.LANGUAGE_BLOCK_TYPES.get(language, frozenset())
+    def _create_embedded_sql_blocks(
+        self, root: Node, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 SQL 문자열 리터럴의 내용을 SQL 추출기로 다시 추출한다.
 
-    def _is_root_node(self, node: Node) -> bool:
-        """노드가 루트(전체 파일) 노드인지 확인한다.
+        리터럴 내용 기준의 라인 번호를 파일 기준으로 되돌리고, 리터럴을 감싸는
+        선언 이름들을 scope_path로 기록한다. SQL 추출기를 사용할 수 없거나
+        파싱이 시간 제한을 넘긴 리터럴은 건너뛴다.
 
         Args:
-            node: 확인할 노드
+            root: AST 루트 노드
+            changed_ranges: 의미있는 변경 라인 범위들
 
         Returns:
-            루트 노드 여부
+            reason이 EMBEDDED_SQL_REASON인 SQL 문장 블록들
         """
-        root_type = self.LANGUAGE_ROOT_TYPES.get(self._language_name)
-        return root_type and node.type == root_type
+        resolver = self._embedded_sql_resolver
+        if not resolver.is_supported():
+            return []
+        blocks: list[ContextBlock] = []
+        for literal, content in resolver.find_fragments(root, changed_ranges):
+            offset = literal.start_point[0]
+            fragment_range = LineRange(offset + 1, literal.end_point[0] + 1)
+            local_ranges = [
+                LineRange(
+                    max(changed_range.start_line, fragment_range.start_line) - offset,
+                    min(changed_range.end_line, fragment_range.end_line) - offset,
+                )
+                for changed_range in changed_ranges
+                if changed_range.overlaps(fragment_range)
+            ]
+            try:
+                sql_blocks = self._get_embedded_sql_extractor().extract_context_blocks(
+                    content, local_ranges
+                )
+            except (UnsupportedLanguageError, ValueError, ParseTimeoutError) as e:
+                logger.debug(f"문자열 안의 SQL 추출 건너뜀: {e}")
+                continue
+            scope_path = self._embedded_scope_path(literal)
+            blocks.extend(
+                replace(
+                    block,
+                    line_range=LineRange(
+                        block.line_range.start_line + offset,
+                        block.line_range.end_line + offset,
+                    ),
+                    changed_lines=tuple(line + offset for line in block.changed_lines),
+                    reason=self.EMBEDDED_SQL_REASON,
+                    scope_path=scope_path,
+                )
+                for block in sql_blocks
+                if not block.is_dependency
+            )
+        return blocks
 
-    def extract_contexts(
-        self, file_content: str, changed_ranges: Sequence[LineRange]
-    ) -> list[str]:
-        """변경된 라인 범위들을 기반으로 컨텍스트 블록들을 추출한다.
+    def _get_embedded_sql_extractor(self) -> ContextExtractor:
+        """문자열 안의 SQL을 추출할 SQL 추출기를 반환한다.
 
-        Args:
-            file_content: 분석할 파일의 내용
-            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+        추출 옵션은 이 추출기의 옵션을 그대로 쓰되 SQL 안에서 다시 문자열
+        리터럴을 찾지 않도록 extract_embedded_sql만 끈다. 처음 호출할 때 만들어
+        이후 리터럴들에서 재사용한다.
 
         Returns:
-            추출된 컨텍스트 코드 블록들의 리스트
+            SQL ContextExtractor
 
         Raises:
-            ValueError: 파일 내용이 없거나 파싱 오류
+            UnsupportedLanguageError: SQL을 지원하지 않는 경우
+            ValueError: SQL 추출기 초기화에 실패한 경우
         """
-        if not changed_ranges:
-            return []
+        if self._embedded_sql_extractor is None:
+            self._embedded_sql_extractor = ContextExtractor(
+                "sql", replace(self._options, extract_embedded_sql=False)
+            )
+        return self._embedded_sql_extractor
+
+    def _embedded_scope_path(self, literal: Node) -> tuple[str, ...]:
+        """문자열 리터럴을 감싸는 선언의 조상 경로와 이름을 반환한다."""
+        host = self._find_minimal_enclosing_block(literal)
+        if host is None or host == literal:
+            return ()
+        name = self._get_node_name(host)
+        if name is None:
+            return ()
+        return (*self._get_scope_path(host), name)
+
+    def _find_caller_name(self, node: Node) -> str | None:
+        """노드를 감싸는 가장 가까운 이름 있는 심볼 블록의 이름을 반환한다."""
+        current = node.parent
+        while current is not None and not self._is_root_node(current):
+            if current.type in self._block_types:
+                name = self._get_node_name(current)
+                if name is not None:
+                    return name
+            current = current.parent
+        return None
 
-        # 1. file_content 인코딩 처리
-        try:
-            code_bytes = file_content.encode("utf-8")
-        except UnicodeEncodeError as e:
-            raise ValueError(f"파일 인코딩 오류: {e}") from e
+    def _create_container_header_block(self, container: Node) -> ContextBlock:
+        """컨테이너 선언의 헤더 라인만 담은 ContextBlock을 생성한다.
 
-        # 2. 1줄 무의미 변경 필터링
-        meaningful_ranges = self._filter.filter_meaningful_ranges_with_file_content(
-            file_content, changed_ranges
+        Args:
+            container: @interface/@implementation, @media 등 컨테이너 노드
+
+        Returns:
+            reason이 resolver의 CONTAINER_REASON인 ContextBlock
+        """
+        resolver = self._scope_resolver
+        start_line = container.start_point[0] + 1
+        return ContextBlock(
+            text=resolver.container_header(container),
+            line_range=LineRange(start_line, start_line),
+            block_type=container.type,
+            name=resolver.container_name(container),
+            reason=resolver.CONTAINER_REASON,
         )
 
-        # 의미있는 변경이 없으면 빈 결과 반환
-        if not meaningful_ranges:
-            return []
+    def _create_reference_block(self, node: Node, reason: str) -> ContextBlock:
+        """참고용으로 포함되는 노드의 ContextBlock을 생성한다.
 
-        # 3. AST 파싱
-        try:
-            tree = self._parser.parse(code_bytes)
-            if tree.root_node.has_error:
-                logger.warning("파싱 경고: 구문 오류 감지됨")
-        except Exception as e:
-            raise ValueError(f"파싱 실패: {e}") from e
+        Args:
+            node: 포함할 노드
+            reason: 포함 사유
 
-        # 4. 변경 범위의 각 라인에 대해 최소 블록들 찾기
-        context_blocks: set[Node] = set()
-        for changed_range in meaningful_ranges:
-            # 각 LineRange에 대해 최소 노드들 찾기
-            minimal_nodes = self._find_minimal_nodes_for_range(
-                tree.root_node, changed_range
-            )
-            for node in minimal_nodes:
-                block = self._get_appropriate_context_for_node(node)
-                if block is not None:
-                    context_blocks.add(block)
+        Returns:
+            reason이 지정된 ContextBlock
+        """
+        name = self._get_node_name(node)
+        display_name, qualified_name = (
+            self._format_symbol_names(node, name, self._get_scope_path(node))
+            if name is not None
+            else (None, None)
+        )
+        return ContextBlock(
+            text=node_text(node),
+            line_range=LineRange(node.start_point[0] + 1, node.end_point[0] + 1),
+            block_type=node.type,
+            name=display_name,
+            reason=reason,
+            qualified_name=qualified_name,
+        )
 
-        # 4. 의존성 노드들 수집
-        dependency_nodes = self._collect_dependency_nodes(tree.root_node)
+    def _parse_signature(self, node: Node) -> SymbolSignature | None:
+        """옵션이 켜진 경우 함수/메서드 노드의 구조화된 시그니처를 반환한다.
 
-        # 5. 포함 관계 중복 블록 제거
-        filtered_blocks = self._filter_nested_blocks(context_blocks)
+        Args:
+            node: 시그니처를 파싱할 노드
 
-        # 6. dependency 노드와 겹치는 context 블록 제거
-        filtered_blocks = self._remove_context_dependency_overlap(
-            filtered_blocks, dependency_nodes
-        )
+        Returns:
+            구조화된 시그니처 (옵션이 꺼져 있거나 함수 노드가 아니면 None)
+        """
+        if not self._options.include_signatures:
+            return None
+        return self._signature_parser.parse(node)
 
-        # 7. 모든 노드들을 합치고 위치 순으로 정렬
-        all_nodes = list(filtered_blocks) + dependency_nodes
-        sorted_nodes = sorted(all_nodes, key=lambda n: n.start_point)
+    def _get_node_name(self, node: Node) -> str | None:
+        """노드의 이름(식별자)을 반환한다.
 
-        # 8. 텍스트 추출 및 포맷팅
-        # 의존성 노드들과 컨텍스트 노드들 분리
-        contexts = []
-        dependency_blocks = []
-        context_blocks = []
-        for node in sorted_nodes:
-            try:
-                node_text = node.text.decode("utf-8")
+        Args:
+            node: 이름을 찾을 노드
 
-                # 코틀린 import_header 노드인 경우 주석 제거
-                node_text = self._clean_kotlin_import(
-                    node, node_text, set(dependency_nodes)
-                )
+        Returns:
+            언어 resolver가 계산한 이름 또는 name 필드의 텍스트 (없으면 None)
+        """
+        # OWNS_NAMES인 언어는 resolver가 이름을 못 찾아도 name 필드를 보지 않음
+        resolver = self._scope_resolver
+        resolver_name = resolver.name(node)
+        if resolver_name is not None or resolver.OWNS_NAMES:
+            return resolver_name
+
+        target = node
+        if node.type == "decorated_definition":
+            target = node.child_by_field_name("definition") or node
+        name_node = target.child_by_field_name("name")
+        if name_node is None:
+            # Go type_declaration처럼 이름이 하위 spec 노드에 있는 경우
+            spec_node = next(
+                (
+                    child
+                    for child in target.named_children
+                    if child.child_by_field_name("name") is not None
+                ),
+                None,
+            )
+            if spec_node is not None:
+                name_node = spec_node.child_by_field_name("name")
+        if name_node is not None and name_node.type == "exported_symbol":
+            # Nim `proc foo*()`처럼 export 표시(`*`)가 붙은 이름은 식별자만 사용
+            name_node = next(iter(name_node.named_children), name_node)
+        if name_node is None or name_node.text is None:
+            return None
+        return node_text(name_node)
+
+    def _get_scope_path(self, node: Node) -> tuple[str, ...]:
+        """노드를 감싸는 조상 선언 이름들을 max_nesting_depth 이하로 반환한다.
 
-                # Python decorated_definition의 원본 파일 직접 추출
-                if (
-                    self._language_name == "python"
-                    and node.type == "decorated_definition"
-                ):
-                    node_text = self._extract_lines_from_original(node, file_content)
+        Args:
+            node: 경로를 계산할 노드
 
-                # 의존성 노드인지 컨텍스트 노드인지 구분
-                if self._is_dependency_node(node):
-                    dependency_blocks.append(node_text)
-                else:
-                    context_blocks.append((node_text, node))
-            except UnicodeDecodeError:
-                logger.error(f"노드 텍스트 디코딩 실패: {node.start_point}")
-                continue
+        Returns:
+            바깥쪽부터 순서대로의 조상 선언 이름 튜플 (제한을 넘으면 가장
+            안쪽 선언들만 남김)
+        """
+        scope_path, _ = self._get_limited_scope_path(node)
+        return scope_path
 
-        # 의존성 블록 포맷팅
-        if dependency_blocks:
-            formatted_dependency = self._format_dependency_block(dependency_blocks)
-            contexts.append(formatted_dependency)
+    def _get_limited_scope_path(self, node: Node) -> tuple[tuple[str, ...], bool]:
+        """scope_path를 max_nesting_depth 옵션 이하로 계산한다.
 
-        # 연속 블록 병합 및 포맷팅
-        merged_blocks = self._merge_adjacent_context_blocks(context_blocks)
-        for i, (merged_context, start_line, end_line) in enumerate(merged_blocks, 1):
-            formatted_context = self._format_context_block(
-                merged_context, start_line, end_line, i
-            )
-            contexts.append(formatted_context)
+        조상을 거슬러 올라가는 resolver에는 제한보다 하나 많은 개수를 넘겨
+        탐색 자체를 멈추게 하고, 그만큼 모였으면 제한을 넘은 것으로 본다.
+
+        Args:
+            node: 경로를 계산할 노드
+
+        Returns:
+            (가장 안쪽 선언들만 남긴 scope_path, 잘렸는지 여부) 튜플
+        """
+        max_depth = self._options.max_nesting_depth
+        scope_path = self._resolve_scope_path(node, max_depth + 1)
+        if len(scope_path) <= max_depth:
+            return scope_path, False
+        logger.debug(f"중첩 깊이가 제한 {max_depth}을(를) 넘어 scope_path를 자릅니다")
+        return scope_path[-max_depth:], True
+
+    def _resolve_scope_path(self, node: Node, limit: int) -> tuple[str, ...]:
+        """노드를 감싸는 조상 선언 이름들을 언어 resolver로 계산한다.
+
+        Java/R/Fortran/Julia는 감싸는 선언들, Solidity/Verilog는 감싸는
+        contract/모듈 이름, Erlang은 모듈과 (익명 함수면) 감싸는 함수 이름,
+        Tcl은 감싸는 namespace와 proc 이름, Haxe는 감싸는 타입과 함수(익명 함수
+        포함) 이름, JavaScript/TypeScript는 감싸는 클래스와 함수(클로저 포함)
+        이름을 사용하며, Go와 Pascal은 AST 조상 대신 메서드의 receiver
+        타입/클래스 이름을 소속 선언으로 사용한다. ScopeResolverRegistry에
+        resolver가 없는 언어는 빈 튜플을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 조상을 거슬러 올라가는 resolver가 모을 최대 이름 수
 
-        return contexts
+        Returns:
+            바깥쪽부터 순서대로의 조상 선언 이름 튜플
+        """
+        return self._scope_resolver.scope_path(node, limit)
 
     def _iter_nodes(self, node: Node) -> Generator[Node, None, None]:
         """DFS 방식으로 모든 노드를 순회한다."""
@@ -366,6 +3983,132 @@ class ContextExtractor:
                 minimal_nodes.add(smallest_node)
         return minimal_nodes
 
+    def _prefer_enclosing_symbol(
+        self, root: Node, line_range: LineRange, range_blocks: set[Node]
+    ) -> set[Node]:
+        """변경 범위(hunk)의 블록들을 범위를 모두 감싸는 가장 작은 심볼로 좁힌다.
+
+        라인별로 찾은 블록은 `logOperation := func(...) {`처럼 심볼 선언 라인의
+        토큰이 심볼 노드 밖에 있으면 바깥 함수(`AddNumbers`)로 넓어진다. 범위의
+        모든 라인을 감싸는 가장 작은 이름 있는 심볼이 있으면 그 심볼을 감싸는
+        블록을 심볼로 바꾸고, 익명 클로저처럼 이미 더 작은 블록은 그대로 둔다.
+        그런 심볼이 없으면(여러 함수에 걸친 변경 등) 라인별 블록을 그대로
+        사용한다. ancestor_depth 옵션이 있으면 그만큼 바깥쪽 심볼을 반환한다.
+
+        Args:
+            root: AST 루트 노드
+            line_range: 변경 범위
+            range_blocks: 범위의 각 라인에서 찾은 블록들
+
+        Returns:
+            변경 범위의 컨텍스트 블록들
+        """
+        if not range_blocks:
+            return range_blocks
+        symbol = self._find_enclosing_symbol(root, line_range)
+        if symbol is None:
+            return range_blocks
+        preferred = {
+            symbol if self._strictly_encloses(block, symbol) else block
+            for block in range_blocks
+        }
+        if self._options.ancestor_depth > 0:
+            preferred.add(symbol)
+        return preferred
+
+    def _find_enclosing_symbol(self, root: Node, line_range: LineRange) -> Node | None:
+        """범위의 모든 라인을 감싸는 가장 작은 이름 있는 심볼 노드를 찾는다.
+
+        ancestor_depth 옵션만큼 바깥쪽 이름 있는 심볼로 넓히며, 데코레이터가
+        있는 정의는 데코레이터를 포함한 전체 정의를 반환한다.
+
+        Args:
+            root: AST 루트 노드
+            line_range: 변경 범위
+
+        Returns:
+            심볼 노드 (범위를 감싸는 심볼이 없으면 None)
+        """
+        current = root
+        while True:
+            child = next(
+                (
+                    child
+                    for child in current.children
+                    if child.start_point[0] + 1 <= line_range.start_line
+                    and line_range.end_line <= child.end_point[0] + 1
+                ),
+                None,
+            )
+            if child is None:
+                break
+            current = child
+
+        symbols: list[Node] = []
+        node: Node | None = current
+        while node is not None and not self._is_root_node(node):
+            candidate: Node | None = node
+            if node.type == "decorated_definition":
+                # 데코레이터와 정의는 한 심볼이므로 안쪽 정의로 한 단계만 센다
+                # (데코레이터 라인의 변경이면 정의를 거치지 않고 여기로 올라옴)
+                candidate = node.children[-1]
+                if symbols and symbols[-1] == candidate:
+                    candidate = None
+            if (
+                candidate is not None
+                and candidate.type in self._block_types
+                and not self._is_dependency_node(candidate)
+                and self._is_named_symbol(candidate)
+            ):
+                symbols.append(candidate)
+            node = node.parent
+        if not symbols:
+            return None
+        symbol = symbols[min(self._options.ancestor_depth, len(symbols) - 1)]
+        parent = symbol.parent
+        if (
+            parent is not None
+            and parent.type == "decorated_definition"
+            and parent.children[-1] == symbol
+        ):
+            return parent
+        return symbol
+
+    def _is_named_symbol(self, node: Node) -> bool:
+        """이름 있는 심볼인지 확인한다.
+
+        `logOperation := func(...)`, `const logOperation = () => ...`처럼 같은
+        라인에서 시작하는 선언이 이름에 바인딩한 익명 함수도 이름 있는 심볼로
+        본다.
+
+        Args:
+            node: 블록 타입 노드
+
+        Returns:
+            이름 있는 심볼 여부
+        """
+        if self._get_node_name(node) is not None:
+            return True
+        parent = node.parent
+        while (
+            parent is not None
+            and parent.type not in self._block_types
+            and parent.start_point[0] == node.start_point[0]
+        ):
+            if parent.type in self.NAME_BINDING_TYPES:
+                return True
+            parent = parent.parent
+        return False
+
+    @staticmethod
+    def _strictly_encloses(outer: Node, inner: Node) -> bool:
+        """outer가 inner를 감싸는 다른 노드인지 바이트 범위로 확인한다."""
+        return (
+            outer != inner
+            and outer.start_byte <= inner.start_byte
+            and inner.end_byte <= outer.end_byte
+        )
+
     def _find_minimal_enclosing_block(self, node: Node) -> Node | None:
         """현재 노드에서 부모 방향으로 올라가며 가장 가까운 블록을 찾는다.
         데코레이터가 있는 경우 데코레이터를 포함한 전체 정의를 반환한다.
@@ -419,6 +4162,23 @@ class ContextExtractor:
 
     def _get_appropriate_context_for_node(self, node: Node) -> Node | None:
         """노드 타입에 따라 적절한 컨텍스트 블록을 결정한다."""
+        # 언어 resolver가 찾은 블록 단위로 처리 (TOML 섹션, Clojure 정의 form,
+        # Java 람다/익명 클래스 등). OWNS_SCOPES인 언어는 공통 규칙을 쓰지 않음
+        resolver = self._scope_resolver
+        scope = resolver.find_scope(node)
+        if scope is not None or resolver.OWNS_SCOPES:
+            return scope
+
+        # 데코레이터 인자(`@Post("/users")`)의 변경은 데코레이터가 붙은 정의로 처리
+        decorated = self._find_decorated_definition(node)
+        if decorated is not None:
+            return decorated
+
+        # 함수/클래스 밖 스크립트 코드는 감싸는 최상위 문장(반복문, 조건문 등) 반환
+        top_level_statement = self._find_top_level_statement(node)
+        if top_level_statement is not None:
+            return top_level_statement
+
         # 파일 레벨 assignment (상수) 처리
         if self._is_file_level_assignment(node):
             return self._handle_assignment_node(node)
@@ -430,6 +4190,92 @@ class ContextExtractor:
         # 일반적인 블록 처리
         return self._find_minimal_enclosing_block(node)
 
+    def _find_decorated_definition(self, node: Node) -> Node | None:
+        """데코레이터 안의 노드면 데코레이터가 붙은 정의 노드를 찾는다.
+
+        Python `decorated_definition`, TypeScript 클래스 데코레이터처럼
+        데코레이터가 정의의 자식이면 정의를 감싸는 블록을, TypeScript 메서드
+        데코레이터처럼 정의의 앞 형제 노드이면 뒤따르는 정의를 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            데코레이터가 붙은 정의 노드 (데코레이터 밖이거나 정의를 찾지
+            못하면 None)
+        """
+        decorator: Node | None = node
+        while decorator is not None and decorator.type != self.DECORATOR_TYPE:
+            decorator = decorator.parent
+        if decorator is None or decorator.parent is None:
+            return None
+        if decorator.parent.type in self._block_types:
+            return self._find_minimal_enclosing_block(decorator.parent)
+        sibling = decorator.next_named_sibling
+        while sibling is not None and sibling.type == self.DECORATOR_TYPE:
+            sibling = sibling.next_named_sibling
+        if sibling is not None and sibling.type in self._block_types:
+            return sibling
+        return None
+
+    def _leading_decorators(self, node: Node) -> list[Node]:
+        """정의 노드 앞에 형제로 붙은 데코레이터 노드들을 위치 순으로 반환한다.
+
+        TypeScript 클래스 본문의 메서드 데코레이터는 정의 노드에 포함되지
+        않으므로, 블록 텍스트와 라인 범위가 인자를 포함한 데코레이터 전체를
+        원문 그대로 포함하도록 할 때 쓴다. Python decorated_definition 안의
+        정의는 바깥 노드가 데코레이터를 포함하므로 제외한다.
+        """
+        if node.parent is None or node.parent.type == "decorated_definition":
+            return []
+        decorators: list[Node] = []
+        sibling = node.prev_named_sibling
+        while sibling is not None and sibling.type == self.DECORATOR_TYPE:
+            decorators.insert(0, sibling)
+            sibling = sibling.prev_named_sibling
+        return decorators
+
+    def _block_start_line(self, node: Node) -> int:
+        """노드 블록의 시작 라인(1-based)을 앞에 붙은 데코레이터까지 포함해 반환한다."""
+        decorators = self._leading_decorators(node)
+        first = decorators[0] if decorators else node
+        return first.start_point[0] + 1
+
+    def _find_top_level_statement(self, node: Node) -> Node | None:
+        """함수/클래스 밖에 있는 노드를 감싸는 가장 바깥 문장을 찾는다.
+
+        문장이 max_top_level_statement_lines를 넘으면 그 안쪽에서 제한 이내인
+        가장 바깥 문장을 반환한다.
+
+        Args:
+            node: 변경 라인의 최소 노드
+
+        Returns:
+            감싸는 최상위 문장 노드 (함수/클래스 안이거나 해당 문장이 없으면 None)
+        """
+        max_lines = self._options.max_top_level_statement_lines
+        if max_lines <= 0 or not self._top_level_statement_types:
+            return None
+
+        candidate: Node | None = None
+        fits = True
+        current = node
+        while current is not None and not self._is_root_node(current):
+            if (
+                current.type in self._block_types
+                and current.type not in self.STATEMENT_TRANSPARENT_BLOCK_TYPES
+            ):
+                # 함수/클래스 등 블록 안의 변경은 기존 블록 탐색 규칙을 따름
+                return None
+            if current.type in self._top_level_statement_types:
+                line_count = current.end_point[0] - current.start_point[0] + 1
+                if fits and line_count <= max_lines:
+                    candidate = current
+                else:
+                    fits = False
+            current = current.parent
+        return candidate
+
     def _is_file_level_assignment(self, node: Node) -> bool:
         """파일 레벨 assignment인지 확인한다."""
         # 노드에서 상위로 올라가면서 assignment 찾기
@@ -444,6 +4290,9 @@ class ContextExtractor:
             # JavaScript/TypeScript의 lexical_declaration (const, let, var)인 경우
             if current.type == "lexical_declaration":
                 return current.parent and self._is_root_node(current.parent)
+            # Go의 const/var 선언인 경우
+            if current.type in ("const_declaration", "var_declaration"):
+                return current.parent and self._is_root_node(current.parent)
             current = current.parent
         return False
 
@@ -525,7 +4374,7 @@ class ContextExtractor:
         Returns:
             의존성 노드들의 리스트 (위치 순으로 정렬됨)
         """
-        if not self._dependency_types:
+        if not self._dependency_types and not self._scope_resolver.OWNS_DEPENDENCIES:
             return []
 
         dependency_nodes = []
@@ -546,6 +4395,10 @@ class ContextExtractor:
         Returns:
             의존성 노드 여부
         """
+        if self._scope_resolver.OWNS_DEPENDENCIES:
+            # Clojure `ns` form, Starlark `load(...)`, Tcl `package require`처럼
+            # 노드 타입 대신 최상위 문장의 내용으로 판별
+            return self._scope_resolver.is_dependency(node)
         if node.type in self._dependency_types:
             # JS/TS의 경우 추가 확인
             if node.type == "call_expression":
@@ -567,11 +4420,7 @@ class ContextExtractor:
             require 호출 포함 여부
         """
         # 정규표현식을 사용한 간단하고 정확한 require() 검출
-        try:
-            node_text = node.text.decode("utf-8")
-            return bool(re.search(r"=\s*require\s*\(", node_text))
-        except UnicodeDecodeError:
-            return False
+        return bool(re.search(r"=\s*require\s*\(", node_text(node)))
 
     def _remove_context_dependency_overlap(
         self, context_blocks: set[Node], dependency_nodes: list[Node]
@@ -601,13 +4450,13 @@ class ContextExtractor:
         return filtered_blocks
 
     def _clean_kotlin_import(
-        self, node: Node, node_text: str, dependency_nodes: set
+        self, node: Node, block_text: str, dependency_nodes: set
     ) -> str:
         """코틀린 import_header 노드인 경우 주석 제거 처리.
 
         Args:
             node: 처리할 노드
-            node_text: 노드의 텍스트
+            block_text: 노드의 텍스트
             dependency_nodes: 의존성 노드 집합
 
         Returns:
@@ -618,8 +4467,8 @@ class ContextExtractor:
             and node.type in self._dependency_types
             and node in dependency_nodes
         ):
-            return self._clean_import_header(node_text)
-        return node_text
+            return self._clean_import_header(block_text)
+        return block_text
 
     def _clean_import_header(self, text: str) -> str:
         """코틀린 import_header에서 주석 부분 제거.
@@ -653,30 +4502,27 @@ class ContextExtractor:
         end_line = node.end_point[0]
 
         # 원본 파일에서 해당 라인들 직접 추출
-        original_lines = original_code.splitlines()
+        original_lines = split_lines(original_code)
 
         # 라인 범위 검증
         if start_line >= len(original_lines) or end_line >= len(original_lines):
             # 범위를 벗어나는 경우 기존 방식 사용
-            return node.text.decode("utf-8")
+            return node_text(node)
 
         # 해당 라인 범위 추출 (end_line 포함)
         extracted_lines = original_lines[start_line : end_line + 1]
 
         return "\n".join(extracted_lines)
 
-    def _format_dependency_block(self, dependency_blocks: list[str]) -> str:
-        """의존성 블록들을 하나의 포맷팅된 블록으로 만든다.
+    def _merge_dependency_lines(self, dependency_blocks: list[str]) -> str:
+        """의존성 블록들을 중복 없는 하나의 텍스트로 합친다.
 
         Args:
             dependency_blocks: 의존성 코드 블록들의 리스트
 
         Returns:
-            포맷팅된 의존성 블록
+            빈 줄과 중복 라인이 제거된 의존성 텍스트
         """
-        if not dependency_blocks:
-            return ""
-
         # 모든 dependency 블록을 합치고 줄 단위로 분리
         all_lines = []
         for block in dependency_blocks:
@@ -691,38 +4537,23 @@ class ContextExtractor:
                 unique_lines.append(line)
                 seen.add(stripped_line)
 
-        dependency_content = "\n".join(unique_lines)
-        return f"---- Dependencies/Imports ----\n{dependency_content}"
-
-    def _format_context_block(
-        self, context: str, start_line: int, end_line: int, block_number: int
-    ) -> str:
-        """컨텍스트 블록을 구분선과 함께 포맷팅한다.
-
-        Args:
-            context: 추출된 컨텍스트 내용
-            start_line: 시작 라인 (1-based)
-            end_line: 끝 라인 (1-based)
-            block_number: 블록 번호
-
-        Returns:
-            포맷팅된 컨텍스트 블록
-        """
-        header = (
-            f"---- Context Block {block_number} (Lines {start_line}-{end_line}) ----"
-        )
-        return f"{header}\n{context}"
+        return "\n".join(unique_lines)
 
     def _merge_adjacent_context_blocks(
-        self, context_blocks: list[tuple[str, Node]]
-    ) -> list[tuple[str, int, int]]:
+        self,
+        context_blocks: list[tuple[str, Node]],
+        comments: Mapping[Node, AssociatedComment] | None = None,
+        trailing_comments: Mapping[Node, AssociatedComment] | None = None,
+    ) -> list[ContextBlock]:
         """연속된 1줄짜리 블록들을 병합한다.
 
         Args:
             context_blocks: (context_text, node) 튜플들의 리스트
+            comments: 노드별로 연결된 주석
+            trailing_comments: 노드별로 닫는 구분자 뒤에 이어지는 주석
 
         Returns:
-            (merged_context, start_line, end_line) 튜플들의 리스트
+            병합된 ContextBlock들의 리스트
         """
         if not context_blocks:
             return []
@@ -750,15 +4581,175 @@ class ContextExtractor:
                 current_group.append((context_text, node))
             else:
                 # 현재 그룹을 병합하여 결과에 추가
-                merged_blocks.append(self._merge_block_group(current_group))
+                merged_blocks.append(
+                    self._merge_block_group(current_group, comments, trailing_comments)
+                )
                 current_group = [(context_text, node)]
 
         # 마지막 그룹 처리
         if current_group:
-            merged_blocks.append(self._merge_block_group(current_group))
+            merged_blocks.append(
+                self._merge_block_group(current_group, comments, trailing_comments)
+            )
 
         return merged_blocks
 
+    def _group_dispatch_methods(
+        self, context_blocks: list[ContextBlock], file_content: str
+    ) -> list[ContextBlock]:
+        """같은 함수의 여러 메서드(다중 디스패치) 블록을 하나로 묶는다.
+
+        같은 scope_path와 이름을 가진 함수 블록이 둘 이상이면 첫 메서드 위치에
+        block_type이 "method_group"인 블록 하나로 합친다. 메서드 사이의 빈
+        줄은 그대로 두고, 다른 코드가 있는 라인들은 생략 표시로 대신한다.
+
+        Args:
+            context_blocks: 병합된 컨텍스트 블록들
+            file_content: 원본 파일 내용
+
+        Returns:
+            메서드들을 묶은 컨텍스트 블록 리스트 (그 밖의 블록은 그대로 유지)
+        """
+        resolver = self._scope_resolver
+        groups: dict[tuple[tuple[str, ...], str], list[ContextBlock]] = {}
+        for block in context_blocks:
+            if (
+                block.block_type in resolver.METHOD_BLOCK_TYPES
+                and block.name is not None
+                and block.reason is None
+            ):
+                groups.setdefault((block.scope_path, block.name), []).append(block)
+
+        lines = split_lines(file_content)
+        grouped: list[ContextBlock] = []
+        for block in context_blocks:
+            methods = groups.get((block.scope_path, block.name or ""), [])
+            if len(methods) < 2 or block not in methods:
+                grouped.append(block)
+                continue
+            if block is not methods[0]:
+                continue
+            grouped.append(
+                self._join_grouped_blocks(
+                    methods, lines, resolver.METHOD_GROUP_BLOCK_TYPE
+                )
+            )
+        return grouped
+
+    def _collect_merged_declaration_groups(
+        self, context_nodes: set[Node]
+    ) -> list[frozenset[Node]]:
+        """변경된 TypeScript 선언과 하나의 심볼로 병합되는 선언 묶음들을 수집한다.
+
+        Args:
+            context_nodes: 변경과 겹치는 컨텍스트 노드들
+
+        Returns:
+            변경된 선언을 포함한 병합 선언 노드 묶음 리스트 (선언 병합이 없는
+            언어이거나 병합되는 선언이 없으면 빈 리스트)
+        """
+        resolver = self._scope_resolver
+        groups: list[frozenset[Node]] = []
+        for node in sorted(context_nodes, key=node_order_key):
+            parts = frozenset(resolver.merged_parts(node))
+            if parts and parts not in groups:
+                groups.append(parts)
+        return groups
+
+    def _group_merged_declarations(
+        self,
+        context_blocks: list[ContextBlock],
+        file_content: str,
+        groups: list[frozenset[Node]],
+    ) -> list[ContextBlock]:
+        """병합되는 TypeScript 선언 블록들을 하나의 블록으로 묶는다.
+
+        선언 묶음의 노드를 감싸는 블록이 둘 이상이면 첫 선언 위치에
+        block_type이 "merged_declaration"인 블록 하나로 합친다. 선언 사이의
+        다른 코드는 생략 표시로 대신한다.
+
+        Args:
+            context_blocks: 병합된 컨텍스트 블록들
+            file_content: 원본 파일 내용
+            groups: _collect_merged_declaration_groups로 수집한 선언 묶음들
+
+        Returns:
+            병합 선언들을 묶은 컨텍스트 블록 리스트 (그 밖의 블록은 그대로 유지)
+        """
+        resolver = self._scope_resolver
+        lines = split_lines(file_content)
+        grouped = list(context_blocks)
+        for group in groups:
+            parts = [
+                block
+                for block in grouped
+                if block.reason is None
+                and any(
+                    block.line_range.contains(node.start_point[0] + 1)
+                    for node in group
+                )
+            ]
+            if len(parts) < 2:
+                continue
+            parts.sort(key=lambda part: part.line_range.start_line)
+            merged = replace(
+                self._join_grouped_blocks(parts, lines, resolver.MERGED_BLOCK_TYPE),
+                name=resolver.merged_name(next(iter(group))),
+            )
+            index = grouped.index(parts[0])
+            grouped = [block for block in grouped if block not in parts]
+            grouped.insert(index, merged)
+        return grouped
+
+    def _join_grouped_blocks(
+        self, blocks: list[ContextBlock], lines: list[str], block_type: str
+    ) -> ContextBlock:
+        """같은 심볼을 이루는 블록들을 첫 블록 위치의 블록 하나로 합친다.
+
+        블록 사이의 빈 줄은 그대로 두고, 다른 코드가 있는 라인들은 생략
+        표시로 대신한다.
+
+        Args:
+            blocks: 합칠 블록들 (라인 순으로 정렬해 사용)
+            lines: 원본 파일 내용의 라인들
+            block_type: 합친 블록의 block_type
+
+        Returns:
+            첫 블록부터 마지막 블록까지를 라인 범위로 갖는 ContextBlock
+        """
+        blocks = sorted(blocks, key=lambda block: block.line_range.start_line)
+        texts = [blocks[0].text]
+        for previous, block in zip(blocks, blocks[1:]):
+            gap_start = previous.line_range.end_line + 1
+            gap_end = block.line_range.start_line - 1
+            gap = lines[gap_start - 1 : gap_end]
+            if gap and any(line.strip() for line in gap):
+                gap = [
+                    self.ADAPTIVE_OMISSION_MARKER.format(start=gap_start, end=gap_end)
+                ]
+            texts.extend(gap)
+            texts.append(block.text)
+        return replace(
+            blocks[0],
+            text="\n".join(texts),
+            line_range=LineRange(
+                blocks[0].line_range.start_line, blocks[-1].line_range.end_line
+            ),
+            block_type=block_type,
+            signature=None,
+            recursive=any(block.recursive for block in blocks),
+        )
+
+    @staticmethod
+    def _block_end_line(
+        node: Node, trailing_comments: Mapping[Node, AssociatedComment] | None
+    ) -> int:
+        """노드 블록의 끝 라인(1-based)을 뒤쪽 주석까지 포함해 반환한다."""
+        trailing = (trailing_comments or {}).get(node)
+        if trailing is not None:
+            return trailing.line_range.end_line
+        return node.end_point[0] + 1
+
     def _is_single_line_node(self, node: Node) -> bool:
         """노드가 1줄짜리인지 확인한다.
 
@@ -771,29 +4762,61 @@ class ContextExtractor:
         return node.start_point[0] == node.end_point[0]
 
     def _merge_block_group(
-        self, block_group: list[tuple[str, Node]]
-    ) -> tuple[str, int, int]:
+        self,
+        block_group: list[tuple[str, Node]],
+        comments: Mapping[Node, AssociatedComment] | None = None,
+        trailing_comments: Mapping[Node, AssociatedComment] | None = None,
+    ) -> ContextBlock:
         """블록 그룹을 하나로 병합한다.
 
         Args:
             block_group: 병합할 블록들의 그룹
+            comments: 노드별로 연결된 주석
+            trailing_comments: 노드별로 닫는 구분자 뒤에 이어지는 주석
+                (있으면 블록의 끝 라인을 주석 끝까지 넓힘)
 
         Returns:
-            (merged_context, start_line, end_line) 튜플
+            병합된 ContextBlock (단일 블록이면 노드 타입, 이름, 주석을 보존)
         """
         if len(block_group) == 1:
             context_text, node = block_group[0]
-            start_line = node.start_point[0] + 1  # 1-based
-            end_line = node.end_point[0] + 1  # 1-based
-            return (context_text, start_line, end_line)
+            start_line = self._block_start_line(node)
+            end_line = self._block_end_line(node, trailing_comments)
+            comment = (comments or {}).get(node)
+            if comment is not None and comment.is_leading:
+                start_line = comment.line_range.start_line
+            name = self._get_node_name(node)
+            signature = self._parse_signature(node)
+            scope_path, depth_limited = self._get_limited_scope_path(node)
+            block_type, block_name = self._get_block_kind(node, name)
+            display_name, qualified_name = (
+                self._format_symbol_names(node, block_name, scope_path, signature)
+                if block_name is not None
+                else (None, None)
+            )
+            return ContextBlock(
+                text=context_text,
+                line_range=LineRange(start_line, end_line),
+                block_type=block_type,
+                name=display_name,
+                doc_comment=comment.text if comment is not None else None,
+                signature=signature,
+                scope_path=scope_path,
+                recursive=self._recursive_call_detector.is_recursive(node, name),
+                qualified_name=qualified_name,
+                depth_limited=depth_limited,
+                unit_section=self._scope_resolver.section(node),
+            )
 
         # 여러 블록을 병합
         merged_contexts = []
-        start_line = block_group[0][1].start_point[0] + 1  # 1-based
-        end_line = block_group[-1][1].end_point[0] + 1  # 1-based
+        start_line = self._block_start_line(block_group[0][1])
+        end_line = self._block_end_line(block_group[-1][1], trailing_comments)
 
         for context_text, _ in block_group:
             merged_contexts.append(context_text)
 
         merged_context = "\n".join(merged_contexts)
-        return (merged_context, start_line, end_line)
+        return ContextBlock(
+            text=merged_context, line_range=LineRange(start_line, end_line)
+        )
@@ -0,0 +1,351 @@
+"""ContextRenderer: 추출된 컨텍스트를 하나의 프롬프트용 문서로 렌더링하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import replace
+
+from .context_block import ContextBlock
+from .extracted_file_context import ExtractedFileContext
+from .indent_style import IndentStyle
+from .render_options import RenderOptions
+from .shared_imports import SharedImports
+
+
+class ContextRenderer:
+    """파일별 컨텍스트 추출 결과를 하나의 문서로 합친다.
+
+    주요 특징:
+    - 파일 헤더와 블록(심볼) 헤더로 구분된 결정적(deterministic) 출력
+    - 선택적인 원본 라인 번호 gutter (파일 안의 블록들이 같은 너비로 정렬)
+    - 전체 문자 수 예산을 초과하면 이후 블록을 생략하고 생략 사실을 명시
+    - 이름이 바뀐 파일은 파일 헤더에 이전 경로를 표시하고, 이름 변경 전
+      심볼 블록을 새 파일 블록 뒤에 이전 파일 라인 번호로 표시
+    - 선택적으로 선행 들여쓰기를 파일의 들여쓰기 단위로 통일해 표시
+    - 선택적으로 파일 안의 블록을 최상위 선언 아래로 묶어 표시
+    - 선택적으로 여러 파일에 공통인 import 라인을 문서 앞에 한 번만 표시
+    """
+
+    FILE_HEADER_TEMPLATE = "==== File: {file_path} ({language}) ===="
+    RENAMED_FILE_HEADER_TEMPLATE = "==== File: {file_path} ({language}) [{rename}] ===="
+    DECLARATION_HEADER_TEMPLATE = "== Declaration: {name} =="
+    SHARED_IMPORTS_HEADER = "==== Shared Imports ===="
+    SHARED_IMPORTS_FILES_TEMPLATE = "---- Used by: {files} ----"
+    PREVIOUS_BLOCK_HEADER_TEMPLATE = (
+        "---- Previous Block {block_number} ({old_path}, Lines {start}-{end})"
+    )
+    TRUNCATION_NOTE_TEMPLATE = (
+        "[... {omitted} block(s) omitted: context budget of {max_chars} chars "
+        "exceeded ...]"
+    )
+
+    def __init__(self, options: RenderOptions | None = None) -> None:
+        """렌더러 초기화.
+
+        Args:
+            options: 렌더링 옵션 (기본값: RenderOptions())
+        """
+        self._options = options or RenderOptions()
+
+    def render(self, results: Sequence[ExtractedFileContext]) -> str:
+        """파일별 추출 결과들을 하나의 문서로 렌더링한다.
+
+        파일 순서는 입력 순서를 따르고, 파일 내부에서는 의존성 블록 다음에
+        컨텍스트 블록이 라인 순서대로 배치된다. 예산은 렌더링 시점에 전달된
+        결과만으로 계산되므로, 호출자가 렌더링 전에 제거한 블록의 몫은 이후
+        블록들에 그대로 배분된다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+
+        Returns:
+            렌더링된 문서 문자열
+        """
+        units = self._build_units(results)
+        if not units:
+            return ""
+
+        max_chars = self._options.max_chars
+        reserve = 0
+        if max_chars is not None:
+            # 최악의 경우(모든 블록 생략)에도 생략 안내가 예산 안에 들어오도록 예약
+            reserve = len(self._truncation_note(len(units))) + 1
+
+        lines: list[str] = []
+        current_length = 0
+        emitted_files: set[int] = set()
+        accepted = 0
+
+        for file_index, file_header, unit_text in units:
+            pieces = [unit_text]
+            if file_index not in emitted_files:
+                pieces.insert(0, file_header)
+            addition = sum(len(piece) + 1 for piece in pieces)
+
+            if max_chars is not None and current_length + addition > (
+                max_chars - reserve
+            ):
+                break
+
+            lines.extend(pieces)
+            current_length += addition
+            emitted_files.add(file_index)
+            accepted += 1
+
+        omitted = len(units) - accepted
+        if omitted:
+            lines.append(self._truncation_note(omitted))
+
+        return "\n".join(lines)
+
+    def _build_units(
+        self, results: Sequence[ExtractedFileContext]
+    ) -> list[tuple[int, str, str]]:
+        """예산 계산 단위인 (파일 인덱스, 파일 헤더, 블록 텍스트) 목록을 만든다.
+
+        공유 import 섹션은 파일 인덱스 -1의 단위로 맨 앞에 둔다.
+        """
+        units: list[tuple[int, str, str]] = []
+        shared_imports = SharedImports()
+        if self._options.include_dependencies and self._options.share_imports:
+            shared_imports = SharedImports.collect(results)
+            if shared_imports.groups:
+                units.append(
+                    (
+                        -1,
+                        self.SHARED_IMPORTS_HEADER,
+                        self._render_shared_imports(shared_imports),
+                    )
+                )
+        for file_index, result in enumerate(results):
+            file_header = self._file_header(result)
+            style = result.indent_style
+            if self._options.include_dependencies:
+                for block in result.dependency_blocks:
+                    stripped = shared_imports.strip(block)
+                    if stripped is None:
+                        continue
+                    rendered = self._render_block(self._display_block(stripped, style))
+                    units.append((file_index, file_header, rendered))
+            blocks = result.context_blocks
+            gutter_width = self._gutter_width(blocks)
+            declaration_headers: dict[int, str] = {}
+            if self._options.group_by_declaration:
+                blocks, declaration_headers = self._group_by_declaration(blocks)
+            for block_number, block in enumerate(blocks, 1):
+                rendered = self._render_block(
+                    self._display_block(block, style), block_number, gutter_width
+                )
+                declaration_header = declaration_headers.get(block_number)
+                if declaration_header is not None:
+                    rendered = f"{declaration_header}\n{rendered}"
+                units.append((file_index, file_header, rendered))
+            if result.rename is not None:
+                previous_width = self._gutter_width(result.previous_blocks)
+                for block_number, block in enumerate(result.previous_blocks, 1):
+                    rendered = self._render_previous_block(
+                        self._display_block(block, style),
+                        block_number,
+                        result.rename.old_path,
+                        previous_width,
+                    )
+                    units.append((file_index, file_header, rendered))
+        return units
+
+    def _render_shared_imports(self, shared_imports: SharedImports) -> str:
+        """공유 import 라인들을 사용하는 파일 묶음별로 렌더링한다."""
+        sections = []
+        for files, lines in shared_imports.groups:
+            header = self.SHARED_IMPORTS_FILES_TEMPLATE.format(files=", ".join(files))
+            sections.append("\n".join([header, *lines]))
+        return "\n".join(sections)
+
+    def _group_by_declaration(
+        self, blocks: Sequence[ContextBlock]
+    ) -> tuple[list[ContextBlock], dict[int, str]]:
+        """블록들을 최상위 선언별로 묶어 재배치한다.
+
+        블록의 scope_path 첫 이름을 소속 선언으로 보고, scope_path가 없는
+        블록은 자기 이름의 선언으로 본다. 따라서 구조체 블록과 그 메서드
+        블록들이 한 그룹이 되며, 그룹은 처음 등장한 위치 순서로, 그룹 안의
+        블록은 원래(라인) 순서로 배치된다. 다른 파일에서 가져온 블록은 묶지
+        않는다.
+
+        Args:
+            blocks: 라인 순으로 정렬된 컨텍스트 블록들
+
+        Returns:
+            (재배치된 블록 리스트, 선언 헤더를 붙일 블록 번호(1-based)별 헤더)
+            튜플. 소속 멤버가 없는 최상위 블록에는 헤더를 붙이지 않는다.
+        """
+        groups: list[list[ContextBlock]] = []
+        groups_by_owner: dict[str, list[ContextBlock]] = {}
+        for block in blocks:
+            owner = self._declaration_owner(block)
+            if owner is None:
+                groups.append([block])
+                continue
+            group = groups_by_owner.get(owner)
+            if group is None:
+                group = groups_by_owner[owner] = []
+                groups.append(group)
+            group.append(block)
+
+        ordered: list[ContextBlock] = []
+        headers: dict[int, str] = {}
+        for group in groups:
+            if any(block.scope_path for block in group):
+                owner = self._declaration_owner(group[0])
+                headers[len(ordered) + 1] = self.DECLARATION_HEADER_TEMPLATE.format(
+                    name=owner
+                )
+            ordered.extend(group)
+        return ordered, headers
+
+    @staticmethod
+    def _declaration_owner(block: ContextBlock) -> str | None:
+        """블록이 속한 최상위 선언 이름을 반환한다 (묶지 않는 블록이면 None)."""
+        if block.source_path is not None:
+            return None
+        if block.scope_path:
+            return block.scope_path[0]
+        return block.name
+
+    def _display_block(
+        self, block: ContextBlock, indent_style: IndentStyle | None
+    ) -> ContextBlock:
+        """옵션이 켜진 경우 선행 들여쓰기를 통일한 표시용 블록 사본을 반환한다.
+
+        원본 블록의 text(바이트 단위로 정확한 내용)와 라인 범위는 바꾸지 않는다.
+        다른 파일에서 가져온 블록은 블록 자체에서 들여쓰기 단위를 감지한다.
+
+        Args:
+            block: 원본 블록
+            indent_style: 파일에서 감지한 들여쓰기 단위 (없으면 공백)
+
+        Returns:
+            표시용 블록 (옵션이 꺼져 있으면 원본 블록)
+        """
+        tab_width = self._options.tab_width
+        if tab_width is None:
+            return block
+        if block.source_path is not None:
+            indent_style = IndentStyle.detect(block.text) or indent_style
+        style = indent_style or IndentStyle.SPACES
+        return replace(block, text=style.normalize(block.text, tab_width))
+
+    def _file_header(self, result: ExtractedFileContext) -> str:
+        """파일 헤더를 만든다 (이름이 바뀐 파일은 이전 경로와 유사도 포함)."""
+        if result.rename is None:
+            return self.FILE_HEADER_TEMPLATE.format(
+                file_path=result.file_path, language=result.language
+            )
+        return self.RENAMED_FILE_HEADER_TEMPLATE.format(
+            file_path=result.file_path,
+            language=result.language,
+            rename=result.rename.describe(),
+        )
+
+    def _render_block(
+        self, block: ContextBlock, block_number: int = 0, gutter_width: int = 0
+    ) -> str:
+        """블록 하나를 헤더와 함께 렌더링한다.
+
+        Args:
+            block: 렌더링할 블록
+            block_number: 컨텍스트 블록 번호 (의존성 블록에서는 무시됨)
+            gutter_width: 라인 번호 gutter의 최소 너비
+
+        Returns:
+            렌더링된 블록 문자열
+        """
+        if block.is_dependency:
+            return block.format(block_number)
+
+        header = block.header(block_number, include_name=True)
+        body = block.body()
+        if self._options.include_line_numbers:
+            body = self._add_line_number_gutter(block, gutter_width)
+            if block.package_declaration is not None:
+                body = f"{block.package_declaration}\n{body}"
+        return f"{header}\n{body}"
+
+    def _render_previous_block(
+        self,
+        block: ContextBlock,
+        block_number: int,
+        old_path: str,
+        gutter_width: int = 0,
+    ) -> str:
+        """이름 변경 전 파일의 심볼 블록을 이전 경로 헤더와 함께 렌더링한다.
+
+        Args:
+            block: 이전 파일에서 추출한 블록 (라인 번호는 이전 파일 기준)
+            block_number: 이전 블록 번호
+            old_path: 이름 변경 전 경로
+            gutter_width: 라인 번호 gutter의 최소 너비
+
+        Returns:
+            렌더링된 블록 문자열
+        """
+        header = self.PREVIOUS_BLOCK_HEADER_TEMPLATE.format(
+            block_number=block_number,
+            old_path=old_path,
+            start=block.line_range.start_line,
+            end=block.line_range.end_line,
+        )
+        if block.name:
+            header += f": {block.name}"
+        body = block.text
+        if self._options.include_line_numbers:
+            body = self._add_line_number_gutter(block, gutter_width)
+        return f"{header} ----\n{body}"
+
+    @staticmethod
+    def _gutter_width(blocks: Sequence[ContextBlock]) -> int:
+        """블록들의 가장 큰 라인 번호 자릿수를 gutter 너비로 반환한다."""
+        return max(
+            (len(str(block.line_range.end_line)) for block in blocks), default=0
+        )
+
+    def _add_line_number_gutter(self, block: ContextBlock, min_width: int = 0) -> str:
+        """블록 텍스트의 각 라인 앞에 원본 라인 번호를 우측 정렬로 붙인다.
+
+        라인은 `\n` 기준으로 나누므로 멀티바이트 문자가 있어도 표시 너비와
+        무관하게 원본 라인과 1:1로 대응하며, 블록의 text는 바꾸지 않는다.
+
+        Args:
+            block: 라인 번호를 붙일 블록
+            min_width: gutter 최소 너비 (같은 파일의 블록들을 맞추는 데 사용)
+
+        Returns:
+            gutter가 붙은 표시용 텍스트
+        """
+        width = max(len(str(block.line_range.end_line)), min_width)
+        numbered_lines = []
+        for offset, line in enumerate(block.text.split("\n")):
+            line_number = block.line_range.start_line + offset
+            gutter = f"{line_number:>{width}} |"
+            numbered_lines.append(f"{gutter} {line}" if line else gutter)
+        return "\n".join(numbered_lines)
+
+    def _truncation_note(self, omitted: int) -> str:
+        """생략 안내 문구를 만든다."""
+        return self.TRUNCATION_NOTE_TEMPLATE.format(
+            omitted=omitted, max_chars=self._options.max_chars
+        )
+
+
+def render_context(
+    results: Sequence[ExtractedFileContext], options: RenderOptions | None = None
+) -> str:
+    """파일별 추출 결과를 하나의 프롬프트용 문서로 렌더링한다.
+
+    Args:
+        results: 파일별 컨텍스트 추출 결과들
+        options: 렌더링 옵션
+
+    Returns:
+        렌더링된 문서 문자열
+    """
+    return ContextRenderer(options).render(results)
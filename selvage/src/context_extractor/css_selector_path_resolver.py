@@ -0,0 +1,183 @@
+"""CssSelectorPathResolver: CSS/SCSS 규칙의 전체 선택자 경로를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class CssSelectorPathResolver(ScopeResolver):
+    """CSS/SCSS AST에서 규칙 블록의 선택자 경로와 감싸는 스코프를 계산한다.
+
+    SCSS 중첩 규칙은 바깥 규칙의 선택자와 결합해 전체 경로를 만들며
+    (`.card { .title {} }` → ".card .title", `&:hover` → ".btn:hover"),
+    `@media`/`@supports` 블록은 규칙을 감싸는 스코프로 취급한다.
+    """
+
+    # 변경을 감싸는 블록은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+
+    # 선택자 규칙 노드 타입
+    RULE_TYPES = frozenset({"rule_set"})
+
+    # 규칙을 감싸는 스코프(컨테이너) 노드 타입
+    CONTAINER_TYPES = frozenset({"media_statement", "supports_statement"})
+
+    # 컨테이너 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = RULE_TYPES | frozenset({"declaration"})
+
+    # 변경 시 블록 전체를 반환하는 at-rule 노드 타입
+    AT_RULE_TYPES = CONTAINER_TYPES | frozenset(
+        {
+            "keyframes_statement",
+            "at_rule",
+            "mixin_statement",
+            "function_statement",
+        }
+    )
+
+    # 파일 루트 노드 타입
+    ROOT_TYPE = "stylesheet"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 변수 선언, 선택자 규칙 또는 at-rule 블록을 찾는다.
+
+        `$foo`/`--foo` 변수 선언은 선언만 반환하고, 일반 속성 선언은 감싸는
+        가장 가까운 선택자 규칙을 반환한다. 규칙 밖의 변경(@media 조건,
+        @keyframes 등)은 감싸는 at-rule 블록 전체를 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            변수 선언, 규칙, at-rule 또는 최상위 문장 노드 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None and current.type != self.ROOT_TYPE:
+            if self.is_variable_declaration(current):
+                return current
+            if current.type in self.RULE_TYPES or current.type in self.AT_RULE_TYPES:
+                return current
+            if current.parent is not None and current.parent.type == self.ROOT_TYPE:
+                # 최상위 선언 등 규칙 밖의 문장
+                return current
+            current = current.parent
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """CSS 노드의 표시용 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            규칙은 전체 선택자 경로, at-rule은 헤더, 변수 선언은 변수 이름
+            (해당하지 않으면 None)
+        """
+        if node.type in self.RULE_TYPES:
+            return self.selector_path(node)
+        if node.type in self.AT_RULE_TYPES:
+            return self.container_header(node)
+        if node.type == "declaration" and self.is_variable_declaration(node):
+            return self._property_name(node)
+        return None
+
+    def is_variable_declaration(self, node: Node) -> bool:
+        """SCSS 변수(`$foo`) 또는 CSS 사용자 정의 속성(`--foo`) 선언인지 확인한다."""
+        return node.type == "declaration" and self._property_name(node).startswith(
+            ("$", "--")
+        )
+
+    def selector_path(self, rule: Node) -> str | None:
+        """규칙의 전체 선택자 경로를 반환한다.
+
+        Args:
+            rule: rule_set 노드
+
+        Returns:
+            바깥 규칙 선택자와 결합한 선택자 경로 (선택자가 없으면 None)
+        """
+        paths: list[str] | None = None
+        for ancestor in reversed(self._enclosing_rules(rule)):
+            selectors = self._split_selectors(self._selectors_text(ancestor))
+            if not selectors:
+                continue
+            paths = selectors if paths is None else self._combine(paths, selectors)
+        return ", ".join(paths) if paths else None
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 @media/@supports 노드를 찾는다."""
+        current = node.parent
+        while current is not None:
+            if current.type in self.CONTAINER_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """at-rule의 `{` 앞 헤더를 공백을 정규화해 반환한다."""
+        text = node_text(container).split("{", 1)[0]
+        return " ".join(text.replace(";", " ").split())
+
+    def container_name(self, container: Node) -> str:
+        """컨테이너 이름으로 헤더를 반환한다."""
+        return self.container_header(container)
+
+    def _enclosing_rules(self, rule: Node) -> list[Node]:
+        """규칙 자신부터 바깥쪽 순서로 감싸는 rule_set 노드들을 반환한다."""
+        rules: list[Node] = []
+        current: Node | None = rule
+        while current is not None:
+            if current.type in self.RULE_TYPES:
+                rules.append(current)
+            current = current.parent
+        return rules
+
+    def _selectors_text(self, rule: Node) -> str:
+        """rule_set의 선택자 텍스트를 반환한다."""
+        selectors = next(
+            (child for child in rule.named_children if child.type == "selectors"),
+            None,
+        )
+        if selectors is not None:
+            return node_text(selectors)
+        return node_text(rule).split("{", 1)[0]
+
+    def _property_name(self, declaration: Node) -> str:
+        """선언의 속성(변수) 이름을 반환한다."""
+        return node_text(declaration).split(":", 1)[0].strip()
+
+    @staticmethod
+    def _combine(parents: list[str], children: list[str]) -> list[str]:
+        """바깥 선택자들과 중첩 선택자들을 결합한다 (`&`는 바깥 선택자로 치환)."""
+        combined: list[str] = []
+        for parent in parents:
+            for child in children:
+                if "&" in child:
+                    combined.append(child.replace("&", parent))
+                else:
+                    combined.append(f"{parent} {child}")
+        return combined
+
+    @staticmethod
+    def _split_selectors(text: str) -> list[str]:
+        """괄호 안의 쉼표는 유지하며 선택자 목록을 쉼표로 나눈다."""
+        selectors: list[str] = []
+        depth = 0
+        current: list[str] = []
+        for char in text:
+            if char in "([":
+                depth += 1
+            elif char in ")]":
+                depth = max(depth - 1, 0)
+            if char == "," and depth == 0:
+                selectors.append("".join(current))
+                current = []
+                continue
+            current.append(char)
+        selectors.append("".join(current))
+        return [
+            " ".join(selector.split()) for selector in selectors if selector.strip()
+        ]
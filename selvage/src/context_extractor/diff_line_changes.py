@@ -0,0 +1,49 @@
+"""DiffLineChanges: 수정 후 파일 기준의 diff 추가/삭제 라인 정보."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class DiffLineChanges:
+    """파일 하나의 diff에서 추가/삭제된 라인 위치.
+
+    모든 라인 번호는 수정 후 파일 기준(1-based)이다. 삭제된 라인은 수정 후
+    파일에 존재하지 않으므로, 삭제가 일어난 위치의 다음 라인 번호로 기록한다.
+
+    Attributes:
+        added_lines: 추가된 라인 번호들
+        deleted_lines: 삭제된 라인마다의 위치(수정 후 파일 라인 번호)
+    """
+
+    added_lines: frozenset[int] = frozenset()
+    deleted_lines: tuple[int, ...] = ()
+
+    @classmethod
+    def combine(cls, changes: Iterable[DiffLineChanges]) -> DiffLineChanges:
+        """여러 hunk의 변경 정보를 하나로 합친다.
+
+        Args:
+            changes: hunk별 변경 정보들
+
+        Returns:
+            합쳐진 변경 정보
+        """
+        added_lines: set[int] = set()
+        deleted_lines: list[int] = []
+        for change in changes:
+            added_lines.update(change.added_lines)
+            deleted_lines.extend(change.deleted_lines)
+        return cls(frozenset(added_lines), tuple(sorted(deleted_lines)))
+
+    def count_added(self, line_range: LineRange) -> int:
+        """범위 안의 추가된 라인 수를 반환한다."""
+        return sum(1 for line in self.added_lines if line_range.contains(line))
+
+    def count_deleted(self, line_range: LineRange) -> int:
+        """범위 안에서 삭제된 라인 수를 반환한다."""
+        return sum(1 for line in self.deleted_lines if line_range.contains(line))
@@ -0,0 +1,366 @@
+"""DirectoryContextExtractor: 디렉토리 트리 전체의 심볼 구조를 수집하는 추출기."""
+
+from __future__ import annotations
+
+import logging
+import os
+import threading
+from concurrent.futures import ThreadPoolExecutor
+from pathlib import Path
+
+from selvage.src.exceptions import UnsupportedLanguageError
+from selvage.src.utils.code_owners import CodeOwners
+from selvage.src.utils.git_attributes import GitAttributes
+from selvage.src.utils.language_detector import (
+    CONTENT_SNIFF_BYTES,
+    detect_language_from_content,
+    detect_language_from_filename,
+    detect_language_from_shebang,
+)
+from selvage.src.utils.selvage_ignore import SelvageIgnore
+
+from .code_owner_annotator import CodeOwnerAnnotator
+from .context_extractor import ContextExtractor
+from .extracted_file_context import ExtractedFileContext
+from .extraction_options import ExtractionOptions
+from .language_filter import LanguageFilter
+
+logger = logging.getLogger(__name__)
+
+
+class DirectoryContextExtractor:
+    """디렉토리를 순회하며 지원 언어 파일마다 모든 심볼 블록을 수집한다.
+
+    diff 리뷰가 아닌 인덱싱 용도로, 변경 범위 없이 파일의 심볼 구조를
+    ContextExtractor.extract_symbol_outline으로 수집한다.
+
+    주요 특징:
+    - `.selvageignore`로 제외된 경로와 `.gitattributes`의 `linguist-generated`
+      파일은 건너뜀
+    - 언어는 `.gitattributes`의 `linguist-language`, 확장자, 등록된 내용 분류기,
+      shebang 순으로 감지
+    - 파일들을 스레드 풀에서 동시에 파싱 (추출기는 스레드별로 재사용)
+    - 심볼릭 링크를 따라가되 이미 방문한 디렉토리를 가리키는 링크(순환)와,
+      기본적으로 루트 밖을 가리키는 링크는 따라가지 않음
+    - 읽기/파싱 오류, 크기 제한 초과, 언어 허용/거부 목록으로 거른 파일과
+      따라가지 않은 심볼릭 링크는 파일별 결과의 status로 기록
+    - 선택적으로 루트의 `CODEOWNERS`로 파일별 소유자를 기록
+    """
+
+    # 기본 파일 크기 제한 (UTF-8 바이트)
+    DEFAULT_MAX_FILE_BYTES = 1024 * 1024
+
+    DEFAULT_MAX_WORKERS = 4
+
+    # 항상 순회하지 않는 디렉토리 이름
+    SKIPPED_DIRECTORY_NAMES = frozenset({".git"})
+
+    def __init__(
+        self,
+        options: ExtractionOptions | None = None,
+        max_workers: int = DEFAULT_MAX_WORKERS,
+        max_file_bytes: int | None = DEFAULT_MAX_FILE_BYTES,
+        language_filter: LanguageFilter | None = None,
+        follow_external_symlinks: bool = False,
+        include_code_owners: bool = False,
+    ) -> None:
+        """추출기 초기화.
+
+        Args:
+            options: 파일별 추출기에 전달할 추출 옵션 (parse_timeout_seconds 등)
+            max_workers: 동시에 파싱할 최대 스레드 수
+            max_file_bytes: 읽을 파일의 최대 크기. 넘는 파일은 status가
+                "too-large"인 결과로 기록한다 (None이면 제한 없음).
+            language_filter: 추출할 언어의 허용/거부 목록. 거른 파일은 읽지 않고
+                status가 "language-filtered"인 결과로 기록한다 (None이면 모든
+                지원 언어를 추출).
+            follow_external_symlinks: 루트 밖을 가리키는 심볼릭 링크도 따라갈지
+                여부. 따라가지 않은 링크는 status가 "symlink-outside-root"인
+                결과로 기록한다.
+            include_code_owners: 루트 디렉토리의 `CODEOWNERS`(하위 디렉토리의
+                `CODEOWNERS` 포함)로 찾은 소유자를 파일별 결과의 owners에
+                기록할지 여부
+
+        Raises:
+            ValueError: max_workers나 max_file_bytes가 1 미만인 경우
+        """
+        if max_workers < 1:
+            raise ValueError("max_workers는 1 이상이어야 합니다")
+        if max_file_bytes is not None and max_file_bytes < 1:
+            raise ValueError("max_file_bytes는 1 이상이어야 합니다")
+        self._options = options or ExtractionOptions()
+        self._max_workers = max_workers
+        self._max_file_bytes = max_file_bytes
+        self._language_filter = language_filter or LanguageFilter()
+        self._follow_external_symlinks = follow_external_symlinks
+        self._include_code_owners = include_code_owners
+        self._local = threading.local()
+
+    def extract(self, root_dir: str | Path) -> dict[str, ExtractedFileContext]:
+        """디렉토리 트리의 지원 언어 파일들에서 심볼 블록을 수집한다.
+
+        Args:
+            root_dir: 순회할 루트 디렉토리
+
+        Returns:
+            루트 기준 경로(`/` 구분) → 파일 단위 결과 딕셔너리 (경로 순).
+            지원하지 않는 언어의 파일은 포함하지 않지만, 따라가지 않은 심볼릭
+            링크는 언어와 관계없이 링크 경로로 포함한다.
+
+        Raises:
+            NotADirectoryError: root_dir이 디렉토리가 아닌 경우
+        """
+        root = Path(root_dir)
+        if not root.is_dir():
+            raise NotADirectoryError(f"디렉토리가 아닙니다: {root_dir}")
+
+        git_attributes = GitAttributes(root)
+        skipped_links: dict[str, str] = {}
+        candidates: list[tuple[str, str]] = []
+        for relative_path in self._walk(root, skipped_links):
+            language = self._detect_language(root, relative_path, git_attributes)
+            if language is not None:
+                candidates.append((relative_path, language))
+        with ThreadPoolExecutor(max_workers=self._max_workers) as executor:
+            results = {
+                result.file_path: result
+                for result in executor.map(
+                    lambda candidate: self._extract_file(root, *candidate),
+                    candidates,
+                )
+            }
+        for relative_path, status in skipped_links.items():
+            results[relative_path] = ExtractedFileContext.skipped(
+                relative_path, detect_language_from_filename(relative_path), status
+            )
+        if self._include_code_owners:
+            CodeOwnerAnnotator(CodeOwners(root)).annotate(results.values())
+        return dict(sorted(results.items()))
+
+    def _walk(self, root: Path, skipped_links: dict[str, str]) -> list[str]:
+        """제외 규칙을 적용하며 루트 아래 파일들의 상대 경로를 수집한다.
+
+        심볼릭 링크 디렉토리도 따라가지만 실제 디렉토리가 이미 방문한 곳이면
+        순회하지 않으므로 링크 순환에서도 끝난다. 루트 밖을 가리키는 링크는
+        follow_external_symlinks가 꺼져 있으면 따라가지 않는다. 읽을 수 없는
+        디렉토리는 경고를 남기고 건너뛴다.
+
+        Args:
+            root: 루트 디렉토리
+            skipped_links: 따라가지 않은 심볼릭 링크의 루트 기준 경로 → status를
+                기록할 딕셔너리
+
+        Returns:
+            경로 순으로 정렬된 루트 기준 파일 경로(`/` 구분) 리스트
+        """
+        ignore = SelvageIgnore(root)
+        real_root = root.resolve()
+        visited: set[tuple[int, int]] = set()
+        paths: list[str] = []
+
+        def on_error(error: OSError) -> None:
+            logger.warning(f"{error.filename}: 디렉토리를 읽을 수 없어 건너뜁니다")
+
+        for directory, dir_names, file_names in os.walk(
+            root, onerror=on_error, followlinks=True
+        ):
+            try:
+                stat = os.stat(directory)
+            except OSError:
+                dir_names[:] = []
+                continue
+            if (stat.st_dev, stat.st_ino) in visited:
+                logger.debug(f"{directory}: 이미 방문한 디렉토리라 건너뜁니다")
+                dir_names[:] = []
+                continue
+            visited.add((stat.st_dev, stat.st_ino))
+
+            relative_dir = Path(directory).relative_to(root).as_posix()
+            prefix = "" if relative_dir == "." else f"{relative_dir}/"
+            kept_dir_names: list[str] = []
+            for name in sorted(dir_names):
+                relative_path = f"{prefix}{name}"
+                if name in self.SKIPPED_DIRECTORY_NAMES or ignore.is_ignored(
+                    relative_path, is_dir=True
+                ):
+                    continue
+                status = self._skipped_link_status(
+                    os.path.join(directory, name), real_root, visited
+                )
+                if status is not None:
+                    logger.debug(f"{relative_path}: 심볼릭 링크를 따라가지 않습니다")
+                    skipped_links[relative_path] = status
+                    continue
+                kept_dir_names.append(name)
+            dir_names[:] = kept_dir_names
+
+            for name in file_names:
+                relative_path = f"{prefix}{name}"
+                if ignore.is_ignored(relative_path):
+                    continue
+                status = self._skipped_link_status(
+                    os.path.join(directory, name), real_root, None
+                )
+                if status is not None:
+                    skipped_links[relative_path] = status
+                    continue
+                paths.append(relative_path)
+        return sorted(paths)
+
+    def _skipped_link_status(
+        self,
+        path: str,
+        real_root: Path,
+        visited: set[tuple[int, int]] | None,
+    ) -> str | None:
+        """심볼릭 링크를 따라가지 않아야 하면 그 사유(status)를 반환한다.
+
+        Args:
+            path: 확인할 디렉토리 항목 경로
+            real_root: 심볼릭 링크를 해석한 루트 디렉토리 경로
+            visited: 이미 방문한 디렉토리의 (장치, inode) 집합 (파일이면 None)
+
+        Returns:
+            루트 밖을 가리키면 "symlink-outside-root", 이미 방문한 디렉토리를
+            가리키면 "symlink-loop" (심볼릭 링크가 아니거나 따라가도 되면 None)
+        """
+        if not os.path.islink(path):
+            return None
+        target = Path(os.path.realpath(path))
+        if not self._follow_external_symlinks and not target.is_relative_to(
+            real_root
+        ):
+            return ExtractedFileContext.SYMLINK_OUTSIDE_ROOT_STATUS
+        if visited is None:
+            return None
+        try:
+            stat = os.stat(path)
+        except OSError:
+            return None
+        if (stat.st_dev, stat.st_ino) in visited:
+            return ExtractedFileContext.SYMLINK_LOOP_STATUS
+        return None
+
+    def _detect_language(
+        self, root: Path, relative_path: str, git_attributes: GitAttributes
+    ) -> str | None:
+        """파일 언어를 감지하고 추출 대상이 아니면 None을 반환한다.
+
+        Args:
+            root: 루트 디렉토리
+            relative_path: 루트 기준 파일 경로
+            git_attributes: 루트의 `.gitattributes` 해석기
+
+        Returns:
+            ContextExtractor가 지원하는 언어 (생성 파일이거나 미지원 언어면 None)
+        """
+        if git_attributes.is_generated(relative_path):
+            return None
+
+        language = git_attributes.linguist_language(relative_path)
+        if language is None:
+            language = detect_language_from_filename(relative_path)
+        if language == "text":
+            try:
+                with open(root / relative_path, "rb") as file:
+                    head = file.read(CONTENT_SNIFF_BYTES)
+            except OSError:
+                return None
+            first_line = head.split(b"\n", 1)[0].decode("utf-8", errors="replace")
+            language = (
+                detect_language_from_content(head)
+                or detect_language_from_shebang(first_line)
+                or language
+            )
+        if language not in ContextExtractor.get_supported_languages():
+            return None
+        return language
+
+    def _extract_file(
+        self, root: Path, relative_path: str, language: str
+    ) -> ExtractedFileContext:
+        """파일 하나를 읽어 심볼 블록을 수집하고 오류는 결과 status로 기록한다.
+
+        Args:
+            root: 루트 디렉토리
+            relative_path: 루트 기준 파일 경로
+            language: 감지된 언어
+
+        Returns:
+            파일 단위 결과
+        """
+        if not self._language_filter.allows(language):
+            return ExtractedFileContext.skipped(
+                relative_path, language, ExtractedFileContext.LANGUAGE_FILTERED_STATUS
+            )
+        path = root / relative_path
+        try:
+            if (
+                self._max_file_bytes is not None
+                and path.stat().st_size > self._max_file_bytes
+            ):
+                return ExtractedFileContext.skipped(
+                    relative_path, language, ExtractedFileContext.TOO_LARGE_STATUS
+                )
+            with open(path, encoding="utf-8", newline="") as file:
+                content = file.read()
+        except (OSError, UnicodeDecodeError) as e:
+            logger.warning(f"{relative_path}: 파일을 읽을 수 없습니다: {e}")
+            return ExtractedFileContext.skipped(
+                relative_path, language, ExtractedFileContext.ERROR_STATUS, str(e)
+            )
+        if not content.strip():
+            return ExtractedFileContext(
+                file_path=relative_path,
+                language=language,
+                extraction_mode=ExtractedFileContext.OUTLINE_MODE,
+            )
+
+        try:
+            extractor = self._extractor(language)
+            return extractor.extract_symbol_outline(relative_path, content)
+        except (UnsupportedLanguageError, ValueError) as e:
+            logger.warning(f"{relative_path}: 심볼 수집 실패: {e}")
+            return ExtractedFileContext.skipped(
+                relative_path, language, ExtractedFileContext.ERROR_STATUS, str(e)
+            )
+
+    def _extractor(self, language: str) -> ContextExtractor:
+        """현재 스레드에서 재사용할 언어별 추출기를 반환한다.
+
+        tree-sitter 파서는 스레드 간에 공유할 수 없으므로 스레드마다 만든다.
+        """
+        extractors: dict[str, ContextExtractor] | None = getattr(
+            self._local, "extractors", None
+        )
+        if extractors is None:
+            extractors = self._local.extractors = {}
+        if language not in extractors:
+            extractors[language] = ContextExtractor(language, self._options)
+        return extractors[language]
+
+
+def extract_tree(
+    root_dir: str | Path,
+    options: ExtractionOptions | None = None,
+    max_workers: int = DirectoryContextExtractor.DEFAULT_MAX_WORKERS,
+    max_file_bytes: int | None = DirectoryContextExtractor.DEFAULT_MAX_FILE_BYTES,
+    language_filter: LanguageFilter | None = None,
+    follow_external_symlinks: bool = False,
+) -> dict[str, ExtractedFileContext]:
+    """디렉토리 트리 전체의 심볼 블록을 한 번에 수집하는 편의 함수.
+
+    Args:
+        root_dir: 순회할 루트 디렉토리
+        options: 파일별 추출기에 전달할 추출 옵션
+        max_workers: 동시에 파싱할 최대 스레드 수
+        max_file_bytes: 읽을 파일의 최대 크기 (None이면 제한 없음)
+        language_filter: 추출할 언어의 허용/거부 목록 (None이면 모든 지원 언어)
+        follow_external_symlinks: 루트 밖을 가리키는 심볼릭 링크도 따라갈지 여부
+
+    Returns:
+        루트 기준 경로 → 파일 단위 결과 딕셔너리
+    """
+    return DirectoryContextExtractor(
+        options, max_workers, max_file_bytes, language_filter, follow_external_symlinks
+    ).extract(root_dir)
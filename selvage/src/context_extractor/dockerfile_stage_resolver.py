@@ -0,0 +1,115 @@
+"""DockerfileStageResolver: Dockerfile 명령어가 속한 빌드 스테이지를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class DockerfileStageResolver:
+    """Dockerfile AST에서 명령어가 속한 빌드 스테이지를 계산한다.
+
+    Dockerfile 문법은 모든 명령어를 루트의 형제 노드로 나열하므로 한 `FROM`
+    명령어부터 다음 `FROM` 직전까지를 하나의 스테이지로 본다. 스테이지 이름은
+    `FROM ... AS build`의 별칭이며, 별칭이 없으면 `COPY --from=0`처럼 Docker가
+    사용하는 0부터 시작하는 스테이지 번호를 사용한다. 첫 `FROM` 앞의 명령어
+    (전역 `ARG` 등)는 어느 스테이지에도 속하지 않는다.
+    """
+
+    # 스테이지를 시작하는 명령어 노드 타입
+    STAGE_TYPES = frozenset({"from_instruction"})
+
+    # 주석 노드 타입
+    COMMENT_TYPES = frozenset({"comment"})
+
+    # 스테이지 헤더 블록의 포함 사유
+    CONTAINER_REASON = "enclosing-stage"
+
+    def find_instruction(self, root: Node, line_no: int) -> Node | None:
+        """라인을 포함하는 최상위 명령어 노드를 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            line_no: 1-based 라인 번호
+
+        Returns:
+            명령어 노드 (주석이나 빈 라인이면 None)
+        """
+        for item in root.named_children:
+            if item.type in self.COMMENT_TYPES:
+                continue
+            if item.start_point[0] + 1 <= line_no <= self.last_line(item):
+                return item
+        return None
+
+    def find_stage(self, root: Node, node: Node) -> Node | None:
+        """명령어가 속한 스테이지의 `FROM` 명령어를 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            node: 명령어 노드
+
+        Returns:
+            `FROM` 명령어 노드 (첫 `FROM` 앞의 명령어면 None)
+        """
+        stage: Node | None = None
+        for item in root.named_children:
+            if item.start_byte > node.start_byte:
+                break
+            if item.type in self.STAGE_TYPES:
+                stage = item
+        return stage
+
+    def instructions(self, root: Node, stage: Node | None) -> list[Node]:
+        """스테이지에 속한 명령어들을 `FROM`부터 위치 순으로 반환한다.
+
+        Args:
+            root: AST 루트 노드
+            stage: `FROM` 명령어 노드 (None이면 첫 `FROM` 앞의 전역 명령어)
+
+        Returns:
+            주석을 제외한 명령어 노드 리스트
+        """
+        items = [
+            item for item in root.named_children if item.type not in self.COMMENT_TYPES
+        ]
+        result: list[Node] = []
+        position = 0
+        if stage is not None:
+            position = self._index(items, stage) + 1
+            result.append(stage)
+        for item in items[position:]:
+            if item.type in self.STAGE_TYPES:
+                break
+            result.append(item)
+        return result
+
+    def stage_name(self, root: Node, stage: Node) -> str:
+        """`AS` 별칭 또는 0부터 시작하는 스테이지 번호를 반환한다."""
+        words = node_text(stage).replace("\\\n", " ").split()
+        for position, word in enumerate(words[:-1]):
+            if position > 0 and word.lower() == "as":
+                return words[position + 1]
+        stages = [item for item in root.named_children if item.type in self.STAGE_TYPES]
+        return str(max(self._index(stages, stage), 0))
+
+    def last_line(self, node: Node) -> int:
+        """노드의 마지막 라인(1-based)을 반환한다 (끝의 줄바꿈은 제외)."""
+        return self._last_row(node) + 1
+
+    @staticmethod
+    def _last_row(node: Node) -> int:
+        """노드의 마지막 라인(0-based)을 반환한다 (끝의 줄바꿈은 제외)."""
+        end_row, end_column = node.end_point
+        if end_column == 0 and end_row > node.start_point[0]:
+            return end_row - 1
+        return end_row
+
+    @staticmethod
+    def _index(items: list[Node], node: Node) -> int:
+        """형제 노드 목록에서 노드의 위치를 반환한다."""
+        for position, item in enumerate(items):
+            if item.start_byte == node.start_byte and item.type == node.type:
+                return position
+        return -1
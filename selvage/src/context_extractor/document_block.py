@@ -0,0 +1,31 @@
+"""DocumentBlock: 문서 안의 코드 블록/지시자/경고문 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class DocumentBlock:
+    """섹션 본문 안에서 통째로 반환하는 구조 블록.
+
+    kind는 "code"(코드/리터럴 블록), "admonition"(`note`, `warning` 등의
+    경고문), "directive"(그 밖의 RST 지시자와 AsciiDoc 구분 블록) 중 하나이고,
+    name은 지시자 이름(`code-block`, `note`, `sidebar` 등)이다. line_range는
+    블록 속성 라인(`[source,python]`)이나 지시자 라인부터 블록 끝까지이며,
+    content_range는 코드 블록의 코드 라인 범위(없으면 None), language는 코드
+    블록에 지정된 언어를 추출기 언어 이름으로 바꾼 값(없으면 None)이다.
+    """
+
+    kind: str
+    name: str
+    line_range: LineRange
+    content_range: LineRange | None = None
+    language: str | None = None
+
+    @property
+    def is_code(self) -> bool:
+        """코드 블록인지 여부"""
+        return self.kind == "code"
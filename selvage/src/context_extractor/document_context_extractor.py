@@ -0,0 +1,303 @@
+"""DocumentContextExtractor: AsciiDoc/reStructuredText 문서를 위한 섹션 기반 컨텍스트 추출기."""
+
+from __future__ import annotations
+
+import textwrap
+from collections.abc import Sequence
+from dataclasses import replace
+
+from selvage.src.exceptions import ParseTimeoutError, UnsupportedLanguageError
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .document_block import DocumentBlock
+from .document_structure_resolver import DocumentStructureResolver
+from .extraction_options import ExtractionOptions
+from .line_range import LineRange
+from .meaningless_change_filter import MeaninglessChangeFilter
+from .text_lines import split_lines
+
+
+class DocumentContextExtractor:
+    """AsciiDoc, reStructuredText 문서의 변경을 감싸는 섹션/블록을 추출한다.
+
+    tree-sitter 문법이 없는 문서 형식을 위해 각 형식의 제목 규칙으로 섹션
+    계층을 계산한다.
+
+    주요 특징:
+    - 본문 변경은 이를 감싸는 가장 안쪽 섹션(하위 섹션 제외)을 반환하고,
+      scope_path에 상위 섹션 제목들을 채움
+    - 지시자(`.. note::`, `.. code-block::`)나 경고문(`NOTE:`, `[NOTE]` 블록),
+      구분 블록 안의 변경은 섹션 대신 가장 안쪽 블록을 통째로 반환
+    - 언어가 지정된 코드 블록 안의 변경은 해당 호스트 언어 추출기로 추출
+      (추출기를 사용할 수 없으면 코드 블록 전체를 반환)
+    - 첫 제목 앞의 변경은 앞뒤 CONTEXT_LINES 라인을 반환
+    - 다른 문서를 가져오는 지시자(`include::`, `.. include::`)를 의존성 블록으로
+      수집
+    """
+
+    SUPPORTED_LANGUAGES = DocumentStructureResolver.SUPPORTED_LANGUAGES
+
+    # 첫 제목 앞의 변경에 대해 앞뒤로 포함할 라인 수
+    CONTEXT_LINES = 5
+
+    def __init__(self, language: str, options: ExtractionOptions | None = None) -> None:
+        """추출기 초기화.
+
+        Args:
+            language: 문서 언어 ("asciidoc", "rst")
+            options: 코드 블록의 호스트 언어 추출기에 전달할 추출 옵션
+
+        Raises:
+            UnsupportedLanguageError: 지원하지 않는 문서 언어인 경우
+        """
+        if not self.is_supported(language):
+            raise UnsupportedLanguageError(language)
+        self._language = language
+        self._resolver = DocumentStructureResolver(language)
+        self._options = options
+        # 문서에서는 `#`, `//`로 시작하는 라인도 본문이므로 주석으로 보지 않음
+        self._filter = MeaninglessChangeFilter(detect_comments=False)
+
+    @classmethod
+    def is_supported(cls, language: str) -> bool:
+        """문서 추출기가 지원하는 언어인지 확인한다."""
+        return language in cls.SUPPORTED_LANGUAGES
+
+    def extract_contexts(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[str]:
+        """변경된 라인 범위들을 기반으로 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 문서 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            추출된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        blocks = self.extract_context_blocks(file_content, changed_ranges)
+        return ContextBlock.format_blocks(blocks)
+
+    def extract_context_blocks(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 라인 범위들을 기반으로 구조화된 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 문서 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            의존성 블록(있는 경우)과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        if not changed_ranges:
+            return []
+        if not file_content:
+            raise ValueError("파일 내용이 비어있습니다")
+
+        lines = split_lines(file_content)
+        meaningful_ranges = self._filter.filter_meaningful_ranges_with_lines(
+            lines, changed_ranges
+        )
+        changed_lines = sorted(
+            {
+                line
+                for line_range in meaningful_ranges
+                for line in range(line_range.start_line, line_range.end_line + 1)
+                if line <= len(lines)
+            }
+        )
+        if not changed_lines:
+            return []
+
+        document_blocks = self._resolver.blocks(file_content)
+        blocks, changed_lines = self._create_code_blocks(
+            lines, document_blocks, changed_lines
+        )
+        blocks.extend(
+            self._create_section_blocks(
+                file_content, lines, document_blocks, changed_lines
+            )
+        )
+        if not blocks:
+            return []
+
+        dependencies = self._resolver.dependencies(file_content)
+        if dependencies:
+            line_numbers = [line_number for line_number, _ in dependencies]
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(directive for _, directive in dependencies),
+                    line_range=LineRange(min(line_numbers), max(line_numbers)),
+                    is_dependency=True,
+                )
+            )
+        return sorted(
+            blocks,
+            key=lambda block: (not block.is_dependency, block.line_range.start_line),
+        )
+
+    def _create_section_blocks(
+        self,
+        file_content: str,
+        lines: list[str],
+        document_blocks: list[DocumentBlock],
+        changed_lines: list[int],
+    ) -> list[ContextBlock]:
+        """변경 라인들을 감싸는 블록 또는 섹션(없으면 주변 라인)을 만든다.
+
+        Args:
+            file_content: 문서 파일 내용
+            lines: 파일의 모든 라인들
+            document_blocks: resolver가 계산한 문서 블록들
+            changed_lines: 호스트 언어 추출기로 처리되지 않은 변경 라인들
+
+        Returns:
+            라인 순으로 정렬된 컨텍스트 블록들
+        """
+        sections = self._resolver.sections(file_content)
+        blocks_by_range: dict[tuple[int, int], ContextBlock] = {}
+        outside_lines: list[int] = []
+        for line in changed_lines:
+            section = self._resolver.find_section(sections, line)
+            section_path = (
+                (*section.scope_path, section.title) if section is not None else ()
+            )
+            document_block = self._resolver.find_block(document_blocks, line)
+            if document_block is not None:
+                line_range = document_block.line_range
+                block_type, name = document_block.kind, document_block.name
+                scope_path = section_path
+            elif section is not None:
+                line_range = section.line_range
+                block_type, name = "section", section.title
+                scope_path = section.scope_path
+            else:
+                outside_lines.append(line)
+                continue
+            key = (line_range.start_line, line_range.end_line)
+            block = blocks_by_range.get(key)
+            if block is None:
+                block = blocks_by_range[key] = ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    block_type=block_type,
+                    name=name,
+                    scope_path=scope_path,
+                )
+            block.changed_lines = (*block.changed_lines, line)
+
+        blocks = list(blocks_by_range.values())
+        for line_range in self._windows(outside_lines, len(lines)):
+            blocks.append(
+                ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    changed_lines=tuple(
+                        line for line in outside_lines if line_range.contains(line)
+                    ),
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _create_code_blocks(
+        self,
+        lines: list[str],
+        document_blocks: list[DocumentBlock],
+        changed_lines: list[int],
+    ) -> tuple[list[ContextBlock], list[int]]:
+        """언어가 지정된 코드 블록 안의 변경을 호스트 언어 추출기로 추출한다.
+
+        코드 라인들의 공통 들여쓰기(RST 지시자 본문)를 걷어내 추출한 뒤, 라인
+        번호를 파일 기준으로 되돌리고 텍스트를 원문 라인으로 채운 블록을
+        반환한다. 호스트 언어 추출기를 사용할 수 없거나 파싱이 시간 제한을
+        넘긴 코드 블록의 변경은 코드 블록 전체로 처리하도록 남겨 둔다.
+
+        Args:
+            lines: 파일의 모든 라인들
+            document_blocks: resolver가 계산한 문서 블록들
+            changed_lines: 변경 라인들
+
+        Returns:
+            (호스트 언어 블록 리스트, 처리되지 않고 남은 변경 라인 리스트) 튜플
+        """
+        blocks: list[ContextBlock] = []
+        remaining = set(changed_lines)
+        for document_block in document_blocks:
+            content_range = document_block.content_range
+            if document_block.language is None or content_range is None:
+                continue
+            region_lines = [
+                line for line in changed_lines if content_range.contains(line)
+            ]
+            if not region_lines:
+                continue
+            offset = content_range.start_line - 1
+            content = textwrap.dedent(self._text(lines, content_range))
+            local_ranges = self._windows(
+                [line - offset for line in region_lines],
+                content_range.line_count(),
+                context_lines=0,
+            )
+            try:
+                region_blocks = ContextExtractor(
+                    document_block.language, self._options
+                ).extract_context_blocks(content, local_ranges)
+            except (UnsupportedLanguageError, ValueError, ParseTimeoutError):
+                continue
+            for block in region_blocks:
+                line_range = LineRange(
+                    block.line_range.start_line + offset,
+                    block.line_range.end_line + offset,
+                )
+                blocks.append(
+                    replace(
+                        block,
+                        text=block.text
+                        if block.is_dependency
+                        else self._text(lines, line_range),
+                        line_range=line_range,
+                        changed_lines=tuple(
+                            line + offset for line in block.changed_lines
+                        ),
+                    )
+                )
+            remaining.difference_update(region_lines)
+        return blocks, sorted(remaining)
+
+    def _windows(
+        self, lines: list[int], line_count: int, context_lines: int | None = None
+    ) -> list[LineRange]:
+        """라인들을 앞뒤로 확장하고 겹치거나 인접한 범위를 병합한다.
+
+        Args:
+            lines: 정렬된 1-based 라인 번호들
+            line_count: 범위를 자를 전체 라인 수
+            context_lines: 앞뒤로 확장할 라인 수 (기본값: CONTEXT_LINES)
+
+        Returns:
+            시작 라인 순의 병합된 범위들
+        """
+        if context_lines is None:
+            context_lines = self.CONTEXT_LINES
+        windows: list[LineRange] = []
+        for line in lines:
+            start = max(1, line - context_lines)
+            end = min(line + context_lines, line_count)
+            if windows and start <= windows[-1].end_line + 1:
+                windows[-1] = LineRange(windows[-1].start_line, end)
+                continue
+            windows.append(LineRange(start, end))
+        return windows
+
+    @staticmethod
+    def _text(lines: list[str], line_range: LineRange) -> str:
+        """라인 범위의 원문 텍스트를 반환한다."""
+        return "\n".join(lines[line_range.start_line - 1 : line_range.end_line])
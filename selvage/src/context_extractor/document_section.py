@@ -0,0 +1,23 @@
+"""DocumentSection: 문서(AsciiDoc/reStructuredText)의 섹션 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class DocumentSection:
+    """제목 라인부터 다음 제목 직전까지의 문서 섹션.
+
+    level은 제목 계층의 깊이(1이 가장 바깥)이다. line_range는 제목의 첫
+    라인(RST 윗줄 장식이 있으면 그 라인)부터 다음 제목 직전의 마지막 비어
+    있지 않은 라인까지로, 하위 섹션은 포함하지 않는다. scope_path는 이
+    섹션을 감싸는 상위 섹션 제목들을 바깥쪽부터 나열한 것이다.
+    """
+
+    title: str
+    level: int
+    line_range: LineRange
+    scope_path: tuple[str, ...] = ()
@@ -0,0 +1,552 @@
+"""DocumentStructureResolver: AsciiDoc/reStructuredText의 섹션과 블록 경계를 계산하는 모듈."""
+
+from __future__ import annotations
+
+import re
+
+from .document_block import DocumentBlock
+from .document_section import DocumentSection
+from .line_range import LineRange
+from .text_lines import split_lines
+
+
+class DocumentStructureResolver:
+    """AsciiDoc, reStructuredText 문서에서 라인 규칙으로 섹션과 블록을 계산한다.
+
+    tree-sitter 문법이 없는 문서 형식을 위해 각 형식의 제목 규칙으로 섹션
+    계층을 만든다. AsciiDoc은 `==` 개수가 제목 레벨이고, RST는 밑줄(과
+    선택적인 윗줄) 장식 스타일이 처음 나온 순서대로 레벨이 정해진다.
+    코드 블록, 지시자(directive), 경고문(admonition)은 섹션과 별도의 블록으로
+    계산하며, 블록 안의 제목 모양 라인은 섹션을 나누지 않는다. 닫히지 않은
+    AsciiDoc 구분 블록은 파일 끝까지로 본다.
+    """
+
+    SUPPORTED_LANGUAGES = ("asciidoc", "rst")
+
+    # 코드 블록에 쓰는 언어 표기 → 추출기 언어 이름 (없으면 소문자 표기 그대로)
+    CODE_LANGUAGE_ALIASES = {
+        "py": "python",
+        "python3": "python",
+        "js": "javascript",
+        "ts": "typescript",
+        "golang": "go",
+        "kt": "kotlin",
+        "sh": "shell",
+        "bash": "shell",
+        "console": "shell",
+    }
+
+    # RST 섹션 제목 장식 라인 (같은 문장 부호의 반복)
+    RST_ADORNMENT_PATTERN = re.compile(r"^([!-/:-@\[-`{-~])\1+\s*$")
+
+    # RST 지시자 라인 (`.. note::`, `.. code-block:: python`, `.. py:function::`)
+    RST_DIRECTIVE_PATTERN = re.compile(
+        r"^(?P<indent>\s*)\.\.\s+(?P<name>[\w-]+(?::[\w-]+)*)::"
+        r"(?:\s+(?P<argument>.*?))?\s*$"
+    )
+
+    # RST 리터럴 블록을 여는 `::`로 끝나는 라인
+    RST_LITERAL_MARKER_PATTERN = re.compile(r"::\s*$")
+
+    # RST 지시자 옵션 라인 (`:linenos:`, `:caption: 예제`)
+    RST_OPTION_PATTERN = re.compile(r"^\s*:[\w-]+:")
+
+    # 본문을 코드로 보는 RST 지시자
+    RST_CODE_DIRECTIVES = frozenset({"code-block", "code", "sourcecode"})
+
+    # RST 경고문 지시자
+    RST_ADMONITIONS = frozenset(
+        {
+            "admonition",
+            "attention",
+            "caution",
+            "danger",
+            "error",
+            "hint",
+            "important",
+            "note",
+            "seealso",
+            "tip",
+            "warning",
+        }
+    )
+
+    # 다른 파일을 가져오는 RST 지시자 (의존성 블록으로 수집)
+    RST_INCLUDE_DIRECTIVES = frozenset({"include", "literalinclude"})
+
+    # AsciiDoc 섹션 제목 (`== Title`, 대칭형 `== Title ==` 포함)
+    ASCIIDOC_TITLE_PATTERN = re.compile(
+        r"^(?P<marks>={1,6})\s+(?P<title>\S.*?)(?:\s+=+)?\s*$"
+    )
+
+    # AsciiDoc 구분 블록 라인 (`----`, `====`, `****`, 열린 블록 `--` 등)
+    ASCIIDOC_DELIMITER_PATTERN = re.compile(r"^(--|([-.=*_+/])\2{3,})\s*$")
+
+    # AsciiDoc 블록 속성 라인 (`[source,python]`, `[NOTE]`; `[[anchor]]` 제외)
+    ASCIIDOC_ATTRIBUTE_PATTERN = re.compile(
+        r"^\[(?!\[)(?P<style>[^\],]*)(?:,(?P<args>[^\]]*))?\]\s*$"
+    )
+
+    # AsciiDoc 블록 제목 라인 (`.예제`)
+    ASCIIDOC_BLOCK_TITLE_PATTERN = re.compile(r"^\.[^.\s]")
+
+    # AsciiDoc 경고문 스타일 (`NOTE: ...` 문단, `[NOTE]` 블록)
+    ASCIIDOC_ADMONITIONS = frozenset(
+        {"NOTE", "TIP", "IMPORTANT", "WARNING", "CAUTION"}
+    )
+
+    # AsciiDoc 경고문 문단 (`NOTE: 내용`)
+    ASCIIDOC_ADMONITION_PARAGRAPH_PATTERN = re.compile(
+        r"^(?P<style>NOTE|TIP|IMPORTANT|WARNING|CAUTION):\s"
+    )
+
+    # AsciiDoc 구분자 문자 → 블록 이름 (`--`는 열린 블록)
+    ASCIIDOC_DELIMITER_NAMES = {
+        "-": "listing",
+        ".": "literal",
+        "=": "example",
+        "*": "sidebar",
+        "_": "quote",
+        "+": "pass",
+        "/": "comment",
+    }
+
+    # 안쪽 내용을 AsciiDoc으로 해석하지 않는 구분 블록 이름
+    ASCIIDOC_VERBATIM_NAMES = frozenset({"listing", "literal", "pass", "comment"})
+
+    # 다른 파일을 가져오는 AsciiDoc 지시자 (의존성 블록으로 수집)
+    ASCIIDOC_INCLUDE_PATTERN = re.compile(r"^include::[^\[\s]+\[.*\]\s*$")
+
+    def __init__(self, language: str) -> None:
+        """resolver 초기화.
+
+        Args:
+            language: 문서 언어 ("asciidoc", "rst")
+
+        Raises:
+            ValueError: 지원하지 않는 문서 언어인 경우
+        """
+        if language not in self.SUPPORTED_LANGUAGES:
+            raise ValueError(f"지원하지 않는 문서 언어입니다: {language}")
+        self._language = language
+
+    def sections(self, text: str) -> list[DocumentSection]:
+        """문서의 모든 섹션을 위치 순으로 반환한다.
+
+        Args:
+            text: 문서 파일 내용
+
+        Returns:
+            DocumentSection 리스트 (제목이 없는 문서면 빈 리스트)
+        """
+        lines = split_lines(text)
+        block_ranges = [block.line_range for block in self.blocks(text)]
+        if self._language == "rst":
+            headings = self._rst_headings(lines, block_ranges)
+        else:
+            headings = self._asciidoc_headings(lines, block_ranges)
+
+        sections: list[DocumentSection] = []
+        open_sections: list[tuple[int, str]] = []
+        for index, (start_line, level, title) in enumerate(headings):
+            next_start = (
+                headings[index + 1][0] if index + 1 < len(headings) else len(lines) + 1
+            )
+            end_line = self._last_content_line(lines, start_line, next_start - 1)
+            while open_sections and open_sections[-1][0] >= level:
+                open_sections.pop()
+            sections.append(
+                DocumentSection(
+                    title=title,
+                    level=level,
+                    line_range=LineRange(start_line, end_line),
+                    scope_path=tuple(outer for _, outer in open_sections),
+                )
+            )
+            open_sections.append((level, title))
+        return sections
+
+    def blocks(self, text: str) -> list[DocumentBlock]:
+        """문서의 코드 블록, 지시자, 경고문을 시작 위치 순으로 반환한다.
+
+        Args:
+            text: 문서 파일 내용
+
+        Returns:
+            DocumentBlock 리스트 (바깥 블록이 안쪽 블록보다 앞에 옴)
+        """
+        lines = split_lines(text)
+        if self._language == "rst":
+            blocks = self._rst_blocks(lines)
+        else:
+            blocks = self._asciidoc_blocks(lines)
+        return sorted(
+            blocks,
+            key=lambda block: (block.line_range.start_line, -block.line_range.end_line),
+        )
+
+    @staticmethod
+    def find_section(
+        sections: list[DocumentSection], line_no: int
+    ) -> DocumentSection | None:
+        """라인이 속한 섹션(하위 섹션 제외 범위 기준)을 찾는다.
+
+        Args:
+            sections: sections()로 계산한 섹션들
+            line_no: 1-based 라인 번호
+
+        Returns:
+            라인을 포함하는 섹션 (첫 제목 앞의 라인이면 None)
+        """
+        return next(
+            (section for section in sections if section.line_range.contains(line_no)),
+            None,
+        )
+
+    @staticmethod
+    def find_block(blocks: list[DocumentBlock], line_no: int) -> DocumentBlock | None:
+        """라인을 감싸는 가장 안쪽 블록을 찾는다.
+
+        Args:
+            blocks: blocks()로 계산한 블록들
+            line_no: 1-based 라인 번호
+
+        Returns:
+            라인을 감싸는 가장 작은 블록 (없으면 None)
+        """
+        return min(
+            (block for block in blocks if block.line_range.contains(line_no)),
+            key=lambda block: block.line_range.line_count(),
+            default=None,
+        )
+
+    def dependencies(self, text: str) -> list[tuple[int, str]]:
+        """다른 문서를 가져오는 지시자들을 (라인 번호, 지시자 텍스트)로 반환한다.
+
+        Args:
+            text: 문서 파일 내용
+
+        Returns:
+            위치 순의 (1-based 라인 번호, 지시자 원문) 리스트
+        """
+        lines = split_lines(text)
+        if self._language == "asciidoc":
+            # AsciiDoc의 include는 전처리 지시자라 구분 블록 안에서도 동작함
+            return [
+                (line_no, line.strip())
+                for line_no, line in enumerate(lines, 1)
+                if self.ASCIIDOC_INCLUDE_PATTERN.match(line)
+            ]
+
+        code_ranges = [
+            block.content_range
+            for block in self._rst_blocks(lines)
+            if block.content_range is not None
+        ]
+        dependencies: list[tuple[int, str]] = []
+        for line_no, line in enumerate(lines, 1):
+            match = self.RST_DIRECTIVE_PATTERN.match(line)
+            if (
+                match
+                and match.group("name").lower() in self.RST_INCLUDE_DIRECTIVES
+                and not any(code.contains(line_no) for code in code_ranges)
+            ):
+                dependencies.append((line_no, line.strip()))
+        return dependencies
+
+    def _rst_headings(
+        self, lines: list[str], block_ranges: list[LineRange]
+    ) -> list[tuple[int, int, str]]:
+        """RST 제목들을 (시작 라인, 레벨, 제목)으로 반환한다."""
+        styles: list[tuple[str, bool]] = []
+        headings: list[tuple[int, int, str]] = []
+        index = 0
+        while index < len(lines):
+            line_no = index + 1
+            if any(block.contains(line_no) for block in block_ranges):
+                index += 1
+                continue
+            heading = self._rst_heading_at(lines, index)
+            if heading is None:
+                index += 1
+                continue
+            title, style, line_count = heading
+            if style not in styles:
+                styles.append(style)
+            headings.append((line_no, styles.index(style) + 1, title))
+            index += line_count
+        return headings
+
+    def _rst_heading_at(
+        self, lines: list[str], index: int
+    ) -> tuple[str, tuple[str, bool], int] | None:
+        """index(0-based)에서 시작하는 RST 제목의 (제목, 스타일, 라인 수)를 반환한다.
+
+        스타일은 (장식 문자, 윗줄 여부)이며, 제목은 앞이 빈 라인(또는 파일
+        시작)이어야 한다.
+        """
+        if index > 0 and lines[index - 1].strip():
+            return None
+        line = lines[index]
+        overline = self.RST_ADORNMENT_PATTERN.match(line)
+        if overline and index + 2 < len(lines):
+            title = lines[index + 1].strip()
+            if title and lines[index + 2].strip() == line.strip():
+                return title, (line.strip()[0], True), 3
+        if (
+            overline
+            or index + 1 >= len(lines)
+            or not line.strip()
+            or line[0].isspace()
+            or line.startswith("..")
+        ):
+            return None
+        underline = lines[index + 1].strip()
+        if not self.RST_ADORNMENT_PATTERN.match(underline):
+            return None
+        title = line.strip()
+        if len(underline) < min(len(title), 4):
+            return None
+        return title, (underline[0], False), 2
+
+    def _asciidoc_headings(
+        self, lines: list[str], block_ranges: list[LineRange]
+    ) -> list[tuple[int, int, str]]:
+        """AsciiDoc 제목들을 (시작 라인, 레벨, 제목)으로 반환한다."""
+        headings: list[tuple[int, int, str]] = []
+        for line_no, line in enumerate(lines, 1):
+            match = self.ASCIIDOC_TITLE_PATTERN.match(line)
+            if match is None or any(block.contains(line_no) for block in block_ranges):
+                continue
+            headings.append((line_no, len(match.group("marks")), match.group("title")))
+        return headings
+
+    def _rst_blocks(self, lines: list[str]) -> list[DocumentBlock]:
+        """RST 지시자와 `::` 리터럴 블록을 계산한다."""
+        blocks: list[DocumentBlock] = []
+        for line_no, line in enumerate(lines, 1):
+            match = self.RST_DIRECTIVE_PATTERN.match(line)
+            if match is not None:
+                name = match.group("name").lower()
+                if name in self.RST_INCLUDE_DIRECTIVES:
+                    continue
+                indent = len(match.group("indent"))
+                end_line = self._indented_end(lines, line_no, indent)
+                line_range = LineRange(line_no, end_line)
+                if name in self.RST_CODE_DIRECTIVES:
+                    argument = (match.group("argument") or "").split()
+                    blocks.append(
+                        DocumentBlock(
+                            kind="code",
+                            name=name,
+                            line_range=line_range,
+                            content_range=self._rst_content_range(
+                                lines, line_no, end_line
+                            ),
+                            language=self._code_language(argument[0])
+                            if argument
+                            else None,
+                        )
+                    )
+                elif name in self.RST_ADMONITIONS:
+                    blocks.append(DocumentBlock("admonition", name, line_range))
+                else:
+                    blocks.append(DocumentBlock("directive", name, line_range))
+                continue
+
+            if line.lstrip().startswith("..") or not (
+                self.RST_LITERAL_MARKER_PATTERN.search(line)
+            ):
+                continue
+            indent = len(line) - len(line.lstrip())
+            end_line = self._indented_end(lines, line_no, indent)
+            if end_line > line_no:
+                blocks.append(
+                    DocumentBlock(
+                        kind="code",
+                        name="literal",
+                        line_range=LineRange(line_no, end_line),
+                        content_range=self._rst_content_range(lines, line_no, end_line),
+                    )
+                )
+        return blocks
+
+    def _rst_content_range(
+        self, lines: list[str], line_no: int, end_line: int
+    ) -> LineRange | None:
+        """지시자 옵션과 빈 라인을 건너뛴 본문 라인 범위를 반환한다."""
+        start = line_no + 1
+        while start <= end_line and self.RST_OPTION_PATTERN.match(lines[start - 1]):
+            start += 1
+        while start <= end_line and not lines[start - 1].strip():
+            start += 1
+        if start > end_line:
+            return None
+        return LineRange(start, end_line)
+
+    def _asciidoc_blocks(self, lines: list[str]) -> list[DocumentBlock]:
+        """AsciiDoc 구분 블록과 경고문 문단을 계산한다."""
+        blocks: list[DocumentBlock] = []
+        # (구분자, 블록 시작 라인, 여는 구분자 라인, 블록 스타일, 스타일 인자)
+        open_blocks: list[tuple[str, int, int, str, str]] = []
+        for line_no, line in enumerate(lines, 1):
+            delimiter = line.strip()
+            if open_blocks:
+                top_delimiter = open_blocks[-1][0]
+                if delimiter == top_delimiter:
+                    blocks.extend(
+                        self._close_asciidoc_block(
+                            open_blocks.pop(), line_no, line_no - 1
+                        )
+                    )
+                    continue
+                if (
+                    self._asciidoc_block_name(top_delimiter)
+                    in self.ASCIIDOC_VERBATIM_NAMES
+                ):
+                    continue
+
+            start_line, style, args = self._asciidoc_attributes(lines, line_no)
+            if self.ASCIIDOC_DELIMITER_PATTERN.match(line):
+                open_blocks.append((delimiter, start_line, line_no, style, args))
+                continue
+            if not delimiter or self._is_asciidoc_prefix(line):
+                continue
+
+            paragraph = self.ASCIIDOC_ADMONITION_PARAGRAPH_PATTERN.match(line)
+            if paragraph is not None:
+                # `NOTE:`는 문단의 첫 라인에서만 경고문을 연다
+                if start_line == line_no and line_no > 1 and lines[line_no - 2].strip():
+                    continue
+                style = paragraph.group("style")
+            elif style not in self.ASCIIDOC_ADMONITIONS or start_line == line_no:
+                continue
+            blocks.append(
+                DocumentBlock(
+                    kind="admonition",
+                    name=style.lower(),
+                    line_range=LineRange(
+                        start_line, self._paragraph_end(lines, line_no)
+                    ),
+                )
+            )
+
+        while open_blocks:
+            blocks.extend(
+                self._close_asciidoc_block(open_blocks.pop(), len(lines), len(lines))
+            )
+        return blocks
+
+    def _close_asciidoc_block(
+        self, opened: tuple[str, int, int, str, str], end_line: int, content_end: int
+    ) -> list[DocumentBlock]:
+        """열린 구분 블록을 내용 끝 라인까지의 DocumentBlock으로 만든다.
+
+        Args:
+            opened: (구분자, 블록 시작 라인, 여는 구분자 라인, 블록 스타일,
+                스타일 인자) 튜플
+            end_line: 블록의 마지막 라인 (닫는 구분자 라인, 닫히지 않았으면
+                파일 끝)
+            content_end: 블록 내용의 마지막 라인
+
+        Returns:
+            블록 리스트 (주석 블록이면 빈 리스트)
+        """
+        delimiter, start_line, delimiter_line, style, args = opened
+        name = self._asciidoc_block_name(delimiter)
+        line_range = LineRange(start_line, end_line)
+        if name == "comment":
+            return []
+        if style in self.ASCIIDOC_ADMONITIONS:
+            return [DocumentBlock("admonition", style.lower(), line_range)]
+        if style == "source" or name in ("listing", "literal"):
+            language_name = args.split(",")[0].strip() if style == "source" else ""
+            return [
+                DocumentBlock(
+                    kind="code",
+                    name="source" if style == "source" else name,
+                    line_range=line_range,
+                    content_range=LineRange(delimiter_line + 1, content_end)
+                    if content_end > delimiter_line
+                    else None,
+                    language=self._code_language(language_name)
+                    if language_name
+                    else None,
+                )
+            ]
+        return [DocumentBlock("directive", style.lower() or name, line_range)]
+
+    def _asciidoc_attributes(
+        self, lines: list[str], line_no: int
+    ) -> tuple[int, str, str]:
+        """라인 바로 위의 블록 속성/제목 라인들을 (시작 라인, 스타일, 인자)로 반환한다.
+
+        스타일과 인자는 라인에 가장 가까운 속성 라인(`[source,python]`의
+        "source", "python")의 값이며, 속성 라인이 없으면 빈 문자열이다.
+        """
+        start_line = line_no
+        style = args = None
+        while start_line > 1 and self._is_asciidoc_prefix(lines[start_line - 2]):
+            start_line -= 1
+            match = self.ASCIIDOC_ATTRIBUTE_PATTERN.match(lines[start_line - 1])
+            if match is not None and style is None:
+                style = match.group("style").strip()
+                args = match.group("args") or ""
+        return start_line, style or "", args or ""
+
+    def _is_asciidoc_prefix(self, line: str) -> bool:
+        """블록 앞에 붙는 속성 라인이나 블록 제목 라인인지 확인한다."""
+        return bool(
+            self.ASCIIDOC_ATTRIBUTE_PATTERN.match(line)
+            or self.ASCIIDOC_BLOCK_TITLE_PATTERN.match(line)
+        )
+
+    def _asciidoc_block_name(self, delimiter: str) -> str:
+        """구분자의 블록 이름을 반환한다 (`----`의 "listing" 등)."""
+        if delimiter == "--":
+            return "open"
+        return self.ASCIIDOC_DELIMITER_NAMES[delimiter[0]]
+
+    def _paragraph_end(self, lines: list[str], line_no: int) -> int:
+        """빈 라인이나 구분자 라인 직전까지의 문단 끝 라인을 반환한다."""
+        end_line = line_no
+        while end_line < len(lines):
+            line = lines[end_line]
+            if not line.strip() or self.ASCIIDOC_DELIMITER_PATTERN.match(line):
+                break
+            end_line += 1
+        return end_line
+
+    def _code_language(self, name: str) -> str:
+        """코드 블록의 언어 표기를 추출기 언어 이름으로 바꾼다."""
+        language = name.lower()
+        return self.CODE_LANGUAGE_ALIASES.get(language, language)
+
+    @staticmethod
+    def _indented_end(lines: list[str], line_no: int, indent: int) -> int:
+        """라인보다 깊게 들여쓴 본문의 마지막 비어 있지 않은 라인을 반환한다.
+
+        Args:
+            lines: 문서의 모든 라인들
+            line_no: 지시자(또는 `::`) 라인의 1-based 번호
+            indent: 지시자 라인의 들여쓰기 폭
+
+        Returns:
+            본문의 마지막 라인 번호 (본문이 없으면 line_no)
+        """
+        end_line = line_no
+        for index in range(line_no, len(lines)):
+            line = lines[index]
+            if not line.strip():
+                continue
+            if len(line) - len(line.lstrip()) <= indent:
+                break
+            end_line = index + 1
+        return end_line
+
+    @staticmethod
+    def _last_content_line(lines: list[str], start_line: int, end_line: int) -> int:
+        """범위 끝의 빈 라인들을 제외한 마지막 라인 번호를 반환한다."""
+        while end_line > start_line and not lines[end_line - 1].strip():
+            end_line -= 1
+        return end_line
@@ -0,0 +1,146 @@
+"""DuplicateSymbolDetector: 추출된 변경 심볼 중 거의 같은 심볼 쌍을 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from difflib import SequenceMatcher
+from itertools import combinations
+
+from selvage.src.exceptions import ParseTimeoutError, UnsupportedLanguageError
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .duplicate_symbol_pair import DuplicateSymbolPair
+from .extracted_file_context import ExtractedFileContext
+from .extraction_options import ExtractionOptions
+
+
+class DuplicateSymbolDetector:
+    """여러 파일의 변경 심볼을 토큰 단위로 비교해 복사-붙여넣기 의심 쌍을 찾는다.
+
+    각 심볼 텍스트를 tree-sitter로 다시 파싱한 토큰 시퀀스(주석 제외)로
+    비교하므로 공백, 주석, 줄바꿈 차이는 유사도에 영향을 주지 않는다.
+    모든 쌍을 비교하는 O(n²) 작업이므로 호출자가 명시적으로 사용하며,
+    입력 순서상 앞의 max_symbols개 심볼만 비교한다. 같은 언어의 심볼끼리만
+    비교하고, 토큰이 너무 적은 심볼(한 줄 getter 등)은 우연히 같아지기
+    쉬우므로 제외한다.
+    """
+
+    # 거의 같은 심볼로 보는 기본 최소 토큰 유사도
+    DEFAULT_THRESHOLD = 0.85
+
+    # 기본 최대 비교 심볼 수
+    DEFAULT_MAX_SYMBOLS = 200
+
+    # 비교 대상이 되는 심볼의 최소 토큰 수
+    MIN_TOKEN_COUNT = 10
+
+    def __init__(
+        self,
+        threshold: float = DEFAULT_THRESHOLD,
+        max_symbols: int = DEFAULT_MAX_SYMBOLS,
+        options: ExtractionOptions | None = None,
+    ) -> None:
+        """탐지기 초기화.
+
+        Args:
+            threshold: 거의 같은 심볼로 보는 최소 유사도 (0.0~1.0)
+            max_symbols: 비교할 최대 심볼 수 (초과분은 비교하지 않음)
+            options: 토큰화에 사용할 추출 옵션 (파싱 시간 제한 등)
+
+        Raises:
+            ValueError: threshold가 0.0~1.0 범위를 벗어나거나 max_symbols가
+                1보다 작은 경우
+        """
+        if not 0.0 <= threshold <= 1.0:
+            raise ValueError("threshold는 0.0 이상 1.0 이하여야 합니다")
+        if max_symbols < 1:
+            raise ValueError("max_symbols는 1 이상이어야 합니다")
+        self._threshold = threshold
+        self._max_symbols = max_symbols
+        self._options = options
+        self._extractors: dict[str, ContextExtractor | None] = {}
+
+    def detect(
+        self, results: Sequence[ExtractedFileContext]
+    ) -> list[DuplicateSymbolPair]:
+        """파일별 추출 결과에서 거의 같은 변경 심볼 쌍을 찾는다.
+
+        변경과 직접 겹친 블록(reason이 None)만 비교하며, 의존성 블록과 다른
+        파일에서 가져온 정의 블록은 제외한다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+
+        Returns:
+            입력 순서로 정렬된 유사 심볼 쌍 리스트
+        """
+        symbols = self._collect_symbols(results)
+        pairs: list[DuplicateSymbolPair] = []
+        for first, second in combinations(symbols, 2):
+            first_path, first_language, first_block, first_tokens = first
+            second_path, second_language, second_block, second_tokens = second
+            if first_language != second_language:
+                continue
+            similarity = self._similarity(first_tokens, second_tokens)
+            if similarity is None:
+                continue
+            pairs.append(
+                DuplicateSymbolPair(
+                    first_path=first_path,
+                    first_block=first_block,
+                    second_path=second_path,
+                    second_block=second_block,
+                    similarity=similarity,
+                )
+            )
+        return pairs
+
+    def _collect_symbols(
+        self, results: Sequence[ExtractedFileContext]
+    ) -> list[tuple[str, str, ContextBlock, tuple[str, ...]]]:
+        """비교할 (파일 경로, 언어, 블록, 토큰) 목록을 최대 개수까지 만든다."""
+        symbols: list[tuple[str, str, ContextBlock, tuple[str, ...]]] = []
+        for result in results:
+            if result.status != ExtractedFileContext.OK_STATUS:
+                continue
+            for block in result.context_blocks:
+                if block.reason is not None or block.source_path is not None:
+                    continue
+                if len(symbols) >= self._max_symbols:
+                    return symbols
+                tokens = self._tokenize(result.language, block.text)
+                if len(tokens) < self.MIN_TOKEN_COUNT:
+                    continue
+                symbols.append((result.file_path, result.language, block, tokens))
+        return symbols
+
+    def _tokenize(self, language: str, text: str) -> tuple[str, ...]:
+        """블록 텍스트를 토큰화한다 (지원하지 않는 언어나 시간 초과면 빈 튜플)."""
+        if language not in self._extractors:
+            try:
+                self._extractors[language] = ContextExtractor(language, self._options)
+            except (UnsupportedLanguageError, ValueError):
+                self._extractors[language] = None
+        extractor = self._extractors[language]
+        if extractor is None:
+            return ()
+        try:
+            return extractor.token_stream(text)
+        except ParseTimeoutError:
+            return ()
+
+    def _similarity(
+        self, tokens: tuple[str, ...], other_tokens: tuple[str, ...]
+    ) -> float | None:
+        """두 토큰 시퀀스의 유사도를 반환한다 (임계값 미만이면 None).
+
+        정확한 비교 전에 계산이 싼 상한값으로 먼저 걸러 낸다.
+        """
+        matcher = SequenceMatcher(None, tokens, other_tokens, autojunk=False)
+        if matcher.real_quick_ratio() < self._threshold:
+            return None
+        if matcher.quick_ratio() < self._threshold:
+            return None
+        ratio = matcher.ratio()
+        return ratio if ratio >= self._threshold else None
@@ -0,0 +1,53 @@
+"""DuplicateSymbolPair: 내용이 거의 같은 두 추출 심볼을 묶은 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import Any
+
+from .context_block import ContextBlock
+
+
+@dataclass(frozen=True)
+class DuplicateSymbolPair:
+    """토큰 유사도가 임계값 이상인 두 변경 심볼.
+
+    first는 입력 순서상 먼저 나온 심볼이며, similarity는 두 심볼의 주석을
+    제외한 토큰 시퀀스 유사도(0.0~1.0)이다. 같은 파일 안의 두 심볼이면
+    first_path와 second_path가 같다.
+    """
+
+    first_path: str
+    first_block: ContextBlock
+    second_path: str
+    second_block: ContextBlock
+    similarity: float
+
+    @property
+    def is_cross_file(self) -> bool:
+        """서로 다른 파일의 심볼 쌍인지 여부"""
+        return self.first_path != self.second_path
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            first/second(`경로:시작-끝` 위치와 심볼 이름)와 similarity
+            (소수점 셋째 자리 반올림) 키를 가진 딕셔너리
+        """
+        return {
+            "first": self._describe(self.first_path, self.first_block),
+            "second": self._describe(self.second_path, self.second_block),
+            "similarity": round(self.similarity, 3),
+        }
+
+    @staticmethod
+    def _describe(file_path: str, block: ContextBlock) -> dict[str, Any]:
+        """심볼 하나의 위치와 이름을 딕셔너리로 만든다."""
+        line_range = block.line_range
+        return {
+            "location": (
+                f"{file_path}:{line_range.start_line}-{line_range.end_line}"
+            ),
+            "name": block.name,
+        }
@@ -0,0 +1,142 @@
+"""EmbeddedSqlResolver: 호스트 언어 문자열 리터럴 안의 SQL 조각을 찾는 모듈."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Sequence
+
+from tree_sitter import Node
+
+from .line_range import LineRange
+from .text_lines import node_text
+
+
+class EmbeddedSqlResolver:
+    """호스트 언어 AST에서 SQL을 담은 문자열 리터럴을 찾는다.
+
+    JetBrains 언어 주입 주석(`// language=sql`)이 리터럴 바로 윗줄이나 같은
+    줄 앞에 있으면 SQL로 보고, 주석이 없으면 내용이 `SELECT ... FROM`,
+    `INSERT INTO`, `UPDATE ... SET` 같은 SQL 문장 형태로 시작하는지 휴리스틱으로
+    판단한다. 리터럴의 따옴표와 접두사(`r"`, `b'` 등)를 뺀 내용을 반환하며,
+    내용의 첫 라인은 리터럴이 시작하는 라인과 같다. 문자열 연결이나 포맷팅으로
+    조립되는 쿼리는 리터럴 단위로만 본다.
+    """
+
+    # 언어별 문자열 리터럴 노드 타입
+    LANGUAGE_STRING_TYPES = {
+        "go": frozenset({"interpreted_string_literal", "raw_string_literal"}),
+        "python": frozenset({"string"}),
+        "java": frozenset({"string_literal"}),
+        "kotlin": frozenset({"string_literal", "multiline_string_literal"}),
+        "javascript": frozenset({"string", "template_string"}),
+        "typescript": frozenset({"string", "template_string"}),
+    }
+
+    # 언어별 주석 노드 타입 (언어 주입 주석 탐색용)
+    LANGUAGE_COMMENT_TYPES = {
+        "go": frozenset({"comment"}),
+        "python": frozenset({"comment"}),
+        "java": frozenset({"line_comment", "block_comment"}),
+        "kotlin": frozenset({"line_comment", "multiline_comment"}),
+        "javascript": frozenset({"comment"}),
+        "typescript": frozenset({"comment"}),
+    }
+
+    # JetBrains 언어 주입 주석 (`// language=SQL`, `/* language=sql */`)
+    MARKER_PATTERN = re.compile(r"\blanguage\s*=\s*sql\b", re.IGNORECASE)
+
+    # 리터럴 앞의 접두사와 여는 따옴표
+    DELIMITER_PATTERN = re.compile(r"^[A-Za-z]*(\"\"\"|'''|\"|'|`)")
+
+    # 주석이 없는 리터럴을 SQL로 볼 문장 형태
+    SQL_PATTERN = re.compile(
+        r"^\s*(?:"
+        r"SELECT\b.*\bFROM\b"
+        r"|INSERT\s+(?:OR\s+\w+\s+)?INTO\b"
+        r"|UPDATE\s+\S+\s+SET\b"
+        r"|DELETE\s+FROM\b"
+        r"|WITH\b.*\bAS\s*\("
+        r"|(?:CREATE|ALTER|DROP)\s+(?:UNIQUE\s+)?(?:TABLE|INDEX|VIEW)\b"
+        r")",
+        re.IGNORECASE | re.DOTALL,
+    )
+
+    def __init__(self, language: str) -> None:
+        """EmbeddedSqlResolver를 초기화한다.
+
+        Args:
+            language: 호스트 언어 이름
+        """
+        self._string_types = self.LANGUAGE_STRING_TYPES.get(language, frozenset())
+        self._comment_types = self.LANGUAGE_COMMENT_TYPES.get(language, frozenset())
+
+    def is_supported(self) -> bool:
+        """해당 언어에서 문자열 안의 SQL 탐색을 지원하는지 반환한다."""
+        return bool(self._string_types)
+
+    def find_fragments(
+        self, root: Node, line_ranges: Sequence[LineRange]
+    ) -> list[tuple[Node, str]]:
+        """변경 라인 범위와 겹치는 SQL 문자열 리터럴들을 찾는다.
+
+        Args:
+            root: 호스트 언어 AST 루트 노드
+            line_ranges: 변경 라인 범위들
+
+        Returns:
+            위치 순의 (문자열 리터럴 노드, 따옴표를 뺀 SQL 내용) 리스트
+        """
+        marker_comments: list[Node] = []
+        literals: list[Node] = []
+        stack = [root]
+        while stack:
+            node = stack.pop()
+            if node.type in self._comment_types:
+                if self.MARKER_PATTERN.search(node_text(node)):
+                    marker_comments.append(node)
+                continue
+            if node.type in self._string_types:
+                if self._overlaps(node, line_ranges):
+                    literals.append(node)
+                continue
+            stack.extend(reversed(node.children))
+
+        fragments: list[tuple[Node, str]] = []
+        for literal in literals:
+            content = self._content(literal)
+            if content is None:
+                continue
+            if self._has_marker(literal, marker_comments) or self.is_sql(content):
+                fragments.append((literal, content))
+        return fragments
+
+    @classmethod
+    def is_sql(cls, content: str) -> bool:
+        """문자열 내용이 SQL 문장 형태로 시작하는지 휴리스틱으로 판단한다."""
+        return cls.SQL_PATTERN.match(content) is not None
+
+    def _has_marker(self, literal: Node, marker_comments: Sequence[Node]) -> bool:
+        """리터럴 바로 윗줄이나 같은 줄 앞에 언어 주입 주석이 있는지 확인한다."""
+        start_row = literal.start_point[0]
+        return any(
+            comment.end_byte <= literal.start_byte
+            and comment.end_point[0] in (start_row - 1, start_row)
+            for comment in marker_comments
+        )
+
+    def _content(self, literal: Node) -> str | None:
+        """리터럴에서 접두사와 따옴표를 뺀 내용을 반환한다 (형태가 다르면 None)."""
+        text = node_text(literal)
+        match = self.DELIMITER_PATTERN.match(text)
+        if match is None:
+            return None
+        delimiter = match.group(1)
+        if len(text) < match.end() + len(delimiter) or not text.endswith(delimiter):
+            return None
+        return text[match.end() : len(text) - len(delimiter)]
+
+    @staticmethod
+    def _overlaps(node: Node, line_ranges: Sequence[LineRange]) -> bool:
+        """노드의 라인 범위가 변경 범위 중 하나와 겹치는지 확인한다."""
+        node_range = LineRange(node.start_point[0] + 1, node.end_point[0] + 1)
+        return any(node_range.overlaps(line_range) for line_range in line_ranges)
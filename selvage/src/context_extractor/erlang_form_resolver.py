@@ -0,0 +1,175 @@
+"""ErlangFormResolver: Erlang 함수 선언과 속성 form의 범위와 이름을 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class ErlangFormResolver(ScopeResolver):
+    """Erlang AST에서 변경을 감싸는 form을 찾고 이름을 계산한다.
+
+    Erlang은 같은 이름/arity의 함수 절(clause)들을 `;`로 이어 하나의 함수
+    선언(`fun_decl`)으로 쓰므로, 절 하나가 바뀌면 그 이름/arity의 모든 절을
+    담은 함수 선언 전체를 반환한다. 함수 안의 익명 함수(`fun (X) -> ... end`)
+    변경은 익명 함수만 내부 스코프로 반환한다. 그 밖의 변경은 `-export`,
+    `-record` 같은 최상위 속성 form 하나를 반환한다.
+
+    `.erl` 모듈 파일의 form에는 `-module(name).` 속성을 컨테이너 헤더로 함께
+    포함하며, `-module` 속성이 없는 `.hrl` 헤더 파일(레코드, 매크로 정의 등)은
+    컨테이너 헤더 없이 form만 반환한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 같은 이름/arity의 절들을 묶은 함수 선언 노드 타입
+    FUNCTION_TYPES = frozenset({"fun_decl"})
+
+    # 함수 선언의 절 노드 타입
+    CLAUSE_TYPES = frozenset({"function_clause"})
+
+    # 내부 스코프로 반환하는 익명 함수 노드 타입
+    ANONYMOUS_FUN_TYPES = frozenset({"anonymous_fun"})
+
+    # 모듈 이름을 선언하는 속성 노드 타입
+    MODULE_ATTRIBUTE_TYPES = frozenset({"module_attribute"})
+
+    # 이름이 첫 번째 atom인 속성 노드 타입
+    NAMED_ATTRIBUTE_TYPES = frozenset(
+        {"record_decl", "type_alias", "opaque", "spec", "callback", "pp_define"}
+    )
+
+    # 컨테이너(`-module`) 헤더를 함께 포함할 최상위 form 노드 타입
+    MEMBER_TYPES = (
+        FUNCTION_TYPES
+        | ANONYMOUS_FUN_TYPES
+        | NAMED_ATTRIBUTE_TYPES
+        | frozenset({"export_attribute", "export_type_attribute"})
+    )
+
+    # 주석 노드 타입 (arity 계산에서 제외)
+    COMMENT_TYPES = frozenset({"comment"})
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 익명 함수, 함수 선언 또는 최상위 form을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 익명 함수, 그렇지 않으면 최상위 form 노드
+            (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.ANONYMOUS_FUN_TYPES:
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None:
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """파일의 `-module(name).` 속성 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            module_attribute 노드 (`.hrl` 헤더처럼 없으면 None)
+        """
+        root = node
+        while root.parent is not None:
+            root = root.parent
+        return next(
+            (
+                child
+                for child in root.named_children
+                if child.type in self.MODULE_ATTRIBUTE_TYPES
+            ),
+            None,
+        )
+
+    def container_header(self, container: Node) -> str:
+        """`-module(name).` 속성 텍스트를 반환한다."""
+        return node_text(container).strip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 모듈 이름을 반환한다."""
+        return self.name(container)
+
+    def name(self, node: Node) -> str | None:
+        """함수의 `이름/arity`, 모듈/레코드/타입/매크로 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            함수 선언과 절은 `handle_call/3`, 속성은 선언된 이름
+            (`-export`나 익명 함수처럼 이름이 없는 노드면 None)
+        """
+        if node.type in self.FUNCTION_TYPES:
+            clause = next(
+                (
+                    child
+                    for child in node.named_children
+                    if child.type in self.CLAUSE_TYPES
+                ),
+                None,
+            )
+            return self.name(clause) if clause is not None else None
+        if node.type in self.CLAUSE_TYPES:
+            name_node = node.child_by_field_name("name")
+            if name_node is None:
+                return None
+            return f"{node_text(name_node)}/{self._arity(node)}"
+        if node.type in self.MODULE_ATTRIBUTE_TYPES | self.NAMED_ATTRIBUTE_TYPES:
+            name_node = node.child_by_field_name("name") or next(
+                iter(node.named_children), None
+            )
+            if name_node is None:
+                return None
+            return node_text(name_node).split("(", 1)[0].strip() or None
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 모듈과 함수 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 사용하지 않음 (모듈과 함수 이름 두 개까지만 반환)
+
+        Returns:
+            모듈 이름과 (익명 함수면) 감싸는 함수의 `이름/arity` 튜플
+        """
+        path: list[str] = []
+        container = self.find_container(node)
+        if container is not None and container != node:
+            module_name = self.name(container)
+            if module_name:
+                path.append(module_name)
+        current = node.parent
+        while current is not None:
+            if current.type in self.FUNCTION_TYPES:
+                function_name = self.name(current)
+                if function_name:
+                    path.append(function_name)
+                break
+            current = current.parent
+        return tuple(path)
+
+    def _arity(self, clause: Node) -> int:
+        """함수 절의 인자 수를 반환한다."""
+        args = clause.child_by_field_name("args")
+        if args is None:
+            return 0
+        return sum(
+            1 for child in args.named_children if child.type not in self.COMMENT_TYPES
+        )
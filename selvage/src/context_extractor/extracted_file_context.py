@@ -0,0 +1,190 @@
+"""ExtractedFileContext: 파일 하나에서 추출된 컨텍스트 블록 묶음."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+
+from selvage.src.utils.code_owners import CodeOwner
+
+from .context_block import ContextBlock
+from .file_rename import FileRename
+from .indent_style import IndentStyle
+from .metrics import ExtractionMetrics
+
+
+@dataclass
+class ExtractedFileContext:
+    """파일 단위 컨텍스트 추출 결과.
+
+    여러 파일의 결과를 하나의 프롬프트 문서로 합칠 때 사용된다.
+    extraction_mode는 심볼 단위 추출("symbol")인지 작은 파일을 통째로
+    반환한 것("whole-file")인지, 변경과 관계없이 파일의 모든 심볼을 수집한
+    것("outline")인지, 파일 전체 없이 patch의 hunk 조각만 파싱한
+    것("fragment")인지를 나타낸다. metrics는 추출 계측이 켜진
+    경우에만 설정된다. status는 파싱이 제한 시간을 넘겨 중단된 경우
+    "timeout"이며, 이때 blocks는 비어 있다. strict 옵션에서 변경된 심볼에
+    구문 오류가 있으면 status는 "parse-error"이고 blocks는 비어 있으며,
+    parse_error_locations에 오류 위치((라인, 컬럼), 1-based)가 기록된다.
+    지원하지 않는 언어이거나 추출 중 오류가 난 파일은 skipped로 만든 결과로
+    요약 집계에 포함하며, 오류 내용은 error_message에 기록한다. 크기 제한을
+    넘어 읽지 않은 파일의 status는 "too-large"이고, 언어 허용/거부 목록으로
+    거른 파일의 status는 "language-filtered"이다. 디렉토리 추출에서 따라가지
+    않은 심볼릭 링크는 루트 밖을 가리키면 "symlink-outside-root", 이미 방문한
+    디렉토리를 가리키면 "symlink-loop"이다.
+    rename은 이름이 바뀐 파일의 이전 경로와 유사도이며, previous_blocks는
+    삭제/이동된 코드가 있던 이름 변경 전 심볼 블록들(라인 번호는 이전 파일
+    기준)이다. indent_style은 파일 내용에서 감지한 들여쓰기 단위로,
+    렌더링 시 들여쓰기 정규화 옵션이 켜진 경우에 사용된다. owners는
+    CodeOwnerAnnotator로 기록한 파일의 CODEOWNERS 소유자들(팀과 사용자)이다.
+
+    blocks는 변경과 겹치는 블록(primary_blocks)과 참조 타입, 다른 파일의 정의
+    등 참고 자료로 끌어온 블록(reference_blocks)으로 나눠 조회할 수 있다.
+    """
+
+    SYMBOL_MODE = "symbol"
+    WHOLE_FILE_MODE = "whole-file"
+    OUTLINE_MODE = "outline"
+    FRAGMENT_MODE = "fragment"
+
+    OK_STATUS = "ok"
+    TIMEOUT_STATUS = "timeout"
+    PARSE_ERROR_STATUS = "parse-error"
+    UNSUPPORTED_STATUS = "unsupported-language"
+    ERROR_STATUS = "error"
+    TOO_LARGE_STATUS = "too-large"
+    LANGUAGE_FILTERED_STATUS = "language-filtered"
+    SYMLINK_OUTSIDE_ROOT_STATUS = "symlink-outside-root"
+    SYMLINK_LOOP_STATUS = "symlink-loop"
+
+    # 변경된 심볼이 아니라 참고 자료로 끌어온 블록의 포함 사유
+    REFERENCE_REASONS = frozenset(
+        {
+            "referenced-type",
+            "cross-file-reference",
+            "applied-modifier",
+            "sibling-overload",
+            "overridden-method",
+            "neighbor-symbol",
+            "call-site",
+        }
+    )
+
+    file_path: str
+    language: str
+    blocks: list[ContextBlock] = field(default_factory=list)
+    extraction_mode: str = SYMBOL_MODE
+    metrics: ExtractionMetrics | None = None
+    status: str = OK_STATUS
+    rename: FileRename | None = None
+    previous_blocks: list[ContextBlock] = field(default_factory=list)
+    indent_style: IndentStyle | None = None
+    parse_error_locations: tuple[tuple[int, int], ...] = ()
+    error_message: str | None = None
+    owners: tuple[CodeOwner, ...] = ()
+
+    @classmethod
+    def skipped(
+        cls,
+        file_path: str,
+        language: str,
+        status: str,
+        error_message: str | None = None,
+    ) -> ExtractedFileContext:
+        """컨텍스트를 추출하지 않고 건너뛴 파일의 결과를 만든다.
+
+        Args:
+            file_path: 파일 경로
+            language: 파일 언어
+            status: 건너뛴 사유 (예: UNSUPPORTED_STATUS, ERROR_STATUS)
+            error_message: 오류로 건너뛴 경우의 오류 내용
+
+        Returns:
+            블록이 없고 status가 지정된 결과
+        """
+        return cls(
+            file_path=file_path,
+            language=language,
+            status=status,
+            error_message=error_message,
+        )
+
+    @property
+    def timed_out(self) -> bool:
+        """파싱 시간 제한으로 추출이 중단되었는지 반환한다."""
+        return self.status == self.TIMEOUT_STATUS
+
+    @property
+    def has_parse_errors(self) -> bool:
+        """strict 옵션에서 구문 오류로 추출이 실패했는지 반환한다."""
+        return self.status == self.PARSE_ERROR_STATUS
+
+    @property
+    def is_renamed(self) -> bool:
+        """이름이 바뀐 파일의 결과인지 반환한다."""
+        return self.rename is not None
+
+    @property
+    def is_whole_file(self) -> bool:
+        """파일 전체 모드로 추출되었는지 반환한다."""
+        return self.extraction_mode == self.WHOLE_FILE_MODE
+
+    @property
+    def anonymized_identifier_count(self) -> int:
+        """식별자 익명화로 토큰으로 바뀐 식별자 수의 블록별 합계를 반환한다."""
+        return sum(
+            block.anonymized_identifier_count
+            for block in [*self.blocks, *self.previous_blocks]
+        )
+
+    @property
+    def dependency_blocks(self) -> list[ContextBlock]:
+        """의존성(import) 블록들을 반환한다."""
+        return [block for block in self.blocks if block.is_dependency]
+
+    @property
+    def size_capped_blocks(self) -> list[ContextBlock]:
+        """심볼 크기 상한을 넘어 개별로 줄인 블록들을 라인 순으로 반환한다."""
+        return [block for block in self.context_blocks if block.size_capped]
+
+    @property
+    def primary_blocks(self) -> list[ContextBlock]:
+        """변경과 겹치는 블록들을 라인 순으로 반환한다.
+
+        의존성 블록과 reference_blocks를 제외한 context_blocks이며, 변경된
+        심볼을 감싸는 컨테이너 헤더처럼 변경된 블록의 위치를 보여주는 블록을
+        포함한다.
+        """
+        return [
+            block
+            for block in self.context_blocks
+            if block.reason not in self.REFERENCE_REASONS
+        ]
+
+    @property
+    def reference_blocks(self) -> list[ContextBlock]:
+        """참고 자료로 끌어온 블록들을 context_blocks와 같은 순서로 반환한다.
+
+        참조 타입(`referenced-type`), 다른 파일의 정의(`cross-file-reference`)
+        등 reason이 REFERENCE_REASONS에 속한 블록이다.
+        """
+        return [
+            block
+            for block in self.context_blocks
+            if block.reason in self.REFERENCE_REASONS
+        ]
+
+    @property
+    def context_blocks(self) -> list[ContextBlock]:
+        """의존성 블록을 제외한 컨텍스트 블록들을 라인 순으로 반환한다.
+
+        다른 파일에서 가져온 블록(source_path가 있는 블록)은 라인 번호가 다른
+        파일 기준이므로 이 파일의 블록들 뒤에 둔다.
+        """
+        return sorted(
+            (block for block in self.blocks if not block.is_dependency),
+            key=lambda block: (
+                block.source_path is not None,
+                block.line_range.start_line,
+                block.line_range.end_line,
+            ),
+        )
@@ -0,0 +1,333 @@
+"""ExtractionOptions: 컨텍스트 추출 옵션."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Callable, Mapping
+from dataclasses import dataclass
+from typing import TYPE_CHECKING
+
+from .metrics import ExtractionMetrics
+from .symbol_cost_weights import SymbolCostWeights
+from .symbol_name_formatter import SymbolNameFormatter
+from .symbol_resolver import SymbolResolver
+
+if TYPE_CHECKING:
+    from .anchor_query_registry import AnchorQueryRegistry
+
+
+@dataclass(frozen=True)
+class ExtractionOptions:
+    """ContextExtractor의 추출 동작을 제어하는 옵션.
+
+    추가 블록이나 표시를 만드는 옵션은 기본값에서 꺼져 있지만, 다음 동작은
+    기본값에서도 적용되므로 기본 출력이 옵션 도입 이전과 다를 수 있다.
+
+    - 변경 범위(hunk)를 모두 감싸는 가장 작은 이름 있는 심볼이 라인별로 찾은
+      블록보다 작으면 그 심볼을 블록으로 선택한다 (ancestor_depth 참고).
+    - Go 구조체 필드나 테이블 테스트 케이스가 변경되면 블록 헤더에 변경 라인
+      (`[changed: ...]`)과 필드(`[fields: ...]`)/케이스(`[cases: ...]`) 이름이
+      표시된다.
+    - max_top_level_statement_lines와 parse_timeout_seconds는 기본값에서
+      제한이 적용된다.
+
+    Attributes:
+        include_signature_types: 변경된 함수 시그니처(파라미터/반환)에 등장하는
+            타입의 같은 파일 내 선언을 함께 포함할지 여부
+        max_signature_types: 시그니처 타입 선언으로 포함할 최대 개수
+            (symbol_resolver로 찾은 다른 파일의 선언 포함)
+        symbol_resolver: include_signature_types가 켜진 경우 같은 파일에서
+            선언을 찾지 못한 타입을 조회할 외부 resolver. 찾은 정의는 출처
+            파일 경로가 기록된 cross-file 참조 블록으로 포함된다.
+        include_signatures: 함수/메서드 블록의 파라미터(이름, 타입)와 반환 타입을
+            구조화해 ContextBlock.signature에 기록할지 여부
+        include_comments: 언어별 주석 연결 전략으로 찾은 문서 주석을 블록에
+            연결할지 여부 (선언 위쪽 주석은 블록 텍스트에도 포함됨)
+        include_exported_doc_comments: include_comments가 꺼져 있어도 공개(export)
+            심볼의 문서 주석은 API 계약으로 보고 항상 연결할지 여부. 공개 여부는
+            언어 관례(Go 대문자 이름, Java `public` 등)로 판단하며, 비공개 심볼은
+            include_comments 설정을 따른다.
+        whole_file_max_lines: 파일 라인 수가 이 값 이하이면 심볼 추출 대신
+            파일 전체를 하나의 블록으로 반환 (None이면 라인 기준 미사용)
+        whole_file_max_bytes: 파일 크기(UTF-8 바이트)가 이 값 이하이면 파일
+            전체를 반환 (None이면 바이트 기준 미사용). 두 기준이 모두 설정되면
+            둘 다 만족해야 한다.
+        max_top_level_statement_lines: 함수/클래스 밖 스크립트 코드가 변경되면
+            감싸는 최상위 문장(반복문, 조건문, try 등)을 반환할 때의 최대 라인 수.
+            0이면 기존처럼 변경된 노드만 반환한다. Dockerfile에서는 변경된
+            명령어에 앞뒤 명령어를 붙여 반환할 때의 최대 라인 수로 쓰인다.
+        collect_metrics: 파일별 파싱/탐색 시간과 트리 크기를 측정할지 여부
+            (결과는 ContextExtractor.last_metrics로 조회)
+        metrics_callback: 파일별 측정이 끝날 때마다 호출되는 콜백.
+            설정되면 collect_metrics와 관계없이 측정이 활성화된다.
+        parse_timeout_seconds: 파일 하나의 AST 파싱에 허용하는 최대 시간 (초).
+            넘기면 파싱을 중단하고 ParseTimeoutError를 발생시킨다
+            (None이면 제한 없음).
+        include_package_declaration: 파일의 package 선언(Go `package main`,
+            Java/Kotlin `package ...`)을 각 심볼 블록의
+            ContextBlock.package_declaration에 기록해 블록 앞에 함께 표시할지 여부
+        minimal_block: 심볼 전체 대신 변경 라인을 감싸는 가장 작은 `{}` 블록
+            (Python 등 들여쓰기 언어는 헤더부터 dedent 직전까지의 블록)만
+            여는/닫는 구분자를 포함해 반환할지 여부. Gleam은 변경을 감싸는
+            `case` 절(arm) 하나도 블록으로 본다. 의존성 블록은 포함하지
+            않으며, 파일 전체 모드 기준을 만족하면 파일 전체 모드가 우선한다.
+        anonymize_identifiers: 의존성 블록을 제외한 각 블록의 사용자 식별자
+            (변수, 함수 이름)를 `v1`, `fn2` 같은 토큰으로 바꿔 코드 구조만 전달할지
+            여부. 매핑은 블록마다 일관되며 키워드, 타입, 내장 이름은 유지한다
+            (Python, JavaScript, TypeScript, Java, Go 지원).
+        preserve_public_names: anonymize_identifiers가 켜진 경우 공개 API로
+            선언된 함수 이름은 바꾸지 않을지 여부
+        strict: 변경된 라인이나 변경된 심볼 블록 안에 구문 오류(ERROR/MISSING
+            노드)가 있으면 best-effort 추출 대신 StrictParseError를 발생시킬지
+            여부. 파싱하지 않는 파일 전체 모드에는 적용되지 않는다.
+        include_line_metrics: 의존성/참고용 블록을 제외한 각 블록의 코드 라인 수
+            (LOC)와 주석 비율을 AST의 주석 노드 기준으로 계산해
+            ContextBlock.line_metrics에 기록할지 여부
+        adaptive_detail: 함수 크기에 따라 상세 수준을 자동으로 정할지 여부.
+            adaptive_detail_max_lines 이하인 함수는 전체를, 더 긴 함수는
+            시그니처와 변경 라인 주변 윈도우만 남기고 나머지를 생략 표시로
+            바꿔 반환한다. 블록의 line_range는 함수 전체 범위를 유지한다.
+        adaptive_detail_max_lines: adaptive_detail에서 전체를 포함할 함수의
+            최대 라인 수 (선행 주석 포함)
+        adaptive_detail_window_lines: adaptive_detail이나 심볼 크기 상한으로 줄인
+            블록에서 변경 라인 앞뒤로 남길 라인 수
+        table_case_only: Go 테이블 기반 테스트(`tests := []struct{...}{...}`)의
+            케이스 안 변경에 대해 감싸는 테스트 함수 대신 변경된 케이스 항목만
+            블록으로 반환할지 여부. 꺼져 있거나 테이블 밖의 변경도 있으면 테스트
+            함수 전체를 반환하고 변경된 케이스를 ContextBlock.changed_cases로
+            표시한다.
+        include_call_sites: 변경된 함수를 같은 파일 안에서 호출하는 위치를 찾아
+            호출을 감싸는 문장(여러 줄 문장은 첫 줄부터 호출이 끝나는 줄까지)을
+            reason이 "call-site"인 참고용 블록으로 포함할지 여부. 호출 대상은
+            이름으로만 비교하며, 이미 포함된 블록 안의 호출은 제외한다.
+        max_call_sites: include_call_sites에서 파일 하나에 포함할 최대 호출
+            위치 수
+        include_comment_changes: 주석만 바뀐 변경(문서 주석 오타 수정 등)을 버리지
+            않고, 언어별 주석 연결 규칙으로 그 주석이 연결된 선언을 찾아 주석과
+            함께 reason이 "comment-change"인 블록으로 포함할지 여부. 어떤
+            선언에도 연결되지 않은 주석의 변경은 기존처럼 무시한다.
+        max_nesting_depth: 블록의 scope_path(감싸는 선언 이름들)와 심볼 조회용
+            조상 경로에 기록할 최대 중첩 깊이. 콜백 피라미드처럼 비정상적으로
+            깊게 중첩된 코드에서는 가장 안쪽부터 이 개수만 남기고 블록의
+            depth_limited를 True로 표시한다
+        name_formatters: 언어 이름별로 심볼 블록의 name/qualified_name을 만드는
+            포맷터. 등록되지 않은 언어는 기본 SymbolNameFormatter를 사용한다
+            (None이면 모든 언어에서 기본 포맷터)
+        include_trailing_comments: 심볼의 닫는 구분자 뒤 줄 주석
+            (`} // end of retry loop`)과 그 아래에 빈 줄 없이 이어지는 줄 주석을
+            블록 텍스트와 라인 범위에 포함할지 여부. 첫 빈 줄이나 다음 문장에서
+            멈추며, 다음 문장에 바로 붙은 주석은 그 문장의 선행 주석으로 본다.
+            주석 문법은 언어별 주석 연결 전략을 따른다.
+        ancestor_depth: 변경 범위(hunk)를 모두 감싸는 가장 작은 이름 있는 심볼에서
+            바깥쪽으로 몇 단계 넓힌 심볼을 반환할지 정하는 값. 0이면 가장 작은
+            심볼을, 1이면 `AddNumbers` 안의 `logOperation` 변경에 `AddNumbers`를
+            반환하며, 감싸는 심볼이 부족하면 가장 바깥쪽 심볼에서 멈춘다.
+        include_sibling_overloads: 변경된 함수와 같은 선언 범위(클래스, 파일 등)에
+            있는 같은 이름의 다른 오버로드/메서드(Java, Kotlin, TypeScript,
+            Solidity 오버로드와 Julia 다중 디스패치 메서드)를 reason이
+            "sibling-overload"인 참고용 블록으로 포함할지 여부
+        max_sibling_overloads: include_sibling_overloads에서 파일 하나에 포함할
+            최대 오버로드 수
+        max_sibling_overload_lines: include_sibling_overloads에서 포함할 오버로드
+            블록들의 총 라인 수 상한. 남은 라인 수보다 긴 오버로드는 건너뛴다.
+        include_overridden_methods: 변경된 메서드를 감싸는 클래스의
+            `extends`/`implements` 절을 따라 같은 파일의 상위 타입에서 이름과
+            파라미터 수가 같은 메서드를 찾아, 그 시그니처(본문 제외)를 reason이
+            "overridden-method"인 참고용 블록으로 포함할지 여부 (Java, Kotlin,
+            TypeScript). 파일 안에 선언이 없는 상위 타입은 symbol_resolver에
+            `Base.method` 형태로 조회한다. 상속 계층을 따라가므로 비용이 크다.
+        extract_embedded_sql: 변경된 문자열 리터럴이 SQL이면(바로 윗줄의
+            `// language=sql` 주석이나 `SELECT ... FROM` 같은 문장 형태로 판단)
+            그 내용을 SQL 추출기로 다시 추출해 변경된 SQL 문장을 reason이
+            "embedded-sql"인 블록으로 포함할지 여부 (Go, Python, Java, Kotlin,
+            JavaScript, TypeScript). 휴리스틱이므로 조립되는 쿼리는 놓칠 수 있다.
+        include_concurrency_flags: Go 심볼 블록마다 `go` 문, 채널(`chan` 타입,
+            송수신, `select`), 뮤텍스(`sync.Mutex` 참조와 `Lock`/`Unlock` 호출)
+            사용 여부를 ContextBlock.uses_goroutine/uses_channel/uses_mutex에
+            기록할지 여부 (Go 전용)
+        symbol_radius: 변경된 심볼과 같은 선언 범위(클래스 본문, 파일 등)에서
+            소스 위치가 가까운 앞뒤 심볼을 각각 최대 이 개수만큼 reason이
+            "neighbor-symbol"인 참고용 블록으로 포함한다. 가까운 거리부터 앞,
+            뒤 순서로 번갈아 고르므로 양쪽이 대칭이다 (0이면 포함하지 않음)
+        symbol_radius_bodies: symbol_radius로 포함하는 심볼의 본문까지 포함할지
+            여부 (False면 본문을 뺀 시그니처만 포함)
+        max_symbol_radius_lines: symbol_radius로 포함할 블록들의 총 라인 수 상한.
+            남은 라인 수보다 긴 심볼은 건너뛴다.
+        dedent_blocks: 파일 기준으로 깊게 들여쓰인 블록(중첩 클로저 등)을 따로
+            보기 쉽도록 블록 라인들이 공유하는 선행 공백을 제거할지 여부. 모든
+            라인에서 같은 공백 문자열을 지우므로 들여쓰기가 의미 있는 언어의
+            상대 들여쓰기는 유지되며, 제거 전 텍스트는
+            ContextBlock.undedented_text에 남는다. line_range는 원본 파일 기준을
+            유지한다.
+        headers_only: 심볼 본문 대신 변경된 심볼과 이를 감싸는 선언들(클래스,
+            바깥 함수, Go 메서드의 receiver 타입 선언)의 여는 라인만 바깥쪽부터
+            블록으로 반환할지 여부. 본문과 닫는 구분자는 생략하므로 가장 적은
+            토큰으로 변경 위치를 보여주는 탐색용 경로(breadcrumb)가 된다. 심볼
+            밖의 변경 라인은 무시한다.
+        include_cost: 의존성 블록과 다른 파일의 블록을 제외한 각 블록의 바이트
+            수, 추정 토큰 수, (가중치가 있으면) 순환 복잡도를 cost_weights로
+            가중합해 ContextBlock.cost에 기록할지 여부. 프롬프트 예산 안에서
+            변경 영향도 대비 비용 순으로 블록을 고를 때 쓴다.
+        cost_weights: include_cost에서 쓰는 지표별 가중치
+        include_file_outline: 추출 결과 맨 앞에 파일의 최상위 심볼들을
+            `종류 이름 (line N)` 한 줄씩 나열한 개요 블록(reason이
+            "file-outline")을 파일마다 한 번 붙일지 여부. 변경된 심볼이 하나여도
+            파일 전체 구조를 파악할 수 있게 한다. 심볼 이름이 그대로 드러나므로
+            anonymize_identifiers가 켜져 있으면 붙이지 않는다.
+        max_symbol_lines: 심볼 블록 하나의 라인 수 상한. 넘는 심볼은 전체 예산과
+            관계없이 시그니처, 변경 라인 앞뒤 adaptive_detail_window_lines 라인,
+            (중괄호 언어의) 닫는 라인만 남기고 나머지를 생략 표시로 바꾸며,
+            ContextBlock.size_capped로 표시한다 (None이면 라인 기준 미사용)
+        max_symbol_bytes: 심볼 블록 하나의 크기(UTF-8 바이트) 상한 (None이면
+            바이트 기준 미사용). 두 기준 중 하나라도 넘으면 줄인다.
+        order_by_call_graph: 변경된 심볼 블록들을 소스 순서 대신 같은 파일 호출
+            관계 순서(호출하는 쪽이 먼저)로 배치할지 여부. 호출 관계는 타입을
+            해석하지 않고 호출의 마지막 이름으로만 추정하는 휴리스틱이므로 같은
+            이름의 다른 심볼을 잘못 연결할 수 있으며, 순환 호출은 소스 순서를
+            따른다. 블록 내용은 바뀌지 않고 표시 순서만 바뀐다.
+        include_section_labels: 블록 앞에서 가장 가까운 배너 주석
+            (`// ===== Handlers =====`, `// MARK: - Views`, 대문자 주석 등)의
+            구역 이름을 ContextBlock.section에 기록할지 여부. 배너 판별은
+            SectionBannerDetector의 휴리스틱을 따른다.
+        section_banner_patterns: 배너로 볼 주석 텍스트(주석 기호 제외)의
+            정규식들. `label` 이름 그룹이 있으면 그 부분을 구역 이름으로
+            사용한다 (None이면 SectionBannerDetector.DEFAULT_PATTERNS)
+        trim_blank_lines: 블록 텍스트 앞뒤의 빈 라인(공백만 있는 라인 포함)을
+            제거할지 여부. 블록 안쪽의 빈 라인은 유지하며, 원문과 바이트 단위로
+            같아야 하는 경우를 위해 기본값은 꺼져 있다. line_range는 제거한
+            라인만큼 줄인다.
+        keep_untrimmed_line_ranges: trim_blank_lines로 빈 라인을 제거해도
+            line_range를 제거 전 범위로 유지할지 여부
+        anchor_queries: 언어별 앵커 쿼리 레지스트리. 변경 라인이 `@anchor`로
+            캡처된 노드(HTTP 핸들러, 트랜잭션 블록 등) 안에 있으면 심볼 단위와
+            관계없이 그 노드 전체를 변경된 블록으로 포함하며, 앵커 안의 더
+            작은 블록은 앵커 블록으로 대체된다. minimal_block/headers_only
+            모드와 Markdown 등 전용 추출 경로에는 적용하지 않는다
+    """
+
+    include_signature_types: bool = False
+    max_signature_types: int = 5
+    symbol_resolver: SymbolResolver | None = None
+    include_signatures: bool = False
+    include_comments: bool = False
+    include_exported_doc_comments: bool = False
+    whole_file_max_lines: int | None = None
+    whole_file_max_bytes: int | None = None
+    max_top_level_statement_lines: int = 50
+    collect_metrics: bool = False
+    metrics_callback: Callable[[ExtractionMetrics], None] | None = None
+    parse_timeout_seconds: float | None = 5.0
+    include_package_declaration: bool = False
+    minimal_block: bool = False
+    anonymize_identifiers: bool = False
+    preserve_public_names: bool = False
+    strict: bool = False
+    include_line_metrics: bool = False
+    adaptive_detail: bool = False
+    adaptive_detail_max_lines: int = 60
+    adaptive_detail_window_lines: int = 3
+    table_case_only: bool = False
+    include_call_sites: bool = False
+    max_call_sites: int = 10
+    include_comment_changes: bool = False
+    max_nesting_depth: int = 64
+    name_formatters: Mapping[str, SymbolNameFormatter] | None = None
+    include_trailing_comments: bool = False
+    ancestor_depth: int = 0
+    include_sibling_overloads: bool = False
+    max_sibling_overloads: int = 5
+    max_sibling_overload_lines: int = 200
+    include_overridden_methods: bool = False
+    extract_embedded_sql: bool = False
+    include_concurrency_flags: bool = False
+    symbol_radius: int = 0
+    symbol_radius_bodies: bool = False
+    max_symbol_radius_lines: int = 200
+    dedent_blocks: bool = False
+    headers_only: bool = False
+    include_cost: bool = False
+    cost_weights: SymbolCostWeights = SymbolCostWeights()
+    include_file_outline: bool = False
+    max_symbol_lines: int | None = None
+    max_symbol_bytes: int | None = None
+    order_by_call_graph: bool = False
+    include_section_labels: bool = False
+    section_banner_patterns: tuple[str, ...] | None = None
+    trim_blank_lines: bool = False
+    keep_untrimmed_line_ranges: bool = False
+    anchor_queries: AnchorQueryRegistry | None = None
+
+    def __post_init__(self) -> None:
+        """유효성 검증을 수행한다."""
+        if self.max_signature_types < 0:
+            raise ValueError("max_signature_types는 0 이상이어야 합니다")
+        if self.max_top_level_statement_lines < 0:
+            raise ValueError("max_top_level_statement_lines는 0 이상이어야 합니다")
+        if self.whole_file_max_lines is not None and self.whole_file_max_lines <= 0:
+            raise ValueError("whole_file_max_lines는 1 이상이어야 합니다")
+        if self.whole_file_max_bytes is not None and self.whole_file_max_bytes <= 0:
+            raise ValueError("whole_file_max_bytes는 1 이상이어야 합니다")
+        if self.parse_timeout_seconds is not None and self.parse_timeout_seconds <= 0:
+            raise ValueError("parse_timeout_seconds는 0보다 커야 합니다")
+        if self.adaptive_detail_max_lines <= 0:
+            raise ValueError("adaptive_detail_max_lines는 1 이상이어야 합니다")
+        if self.adaptive_detail_window_lines < 0:
+            raise ValueError("adaptive_detail_window_lines는 0 이상이어야 합니다")
+        if self.max_call_sites < 0:
+            raise ValueError("max_call_sites는 0 이상이어야 합니다")
+        if self.max_nesting_depth <= 0:
+            raise ValueError("max_nesting_depth는 1 이상이어야 합니다")
+        if self.ancestor_depth < 0:
+            raise ValueError("ancestor_depth는 0 이상이어야 합니다")
+        if self.max_sibling_overloads < 0:
+            raise ValueError("max_sibling_overloads는 0 이상이어야 합니다")
+        if self.max_sibling_overload_lines < 0:
+            raise ValueError("max_sibling_overload_lines는 0 이상이어야 합니다")
+        if self.max_symbol_lines is not None and self.max_symbol_lines <= 0:
+            raise ValueError("max_symbol_lines는 1 이상이어야 합니다")
+        if self.max_symbol_bytes is not None and self.max_symbol_bytes <= 0:
+            raise ValueError("max_symbol_bytes는 1 이상이어야 합니다")
+        if self.symbol_radius < 0:
+            raise ValueError("symbol_radius는 0 이상이어야 합니다")
+        if self.max_symbol_radius_lines < 0:
+            raise ValueError("max_symbol_radius_lines는 0 이상이어야 합니다")
+        for pattern in self.section_banner_patterns or ():
+            try:
+                re.compile(pattern)
+            except re.error as e:
+                raise ValueError(
+                    f"section_banner_patterns의 정규식이 올바르지 않습니다: {pattern}"
+                ) from e
+
+    @property
+    def metrics_enabled(self) -> bool:
+        """추출 계측이 활성화되었는지 반환한다."""
+        return self.collect_metrics or self.metrics_callback is not None
+
+    @property
+    def whole_file_enabled(self) -> bool:
+        """파일 전체 모드 기준이 하나라도 설정되었는지 반환한다."""
+        return (
+            self.whole_file_max_lines is not None
+            or self.whole_file_max_bytes is not None
+        )
+
+    def allows_whole_file(self, line_count: int, byte_count: int) -> bool:
+        """주어진 파일 크기가 파일 전체 모드 기준을 만족하는지 반환한다.
+
+        Args:
+            line_count: 파일 라인 수
+            byte_count: 파일 크기 (UTF-8 바이트)
+
+        Returns:
+            파일 전체 모드를 사용할 수 있으면 True
+        """
+        if not self.whole_file_enabled:
+            return False
+        if self.whole_file_max_lines is not None and (
+            line_count > self.whole_file_max_lines
+        ):
+            return False
+        return self.whole_file_max_bytes is None or (
+            byte_count <= self.whole_file_max_bytes
+        )
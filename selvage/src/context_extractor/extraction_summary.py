@@ -0,0 +1,179 @@
+"""ExtractionSummary: 리뷰 실행 전체의 언어별 컨텍스트 추출 결과 집계."""
+
+from __future__ import annotations
+
+from collections import Counter
+from collections.abc import Callable, Iterable, Mapping
+from dataclasses import dataclass, field
+from typing import Any
+
+from .duplicate_symbol_detector import DuplicateSymbolDetector
+from .duplicate_symbol_pair import DuplicateSymbolPair
+from .extracted_file_context import ExtractedFileContext
+from .language_extraction_summary import LanguageExtractionSummary
+from .token_estimate import estimate_tokens
+
+# 블록이 하나도 추출되지 않은 파일의 건너뜀 사유
+NO_CONTEXT_REASON = "no-context"
+
+
+@dataclass(frozen=True)
+class ExtractionSummary:
+    """여러 파일의 추출 결과를 언어별로 집계한 요약.
+
+    CI 대시보드 등에서 특정 언어의 컨텍스트 추출이 멈춘 회귀를 감지할 수
+    있도록 언어별 파일/심볼 수와 건너뛴 사유를 정확한 정수로 기록한다.
+
+    Attributes:
+        languages: 언어 이름 순으로 정렬된 언어별 요약
+        parse_errors: strict 옵션에서 변경된 심볼의 구문 오류로 추출이 실패한
+            파일 경로 → 오류 위치((라인, 컬럼), 1-based) 목록 (경로 순).
+            비어 있지 않으면 CI에서 실행을 실패로 처리할 수 있다.
+        duplicate_symbols: 유사 심볼 탐지를 요청한 경우 토큰 유사도가 임계값
+            이상인 변경 심볼 쌍들 (입력 순서)
+    """
+
+    languages: tuple[LanguageExtractionSummary, ...] = ()
+    parse_errors: Mapping[str, tuple[tuple[int, int], ...]] = field(
+        default_factory=dict
+    )
+    duplicate_symbols: tuple[DuplicateSymbolPair, ...] = ()
+
+    @property
+    def file_count(self) -> int:
+        """전체 파일 수를 반환한다."""
+        return sum(summary.file_count for summary in self.languages)
+
+    @property
+    def symbol_count(self) -> int:
+        """전체 추출 심볼 수를 반환한다."""
+        return sum(summary.symbol_count for summary in self.languages)
+
+    @property
+    def skipped_count(self) -> int:
+        """전체 건너뛴 파일 수를 반환한다."""
+        return sum(summary.skipped_count for summary in self.languages)
+
+    @property
+    def context_bytes(self) -> int:
+        """전체 컨텍스트 바이트 수를 반환한다."""
+        return sum(summary.context_bytes for summary in self.languages)
+
+    @property
+    def context_tokens(self) -> int:
+        """전체 컨텍스트 토큰 수를 반환한다."""
+        return sum(summary.context_tokens for summary in self.languages)
+
+    @property
+    def has_parse_errors(self) -> bool:
+        """구문 오류로 추출이 실패한 파일이 있는지 반환한다."""
+        return bool(self.parse_errors)
+
+    def for_language(self, language: str) -> LanguageExtractionSummary | None:
+        """언어 하나의 요약을 반환한다 (집계되지 않은 언어면 None)."""
+        return next(
+            (summary for summary in self.languages if summary.language == language),
+            None,
+        )
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            totals(전체 합계), languages(언어별 요약 목록), parse_errors(파일 경로 →
+            `라인:컬럼` 오류 위치 목록), duplicate_symbols(유사 심볼 쌍 목록)
+            키를 가진 딕셔너리
+        """
+        return {
+            "totals": {
+                "file_count": self.file_count,
+                "symbol_count": self.symbol_count,
+                "skipped_count": self.skipped_count,
+                "context_bytes": self.context_bytes,
+                "context_tokens": self.context_tokens,
+            },
+            "languages": [summary.to_dict() for summary in self.languages],
+            "parse_errors": {
+                file_path: [f"{line}:{column}" for line, column in locations]
+                for file_path, locations in self.parse_errors.items()
+            },
+            "duplicate_symbols": [pair.to_dict() for pair in self.duplicate_symbols],
+        }
+
+    @classmethod
+    def aggregate(
+        cls,
+        results: Iterable[ExtractedFileContext],
+        token_counter: Callable[[str], int] | None = None,
+        duplicate_detector: DuplicateSymbolDetector | None = None,
+    ) -> ExtractionSummary:
+        """파일별 추출 결과들을 언어별로 집계한다.
+
+        status가 "ok"가 아닌 파일은 status를 사유로, 블록이 하나도 없는
+        파일은 "no-context"를 사유로 건너뛴 파일에 집계한다. strict 옵션의
+        구문 오류로 실패한 파일은 오류 위치와 함께 parse_errors에도 기록한다.
+        duplicate_detector가 주어지면 변경 심볼 간 유사도를 비교해 거의 같은
+        심볼 쌍을 duplicate_symbols에 기록한다 (심볼 수의 제곱에 비례하는
+        비용이 들므로 기본적으로 비교하지 않는다).
+
+        Args:
+            results: 파일별 추출 결과들 (건너뛴 파일은
+                ExtractedFileContext.skipped로 생성)
+            token_counter: 블록 텍스트의 토큰 수를 세는 함수
+                (기본값: estimate_tokens)
+            duplicate_detector: 유사 심볼 탐지기 (None이면 탐지하지 않음)
+
+        Returns:
+            언어별 요약 (입력이 비어 있으면 빈 요약)
+        """
+        results = list(results)
+        count_tokens = token_counter or estimate_tokens
+        by_language: dict[str, list[ExtractedFileContext]] = {}
+        parse_errors: dict[str, tuple[tuple[int, int], ...]] = {}
+        for result in results:
+            by_language.setdefault(result.language, []).append(result)
+            if result.has_parse_errors:
+                parse_errors[result.file_path] = result.parse_error_locations
+
+        summaries: list[LanguageExtractionSummary] = []
+        for language in sorted(by_language):
+            items = by_language[language]
+            skip_reasons: Counter[str] = Counter()
+            extracted_file_count = 0
+            symbol_count = 0
+            context_bytes = 0
+            context_tokens = 0
+            for item in items:
+                if item.status != ExtractedFileContext.OK_STATUS:
+                    skip_reasons[item.status] += 1
+                elif not item.blocks:
+                    skip_reasons[NO_CONTEXT_REASON] += 1
+                else:
+                    extracted_file_count += 1
+                symbol_count += sum(
+                    1 for block in item.context_blocks if block.reason is None
+                )
+                for block in [*item.blocks, *item.previous_blocks]:
+                    context_bytes += len(block.body().encode("utf-8"))
+                    context_tokens += count_tokens(block.body())
+
+            summaries.append(
+                LanguageExtractionSummary(
+                    language=language,
+                    file_count=len(items),
+                    extracted_file_count=extracted_file_count,
+                    symbol_count=symbol_count,
+                    skipped_count=sum(skip_reasons.values()),
+                    skip_reasons=dict(sorted(skip_reasons.items())),
+                    context_bytes=context_bytes,
+                    context_tokens=context_tokens,
+                )
+            )
+        duplicate_symbols: tuple[DuplicateSymbolPair, ...] = ()
+        if duplicate_detector is not None:
+            duplicate_symbols = tuple(duplicate_detector.detect(results))
+        return cls(
+            languages=tuple(summaries),
+            parse_errors=dict(sorted(parse_errors.items())),
+            duplicate_symbols=duplicate_symbols,
+        )
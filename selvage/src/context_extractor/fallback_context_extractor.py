@@ -5,8 +5,11 @@ from __future__ import annotations
 import re
 from collections.abc import Sequence
 
+from .context_block import ContextBlock
 from .line_range import LineRange
+from .macro_definition_resolver import MacroDefinitionResolver
 from .meaningless_change_filter import MeaninglessChangeFilter
+from .text_lines import split_lines
 
 
 class FallbackContextExtractor:
@@ -20,8 +23,16 @@ class FallbackContextExtractor:
     - 겹치는 범위들을 병합하여 최적화
     - 정규표현식으로 범용 import 패턴 추출
     - 주석 및 빈 라인 필터링
+    - 변경 라인에서 호출한 같은 파일의 매크로(C `#define`, Rust
+      `macro_rules!`) 정의를 참고용 블록으로 포함
     """
 
+    # 변경 라인이 호출한 매크로 정의 블록의 포함 사유
+    MACRO_DEFINITION_REASON = "macro-definition"
+
+    # 참고용으로 포함할 최대 매크로 정의 개수
+    MAX_MACRO_DEFINITIONS = 5
+
     # 범용 import/dependency 패턴들
     IMPORT_PATTERNS = [
         # C/C++
@@ -65,6 +76,7 @@ class FallbackContextExtractor:
         self._import_regex = re.compile("|".join(self.IMPORT_PATTERNS), re.MULTILINE)
         # 무의미한 변경 필터링 객체
         self._filter = MeaninglessChangeFilter()
+        self._macro_resolver = MacroDefinitionResolver()
 
     def extract_contexts(
         self, file_content: str, changed_ranges: Sequence[LineRange]
@@ -78,6 +90,24 @@ class FallbackContextExtractor:
         Returns:
             추출된 컨텍스트 코드 블록들의 리스트
 
+        Raises:
+            ValueError: 파일 내용이 없거나 처리 오류
+        """
+        blocks = self.extract_context_blocks(file_content, changed_ranges)
+        return ContextBlock.format_blocks(blocks)
+
+    def extract_context_blocks(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 라인 범위들을 기반으로 구조화된 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            의존성 블록(있는 경우)과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
         Raises:
             ValueError: 파일 내용이 없거나 처리 오류
         """
@@ -90,7 +120,7 @@ class FallbackContextExtractor:
 
         try:
             code_text = file_content
-            lines = code_text.splitlines()
+            lines = split_lines(code_text)
         except Exception as e:
             raise ValueError(f"파일 내용 처리 오류: {e}") from e
 
@@ -104,43 +134,124 @@ class FallbackContextExtractor:
             return []
 
         # 3. 변경 범위 확장 및 병합
-        expanded_ranges = self._expand_ranges(meaningful_ranges)
+        expanded_ranges = self._expand_ranges(meaningful_ranges, len(lines))
         merged_ranges = self._merge_overlapping_ranges(expanded_ranges)
 
         # 4. Import 문 추출
-        import_statements = self._extract_import_statements(code_text)
+        import_lines = self._extract_import_lines(lines)
 
         # 5. 각 범위에서 컨텍스트 추출
-        context_blocks = []
+        blocks: list[ContextBlock] = []
 
         # Import 문들을 하나의 블럭으로 그룹핑
-        if import_statements:
-            import_block = self._format_import_block(import_statements)
-            context_blocks.append(import_block)
+        if import_lines:
+            line_numbers = [line_number for line_number, _ in import_lines]
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(statement for _, statement in import_lines),
+                    line_range=LineRange(min(line_numbers), max(line_numbers)),
+                    is_dependency=True,
+                )
+            )
 
-        # 변경된 범위의 컨텍스트를 구분선과 함께 추가
-        for i, line_range in enumerate(merged_ranges, 1):
+        # 변경된 범위의 컨텍스트를 라인 범위와 함께 추가
+        for line_range in merged_ranges:
             context = self._extract_context_from_range(lines, line_range)
             if context:
-                formatted_context = self._format_context_block(context, line_range, i)
-                context_blocks.append(formatted_context)
+                blocks.append(ContextBlock(text=context, line_range=line_range))
+
+        # 6. 변경 라인에서 호출한 매크로의 정의 추가
+        blocks.extend(
+            self._create_macro_definition_blocks(
+                lines,
+                meaningful_ranges,
+                merged_ranges,
+                {line_number for line_number, _ in import_lines},
+            )
+        )
 
-        return context_blocks
+        return blocks
 
-    def _expand_ranges(self, ranges: Sequence[LineRange]) -> list[LineRange]:
-        """변경 범위들을 앞뒤로 5줄씩 확장한다.
+    def _create_macro_definition_blocks(
+        self,
+        lines: list[str],
+        changed_ranges: Sequence[LineRange],
+        context_ranges: Sequence[LineRange],
+        import_line_numbers: set[int],
+    ) -> list[ContextBlock]:
+        """변경 라인에서 호출한 같은 파일 매크로의 정의 블록들을 만든다.
+
+        정의가 이미 컨텍스트 범위 안에 보이거나 의존성 블록에 모두 들어 있는
+        매크로(한 줄 `#define`)는 제외하며, 첫 호출 순서대로 최대
+        MAX_MACRO_DEFINITIONS개까지 포함한다.
+
+        Args:
+            lines: 파일의 모든 라인들
+            changed_ranges: 의미있는 변경 라인 범위들
+            context_ranges: 확장/병합된 컨텍스트 범위들
+            import_line_numbers: 의존성 블록에 포함된 라인 번호들
+
+        Returns:
+            reason이 MACRO_DEFINITION_REASON인 블록들
+        """
+        definitions = self._macro_resolver.definitions(lines)
+        if not definitions:
+            return []
+
+        blocks: list[ContextBlock] = []
+        included: set[str] = set()
+        for changed_range in changed_ranges:
+            for line_no in range(changed_range.start_line, changed_range.end_line + 1):
+                if line_no > len(lines):
+                    break
+                for name in self._macro_resolver.invoked_names(
+                    lines[line_no - 1], definitions
+                ):
+                    line_range, _ = definitions[name]
+                    if name in included or line_range.contains(line_no):
+                        continue
+                    included.add(name)
+                    definition_lines = range(
+                        line_range.start_line, line_range.end_line + 1
+                    )
+                    if import_line_numbers.issuperset(definition_lines) or any(
+                        context_range.start_line <= line_range.start_line
+                        and line_range.end_line <= context_range.end_line
+                        for context_range in context_ranges
+                    ):
+                        continue
+                    blocks.append(
+                        ContextBlock(
+                            text="\n".join(
+                                lines[line_range.start_line - 1 : line_range.end_line]
+                            ),
+                            line_range=line_range,
+                            block_type="macro_definition",
+                            name=name,
+                            reason=self.MACRO_DEFINITION_REASON,
+                        )
+                    )
+                    if len(blocks) >= self.MAX_MACRO_DEFINITIONS:
+                        return blocks
+        return blocks
+
+    def _expand_ranges(
+        self, ranges: Sequence[LineRange], line_count: int
+    ) -> list[LineRange]:
+        """변경 범위들을 파일 범위 안에서 앞뒤로 5줄씩 확장한다.
 
         Args:
             ranges: 원본 변경 범위들
+            line_count: 파일의 라인 수
 
         Returns:
-            확장된 범위들의 리스트
+            확장된 범위들의 리스트 (끝 라인은 파일의 마지막 라인을 넘지 않음)
         """
         expanded = []
         for line_range in ranges:
-            # 앞뒤로 5줄씩 확장 (최소 1라인 보장)
+            # 앞뒤로 5줄씩 확장 (최소 1라인, 최대 마지막 라인)
             start = max(1, line_range.start_line - 5)
-            end = line_range.end_line + 5
+            end = max(start, min(line_range.end_line + 5, line_count))
             expanded.append(LineRange(start, end))
         return expanded
 
@@ -175,23 +286,23 @@ class FallbackContextExtractor:
 
         return merged
 
-    def _extract_import_statements(self, code_text: str) -> list[str]:
-        """정규표현식으로 import 관련 문장들을 추출한다.
+    def _extract_import_lines(self, lines: list[str]) -> list[tuple[int, str]]:
+        """정규표현식으로 import 관련 문장들을 라인 번호와 함께 추출한다.
 
         Args:
-            code_text: 전체 파일 텍스트
+            lines: 파일의 모든 라인들
 
         Returns:
-            추출된 import 문들의 리스트
+            (1-based 라인 번호, import 문) 튜플들의 리스트
         """
         import_lines = []
 
         # 각 라인별로 import 패턴 검사
-        for line in code_text.splitlines():
+        for line_number, line in enumerate(lines, 1):
             if self._import_regex.match(line):
                 stripped_line = line.strip()
                 if stripped_line and self._filter._is_meaningful_line(stripped_line):
-                    import_lines.append(stripped_line)
+                    import_lines.append((line_number, stripped_line))
 
         return import_lines
 
@@ -222,38 +333,3 @@ class FallbackContextExtractor:
             return "\n".join(context_lines)
 
         return None
-
-    def _format_import_block(self, import_statements: list[str]) -> str:
-        """Import 문들을 하나의 블럭으로 포맷팅한다.
-
-        Args:
-            import_statements: Import 문들의 리스트
-
-        Returns:
-            포맷팅된 import 블럭
-        """
-        if not import_statements:
-            return ""
-
-        import_content = "\n".join(import_statements)
-        return f"---- Dependencies/Imports ----\n{import_content}"
-
-    def _format_context_block(
-        self, context: str, line_range: LineRange, block_number: int
-    ) -> str:
-        """컨텍스트 블럭을 구분선과 함께 포맷팅한다.
-
-        Args:
-            context: 추출된 컨텍스트 내용
-            line_range: 해당 라인 범위
-            block_number: 블럭 번호
-
-        Returns:
-            포맷팅된 컨텍스트 블럭
-        """
-        header = (
-            f"---- Context Block {block_number} "
-            f"(Lines {line_range.start_line}-{line_range.end_line}) ----"
-        )
-
-        return f"{header}\n{context}"
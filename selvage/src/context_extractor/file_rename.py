@@ -0,0 +1,64 @@
+"""FileRename: git이 보고한 파일 이름 변경 정보를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class FileRename:
+    """이름이 바뀐(이동된) 파일의 이전/새 경로와 내용 유사도.
+
+    git diff의 `rename from`/`rename to`/`similarity index` 헤더에 대응하며,
+    이전 경로의 내용과 새 경로의 내용을 하나의 논리적 파일로 묶을 때 사용한다.
+
+    Attributes:
+        old_path: 이름 변경 전 경로
+        new_path: 이름 변경 후 경로
+        similarity: 두 내용의 유사도 (0.0~1.0, git이 보고하지 않았으면 None)
+    """
+
+    old_path: str
+    new_path: str
+    similarity: float | None = None
+
+    def __post_init__(self) -> None:
+        """유사도 범위를 검증한다.
+
+        Raises:
+            ValueError: similarity가 0.0~1.0 범위를 벗어난 경우
+        """
+        if self.similarity is not None and not 0.0 <= self.similarity <= 1.0:
+            raise ValueError("similarity는 0.0 이상 1.0 이하여야 합니다")
+
+    @classmethod
+    def from_similarity_index(
+        cls, old_path: str, new_path: str, similarity_index: int | None
+    ) -> FileRename:
+        """git의 `similarity index N%` 퍼센트 값으로 FileRename을 만든다.
+
+        Args:
+            old_path: 이름 변경 전 경로
+            new_path: 이름 변경 후 경로
+            similarity_index: git이 보고한 유사도 퍼센트 (0~100, 없으면 None)
+
+        Returns:
+            유사도가 0.0~1.0으로 변환된 FileRename
+        """
+        similarity = similarity_index / 100 if similarity_index is not None else None
+        return cls(old_path=old_path, new_path=new_path, similarity=similarity)
+
+    @property
+    def is_pure_rename(self) -> bool:
+        """내용 변경 없이 경로만 바뀌었는지 여부 (유사도 100%)"""
+        return self.similarity == 1.0
+
+    def describe(self) -> str:
+        """렌더링 헤더용 이름 변경 설명을 반환한다.
+
+        Returns:
+            "renamed from old/path (similarity 92%)" 형태의 문자열
+        """
+        if self.similarity is None:
+            return f"renamed from {self.old_path}"
+        return f"renamed from {self.old_path} (similarity {self.similarity:.0%})"
@@ -0,0 +1,150 @@
+"""FortranScopeResolver: Fortran 프로그램 단위와 프로시저의 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class FortranScopeResolver(ScopeResolver):
+    """Fortran AST에서 변경을 감싸는 프로시저/프로그램 단위를 찾고 이름을 계산한다.
+
+    변경 라인을 감싸는 가장 가까운 `subroutine`/`function` 전체를 블록으로
+    사용하며, `contains` 아래의 내부 프로시저 안의 변경은 그 내부 프로시저만
+    반환하고 감싸는 모듈/프로시저 이름을 scope_path로 기록한다. 모듈의 명세부
+    (변수 선언, 파생 타입 등)에서의 변경은 해당 선언문만 반환한다.
+    프로시저와 선언문은 감싸는 `module`/`program`의 선언 라인을 컨테이너
+    헤더로 함께 포함한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 이름 있는 프로시저 노드 타입
+    PROCEDURE_TYPES = frozenset({"subroutine", "function", "module_procedure"})
+
+    # 프로시저를 담는 프로그램 단위 노드 타입
+    UNIT_TYPES = frozenset({"program", "module", "submodule"})
+
+    # 명세부 선언을 블록으로 반환하는 모듈 노드 타입
+    MODULE_TYPES = frozenset({"module", "submodule"})
+
+    # 모듈 바로 아래에 있어도 블록으로 반환하지 않는 노드 타입 (`contains` 영역)
+    MODULE_PASS_THROUGH_TYPES = frozenset({"internal_procedures"})
+
+    # 컨테이너(모듈/프로그램) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = PROCEDURE_TYPES | frozenset(
+        {"variable_declaration", "derived_type_definition", "interface"}
+    )
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 프로시저, 모듈 명세부 선언 또는 프로그램 단위를 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            프로시저, 모듈 바로 아래 선언문 또는 프로그램 단위 노드
+            (프로그램 단위 밖이면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.PROCEDURE_TYPES | self.UNIT_TYPES:
+                return current
+            parent = current.parent
+            if (
+                parent is not None
+                and parent.type in self.MODULE_TYPES
+                and not self._belongs_to_module(current)
+            ):
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 module/submodule/program 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            프로그램 단위 노드 (없으면 None)
+        """
+        current = node.parent
+        while current is not None:
+            if current.type in self.UNIT_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """프로그램 단위의 선언 라인(`module geometry` 등)을 반환한다."""
+        statement = self._unit_statement(container)
+        target = statement if statement is not None else container
+        return node_text(target).split("\n", 1)[0].rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 모듈/프로그램 이름을 반환한다."""
+        return self.name(container)
+
+    def name(self, node: Node) -> str | None:
+        """프로시저/프로그램 단위의 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            선언문에 적힌 이름 (프로시저/프로그램 단위가 아니면 None)
+        """
+        if node.type not in self.PROCEDURE_TYPES | self.UNIT_TYPES:
+            return None
+        statement = self._unit_statement(node)
+        if statement is None:
+            return None
+        name_node = statement.child_by_field_name("name")
+        if name_node is None:
+            name_node = next(
+                (child for child in statement.named_children if child.type == "name"),
+                None,
+            )
+        if name_node is None:
+            return None
+        return node_text(name_node) or None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 프로그램 단위와 프로시저 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (예: ("geometry", "area"))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            if current.type in self.PROCEDURE_TYPES | self.UNIT_TYPES:
+                segment = self.name(current)
+                if segment:
+                    segments.append(segment)
+            current = current.parent
+        return tuple(reversed(segments))
+
+    def _belongs_to_module(self, node: Node) -> bool:
+        """모듈의 시작/끝 선언문이나 `contains` 영역처럼 모듈 자체에 속한 노드인지."""
+        return (
+            node.type.endswith("module_statement")
+            or node.type in self.MODULE_PASS_THROUGH_TYPES
+        )
+
+    def _unit_statement(self, node: Node) -> Node | None:
+        """프로시저/프로그램 단위의 시작 선언문 노드를 반환한다."""
+        for child in node.named_children:
+            if child.type.endswith("_statement") and not child.type.startswith("end"):
+                return child
+        return None
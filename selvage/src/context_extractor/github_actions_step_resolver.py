@@ -0,0 +1,221 @@
+"""GitHubActionsStepResolver: 워크플로 YAML에서 변경 라인이 속한 job과 step을 찾는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class GitHubActionsStepResolver:
+    """GitHub Actions 워크플로 AST에서 변경 라인이 속한 job과 step을 찾는다.
+
+    `jobs:` 아래의 각 항목을 job으로, job의 `steps:` 시퀀스 항목을 step으로
+    본다. step 안의 라인(`run:` 스크립트 포함)이 바뀌면 step 전체를, step 밖의
+    job 설정(`runs-on:`, `needs:` 등)이 바뀌면 job 키부터 `steps:` 라인까지의
+    job 헤더를 반환한다. job 밖의 최상위 설정(`on:`, `env:` 등)은 해당 키 하나를
+    반환하며, `jobs:`/`steps:`처럼 job과 step을 담는 키 자체는 반환하지 않는다.
+    """
+
+    # job들을 담는 최상위 키
+    JOBS_KEY = "jobs"
+
+    # step 시퀀스를 담는 job 키
+    STEPS_KEY = "steps"
+
+    # step 이름으로 사용할 키 (앞에서부터 확인)
+    STEP_LABEL_KEYS = ("name", "uses", "id")
+
+    # 반환 블록의 block_type
+    STEP_TYPE = "step"
+    JOB_TYPE = "job"
+    SETTING_TYPE = "setting"
+
+    # step 블록을 감싸는 job 헤더 블록의 포함 사유
+    CONTAINER_REASON = "enclosing-job"
+
+    def find_job(self, root: Node, line_no: int) -> Node | None:
+        """라인을 포함하는 job 항목을 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            line_no: 1-based 라인 번호
+
+        Returns:
+            `jobs:` 아래의 job 키-값 쌍 노드 (job 밖의 라인이면 None)
+        """
+        jobs = self._find_pair(self._root_mapping(root), self.JOBS_KEY)
+        if jobs is None:
+            return None
+        return self._pair_at(self._value_mapping(jobs), line_no)
+
+    def find_step(self, job: Node, line_no: int) -> Node | None:
+        """job 안에서 라인을 포함하는 step 항목을 찾는다.
+
+        Args:
+            job: job 키-값 쌍 노드
+            line_no: 1-based 라인 번호
+
+        Returns:
+            `steps:` 시퀀스 항목 노드 (step 밖의 라인이면 None)
+        """
+        for item in self._steps(job):
+            if self._contains(item, line_no):
+                return item
+        return None
+
+    def find_setting(
+        self, root: Node, line_no: int, job: Node | None = None
+    ) -> Node | None:
+        """라인을 포함하는 최상위 또는 job 설정 키를 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            line_no: 1-based 라인 번호
+            job: job 키-값 쌍 노드 (주어지면 job 안의 설정 키에서 찾음)
+
+        Returns:
+            설정 키-값 쌍 노드 (`jobs:`/`steps:` 키이거나 없으면 None)
+        """
+        if job is not None:
+            mapping = self._value_mapping(job)
+        else:
+            mapping = self._root_mapping(root)
+        pair = self._pair_at(mapping, line_no)
+        if pair is None or self.key(pair) in (self.JOBS_KEY, self.STEPS_KEY):
+            return None
+        return pair
+
+    def key(self, pair: Node) -> str:
+        """키-값 쌍 노드의 키 텍스트를 반환한다 (따옴표 제외)."""
+        key_node = pair.child_by_field_name("key")
+        if key_node is None:
+            return ""
+        return self._scalar(key_node)
+
+    def step_name(self, job: Node, step: Node) -> str:
+        """step의 `name`, `uses`, `id` 중 처음 있는 값을 반환한다.
+
+        Args:
+            job: step이 속한 job 키-값 쌍 노드
+            step: step 시퀀스 항목 노드
+
+        Returns:
+            step 이름 (세 키가 모두 없으면 `steps[0]`처럼 0부터 시작하는 위치)
+        """
+        mapping = self._value_mapping(step)
+        for label_key in self.STEP_LABEL_KEYS:
+            pair = self._find_pair(mapping, label_key)
+            value = pair.child_by_field_name("value") if pair is not None else None
+            if value is not None and self._scalar(value):
+                return self._scalar(value)
+        position = next(
+            (
+                index
+                for index, item in enumerate(self._steps(job))
+                if item.start_byte == step.start_byte
+            ),
+            0,
+        )
+        return f"{self.STEPS_KEY}[{position}]"
+
+    def header_last_line(self, job: Node) -> int:
+        """job 헤더(job 키부터 `steps:` 라인까지)의 마지막 라인을 반환한다.
+
+        Args:
+            job: job 키-값 쌍 노드
+
+        Returns:
+            `steps:` 키의 라인 (step이 없는 job이면 job의 마지막 라인)
+        """
+        steps = self._find_pair(self._value_mapping(job), self.STEPS_KEY)
+        if steps is None:
+            return self.last_line(job)
+        return steps.start_point[0] + 1
+
+    def last_line(self, node: Node) -> int:
+        """노드의 마지막 라인(1-based)을 반환한다 (끝의 줄바꿈은 제외)."""
+        end_row, end_column = node.end_point
+        if end_column == 0 and end_row > node.start_point[0]:
+            return end_row
+        return end_row + 1
+
+    def _steps(self, job: Node) -> list[Node]:
+        """job의 `steps:` 시퀀스 항목들을 반환한다."""
+        steps = self._find_pair(self._value_mapping(job), self.STEPS_KEY)
+        value = steps.child_by_field_name("value") if steps is not None else None
+        if value is None:
+            return []
+        sequence = next(
+            (child for child in value.named_children if child.type == "block_sequence"),
+            None,
+        )
+        if sequence is None:
+            return []
+        return [
+            item
+            for item in sequence.named_children
+            if item.type == "block_sequence_item"
+        ]
+
+    def _contains(self, node: Node, line_no: int) -> bool:
+        """노드가 라인을 포함하는지 확인한다."""
+        return node.start_point[0] + 1 <= line_no <= self.last_line(node)
+
+    def _pair_at(self, mapping: Node | None, line_no: int) -> Node | None:
+        """매핑에서 라인을 포함하는 키-값 쌍을 찾는다."""
+        if mapping is None:
+            return None
+        for pair in mapping.named_children:
+            if pair.type == "block_mapping_pair" and self._contains(pair, line_no):
+                return pair
+        return None
+
+    def _find_pair(self, mapping: Node | None, key: str) -> Node | None:
+        """매핑에서 키가 일치하는 키-값 쌍을 찾는다."""
+        if mapping is None:
+            return None
+        for pair in mapping.named_children:
+            if pair.type == "block_mapping_pair" and self.key(pair) == key:
+                return pair
+        return None
+
+    @staticmethod
+    def _root_mapping(root: Node) -> Node | None:
+        """첫 번째 문서의 최상위 매핑을 찾는다."""
+        for document in root.named_children:
+            if document.type != "document":
+                continue
+            for child in document.named_children:
+                if child.type != "block_node":
+                    continue
+                for mapping in child.named_children:
+                    if mapping.type == "block_mapping":
+                        return mapping
+            return None
+        return None
+
+    @staticmethod
+    def _value_mapping(node: Node) -> Node | None:
+        """키-값 쌍의 값 또는 시퀀스 항목이 매핑이면 그 매핑을 반환한다."""
+        if node.type == "block_mapping_pair":
+            value = node.child_by_field_name("value")
+        else:
+            value = next(
+                (child for child in node.named_children if child.type == "block_node"),
+                None,
+            )
+        if value is None:
+            return None
+        return next(
+            (child for child in value.named_children if child.type == "block_mapping"),
+            None,
+        )
+
+    @staticmethod
+    def _scalar(node: Node) -> str:
+        """스칼라 노드의 텍스트를 따옴표와 앞뒤 공백을 제외하고 반환한다."""
+        text = node_text(node).strip()
+        if len(text) >= 2 and text[0] == text[-1] and text[0] in "\"'":
+            return text[1:-1]
+        return text
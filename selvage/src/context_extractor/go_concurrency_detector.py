@@ -0,0 +1,89 @@
+"""GoConcurrencyDetector: Go 코드의 goroutine/채널/뮤텍스 사용 위치를 찾는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class GoConcurrencyDetector:
+    """Go AST에서 동시성 관련 구문이 시작하는 라인들을 수집한다.
+
+    - goroutine: `go f()` 문
+    - 채널: `chan T` 타입, 송신(`ch <- v`), 수신(`<-ch`), `select` 문
+    - 뮤텍스: `sync.Mutex`/`sync.RWMutex` 참조와 `Lock`/`Unlock` 계열 메서드 호출
+
+    메서드 호출은 수신자 타입을 확인하지 않고 이름으로만 판단하므로, 구조체
+    필드로 선언된 뮤텍스(`s.mu.Lock()`)도 감지하는 대신 같은 이름의 다른
+    메서드도 뮤텍스 사용으로 볼 수 있다.
+    """
+
+    # goroutine을 시작하는 문 노드 타입
+    GOROUTINE_TYPES = frozenset({"go_statement"})
+
+    # 채널을 사용하는 노드 타입 (수신 `<-ch`는 단항 연산자로 따로 확인)
+    CHANNEL_TYPES = frozenset({"channel_type", "send_statement", "select_statement"})
+
+    # 채널 수신 단항 연산자
+    RECEIVE_OPERATOR = "<-"
+
+    # 뮤텍스 타입 이름
+    MUTEX_TYPE_NAMES = frozenset({"sync.Mutex", "sync.RWMutex"})
+
+    # 패키지로 한정된 타입/선택자 노드 타입
+    QUALIFIED_TYPES = frozenset({"qualified_type", "selector_expression"})
+
+    # 뮤텍스 잠금/해제 메서드 이름
+    LOCK_METHOD_NAMES = frozenset(
+        {"Lock", "Unlock", "RLock", "RUnlock", "TryLock", "TryRLock"}
+    )
+
+    def scan(self, root: Node) -> tuple[set[int], set[int], set[int]]:
+        """goroutine, 채널, 뮤텍스 사용이 시작하는 라인들을 수집한다.
+
+        Args:
+            root: AST 루트 노드
+
+        Returns:
+            (goroutine 라인, 채널 라인, 뮤텍스 라인) 집합 튜플 (1-based)
+        """
+        goroutine_lines: set[int] = set()
+        channel_lines: set[int] = set()
+        mutex_lines: set[int] = set()
+        stack = [root]
+        while stack:
+            node = stack.pop()
+            line = node.start_point[0] + 1
+            if node.type in self.GOROUTINE_TYPES:
+                goroutine_lines.add(line)
+            elif node.type in self.CHANNEL_TYPES or self._is_receive(node):
+                channel_lines.add(line)
+            elif self._is_mutex_reference(node) or self._is_lock_call(node):
+                mutex_lines.add(line)
+            stack.extend(node.named_children)
+        return goroutine_lines, channel_lines, mutex_lines
+
+    def _is_receive(self, node: Node) -> bool:
+        """채널 수신 단항 연산(`<-ch`)인지 확인한다."""
+        if node.type != "unary_expression":
+            return False
+        operator = node.child_by_field_name("operator")
+        return operator is not None and operator.type == self.RECEIVE_OPERATOR
+
+    def _is_mutex_reference(self, node: Node) -> bool:
+        """`sync.Mutex`/`sync.RWMutex` 참조인지 확인한다."""
+        return (
+            node.type in self.QUALIFIED_TYPES
+            and node_text(node) in self.MUTEX_TYPE_NAMES
+        )
+
+    def _is_lock_call(self, node: Node) -> bool:
+        """`x.Lock()` 같은 잠금/해제 메서드 호출인지 확인한다."""
+        if node.type != "call_expression":
+            return False
+        function = node.child_by_field_name("function")
+        if function is None or function.type != "selector_expression":
+            return False
+        field = function.child_by_field_name("field")
+        return field is not None and node_text(field) in self.LOCK_METHOD_NAMES
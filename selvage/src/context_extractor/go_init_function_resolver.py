@@ -0,0 +1,55 @@
+"""GoInitFunctionResolver: Go 패키지 초기화 함수(`func init()`)를 식별하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+
+class GoInitFunctionResolver:
+    """Go AST에서 `init` 함수를 찾아 파일 안의 순서로 구분한다.
+
+    `init` 함수는 패키지 초기화 시점에 자동으로 실행되고 한 파일에 여러 개를
+    선언할 수 있으므로, 같은 이름의 블록들을 구분하도록 파일 안에서의 선언
+    순서(1부터)를 붙인 이름(`init#1`, `init#2`)을 사용한다. 순서는 파일의
+    선언 위치로만 정해지므로 변경 범위와 관계없이 같은 함수는 같은 번호를
+    갖는다.
+    """
+
+    # init 함수 블록의 block_type
+    BLOCK_TYPE = "init_function"
+
+    # 패키지 초기화 함수 이름
+    INIT_NAME = "init"
+
+    # receiver가 없는 함수 선언 노드 타입
+    FUNCTION_TYPE = "function_declaration"
+
+    def index(self, node: Node) -> int | None:
+        """init 함수 선언이면 파일 안에서의 순서(1부터)를 반환한다.
+
+        Args:
+            node: 함수 선언 노드
+
+        Returns:
+            같은 파일의 init 함수들 중 몇 번째인지 (init 함수가 아니면 None)
+        """
+        if not self._is_init(node) or node.parent is None:
+            return None
+        index = 0
+        for sibling in node.parent.named_children:
+            if self._is_init(sibling):
+                index += 1
+            if sibling == node:
+                return index
+        return None
+
+    def name(self, index: int) -> str:
+        """순서를 붙인 init 함수의 표시 이름(`init#1`)을 반환한다."""
+        return f"{self.INIT_NAME}#{index}"
+
+    def _is_init(self, node: Node) -> bool:
+        """노드가 `init` 이름의 최상위 함수 선언인지 확인한다."""
+        if node.type != self.FUNCTION_TYPE:
+            return False
+        name_node = node.child_by_field_name("name")
+        return name_node is not None and name_node.text == self.INIT_NAME.encode()
@@ -0,0 +1,92 @@
+"""GoReceiverResolver: Go 메서드가 속한 receiver 타입을 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class GoReceiverResolver(ScopeResolver):
+    """Go AST에서 메서드 선언의 receiver 타입 이름을 계산한다.
+
+    Go 메서드는 타입 선언 밖의 최상위 선언이므로 AST 조상으로는 소속 타입을
+    알 수 없다. `func (calc *SampleCalculator) Add()`의 receiver 타입에서
+    포인터(`*`)와 타입 인자(`[T]`)를 벗겨낸 `SampleCalculator`를 메서드의
+    소속 타입으로 본다.
+    """
+
+    # receiver를 가진 메서드 선언 노드 타입
+    METHOD_TYPES = frozenset({"method_declaration"})
+
+    # receiver 타입을 감싸는 래퍼 노드 타입 (포인터, 괄호)
+    WRAPPER_TYPES = frozenset({"pointer_type", "parenthesized_type"})
+
+    # 타입 선언 안의 개별 타입 명세 노드 타입
+    TYPE_SPEC_TYPES = frozenset({"type_spec", "type_alias"})
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """메서드 선언이면 receiver 타입 이름을 담은 경로를 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 사용하지 않음 (receiver 타입 하나만 반환)
+
+        Returns:
+            메서드면 `("SampleCalculator",)`, 아니면 빈 튜플
+        """
+        receiver_type = self.receiver_type(node)
+        return (receiver_type,) if receiver_type else ()
+
+    def receiver_type(self, node: Node) -> str | None:
+        """메서드 선언의 receiver 타입 이름을 반환한다.
+
+        Args:
+            node: 메서드 선언 노드
+
+        Returns:
+            포인터와 타입 인자를 제외한 타입 이름 (메서드가 아니면 None)
+        """
+        if node.type not in self.METHOD_TYPES:
+            return None
+        receiver = node.child_by_field_name("receiver")
+        if receiver is None:
+            return None
+        for parameter in receiver.named_children:
+            type_node = parameter.child_by_field_name("type")
+            if type_node is not None:
+                return self._base_type_name(type_node)
+        return None
+
+    def find_type_spec(self, root: Node, type_name: str) -> Node | None:
+        """파일 최상위에서 이름이 같은 타입 명세(`SampleCalculator struct`)를 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            type_name: receiver 타입 이름
+
+        Returns:
+            타입 명세 노드 (같은 파일에 선언이 없으면 None)
+        """
+        for declaration in root.named_children:
+            if declaration.type != "type_declaration":
+                continue
+            for spec in declaration.named_children:
+                if spec.type not in self.TYPE_SPEC_TYPES:
+                    continue
+                name = spec.child_by_field_name("name")
+                if name is not None and name.text == type_name.encode("utf-8"):
+                    return spec
+        return None
+
+    def _base_type_name(self, type_node: Node) -> str | None:
+        """포인터/괄호/제네릭 래퍼를 벗겨낸 타입 이름을 반환한다."""
+        current: Node | None = type_node
+        while current is not None and current.type in self.WRAPPER_TYPES:
+            current = current.named_children[0] if current.named_children else None
+        if current is not None and current.type == "generic_type":
+            current = current.child_by_field_name("type")
+        if current is None or current.text is None:
+            return None
+        return node_text(current)
@@ -0,0 +1,57 @@
+"""GoScopeResolver: Go 메서드 소속 타입, init 함수, 구조체 필드, 테이블 테스트를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .go_concurrency_detector import GoConcurrencyDetector
+from .go_init_function_resolver import GoInitFunctionResolver
+from .go_receiver_resolver import GoReceiverResolver
+from .go_struct_field_resolver import GoStructFieldResolver
+from .go_table_case_resolver import GoTableCaseResolver
+from .struct_field import StructField
+from .table_test_case import TableTestCase
+
+
+class GoScopeResolver(GoReceiverResolver):
+    """Go 전용 규칙을 ContextExtractor에 제공하는 resolver.
+
+    메서드의 scope_path와 receiver 타입 명세는 GoReceiverResolver로 계산하고,
+    `init` 함수 구분(GoInitFunctionResolver), 변경된 구조체 필드
+    (GoStructFieldResolver), 테이블 테스트 케이스(GoTableCaseResolver),
+    동시성 구문 위치(GoConcurrencyDetector)는 각 helper에 위임한다.
+    """
+
+    def __init__(self) -> None:
+        """Go 규칙 helper들을 생성한다."""
+        self._init_resolver = GoInitFunctionResolver()
+        self._struct_field_resolver = GoStructFieldResolver()
+        self._table_case_resolver = GoTableCaseResolver()
+        self._concurrency_detector = GoConcurrencyDetector()
+
+    def block_type(self, node: Node) -> str | None:
+        """init 함수면 전용 block_type을 반환한다."""
+        if self._init_resolver.index(node) is None:
+            return None
+        return self._init_resolver.BLOCK_TYPE
+
+    def block_name(self, node: Node, name: str | None) -> str | None:
+        """init 함수면 선언 순서를 붙인 이름(`init#1`)을 반환한다."""
+        index = self._init_resolver.index(node)
+        if index is None:
+            return name
+        return self._init_resolver.name(index)
+
+    def struct_fields(self, node: Node) -> tuple[StructField, ...]:
+        """노드를 감싸는 가장 가까운 구조체 필드 선언의 필드들을 반환한다."""
+        return self._struct_field_resolver.fields_at(node)
+
+    def table_case(self, node: Node) -> tuple[Node, TableTestCase] | None:
+        """노드를 감싸는 테이블 테스트 케이스 항목 노드와 케이스를 반환한다."""
+        return self._table_case_resolver.case_at(node)
+
+    def concurrency_lines(
+        self, root: Node
+    ) -> tuple[set[int], set[int], set[int]] | None:
+        """goroutine, 채널, 뮤텍스 사용이 시작하는 라인들을 반환한다."""
+        return self._concurrency_detector.scan(root)
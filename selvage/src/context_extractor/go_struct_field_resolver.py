@@ -0,0 +1,70 @@
+"""GoStructFieldResolver: 변경 라인이 속한 Go 구조체 필드를 찾는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .line_range import LineRange
+from .struct_field import StructField
+from .text_lines import node_text
+
+
+class GoStructFieldResolver:
+    """Go AST에서 노드를 감싸는 구조체 필드 선언을 찾아 StructField로 변환한다.
+
+    `type X struct { ... }` 안의 변경은 구조체 전체가 블록으로 반환되므로,
+    어떤 필드가 바뀌었는지를 필드 이름, 타입, 태그로 따로 알려준다.
+    `A, B int`처럼 이름이 여러 개인 선언은 이름마다 필드를 하나씩 만든다.
+    중첩된 익명 구조체 안의 필드는 가장 안쪽 필드 선언을 반환한다.
+    """
+
+    # 구조체 필드 선언 노드 타입과 이를 담는 노드 타입
+    FIELD_TYPE = "field_declaration"
+    FIELD_LIST_TYPE = "field_declaration_list"
+    STRUCT_TYPE = "struct_type"
+
+    def fields_at(self, node: Node) -> tuple[StructField, ...]:
+        """노드를 감싸는 가장 가까운 구조체 필드 선언의 필드들을 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            필드 선언의 이름별 StructField 튜플 (구조체 필드 밖이면 빈 튜플)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type == self.FIELD_TYPE and self._is_struct_field(current):
+                return self._to_fields(current)
+            current = current.parent
+        return ()
+
+    def _is_struct_field(self, declaration: Node) -> bool:
+        """필드 선언이 구조체 본문에 속하는지 확인한다."""
+        field_list = declaration.parent
+        return (
+            field_list is not None
+            and field_list.type == self.FIELD_LIST_TYPE
+            and field_list.parent is not None
+            and field_list.parent.type == self.STRUCT_TYPE
+        )
+
+    def _to_fields(self, declaration: Node) -> tuple[StructField, ...]:
+        """필드 선언 노드를 이름별 StructField들로 변환한다."""
+        type_text = node_text(declaration.child_by_field_name("type"))
+        tag_node = declaration.child_by_field_name("tag")
+        tag = node_text(tag_node) if tag_node is not None else None
+        line_range = LineRange(
+            declaration.start_point[0] + 1, declaration.end_point[0] + 1
+        )
+        names = [
+            node_text(name)
+            for name in declaration.children_by_field_name("name")
+        ]
+        # 임베딩 필드는 Go 규칙대로 패키지와 타입 인자를 뺀 타입 이름이 필드 이름
+        if not names:
+            names = [type_text.lstrip("*").split("[", 1)[0].rsplit(".", 1)[-1]]
+        return tuple(
+            StructField(name=name, type=type_text, line_range=line_range, tag=tag)
+            for name in names
+        )
@@ -0,0 +1,126 @@
+"""GoTableCaseResolver: 변경 라인이 속한 Go 테이블 기반 테스트 케이스를 찾는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .line_range import LineRange
+from .table_test_case import TableTestCase
+from .text_lines import node_text
+
+
+class GoTableCaseResolver:
+    """Go AST에서 노드를 감싸는 테이블 기반 테스트 케이스 항목을 찾는다.
+
+    `tests := []struct{...}{...}`, `var cases = map[string]struct{...}{...}`,
+    `for _, tt := range []struct{...}{...}`처럼 원소 타입이 익명 구조체인
+    slice/map 복합 리터럴을 테스트 테이블로 보는 휴리스틱이다. 테이블 안의
+    변경은 감싸는 테스트 함수 전체가 블록으로 반환되므로, 어떤 케이스 항목이
+    바뀌었는지를 케이스 이름과 라인 범위로 따로 알려준다.
+    """
+
+    # 테이블 리터럴과 케이스 항목 관련 노드 타입
+    COMPOSITE_LITERAL_TYPE = "composite_literal"
+    LITERAL_VALUE_TYPE = "literal_value"
+    TABLE_CONTAINER_TYPES = frozenset({"slice_type", "map_type", "array_type"})
+    STRUCT_TYPE = "struct_type"
+
+    # 케이스 이름으로 사용하는 구조체 필드 이름
+    NAME_FIELDS = frozenset({"name", "desc", "description", "title"})
+
+    # 케이스 이름으로 사용할 수 있는 문자열 리터럴 노드 타입
+    STRING_TYPES = frozenset({"interpreted_string_literal", "raw_string_literal"})
+
+    def case_at(self, node: Node) -> tuple[Node, TableTestCase] | None:
+        """노드를 감싸는 가장 가까운 테스트 테이블의 케이스 항목을 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            (케이스 항목 노드, TableTestCase) 튜플 (테이블 케이스 밖이면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            parent = current.parent
+            if (
+                parent is not None
+                and parent.type == self.LITERAL_VALUE_TYPE
+                and current.is_named
+                and current.type != "comment"
+                and self._is_table(parent.parent)
+            ):
+                return current, self._to_case(current, parent)
+            current = parent
+        return None
+
+    def _is_table(self, literal: Node | None) -> bool:
+        """복합 리터럴이 익명 구조체 원소의 slice/map 테이블인지 확인한다."""
+        if literal is None or literal.type != self.COMPOSITE_LITERAL_TYPE:
+            return False
+        table_type = literal.child_by_field_name("type")
+        if table_type is None or table_type.type not in self.TABLE_CONTAINER_TYPES:
+            return False
+        element_type = table_type.child_by_field_name("element")
+        if element_type is None:
+            element_type = table_type.child_by_field_name("value")
+        return element_type is not None and element_type.type == self.STRUCT_TYPE
+
+    def _to_case(self, entry: Node, body: Node) -> TableTestCase:
+        """케이스 항목 노드를 TableTestCase로 변환한다."""
+        entries = [child for child in body.named_children if child.type != "comment"]
+        return TableTestCase(
+            index=entries.index(entry),
+            line_range=LineRange(entry.start_point[0] + 1, entry.end_point[0] + 1),
+            name=self._case_name(entry),
+            table_name=self._table_name(body.parent),
+        )
+
+    def _case_name(self, entry: Node) -> str | None:
+        """케이스의 이름 필드, 첫 위치 인자 또는 map 키의 문자열 값을 반환한다."""
+        if entry.type == "keyed_element":
+            # map 테이블: `"add": {...}`의 키가 케이스 이름
+            return self._string_value(entry.named_children[0])
+        value = self._unwrap(entry)
+        if value.type != self.LITERAL_VALUE_TYPE:
+            return None
+        fields = [child for child in value.named_children if child.type != "comment"]
+        for field in fields:
+            if field.type != "keyed_element" or len(field.named_children) < 2:
+                continue
+            key, field_value = field.named_children[0], field.named_children[-1]
+            if node_text(self._unwrap(key)) in self.NAME_FIELDS:
+                return self._string_value(field_value)
+        if fields and fields[0].type != "keyed_element":
+            return self._string_value(fields[0])
+        return None
+
+    def _table_name(self, literal: Node | None) -> str | None:
+        """테이블 리터럴을 담은 변수 이름을 반환한다 (`tests := ...`의 tests)."""
+        current = literal.parent if literal is not None else None
+        while current is not None and current.type == "expression_list":
+            current = current.parent
+        if current is None:
+            return None
+        if current.type == "short_var_declaration":
+            target = current.child_by_field_name("left")
+        elif current.type == "var_spec":
+            target = current.child_by_field_name("name")
+        else:
+            return None
+        if target is not None and target.type == "expression_list":
+            target = target.named_children[0] if target.named_children else None
+        return node_text(target) or None
+
+    def _string_value(self, node: Node) -> str | None:
+        """문자열 리터럴 노드의 따옴표를 뺀 값을 반환한다 (문자열이 아니면 None)."""
+        node = self._unwrap(node)
+        if node.type not in self.STRING_TYPES:
+            return None
+        return node_text(node)[1:-1]
+
+    def _unwrap(self, node: Node) -> Node:
+        """`literal_element`로 감싸진 값 노드를 꺼낸다."""
+        if node.type == "literal_element" and node.named_children:
+            return node.named_children[0]
+        return node
@@ -0,0 +1,212 @@
+"""HaxeScopeResolver: Haxe 타입 선언, 함수와 익명 함수 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class HaxeScopeResolver(ScopeResolver):
+    """Haxe AST에서 변경을 감싸는 함수, 익명 함수, 타입 선언을 찾는다.
+
+    변경 라인을 감싸는 가장 가까운 `function` 선언(메서드 포함) 전체를
+    반환하고, 클래스/인터페이스/enum/abstract 안의 멤버는 감싸는 타입의 선언
+    라인(`class Inventory extends Base {`)을 컨테이너 헤더로 함께 포함한다.
+    블록 본문을 가진 익명 함수(`function(item) {...}`, `item -> {...}`)는
+    내부 스코프로 보고 익명 함수 전체를 반환하며, 감싸는 함수의 시그니처와
+    타입 선언 라인을 컨테이너 헤더로 포함한다. 멤버 밖의 타입 선언과
+    `typedef`는 선언 전체를 반환한다. 조건부 컴파일(`#if ... #end`)과
+    매크로 메타데이터 노드는 건너뛰고 바깥 선언을 기준으로 찾는다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 컨테이너의 바깥 컨테이너 헤더까지 모두 포함
+    NESTED_CONTAINERS = True
+
+    # 이름 있는 함수 선언 노드 타입 (메서드, 모듈 수준 함수)
+    FUNCTION_TYPES = frozenset({"function_declaration"})
+
+    # 익명 함수 노드 타입 (이름 없는 function_declaration도 익명 함수로 봄)
+    ANONYMOUS_FUNCTION_TYPES = frozenset({"function_expression", "arrow_function"})
+
+    # 멤버를 가질 수 있는 타입 선언 노드 타입
+    TYPE_TYPES = frozenset(
+        {
+            "class_declaration",
+            "interface_declaration",
+            "enum_declaration",
+            "abstract_declaration",
+        }
+    )
+
+    # 선언 전체를 블록으로 반환하는 typedef 노드 타입
+    TYPEDEF_TYPES = frozenset({"typedef_declaration"})
+
+    # 익명 함수의 블록 본문 노드 타입
+    BODY_TYPE = "block"
+
+    # 이름으로 쓰는 노드 타입
+    NAME_TYPES = frozenset({"identifier", "type_name"})
+
+    # 익명 함수의 표시용 이름
+    ANONYMOUS_NAME = "<anonymous>"
+
+    # 컨테이너(함수/타입) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = (
+        FUNCTION_TYPES
+        | ANONYMOUS_FUNCTION_TYPES
+        | TYPE_TYPES
+        | TYPEDEF_TYPES
+        | frozenset({"variable_declaration"})
+    )
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 익명 함수, 함수 또는 타입 선언을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 블록 본문 익명 함수, 함수 선언, 타입 선언 노드,
+            그렇지 않으면 타입 본문의 멤버나 최상위 문장 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if self.is_inner_scope(current):
+                return current
+            if current.type in self.FUNCTION_TYPES | self.TYPE_TYPES | (
+                self.TYPEDEF_TYPES
+            ):
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None:
+                # 최상위 문장 (import, 모듈 수준 변수 등)
+                return current
+            if parent.parent.type in self.TYPE_TYPES:
+                # 타입 본문 바로 아래 멤버 (필드, enum 생성자 등)
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """멤버를 감싸는 가장 가까운 함수 또는 타입 선언을 찾는다.
+
+        익명 함수는 감싸는 함수 선언을, 함수와 필드는 감싸는 타입 선언을
+        컨테이너로 사용한다. 컨테이너가 다시 멤버이면 바깥 컨테이너도 같은
+        방법으로 찾을 수 있다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            함수 또는 타입 선언 노드 (없으면 None)
+        """
+        current = node.parent
+        while current is not None:
+            if current.type in self.TYPE_TYPES:
+                return current
+            if self.is_inner_scope(node) and self._is_named_function(current):
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """메타데이터를 뺀 타입 선언 라인 또는 함수 시그니처 라인을 반환한다."""
+        lines = node_text(container).split("\n")
+        name_node = self._name_node(container)
+        offset = 0
+        if name_node is not None:
+            offset = name_node.start_point[0] - container.start_point[0]
+        return lines[min(offset, len(lines) - 1)].strip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 타입 또는 함수 이름을 반환한다."""
+        return self.name(container)
+
+    def is_inner_scope(self, node: Node) -> bool:
+        """블록 본문을 가진 익명 함수인지 확인한다."""
+        if node.type in self.FUNCTION_TYPES:
+            if self._is_named_function(node):
+                return False
+        elif node.type not in self.ANONYMOUS_FUNCTION_TYPES:
+            return False
+        body = node.child_by_field_name("body")
+        if body is None:
+            body = next(
+                (
+                    child
+                    for child in node.named_children
+                    if child.type == self.BODY_TYPE
+                ),
+                None,
+            )
+        return body is not None and body.type == self.BODY_TYPE
+
+    def name(self, node: Node) -> str | None:
+        """함수, 타입 선언, typedef의 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            선언된 이름, 익명 함수는 `<anonymous>` (그 밖의 노드는 None)
+        """
+        if self.is_inner_scope(node):
+            return self.ANONYMOUS_NAME
+        if node.type not in self.FUNCTION_TYPES | self.TYPE_TYPES | (
+            self.TYPEDEF_TYPES
+        ):
+            return None
+        name_node = self._name_node(node)
+        if name_node is None:
+            return None
+        return node_text(name_node) or None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 타입, 함수, 익명 함수 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (예: ("Inventory", "restock"))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            segment = self.name(current)
+            if segment:
+                segments.append(segment)
+            current = current.parent
+        return tuple(reversed(segments))
+
+    def _is_named_function(self, node: Node) -> bool:
+        """이름 있는 함수 선언인지 확인한다."""
+        return node.type in self.FUNCTION_TYPES and self._name_node(node) is not None
+
+    def _name_node(self, node: Node) -> Node | None:
+        """선언의 이름 노드를 반환한다 (익명 함수면 None)."""
+        name_node = node.child_by_field_name("name")
+        if name_node is not None:
+            return name_node
+        if node.type in self.FUNCTION_TYPES:
+            # `function(item) {...}`처럼 이름 없이 인자 목록이 먼저 오면 익명
+            for child in node.children:
+                if child.type in self.NAME_TYPES:
+                    return child
+                if child.type in ("(", self.BODY_TYPE):
+                    return None
+            return None
+        return next(
+            (child for child in node.named_children if child.type in self.NAME_TYPES),
+            None,
+        )
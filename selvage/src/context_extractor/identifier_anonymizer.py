@@ -0,0 +1,288 @@
+"""IdentifierAnonymizer: 코드 구조를 유지한 채 사용자 식별자를 익명화하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Generator
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class IdentifierAnonymizer:
+    """블록 AST의 사용자 식별자를 `v1`, `fn2` 같은 불투명한 토큰으로 바꾼다.
+
+    같은 이름은 블록 안에서 항상 같은 토큰으로 바뀌므로 참조 관계가 유지된다.
+    함수 선언/호출 위치에 등장한 이름은 `fn`, 나머지는 `v` 접두사를 쓰며 번호는
+    처음 등장한 순서로 매긴다. 키워드, 타입(타입 노드와 대문자로 시작하는
+    타입 관례 이름), 내장 이름, `obj.member`의 멤버 이름, 주석과 문자열
+    리터럴은 바꾸지 않는다.
+    preserve_public_names가 켜지면 공개 API로 선언된 함수 이름(Python `_`
+    접두사 없음, Java public/protected, JS/TS export)과 Go의 대문자로 시작하는
+    공개 이름도 유지한다.
+    """
+
+    # 언어별 익명화 대상 식별자 노드 타입
+    LANGUAGE_IDENTIFIER_TYPES = {
+        "python": frozenset({"identifier"}),
+        "javascript": frozenset({"identifier"}),
+        "typescript": frozenset({"identifier"}),
+        "java": frozenset({"identifier"}),
+        "go": frozenset({"identifier"}),
+    }
+
+    # 언어별 함수 선언 노드 타입 (name 필드가 함수 이름)
+    LANGUAGE_FUNCTION_DECLARATION_TYPES = {
+        "python": frozenset({"function_definition"}),
+        "javascript": frozenset(
+            {"function_declaration", "generator_function_declaration"}
+        ),
+        "typescript": frozenset(
+            {"function_declaration", "generator_function_declaration"}
+        ),
+        "java": frozenset({"method_declaration"}),
+        "go": frozenset({"function_declaration"}),
+    }
+
+    # 언어별 함수 호출 노드 타입과 호출 대상 필드 이름
+    LANGUAGE_CALL_FIELDS = {
+        "python": {"call": "function"},
+        "javascript": {"call_expression": "function"},
+        "typescript": {"call_expression": "function"},
+        "java": {"method_invocation": "name"},
+        "go": {"call_expression": "function"},
+    }
+
+    # 언어별 `obj.member` 접근 노드 타입과 멤버 이름 필드 이름
+    # (외부 API의 멤버일 수 있으므로 object 필드가 있으면 유지)
+    LANGUAGE_MEMBER_FIELDS = {
+        "python": {"attribute": "attribute"},
+        "java": {"field_access": "field", "method_invocation": "name"},
+    }
+
+    # 언어별 하위 식별자를 타입 이름으로 보고 유지할 노드 타입
+    LANGUAGE_TYPE_CONTEXT_TYPES = {
+        "python": frozenset({"type"}),
+    }
+
+    # 대문자로 시작하는 이름을 타입(클래스) 이름 관례로 보고 유지할 언어
+    CAPITALIZED_TYPE_LANGUAGES = frozenset(
+        {"python", "javascript", "typescript", "java"}
+    )
+
+    # 언어별로 유지할 내장 이름
+    LANGUAGE_BUILTIN_NAMES = {
+        "python": frozenset(
+            {
+                "self",
+                "cls",
+                "super",
+                "print",
+                "len",
+                "range",
+                "enumerate",
+                "zip",
+                "map",
+                "filter",
+                "sorted",
+                "reversed",
+                "min",
+                "max",
+                "sum",
+                "any",
+                "all",
+                "abs",
+                "isinstance",
+                "issubclass",
+                "getattr",
+                "setattr",
+                "hasattr",
+                "open",
+                "iter",
+                "next",
+                "str",
+                "int",
+                "float",
+                "bool",
+                "bytes",
+                "list",
+                "dict",
+                "set",
+                "tuple",
+                "object",
+                "type",
+                "property",
+                "staticmethod",
+                "classmethod",
+            }
+        ),
+        "javascript": frozenset(
+            {"console", "require", "module", "exports", "undefined", "window"}
+        ),
+        "typescript": frozenset(
+            {"console", "require", "module", "exports", "undefined", "window"}
+        ),
+        "java": frozenset(),
+        "go": frozenset(
+            {
+                "append",
+                "cap",
+                "close",
+                "copy",
+                "delete",
+                "len",
+                "make",
+                "new",
+                "panic",
+                "print",
+                "println",
+                "recover",
+            }
+        ),
+    }
+
+    # 함수 이름 토큰 접두사와 그 외 식별자 토큰 접두사
+    FUNCTION_PREFIX = "fn"
+    VARIABLE_PREFIX = "v"
+
+    def __init__(self, language: str, preserve_public_names: bool = False) -> None:
+        """익명화기 초기화.
+
+        Args:
+            language: 대상 언어 이름
+            preserve_public_names: 공개 API 함수 이름을 유지할지 여부
+        """
+        self._language = language
+        self._preserve_public_names = preserve_public_names
+        self._identifier_types = self.LANGUAGE_IDENTIFIER_TYPES.get(
+            language, frozenset()
+        )
+        self._declaration_types = self.LANGUAGE_FUNCTION_DECLARATION_TYPES.get(
+            language, frozenset()
+        )
+        self._call_fields = self.LANGUAGE_CALL_FIELDS.get(language, {})
+        self._member_fields = self.LANGUAGE_MEMBER_FIELDS.get(language, {})
+        self._type_context_types = self.LANGUAGE_TYPE_CONTEXT_TYPES.get(
+            language, frozenset()
+        )
+        self._builtin_names = self.LANGUAGE_BUILTIN_NAMES.get(language, frozenset())
+
+    def is_supported(self) -> bool:
+        """해당 언어에서 식별자 익명화를 지원하는지 반환한다."""
+        return bool(self._identifier_types)
+
+    def anonymize(self, root: Node, source: bytes) -> tuple[str, dict[str, str]]:
+        """블록 AST의 사용자 식별자를 토큰으로 바꾼 텍스트와 매핑을 반환한다.
+
+        Args:
+            root: 블록 텍스트를 파싱한 AST 루트 노드
+            source: 블록 텍스트의 UTF-8 바이트
+
+        Returns:
+            (익명화된 텍스트, 원래 이름 → 토큰 매핑) 튜플
+        """
+        occurrences: list[Node] = []
+        function_names: set[str] = set()
+        preserved_names: set[str] = set()
+        for node in self._iter_identifiers(root):
+            name = node_text(node)
+            if self._is_preserved(node, name):
+                continue
+            if self._is_function_declaration_name(node):
+                function_names.add(name)
+                if self._preserve_public_names and self._is_public_declaration(
+                    node.parent, name
+                ):
+                    preserved_names.add(name)
+            elif self._is_call_target(node):
+                function_names.add(name)
+            occurrences.append(node)
+
+        mapping: dict[str, str] = {}
+        for node in occurrences:
+            name = node_text(node)
+            if name in preserved_names or name in mapping:
+                continue
+            prefix = (
+                self.FUNCTION_PREFIX if name in function_names else self.VARIABLE_PREFIX
+            )
+            mapping[name] = f"{prefix}{len(mapping) + 1}"
+
+        result = bytearray(source)
+        for node in sorted(occurrences, key=lambda n: n.start_byte, reverse=True):
+            token = mapping.get(node_text(node))
+            if token is not None:
+                result[node.start_byte : node.end_byte] = token.encode("utf-8")
+        return result.decode("utf-8", errors="replace"), mapping
+
+    def _iter_identifiers(self, node: Node) -> Generator[Node, None, None]:
+        """타입 위치 밖의 식별자 노드들을 DFS 순서로 반환한다."""
+        if node.type in self._type_context_types:
+            return
+        if node.type in self._identifier_types:
+            yield node
+            return
+        for child in node.children:
+            yield from self._iter_identifiers(child)
+
+    def _is_preserved(self, node: Node, name: str) -> bool:
+        """내장 이름이나 타입 관례 이름처럼 바꾸지 않을 식별자인지 확인한다."""
+        if node.is_missing or name in self._builtin_names or self._is_member(node):
+            return True
+        if self._language == "python" and name.startswith("__") and name.endswith("__"):
+            # `__init__` 등 언어가 정의한 특수 메서드/속성 이름
+            return True
+        if self._language in self.CAPITALIZED_TYPE_LANGUAGES and name[:1].isupper():
+            return True
+        if (
+            self._preserve_public_names
+            and self._language == "go"
+            and name[:1].isupper()
+        ):
+            # Go는 대문자로 시작하는 이름이 패키지 밖으로 공개된다
+            return True
+        return False
+
+    def _is_member(self, node: Node) -> bool:
+        """`obj.member`처럼 객체를 통해 접근하는 멤버 이름인지 확인한다."""
+        parent = node.parent
+        if parent is None or parent.type not in self._member_fields:
+            return False
+        return (
+            parent.child_by_field_name(self._member_fields[parent.type]) == node
+            and parent.child_by_field_name("object") is not None
+        )
+
+    def _is_function_declaration_name(self, node: Node) -> bool:
+        """함수 선언의 이름 위치에 있는 식별자인지 확인한다."""
+        parent = node.parent
+        return (
+            parent is not None
+            and parent.type in self._declaration_types
+            and parent.child_by_field_name("name") == node
+        )
+
+    def _is_call_target(self, node: Node) -> bool:
+        """함수 호출 대상 위치에 있는 식별자인지 확인한다."""
+        parent = node.parent
+        if parent is None or parent.type not in self._call_fields:
+            return False
+        return parent.child_by_field_name(self._call_fields[parent.type]) == node
+
+    def _is_public_declaration(self, declaration: Node, name: str) -> bool:
+        """언어 관례상 공개 API로 선언된 함수인지 확인한다."""
+        if self._language == "python":
+            return not name.startswith("_")
+        if self._language == "java":
+            return any(
+                child.type == "modifiers"
+                and any(
+                    modifier.type in ("public", "protected")
+                    for modifier in child.children
+                )
+                for child in declaration.children
+            )
+        if self._language in ("javascript", "typescript"):
+            parent = declaration.parent
+            return parent is not None and parent.type == "export_statement"
+        return False
@@ -0,0 +1,67 @@
+"""IndentStyle: 파일의 들여쓰기 단위(탭/공백)와 표시용 정규화."""
+
+from __future__ import annotations
+
+from enum import Enum
+
+
+class IndentStyle(str, Enum):
+    """파일이 선행 들여쓰기에 주로 사용하는 문자."""
+
+    SPACES = "spaces"
+    TABS = "tabs"
+
+    @classmethod
+    def detect(cls, text: str) -> IndentStyle | None:
+        """들여쓰기된 라인 수를 비교해 파일의 들여쓰기 단위를 감지한다.
+
+        한 칸 공백으로 시작하는 라인(` * ` 블록 주석 이어짐 등)은 세지 않으며,
+        탭과 공백 라인 수가 같으면 공백으로 본다.
+
+        Args:
+            text: 파일 또는 블록 내용
+
+        Returns:
+            감지한 들여쓰기 단위 (들여쓰기된 라인이 없으면 None)
+        """
+        tab_lines = 0
+        space_lines = 0
+        for line in text.splitlines():
+            if not line.strip():
+                continue
+            if line.startswith("\t"):
+                tab_lines += 1
+            elif line.startswith("  "):
+                space_lines += 1
+        if not tab_lines and not space_lines:
+            return None
+        return cls.TABS if tab_lines > space_lines else cls.SPACES
+
+    def normalize(self, text: str, tab_width: int) -> str:
+        """각 라인의 선행 들여쓰기를 이 단위로 통일한 표시용 텍스트를 반환한다.
+
+        탭은 tab_width 칸 탭 정지 위치까지의 너비로 계산하며, 라인 중간의
+        탭/공백과 라인 수는 그대로 둔다.
+
+        Args:
+            text: 원본 텍스트
+            tab_width: 탭 한 칸의 너비 (공백 수)
+
+        Returns:
+            선행 들여쓰기가 정규화된 텍스트
+        """
+        normalized_lines: list[str] = []
+        for line in text.split("\n"):
+            content = line.lstrip(" \t")
+            column = 0
+            for char in line[: len(line) - len(content)]:
+                if char == "\t":
+                    column += tab_width - column % tab_width
+                else:
+                    column += 1
+            if self is IndentStyle.TABS:
+                indent = "\t" * (column // tab_width) + " " * (column % tab_width)
+            else:
+                indent = " " * column
+            normalized_lines.append(indent + content)
+        return "\n".join(normalized_lines)
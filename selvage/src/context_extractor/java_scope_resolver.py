@@ -0,0 +1,165 @@
+"""JavaScopeResolver: Java 람다/익명 클래스 스코프와 중첩 선언 경로를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class JavaScopeResolver(ScopeResolver):
+    """Java AST에서 람다·익명 클래스 내부 스코프와 조상 선언 경로를 계산한다.
+
+    블록 본문(`() -> { ... }`)을 가진 람다와 익명 클래스(`new Runnable() {...}`)는
+    내부 스코프로 취급해 변경을 감싸는 단위로 반환하며, 이들을 감싸는 메서드의
+    시그니처는 컨테이너 헤더로 함께 포함한다. 중첩 클래스는 `Outer.Inner`처럼
+    바깥 타입 이름으로 한정한 이름을 사용한다.
+    """
+
+    # 이름을 한정할 타입 선언 노드 타입
+    TYPE_DECLARATION_TYPES = frozenset(
+        {
+            "class_declaration",
+            "interface_declaration",
+            "enum_declaration",
+            "record_declaration",
+            "annotation_type_declaration",
+        }
+    )
+
+    # 내부 스코프를 감싸는 메서드 노드 타입
+    CONTAINER_TYPES = frozenset({"method_declaration", "constructor_declaration"})
+
+    # 컨테이너 헤더를 함께 포함할 멤버(내부 스코프) 노드 타입
+    MEMBER_TYPES = frozenset({"lambda_expression", "object_creation_expression"})
+
+    def name(self, node: Node) -> str | None:
+        """Java 노드의 표시용 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            타입 선언은 `Outer.Inner`, 람다는 `Outer.method.<lambda>`, 익명
+            클래스는 `Outer.method.<anonymous Runnable>` 형태의 한정 이름
+            (해당하지 않으면 None)
+        """
+        if node.type in self.TYPE_DECLARATION_TYPES or self.is_inner_scope(node):
+            return ".".join((*self.scope_path(node), self._segment(node)))
+        return None
+
+    def is_inner_scope(self, node: Node) -> bool:
+        """블록 본문을 가진 람다 또는 익명 클래스 생성식인지 확인한다."""
+        if node.type == "lambda_expression":
+            body = node.child_by_field_name("body")
+            return body is not None and body.type == "block"
+        if node.type == "object_creation_expression":
+            return self._anonymous_class_body(node) is not None
+        return False
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 내부 스코프를 찾는다.
+
+        내부 스코프에 도달하기 전에 이름 있는 타입 선언을 만나면 그 타입이
+        변경 단위이므로 None을 반환한다. 익명 클래스 안의 메서드는 건너뛰어
+        익명 클래스 전체를 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            람다 또는 익명 클래스 생성식 노드 (없으면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if self.is_inner_scope(current):
+                return current
+            if current.type in self.TYPE_DECLARATION_TYPES:
+                return None
+            current = current.parent
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 조상 선언(타입, 메서드, 필드, 내부 스코프) 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (예: ("Outer", "process", "<lambda>"))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            if (
+                current.type in self.TYPE_DECLARATION_TYPES
+                or current.type in self.CONTAINER_TYPES
+                or current.type == "field_declaration"
+                or self.is_inner_scope(current)
+            ):
+                segment = self._segment(current)
+                if segment:
+                    segments.append(segment)
+            current = current.parent
+        return tuple(reversed(segments))
+
+    def find_container(self, node: Node) -> Node | None:
+        """내부 스코프를 감싸는 가장 가까운 메서드/생성자 노드를 찾는다."""
+        if not self.is_inner_scope(node):
+            return None
+        current = node.parent
+        while current is not None:
+            if current.type in self.CONTAINER_TYPES:
+                return current
+            if current.type in self.TYPE_DECLARATION_TYPES:
+                return None
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """메서드 본문을 제외한 시그니처(애너테이션 포함)를 한 줄로 반환한다."""
+        body = container.child_by_field_name("body")
+        end_byte = body.start_byte if body is not None else container.end_byte
+        text = self._decode_range(container, container.start_byte, end_byte)
+        return " ".join(text.split())
+
+    def container_name(self, container: Node) -> str:
+        """컨테이너 메서드의 한정 이름을 반환한다 (예: "Outer.process")."""
+        return ".".join((*self.scope_path(container), self._segment(container)))
+
+    def _segment(self, node: Node) -> str:
+        """경로에 사용할 노드 하나의 이름을 반환한다."""
+        if node.type == "lambda_expression":
+            return "<lambda>"
+        if node.type == "object_creation_expression":
+            type_node = node.child_by_field_name("type")
+            type_name = node_text(type_node) if type_node is not None else ""
+            return f"<anonymous {type_name}>" if type_name else "<anonymous>"
+        if node.type == "field_declaration":
+            declarator = node.child_by_field_name("declarator")
+            if declarator is None:
+                return ""
+            node = declarator
+        name_node = node.child_by_field_name("name")
+        return node_text(name_node) if name_node is not None else ""
+
+    @staticmethod
+    def _anonymous_class_body(node: Node) -> Node | None:
+        """생성식에 딸린 익명 클래스 본문을 반환한다."""
+        return next(
+            (child for child in node.named_children if child.type == "class_body"),
+            None,
+        )
+
+    @staticmethod
+    def _decode_range(node: Node, start_byte: int, end_byte: int) -> str:
+        """노드 텍스트 중 지정한 바이트 구간을 디코딩한다."""
+        if node.text is None:
+            return ""
+        offset = node.start_byte
+        return node.text[start_byte - offset : end_byte - offset].decode(
+            "utf-8", errors="replace"
+        )
@@ -0,0 +1,98 @@
+"""JavaScriptScopeResolver: JavaScript/TypeScript 함수와 클로저 중첩 경로를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class JavaScriptScopeResolver(ScopeResolver):
+    """JavaScript/TypeScript AST에서 노드를 감싸는 함수, 클로저, 클래스 경로를 계산한다.
+
+    이름 있는 함수/메서드/클래스는 선언 이름을, 이름 없는 함수 식과 화살표
+    함수는 대입되는 변수나 객체 키 이름(`const onSave = () => {...}`의
+    `onSave`)을 사용하고, 콜백 인자처럼 이름을 붙일 곳이 없으면
+    `<anonymous>`를 사용한다. 콜백 피라미드처럼 클로저가 깊게 중첩되면
+    경로도 그만큼 길어지므로 호출하는 쪽에서 limit으로 탐색 깊이를 제한한다.
+    """
+
+    # 경로에 포함할 함수 노드 타입
+    FUNCTION_TYPES = frozenset(
+        {
+            "function_declaration",
+            "generator_function_declaration",
+            "function_expression",
+            "generator_function",
+            "arrow_function",
+            "method_definition",
+        }
+    )
+
+    # 경로에 포함할 클래스/네임스페이스 노드 타입
+    TYPE_TYPES = frozenset(
+        {
+            "class",
+            "class_declaration",
+            "abstract_class_declaration",
+            "internal_module",
+            "module",
+        }
+    )
+
+    # 경로에 이름을 모으는 노드 타입
+    SCOPE_TYPES = FUNCTION_TYPES | TYPE_TYPES
+
+    # 이름 없는 함수 식에 이름을 붙여주는 부모 노드 타입과 이름 필드
+    BINDING_NAME_FIELDS = {
+        "variable_declarator": "name",
+        "pair": "key",
+        "assignment_expression": "left",
+        "public_field_definition": "name",
+        "field_definition": "property",
+    }
+
+    # 이름을 붙일 수 없는 함수의 표시용 이름
+    ANONYMOUS_NAME = "<anonymous>"
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 함수, 클로저, 클래스 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플
+            (예: ("loadUser", "<anonymous>", "onRow"))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            if current.type in self.SCOPE_TYPES:
+                segments.append(self._segment(current))
+            current = current.parent
+        return tuple(reversed(segments))
+
+    def _segment(self, node: Node) -> str:
+        """경로에 사용할 함수/클래스 노드 하나의 이름을 반환한다.
+
+        Args:
+            node: 함수 또는 클래스 노드
+
+        Returns:
+            선언 이름, 대입 대상 이름, 또는 `<anonymous>`
+        """
+        name_node = node.child_by_field_name("name")
+        if name_node is None and node.parent is not None:
+            field = self.BINDING_NAME_FIELDS.get(node.parent.type)
+            if field is not None:
+                name_node = node.parent.child_by_field_name(field)
+        if name_node is None:
+            return self.ANONYMOUS_NAME
+        if name_node.type == "member_expression":
+            # `exports.handler = function () {...}`는 마지막 속성 이름을 사용
+            name_node = name_node.child_by_field_name("property") or name_node
+        return node_text(name_node) or self.ANONYMOUS_NAME
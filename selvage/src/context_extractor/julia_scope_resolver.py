@@ -0,0 +1,236 @@
+"""JuliaScopeResolver: Julia 모듈, 함수/매크로/struct 정의와 do 블록 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class JuliaScopeResolver(ScopeResolver):
+    """Julia AST에서 변경을 감싸는 정의와 do 블록을 찾고 이름을 계산한다.
+
+    변경 라인을 감싸는 가장 가까운 `function`/`macro`/`struct` 정의와 짧은
+    형식 함수(`f(x) = ...`) 전체를 블록으로 반환한다. `do` 블록은 내부
+    스코프로 보고 `map(xs) do x ... end`처럼 do 블록을 받는 호출 전체를
+    반환하며, 감싸는 함수의 시그니처 라인을 컨테이너 헤더로 함께 포함한다.
+    모듈 안의 정의와 문장은 감싸는 `module` 선언 라인을 컨테이너 헤더로
+    포함하고 모듈 이름을 scope_path로 기록한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 컨테이너의 바깥 컨테이너 헤더까지 모두 포함
+    NESTED_CONTAINERS = True
+
+    # 함수 정의 노드 타입 (짧은 형식 함수는 문법 버전에 따라 assignment로 파싱됨)
+    FUNCTION_TYPES = frozenset({"function_definition", "short_function_definition"})
+
+    # 타입 정의 노드 타입 (이름은 type_head의 첫 identifier)
+    TYPE_DEFINITION_TYPES = frozenset({"struct_definition", "abstract_definition"})
+
+    # 그 자체를 블록으로 반환하는 이름 있는 정의 노드 타입
+    DEFINITION_TYPES = (
+        FUNCTION_TYPES | TYPE_DEFINITION_TYPES | frozenset({"macro_definition"})
+    )
+
+    # 모듈 노드 타입
+    MODULE_TYPES = frozenset({"module_definition"})
+
+    # do 블록 노드 타입 (do 블록을 받는 호출 식의 자식)
+    DO_CLAUSE_TYPE = "do_clause"
+
+    # 함수 시그니처의 호출 식을 감싸는 노드 타입 (`f(x)::T`, `f(x) where T`)
+    SIGNATURE_WRAPPER_TYPES = frozenset(
+        {"signature", "typed_expression", "where_expression"}
+    )
+
+    # 다중 디스패치 메서드로 묶을 블록 타입 (짧은 형식 함수의 assignment 포함)
+    METHOD_BLOCK_TYPES = FUNCTION_TYPES | frozenset({"assignment"})
+
+    # 컨테이너(모듈/함수) 헤더를 함께 포함할 멤버 노드 타입 (모듈 바로 아래
+    # 문장과 do 블록을 받는 호출 식)
+    MEMBER_TYPES = DEFINITION_TYPES | frozenset(
+        {
+            "assignment",
+            "call_expression",
+            "macrocall_expression",
+            "const_statement",
+            "global_statement",
+            "export_statement",
+            "for_statement",
+            "while_statement",
+            "if_statement",
+            "let_statement",
+            "try_statement",
+        }
+    )
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 정의, do 블록 호출 또는 모듈/최상위 문장을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 정의 또는 do 블록을 받는 호출 노드, 정의 밖이면 모듈
+            바로 아래 문장이나 최상위 문장 (모듈 선언 라인이면 모듈 전체)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type == self.DO_CLAUSE_TYPE and current.parent is not None:
+                return current.parent
+            if current.type in self.DEFINITION_TYPES | self.MODULE_TYPES:
+                return current
+            if self.is_short_function(current):
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.type in self.MODULE_TYPES:
+                # 모듈 이름, `end` 같은 모듈 자체의 토큰은 모듈 전체로 처리
+                is_module_name = current == parent.child_by_field_name("name")
+                return current if current.is_named and not is_module_name else parent
+            if parent.parent is None:
+                # 모듈 밖 최상위 문장
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """멤버를 감싸는 컨테이너 노드를 찾는다.
+
+        do 블록을 받는 호출은 감싸는 함수/매크로를, 그 밖의 멤버는 감싸는
+        모듈을 컨테이너로 사용한다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            함수/매크로 정의 또는 module_definition 노드 (없으면 None)
+        """
+        is_do_call = self.is_do_call(node)
+        current = node.parent
+        while current is not None:
+            if is_do_call and (
+                current.type in self.FUNCTION_TYPES | {"macro_definition"}
+                or self.is_short_function(current)
+            ):
+                return current
+            if current.type in self.MODULE_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """모듈 선언 라인 또는 함수 시그니처 라인(첫 줄)을 반환한다."""
+        return node_text(container).split("\n", 1)[0].rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 모듈/함수 이름을 반환한다."""
+        return self.name(container)
+
+    def is_short_function(self, node: Node) -> bool:
+        """짧은 형식 함수 정의(`f(x) = ...`)인지 확인한다."""
+        if node.type == "short_function_definition":
+            return True
+        if node.type != "assignment" or not node.named_children:
+            return False
+        return self._unwrap_call(node.named_children[0]) is not None
+
+    def is_do_call(self, node: Node) -> bool:
+        """do 블록을 받는 호출 식인지 확인한다."""
+        return node.type == "call_expression" and any(
+            child.type == self.DO_CLAUSE_TYPE for child in node.children
+        )
+
+    def name(self, node: Node) -> str | None:
+        """정의, 모듈, do 블록 호출의 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            함수/모듈/struct 이름, 매크로는 `@name`, do 블록 호출은
+            `<do map>` 형태의 이름 (해당하지 않으면 None)
+        """
+        if self.is_do_call(node):
+            callee = self._callee_name(node)
+            return f"<do {callee}>" if callee else "<do>"
+        if node.type in self.FUNCTION_TYPES or self.is_short_function(node):
+            return self._function_name(node)
+        if node.type == "macro_definition":
+            macro_name = self._function_name(node)
+            return f"@{macro_name}" if macro_name else None
+        if node.type in self.TYPE_DEFINITION_TYPES | self.MODULE_TYPES:
+            name_node = node.child_by_field_name("name")
+            if name_node is None:
+                name_node = self._first_identifier(node)
+            if name_node is None:
+                return None
+            return node_text(name_node) or None
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 모듈과 정의 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (예: ("Geometry", "area"))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            if (
+                current.type in self.DEFINITION_TYPES | self.MODULE_TYPES
+                or self.is_short_function(current)
+            ):
+                segment = self.name(current)
+                if segment:
+                    segments.append(segment)
+            current = current.parent
+        return tuple(reversed(segments))
+
+    def _function_name(self, node: Node) -> str | None:
+        """함수/매크로 정의의 시그니처 호출 식에서 이름을 꺼낸다."""
+        name_node = node.child_by_field_name("name")
+        if name_node is not None:
+            return node_text(name_node) or None
+        for child in node.named_children:
+            call = self._unwrap_call(child)
+            if call is not None:
+                return self._callee_name(call)
+        return None
+
+    def _unwrap_call(self, node: Node) -> Node | None:
+        """시그니처 래퍼를 벗겨 호출 식 노드를 반환한다 (호출 식이 아니면 None)."""
+        current: Node | None = node
+        while current is not None and current.type in self.SIGNATURE_WRAPPER_TYPES:
+            current = current.named_children[0] if current.named_children else None
+        if current is not None and current.type == "call_expression":
+            return current
+        return None
+
+    def _callee_name(self, call: Node) -> str | None:
+        """호출 식의 호출 대상 이름(`area`, `Base.show`)을 반환한다."""
+        if not call.named_children:
+            return None
+        return node_text(call.named_children[0]) or None
+
+    @staticmethod
+    def _first_identifier(node: Node) -> Node | None:
+        """전위 순회로 처음 만나는 identifier 자손 노드를 반환한다."""
+        stack = list(reversed(node.children))
+        while stack:
+            current = stack.pop()
+            if current.type == "identifier":
+                return current
+            stack.extend(reversed(current.children))
+        return None
@@ -0,0 +1,50 @@
+"""LanguageExtractionSummary: 언어 하나의 컨텍스트 추출 결과 집계."""
+
+from __future__ import annotations
+
+from collections.abc import Mapping
+from dataclasses import dataclass, field
+from typing import Any
+
+
+@dataclass(frozen=True)
+class LanguageExtractionSummary:
+    """언어별 파일/심볼 수, 건너뛴 파일 수와 사유, 컨텍스트 크기.
+
+    Attributes:
+        language: 언어 이름
+        file_count: 집계된 전체 파일 수 (건너뛴 파일 포함)
+        extracted_file_count: 컨텍스트 블록이 하나 이상 추출된 파일 수
+        symbol_count: 변경과 겹쳐 추출된 심볼(컨텍스트 블록) 수. 의존성 블록과
+            참고용으로 포함된 블록(reason이 있는 블록)은 제외한다.
+        skipped_count: 컨텍스트 없이 건너뛴 파일 수
+        skip_reasons: 건너뛴 사유 → 파일 수 (사유 이름 순)
+        context_bytes: 모든 블록 본문(package 선언 포함)의 UTF-8 바이트 수 합계
+        context_tokens: 모든 블록 본문의 토큰 수 합계
+    """
+
+    language: str
+    file_count: int = 0
+    extracted_file_count: int = 0
+    symbol_count: int = 0
+    skipped_count: int = 0
+    skip_reasons: Mapping[str, int] = field(default_factory=dict)
+    context_bytes: int = 0
+    context_tokens: int = 0
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            모든 필드를 키로 가진 딕셔너리
+        """
+        return {
+            "language": self.language,
+            "file_count": self.file_count,
+            "extracted_file_count": self.extracted_file_count,
+            "symbol_count": self.symbol_count,
+            "skipped_count": self.skipped_count,
+            "skip_reasons": dict(self.skip_reasons),
+            "context_bytes": self.context_bytes,
+            "context_tokens": self.context_tokens,
+        }
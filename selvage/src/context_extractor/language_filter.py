@@ -0,0 +1,55 @@
+"""LanguageFilter: 추출 대상 파일을 언어로 거르는 허용/거부 목록."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import dataclass
+
+from .context_extractor import ContextExtractor
+
+
+@dataclass(frozen=True)
+class LanguageFilter:
+    """여러 파일을 추출할 때 감지된 언어로 추출 대상을 거른다.
+
+    경로 기반 제외(`.selvageignore`)와 별개로, 언어가 감지된 뒤에 적용된다.
+    거른 파일은 추출하지 않고 status가 "language-filtered"인 결과로 기록해
+    요약의 건너뜀 사유에 집계한다.
+
+    Attributes:
+        allow_languages: 추출할 언어들 (비어 있으면 지원하는 모든 언어)
+        deny_languages: 추출하지 않을 언어들 (허용 목록보다 우선)
+    """
+
+    allow_languages: frozenset[str] = frozenset()
+    deny_languages: frozenset[str] = frozenset()
+
+    def __post_init__(self) -> None:
+        """유효성 검증을 수행한다."""
+        self._validate("allow_languages", self.allow_languages)
+        self._validate("deny_languages", self.deny_languages)
+
+    def allows(self, language: str) -> bool:
+        """언어가 추출 대상인지 반환한다.
+
+        Args:
+            language: 파일의 감지된 언어
+
+        Returns:
+            거부 목록에 없고, 허용 목록이 비어 있거나 허용 목록에 있으면 True
+        """
+        if language in self.deny_languages:
+            return False
+        return not self.allow_languages or language in self.allow_languages
+
+    @staticmethod
+    def _validate(field_name: str, languages: Iterable[str]) -> None:
+        """지원하지 않는 언어 이름이 있으면 ValueError를 발생시킨다."""
+        supported = ContextExtractor.get_supported_languages()
+        unknown = sorted(
+            language for language in languages if language not in supported
+        )
+        if unknown:
+            raise ValueError(
+                f"{field_name}에 지원하지 않는 언어가 있습니다: {', '.join(unknown)}"
+            )
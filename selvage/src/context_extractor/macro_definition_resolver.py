@@ -0,0 +1,117 @@
+"""MacroDefinitionResolver: C `#define`/Rust `macro_rules!` 정의와 호출을 찾는 모듈."""
+
+from __future__ import annotations
+
+import re
+
+from .line_range import LineRange
+
+
+class MacroDefinitionResolver:
+    """파일 안의 매크로 정의 위치와 코드 라인의 매크로 호출을 텍스트로 찾는다.
+
+    tree-sitter 문법이 없는 C와 Rust를 위해 정규식과 괄호 짝으로 정의
+    범위를 계산한다. C `#define`은 `\\`로 이어지는 라인까지, Rust
+    `macro_rules!`는 바로 위 속성(`#[macro_export]` 등)부터 본문을 닫는
+    괄호까지를 정의로 본다. 같은 이름이 여러 번 정의되면 첫 정의를 사용한다.
+    """
+
+    C_DEFINE_PATTERN = re.compile(r"^\s*#\s*define\s+(?P<name>[A-Za-z_]\w*)")
+
+    RUST_MACRO_RULES_PATTERN = re.compile(
+        r"^\s*macro_rules!\s*(?P<name>[A-Za-z_]\w*)"
+    )
+
+    RUST_ATTRIBUTE_PATTERN = re.compile(r"^\s*#\[.*\]\s*$")
+
+    # 호출을 찾기 전에 지우는 문자열 리터럴과 주석
+    STRING_OR_COMMENT_PATTERN = re.compile(r'"(?:\\.|[^"\\])*"|//.*$|/\*.*?\*/')
+
+    # 이름과 Rust 매크로 호출 표시(`!` 뒤에 여는 괄호)
+    IDENTIFIER_PATTERN = re.compile(r"\b([A-Za-z_]\w*)\b(\s*!\s*[({\[])?")
+
+    CLOSING_DELIMITERS = {"{": "}", "(": ")", "[": "]"}
+
+    def definitions(self, lines: list[str]) -> dict[str, tuple[LineRange, bool]]:
+        """파일의 매크로 정의들을 찾는다.
+
+        Args:
+            lines: 파일의 모든 라인들
+
+        Returns:
+            매크로 이름 → (정의 라인 범위, Rust 매크로 여부) 딕셔너리
+        """
+        definitions: dict[str, tuple[LineRange, bool]] = {}
+        for index, line in enumerate(lines):
+            c_match = self.C_DEFINE_PATTERN.match(line)
+            if c_match is not None:
+                end = index
+                while lines[end].rstrip().endswith("\\") and end + 1 < len(lines):
+                    end += 1
+                definitions.setdefault(
+                    c_match.group("name"), (LineRange(index + 1, end + 1), False)
+                )
+                continue
+
+            rust_match = self.RUST_MACRO_RULES_PATTERN.match(line)
+            if rust_match is not None:
+                start = index
+                while start > 0 and self.RUST_ATTRIBUTE_PATTERN.match(lines[start - 1]):
+                    start -= 1
+                end = self._rust_body_end(lines, index)
+                definitions.setdefault(
+                    rust_match.group("name"), (LineRange(start + 1, end + 1), True)
+                )
+        return definitions
+
+    def invoked_names(
+        self, line: str, definitions: dict[str, tuple[LineRange, bool]]
+    ) -> list[str]:
+        """코드 라인에서 호출된 매크로 이름들을 등장 순서대로 반환한다.
+
+        Rust 매크로는 `name!` 형태의 호출만, C 매크로는 이름이 등장하면
+        호출로 본다. 문자열 리터럴과 주석 안의 이름은 무시한다.
+
+        Args:
+            line: 검사할 코드 라인
+            definitions: definitions()로 찾은 매크로 정의들
+
+        Returns:
+            중복 없는 매크로 이름 리스트
+        """
+        code = self.STRING_OR_COMMENT_PATTERN.sub(" ", line)
+        names: list[str] = []
+        for match in self.IDENTIFIER_PATTERN.finditer(code):
+            name, bang = match.groups()
+            definition = definitions.get(name)
+            if definition is None or name in names:
+                continue
+            _, is_rust = definition
+            if is_rust and bang is None:
+                continue
+            names.append(name)
+        return names
+
+    def _rust_body_end(self, lines: list[str], start_index: int) -> int:
+        """`macro_rules!` 본문을 닫는 괄호가 있는 라인의 인덱스를 반환한다.
+
+        본문이 닫히지 않으면 파일의 마지막 라인 인덱스를 반환한다.
+        """
+        opening: str | None = None
+        depth = 0
+        for index in range(start_index, len(lines)):
+            code = self.STRING_OR_COMMENT_PATTERN.sub(" ", lines[index])
+            if index == start_index:
+                code = code.split("macro_rules!", 1)[1]
+            for char in code:
+                if opening is None:
+                    if char in self.CLOSING_DELIMITERS:
+                        opening, depth = char, 1
+                    continue
+                if char == opening:
+                    depth += 1
+                elif char == self.CLOSING_DELIMITERS[opening]:
+                    depth -= 1
+                    if depth == 0:
+                        return index
+        return len(lines) - 1
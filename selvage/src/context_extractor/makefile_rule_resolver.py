@@ -0,0 +1,104 @@
+"""MakefileRuleResolver: Makefile 규칙(타깃 + 레시피)과 변수 대입 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class MakefileRuleResolver(ScopeResolver):
+    """Makefile AST에서 변경을 감싸는 규칙이나 변수 대입을 찾고 이름을 계산한다.
+
+    레시피 라인이 바뀌면 타깃 라인부터 레시피 끝까지의 규칙 전체를, 변수
+    대입(`CC := gcc`, `define ... endef`)이 바뀌면 대입 하나를 반환한다.
+    make는 탭으로 시작하는 라인만 레시피로 보므로, 규칙 노드 안에 있더라도
+    탭 대신 공백으로 들여쓴 라인(백슬래시 줄 연결의 다음 줄 제외)은 레시피로
+    보지 않고 그 라인의 문장만 반환한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 타깃 + 레시피 규칙 노드 타입
+    RULE_TYPES = frozenset({"rule"})
+
+    # 변수 대입 노드 타입
+    ASSIGNMENT_TYPES = frozenset(
+        {"variable_assignment", "shell_assignment", "define_directive"}
+    )
+
+    # 레시피 라인 접두사 (make 기본값)
+    RECIPE_PREFIX = "\t"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 변수 대입, 규칙 또는 최상위 문장을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            변수 대입 또는 규칙 노드, 그 밖에는 최상위 문장 (레시피가 아닌
+            들여쓴 라인이면 규칙 안의 해당 문장, 루트 노드면 None)
+        """
+        below: Node | None = None
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.ASSIGNMENT_TYPES:
+                return current
+            if current.type in self.RULE_TYPES:
+                if below is None or self._is_rule_line(current, node):
+                    return current
+                return below
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None:
+                # 규칙 밖 최상위 문장 (include, 조건부 지시어 등)
+                return current
+            below = current
+            current = parent
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """규칙의 타깃 또는 대입된 변수 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            규칙은 타깃 목록(`build`, `%.o`), 변수 대입은 변수 이름
+            (그 밖의 노드는 None)
+        """
+        if node.type in self.RULE_TYPES:
+            targets = next(
+                (child for child in node.named_children if child.type == "targets"),
+                None,
+            )
+            if targets is None:
+                return None
+            return node_text(targets).strip() or None
+        if node.type in self.ASSIGNMENT_TYPES:
+            name_node = node.child_by_field_name("name")
+            if name_node is None:
+                return None
+            return node_text(name_node).strip() or None
+        return None
+
+    def _is_rule_line(self, rule: Node, node: Node) -> bool:
+        """노드가 있는 라인이 규칙의 타깃 라인이나 유효한 레시피 라인인지 확인한다."""
+        recipe = next(
+            (child for child in rule.named_children if child.type == "recipe"), None
+        )
+        row = node.start_point[0]
+        if recipe is None or row < recipe.start_point[0]:
+            return True
+        lines = node_text(rule).split("\n")
+        offset = row - rule.start_point[0]
+        line = lines[offset]
+        if line.startswith(self.RECIPE_PREFIX) or not line.strip():
+            return True
+        # 백슬래시로 이어진 레시피의 다음 줄은 들여쓰기와 관계없이 레시피
+        return offset > 0 and lines[offset - 1].rstrip().endswith("\\")
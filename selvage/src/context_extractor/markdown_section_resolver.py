@@ -0,0 +1,188 @@
+"""MarkdownSectionResolver: Markdown 변경 라인의 본문 블록과 헤딩 계층을 계산하는 모듈."""
+
+from __future__ import annotations
+
+import re
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class MarkdownSectionResolver:
+    """Markdown AST에서 변경 라인을 감싸는 본문 블록과 상위 헤딩 경로를 찾는다.
+
+    tree-sitter-markdown은 ATX 헤딩(`## Install`)에서만 `section` 노드를 열고
+    Setext 헤딩(밑줄 `===`/`---`)은 일반 블록으로 두기 때문에, section 트리
+    대신 문서 순서로 문서 레벨 헤딩들을 훑어 레벨 스택으로 계층을 계산한다.
+    목록 항목/인용문 안의 헤딩은 문서 섹션을 나누지 않으므로 제외한다.
+    블록 노드는 끝의 개행(과 뒤따르는 빈 줄)을 포함하므로 라인 범위는 노드
+    텍스트의 마지막 비어 있지 않은 줄까지로 계산한다.
+    """
+
+    # 헤딩 노드 타입
+    HEADING_TYPES = frozenset({"atx_heading", "setext_heading"})
+
+    # 문서 레벨 헤딩을 자식으로 가지는 노드 타입
+    SECTION_TYPES = frozenset({"document", "section"})
+
+    # 변경을 감싸는 가장 안쪽 항목 전체를 반환하는 목록 항목 노드 타입
+    LIST_ITEM_TYPES = frozenset({"list_item"})
+
+    # 본문과 별도로 블록 전체를 반환하는 코드 블록 노드 타입
+    CODE_BLOCK_TYPES = frozenset({"fenced_code_block", "indented_code_block"})
+
+    # 변경을 감싸는 단위로 반환하는 본문 블록 노드 타입
+    PROSE_TYPES = frozenset({"paragraph", "pipe_table", "html_block"})
+
+    # 헤딩 경로 블록의 포함 사유
+    CONTAINER_REASON = "enclosing-section"
+
+    # ATX 헤딩 끝의 선택적 닫는 `#` 시퀀스
+    _ATX_CLOSING_SEQUENCE = re.compile(r"\s+#+\s*$")
+
+    def find_block(self, root: Node, line_no: int) -> Node | None:
+        """라인을 감싸는 목록 항목, 헤딩, 코드 블록 또는 본문 블록을 찾는다.
+
+        Args:
+            root: document 노드
+            line_no: 1-based 라인 번호
+
+        Returns:
+            가장 안쪽 목록 항목, 헤딩, 코드 블록, 문단 등의 블록 노드
+            (빈 줄처럼 블록 밖의 라인이면 None)
+        """
+        current = root
+        while True:
+            child = next(
+                (c for c in current.children if self._contains_line(c, line_no)),
+                None,
+            )
+            if child is None:
+                break
+            current = child
+
+        candidate: Node | None = None
+        top: Node | None = None
+        while current is not None and current.type not in self.SECTION_TYPES:
+            if current.type in self.CODE_BLOCK_TYPES:
+                return current
+            if current.type in self.LIST_ITEM_TYPES:
+                return current
+            if current.type in self.HEADING_TYPES:
+                # Setext 헤딩의 제목 줄은 paragraph 노드이므로 헤딩이 우선한다
+                candidate = current
+            elif candidate is None and current.type in self.PROSE_TYPES:
+                candidate = current
+            top = current
+            current = current.parent
+        return candidate or top
+
+    def line_span(self, node: Node) -> tuple[int, int]:
+        """끝의 개행과 빈 줄을 제외한 노드의 라인 범위를 반환한다.
+
+        Args:
+            node: 블록 노드
+
+        Returns:
+            1-based (시작 라인, 끝 라인) 튜플
+        """
+        start_line = node.start_point[0] + 1
+        lines = node_text(node).split("\n")
+        while len(lines) > 1 and not lines[-1].strip():
+            lines.pop()
+        return start_line, start_line + len(lines) - 1
+
+    def heading_path(self, node: Node) -> list[Node]:
+        """노드가 속한 섹션들의 헤딩을 바깥쪽부터 반환한다.
+
+        노드 자신이 헤딩이면 자신보다 레벨이 높은(숫자가 작은) 헤딩들만 포함한다.
+
+        Args:
+            node: 기준 블록 노드
+
+        Returns:
+            `# Guide`, `## Install` 순서의 헤딩 노드 리스트
+        """
+        stack: list[Node] = []
+        for heading in self._document_headings(self._root(node)):
+            if heading.start_byte >= node.start_byte:
+                break
+            level = self.level(heading)
+            while stack and self.level(stack[-1]) >= level:
+                stack.pop()
+            stack.append(heading)
+        if node.type in self.HEADING_TYPES:
+            level = self.level(node)
+            while stack and self.level(stack[-1]) >= level:
+                stack.pop()
+        return stack
+
+    def scope_path(self, node: Node) -> tuple[str, ...]:
+        """노드가 속한 섹션 헤딩들의 표시용 문자열을 반환한다.
+
+        Args:
+            node: 기준 블록 노드
+
+        Returns:
+            ("# Guide", "## Install")처럼 ATX 형식으로 정규화한 헤딩 튜플
+        """
+        return tuple(self.label(heading) for heading in self.heading_path(node))
+
+    def level(self, heading: Node) -> int:
+        """헤딩 레벨(1~6)을 반환한다 (Setext는 `=` 밑줄이 1, `-` 밑줄이 2)."""
+        for child in heading.children:
+            if child.type.startswith("atx_h") and child.type.endswith("_marker"):
+                return int(child.type[len("atx_h")])
+            if child.type == "setext_h1_underline":
+                return 1
+            if child.type == "setext_h2_underline":
+                return 2
+        return 1
+
+    def title(self, heading: Node) -> str:
+        """헤딩의 제목 텍스트를 반환한다 (여러 줄 Setext 제목은 공백으로 연결)."""
+        content = heading.child_by_field_name("heading_content")
+        if content is None:
+            # 제목 없이 `#`만 있는 ATX 헤딩
+            return ""
+        text = node_text(content)
+        if heading.type == "setext_heading":
+            return " ".join(line.strip() for line in text.splitlines() if line.strip())
+        return self._ATX_CLOSING_SEQUENCE.sub("", text).strip()
+
+    def label(self, heading: Node) -> str:
+        """헤딩을 `## Install`처럼 ATX 형식의 한 줄로 반환한다."""
+        return f"{'#' * self.level(heading)} {self.title(heading)}"
+
+    def name(self, node: Node) -> str | None:
+        """헤딩 블록이면 제목을 이름으로 반환한다 (그 외 블록은 None)."""
+        if node.type in self.HEADING_TYPES:
+            return self.title(node) or None
+        return None
+
+    def _document_headings(self, root: Node) -> list[Node]:
+        """목록 항목/인용문 밖의 문서 레벨 헤딩들을 문서 순서로 반환한다."""
+        headings: list[Node] = []
+        pending = [root]
+        while pending:
+            current = pending.pop()
+            for child in current.children:
+                if child.type in self.HEADING_TYPES:
+                    headings.append(child)
+                elif child.type in self.SECTION_TYPES:
+                    pending.append(child)
+        return sorted(headings, key=lambda heading: heading.start_byte)
+
+    def _contains_line(self, node: Node, line_no: int) -> bool:
+        """노드의 라인 범위가 라인을 포함하는지 확인한다."""
+        start_line, end_line = self.line_span(node)
+        return start_line <= line_no <= end_line
+
+    @staticmethod
+    def _root(node: Node) -> Node:
+        """노드의 최상위 조상(document)을 반환한다."""
+        current = node
+        while current.parent is not None:
+            current = current.parent
+        return current
@@ -6,6 +6,7 @@ import re
 from collections.abc import Sequence
 
 from .line_range import LineRange
+from .text_lines import split_lines
 
 
 class MeaninglessChangeFilter:
@@ -15,6 +16,15 @@ class MeaninglessChangeFilter:
     제거한다.
     """
 
+    def __init__(self, detect_comments: bool = True) -> None:
+        """필터 초기화.
+
+        Args:
+            detect_comments: 주석 패턴 라인을 무의미한 라인으로 볼지 여부
+                (Markdown처럼 `#`, `*`, `--`로 시작하는 라인이 본문인 경우 False)
+        """
+        self._detect_comments = detect_comments
+
     def filter_meaningful_ranges_with_file_content(
         self, file_content: str, changed_ranges: Sequence[LineRange]
     ) -> list[LineRange]:
@@ -27,7 +37,7 @@ class MeaninglessChangeFilter:
         Returns:
             의미있는 변경 범위들의 리스트
         """
-        lines = file_content.splitlines()
+        lines = split_lines(file_content)
         return self.filter_meaningful_ranges_with_lines(lines, changed_ranges)
 
     def filter_meaningful_ranges_with_lines(
@@ -63,6 +73,9 @@ class MeaninglessChangeFilter:
         if not stripped:
             return False
 
+        if not self._detect_comments:
+            return True
+
         # 다양한 언어의 주석 패턴
         comment_patterns = [
             r"^\s*//",  # C/C++/Java/JavaScript 단일행 주석
@@ -0,0 +1,11 @@
+"""컨텍스트 추출 계측(telemetry) 패키지."""
+
+from .extraction_metrics import ExtractionMetrics
+from .extraction_metrics_recorder import ExtractionMetricsRecorder
+from .extraction_metrics_summary import ExtractionMetricsSummary
+
+__all__ = [
+    "ExtractionMetrics",
+    "ExtractionMetricsRecorder",
+    "ExtractionMetricsSummary",
+]
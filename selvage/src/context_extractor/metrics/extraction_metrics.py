@@ -0,0 +1,37 @@
+"""ExtractionMetrics: 파일 하나의 컨텍스트 추출 계측 결과."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class ExtractionMetrics:
+    """파일 하나를 추출하는 동안 측정된 값들.
+
+    시간 단위는 초이며, 파일 전체 모드처럼 파싱을 건너뛴 경우
+    parse_seconds, query_seconds, node_count, max_depth는 0이다.
+
+    Attributes:
+        line_count: 파일 라인 수
+        byte_count: 파일 크기 (UTF-8 바이트)
+        parse_seconds: AST 파싱 소요 시간
+        query_seconds: 변경 노드 탐색/블록 선정 소요 시간
+        total_seconds: extract_context_blocks 전체 소요 시간
+        node_count: AST 전체 노드 수
+        max_depth: AST 최대 깊이 (루트 = 0)
+        symbol_count: 추출된 컨텍스트 블록 수 (의존성 블록 제외)
+        file_path: 파일 경로 (extract_file_context로 추출한 경우에만 설정)
+        timed_out: 파싱 시간 제한을 넘겨 추출이 중단되었는지 여부
+    """
+
+    line_count: int
+    byte_count: int
+    parse_seconds: float = 0.0
+    query_seconds: float = 0.0
+    total_seconds: float = 0.0
+    node_count: int = 0
+    max_depth: int = 0
+    symbol_count: int = 0
+    file_path: str | None = None
+    timed_out: bool = False
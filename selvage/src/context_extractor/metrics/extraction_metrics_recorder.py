@@ -0,0 +1,107 @@
+"""ExtractionMetricsRecorder: 추출 단계별 측정값을 모으는 모듈."""
+
+from __future__ import annotations
+
+import time
+from collections.abc import Iterable
+
+from tree_sitter import Node
+
+from ..context_block import ContextBlock
+from ..text_lines import split_lines
+from .extraction_metrics import ExtractionMetrics
+
+
+class ExtractionMetricsRecorder:
+    """extract_context_blocks 한 번의 호출 동안 측정값을 기록한다.
+
+    계측이 꺼진 경우에는 생성되지 않으므로, 비활성 상태의 오버헤드는
+    None 검사 몇 번으로 제한된다.
+    """
+
+    def __init__(self, file_content: str) -> None:
+        """기록기 초기화. 생성 시점부터 전체 소요 시간 측정을 시작한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+        """
+        self._started = time.perf_counter()
+        self._line_count = len(split_lines(file_content))
+        self._byte_count = len(file_content.encode("utf-8", errors="replace"))
+        self._parse_seconds = 0.0
+        self._query_seconds = 0.0
+        self._node_count = 0
+        self._max_depth = 0
+
+    @staticmethod
+    def now() -> float:
+        """단계 시작 시각을 반환한다."""
+        return time.perf_counter()
+
+    def record_parse(self, started: float, root: Node) -> None:
+        """파싱 소요 시간과 트리 크기를 기록한다.
+
+        Args:
+            started: 파싱 시작 시각 (now()의 반환값)
+            root: 파싱된 AST 루트 노드
+        """
+        self._parse_seconds = time.perf_counter() - started
+        self._node_count, self._max_depth = self._measure_tree(root)
+
+    def record_query(self, started: float) -> None:
+        """변경 노드 탐색/블록 선정 소요 시간을 기록한다.
+
+        Args:
+            started: 탐색 시작 시각 (now()의 반환값)
+        """
+        self._query_seconds = time.perf_counter() - started
+
+    def finish(
+        self,
+        blocks: Iterable[ContextBlock],
+        file_path: str | None = None,
+        timed_out: bool = False,
+    ) -> ExtractionMetrics:
+        """최종 측정 결과를 만든다.
+
+        Args:
+            blocks: 추출된 블록들
+            file_path: 파일 경로 (알 수 없으면 None)
+            timed_out: 파싱 시간 제한으로 추출이 중단되었는지 여부
+
+        Returns:
+            파일 단위 계측 결과
+        """
+        return ExtractionMetrics(
+            line_count=self._line_count,
+            byte_count=self._byte_count,
+            parse_seconds=self._parse_seconds,
+            query_seconds=self._query_seconds,
+            total_seconds=time.perf_counter() - self._started,
+            node_count=self._node_count,
+            max_depth=self._max_depth,
+            symbol_count=sum(1 for block in blocks if not block.is_dependency),
+            file_path=file_path,
+            timed_out=timed_out,
+        )
+
+    @staticmethod
+    def _measure_tree(root: Node) -> tuple[int, int]:
+        """TreeCursor로 트리를 한 번 순회하며 노드 수와 최대 깊이를 센다.
+
+        깊게 중첩된 파일에서도 재귀 한도에 걸리지 않도록 반복문으로 순회한다.
+        """
+        cursor = root.walk()
+        node_count = 0
+        depth = 0
+        max_depth = 0
+        while True:
+            node_count += 1
+            max_depth = max(max_depth, depth)
+            if cursor.goto_first_child():
+                depth += 1
+                continue
+            while not cursor.goto_next_sibling():
+                if depth == 0 or not cursor.goto_parent():
+                    return node_count, max_depth
+                depth -= 1
@@ -0,0 +1,66 @@
+"""ExtractionMetricsSummary: 여러 파일의 추출 계측 결과 집계."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+from dataclasses import dataclass
+
+from .extraction_metrics import ExtractionMetrics
+
+
+@dataclass(frozen=True)
+class ExtractionMetricsSummary:
+    """파일별 계측 결과의 합계와 이상치(가장 느린/깊은 파일).
+
+    Attributes:
+        file_count: 집계된 파일 수
+        total_parse_seconds: 파싱 소요 시간 합계
+        total_query_seconds: 탐색 소요 시간 합계
+        total_seconds: 전체 소요 시간 합계
+        total_node_count: AST 노드 수 합계
+        total_symbol_count: 추출된 컨텍스트 블록 수 합계
+        slowest: 전체 소요 시간이 가장 긴 파일의 계측 결과
+        deepest: AST 깊이가 가장 깊은 파일의 계측 결과
+        timed_out_files: 파싱 시간 제한을 넘긴 파일 경로들 (경로를 모르면
+            "<unknown>")
+    """
+
+    file_count: int = 0
+    total_parse_seconds: float = 0.0
+    total_query_seconds: float = 0.0
+    total_seconds: float = 0.0
+    total_node_count: int = 0
+    total_symbol_count: int = 0
+    slowest: ExtractionMetrics | None = None
+    deepest: ExtractionMetrics | None = None
+    timed_out_files: tuple[str, ...] = ()
+
+    @classmethod
+    def aggregate(
+        cls, metrics: Iterable[ExtractionMetrics]
+    ) -> ExtractionMetricsSummary:
+        """파일별 계측 결과들을 집계한다.
+
+        Args:
+            metrics: 파일별 계측 결과들
+
+        Returns:
+            집계 결과 (입력이 비어 있으면 모든 값이 0인 요약)
+        """
+        items = list(metrics)
+        if not items:
+            return cls()
+
+        return cls(
+            file_count=len(items),
+            total_parse_seconds=sum(item.parse_seconds for item in items),
+            total_query_seconds=sum(item.query_seconds for item in items),
+            total_seconds=sum(item.total_seconds for item in items),
+            total_node_count=sum(item.node_count for item in items),
+            total_symbol_count=sum(item.symbol_count for item in items),
+            slowest=max(items, key=lambda item: item.total_seconds),
+            deepest=max(items, key=lambda item: item.max_depth),
+            timed_out_files=tuple(
+                item.file_path or "<unknown>" for item in items if item.timed_out
+            ),
+        )
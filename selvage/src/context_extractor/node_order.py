@@ -0,0 +1,22 @@
+"""실행마다 같은 순서로 AST 노드를 정렬하기 위한 정렬 키 함수 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+
+def node_order_key(node: Node) -> tuple[int, int, str]:
+    """노드 집합을 위치 순으로 정렬할 때 쓰는 결정적인 정렬 키를 반환한다.
+
+    Node의 해시는 메모리 주소에 의존하므로 set을 그대로 순회하면 실행마다
+    순서가 달라질 수 있다. 시작 위치만으로 정렬하면 시작이 같은 노드들
+    (같은 범위의 부모/자식 등)이 set 순회 순서대로 남으므로, 시작 바이트,
+    긴 노드(바깥 노드) 우선, 노드 타입 순으로 순서를 완전히 정한다.
+
+    Args:
+        node: 정렬할 노드
+
+    Returns:
+        (시작 바이트, 음수 끝 바이트, 노드 타입) 튜플
+    """
+    return node.start_byte, -node.end_byte, node.type
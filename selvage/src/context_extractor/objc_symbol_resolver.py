@@ -0,0 +1,121 @@
+"""ObjcSymbolResolver: Objective-C 선언의 이름/헤더를 계산하는 모듈."""
+
+from __future__ import annotations
+
+import re
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class ObjcSymbolResolver(ScopeResolver):
+    """Objective-C 메서드 selector와 @interface/@implementation 헤더를 계산한다.
+
+    문법 버전별 노드 구조 차이에 영향을 받지 않도록 선언 텍스트를 기준으로
+    계산하며, Objective-C++(.mm)처럼 일부 구문 오류가 있는 트리에서도
+    예외 없이 동작한다.
+    """
+
+    # 메서드 선언/정의 노드 타입
+    METHOD_TYPES = frozenset({"method_definition", "method_declaration"})
+
+    # 컨테이너 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = METHOD_TYPES | frozenset({"property_declaration"})
+
+    # 메서드를 감싸는 컨테이너 노드 타입
+    CONTAINER_TYPES = frozenset(
+        {
+            "class_interface",
+            "class_implementation",
+            "category_interface",
+            "category_implementation",
+            "protocol_declaration",
+        }
+    )
+
+    _CONTAINER_HEADER_PATTERN = re.compile(
+        r"@(?:interface|implementation|protocol)\s+(\w+)(?:\s*\(\s*(\w*)\s*\))?"
+    )
+    _RETURN_TYPE_PATTERN = re.compile(r"^[-+]\s*(?:\([^)]*\))?\s*")
+    _KEYWORD_PATTERN = re.compile(r"(\w+)\s*:")
+    _UNARY_SELECTOR_PATTERN = re.compile(r"\w+")
+    _PROPERTY_NAME_PATTERN = re.compile(r"(\w+)\s*;?\s*$")
+
+    def name(self, node: Node) -> str | None:
+        """Objective-C 노드의 표시용 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            메서드는 `-[Class selector:]` 형태의 selector, 컨테이너는
+            `Class` 또는 `Class (Category)`, 프로퍼티는 프로퍼티 이름
+            (해당하지 않으면 None)
+        """
+        if node.type in self.METHOD_TYPES:
+            return self.selector(node)
+        if node.type in self.CONTAINER_TYPES:
+            return self.container_name(node)
+        if node.type == "property_declaration":
+            return self._property_name(node)
+        return None
+
+    def selector(self, method_node: Node) -> str | None:
+        """메서드 노드의 selector를 `-[Class key:value:]` 형태로 반환한다."""
+        signature = self._signature_text(method_node)
+        if not signature or signature[0] not in "-+":
+            return None
+
+        body = self._RETURN_TYPE_PATTERN.sub("", signature, count=1)
+        keywords = self._KEYWORD_PATTERN.findall(body)
+        if keywords:
+            selector = "".join(f"{keyword}:" for keyword in keywords)
+        else:
+            unary = self._UNARY_SELECTOR_PATTERN.search(body)
+            if unary is None:
+                return None
+            selector = unary.group(0)
+
+        container = self.find_container(method_node)
+        container_name = self.container_name(container) if container else None
+        if container_name:
+            return f"{signature[0]}[{container_name} {selector}]"
+        return f"{signature[0]}{selector}"
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 @interface/@implementation/@protocol 노드를 찾는다."""
+        current = node.parent
+        while current is not None:
+            if current.type in self.CONTAINER_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름을 `Class` 또는 `Class (Category)` 형태로 반환한다."""
+        match = self._CONTAINER_HEADER_PATTERN.search(self.container_header(container))
+        if match is None:
+            return None
+        class_name, category = match.groups()
+        if category is not None:
+            return f"{class_name} ({category})"
+        return class_name
+
+    def container_header(self, container: Node) -> str:
+        """컨테이너 선언의 첫 줄(헤더)을 반환한다."""
+        text = node_text(container)
+        return text.split("\n", 1)[0].rstrip()
+
+    def _signature_text(self, method_node: Node) -> str:
+        """메서드 본문({ ... })과 세미콜론을 제외한 시그니처 텍스트를 반환한다."""
+        text = node_text(method_node)
+        signature = text.split("{", 1)[0]
+        return " ".join(signature.replace(";", " ").split())
+
+    def _property_name(self, node: Node) -> str | None:
+        """@property 선언의 프로퍼티 이름을 반환한다."""
+        text = " ".join(node_text(node).split())
+        match = self._PROPERTY_NAME_PATTERN.search(text)
+        return match.group(1) if match else None
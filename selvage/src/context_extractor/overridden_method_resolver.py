@@ -0,0 +1,240 @@
+"""OverriddenMethodResolver: 변경된 메서드가 재정의하는 상위 타입 메서드를 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections import deque
+
+from tree_sitter import Node
+
+from .signature_parser import SignatureParser
+from .text_lines import node_text
+
+
+class OverriddenMethodResolver:
+    """클래스의 `extends`/`implements` 절을 따라 상위 타입의 같은 메서드를 찾는다.
+
+    변경된 메서드를 감싸는 클래스의 상위 타입들을 같은 파일의 클래스/인터페이스
+    선언에서 찾아, 이름과 파라미터 수가 같은 메서드를 재정의 대상으로 본다.
+    상위 타입에서 찾지 못하면 그 타입의 상위 타입을 다시 따라가며 (가장 가까운
+    상위 타입의 선언 하나만 사용), 파일 안에 선언이 없는 상위 타입은
+    `Base.method` 형태의 한정 이름으로 돌려주어 외부 resolver로 조회하게 한다.
+    타입 인자(`List<T>`)와 패키지 한정자는 이름 비교에서 제외한다.
+    """
+
+    # 언어별 상위 타입을 가질 수 있는 클래스/인터페이스 노드 타입
+    LANGUAGE_CLASS_TYPES = {
+        "java": frozenset(
+            {
+                "class_declaration",
+                "interface_declaration",
+                "enum_declaration",
+                "record_declaration",
+            }
+        ),
+        "kotlin": frozenset({"class_declaration", "object_declaration"}),
+        "typescript": frozenset(
+            {
+                "class_declaration",
+                "abstract_class_declaration",
+                "interface_declaration",
+            }
+        ),
+    }
+
+    # 언어별 메서드 노드 타입
+    LANGUAGE_METHOD_TYPES = {
+        "java": frozenset({"method_declaration"}),
+        "kotlin": frozenset({"function_declaration"}),
+        "typescript": frozenset(
+            {"method_definition", "method_signature", "abstract_method_signature"}
+        ),
+    }
+
+    # 언어별 상속 절 노드 타입 (클래스 노드의 직계 자식)
+    LANGUAGE_CLAUSE_TYPES = {
+        "java": frozenset({"superclass", "super_interfaces", "extends_interfaces"}),
+        "kotlin": frozenset({"delegation_specifier", "delegation_specifiers"}),
+        "typescript": frozenset({"class_heritage", "extends_type_clause"}),
+    }
+
+    # 언어별 상속 절 안에서 상위 타입 하나를 가리키는 노드 타입
+    LANGUAGE_SUPERTYPE_TYPES = {
+        "java": frozenset(
+            {"type_identifier", "generic_type", "scoped_type_identifier"}
+        ),
+        "kotlin": frozenset({"user_type"}),
+        "typescript": frozenset(
+            {
+                "identifier",
+                "type_identifier",
+                "generic_type",
+                "member_expression",
+                "nested_type_identifier",
+            }
+        ),
+    }
+
+    # 상위 타입 이름을 찾을 때 들어가지 않는 노드 타입 (타입/생성자 인자)
+    SKIPPED_TYPES = frozenset({"type_arguments", "value_arguments", "arguments"})
+
+    # 이름 노드 타입 (name 필드가 없는 Kotlin 선언용)
+    NAME_TYPES = frozenset(
+        {"type_identifier", "simple_identifier", "identifier", "property_identifier"}
+    )
+
+    # 메서드 본문 노드 타입 (시그니처는 본문 직전까지)
+    BODY_TYPES = frozenset({"block", "statement_block", "function_body"})
+
+    def __init__(self, language: str) -> None:
+        """OverriddenMethodResolver를 초기화한다.
+
+        Args:
+            language: 언어 이름
+        """
+        self._class_types = self.LANGUAGE_CLASS_TYPES.get(language, frozenset())
+        self._method_types = self.LANGUAGE_METHOD_TYPES.get(language, frozenset())
+        self._clause_types = self.LANGUAGE_CLAUSE_TYPES.get(language, frozenset())
+        self._supertype_types = self.LANGUAGE_SUPERTYPE_TYPES.get(
+            language, frozenset()
+        )
+        self._signature_parser = SignatureParser(language)
+
+    def is_supported(self) -> bool:
+        """해당 언어에서 재정의 대상 탐색을 지원하는지 반환한다."""
+        return bool(self._class_types)
+
+    def is_method(self, node: Node) -> bool:
+        """노드가 재정의 대상을 찾을 수 있는 메서드 노드인지 확인한다."""
+        return node.type in self._method_types
+
+    def find_overridden(self, root: Node, method: Node) -> tuple[list[Node], list[str]]:
+        """메서드가 재정의하는 상위 타입 메서드들을 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            method: 변경된 메서드 노드
+
+        Returns:
+            (같은 파일의 재정의 대상 메서드 노드들, 파일 안에 선언이 없어 외부
+            resolver로 조회할 `상위타입.메서드` 한정 이름들) 튜플
+        """
+        name = self.declaration_name(method)
+        owner = self._enclosing_class(method)
+        if name is None or owner is None:
+            return [], []
+        classes = self._class_index(root)
+        arity = self._arity(method)
+        found: list[Node] = []
+        unresolved: list[str] = []
+        visited: set[str] = set()
+        queue = deque(self.supertype_names(owner))
+        while queue:
+            type_name = queue.popleft()
+            if type_name in visited:
+                continue
+            visited.add(type_name)
+            declaration = classes.get(type_name)
+            if declaration is None:
+                unresolved.append(f"{type_name}.{name}")
+                continue
+            overridden = self._find_method(declaration, name, arity)
+            if overridden is not None:
+                found.append(overridden)
+            else:
+                queue.extend(self.supertype_names(declaration))
+        return found, unresolved
+
+    def supertype_names(self, declaration: Node) -> list[str]:
+        """클래스/인터페이스의 상속 절에 나열된 상위 타입 이름들을 반환한다.
+
+        Args:
+            declaration: 클래스/인터페이스 노드
+
+        Returns:
+            타입 인자와 패키지 한정자를 뺀 상위 타입 이름들 (선언 순서)
+        """
+        names: list[str] = []
+        stack = [
+            child for child in declaration.children if child.type in self._clause_types
+        ]
+        stack.reverse()
+        while stack:
+            node = stack.pop()
+            if node.type in self.SKIPPED_TYPES:
+                continue
+            if node.type in self._supertype_types:
+                text = node_text(node).split("<", 1)[0].strip()
+                name = text.rsplit(".", 1)[-1]
+                if name and name not in names:
+                    names.append(name)
+                continue
+            stack.extend(reversed(node.named_children))
+        return names
+
+    def declaration_name(self, node: Node) -> str | None:
+        """클래스나 메서드 선언의 이름을 반환한다."""
+        name_node = node.child_by_field_name("name")
+        if name_node is None:
+            name_node = next(
+                (
+                    child
+                    for child in node.named_children
+                    if child.type in self.NAME_TYPES
+                ),
+                None,
+            )
+        if name_node is None:
+            return None
+        return node_text(name_node) or None
+
+    def signature_end(self, method: Node) -> Node | None:
+        """메서드 본문 노드를 반환한다 (추상/인터페이스 메서드면 None)."""
+        body = method.child_by_field_name("body")
+        if body is not None:
+            return body
+        return next(
+            (child for child in method.named_children if child.type in self.BODY_TYPES),
+            None,
+        )
+
+    def _find_method(self, declaration: Node, name: str, arity: int) -> Node | None:
+        """클래스 본문에서 이름과 파라미터 수가 같은 메서드를 찾는다."""
+        stack = list(reversed(declaration.named_children))
+        while stack:
+            node = stack.pop()
+            if node.type in self._class_types:
+                # 중첩 클래스의 메서드는 제외
+                continue
+            if node.type in self._method_types:
+                if self.declaration_name(node) == name and self._arity(node) == arity:
+                    return node
+                continue
+            stack.extend(reversed(node.named_children))
+        return None
+
+    def _enclosing_class(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 클래스/인터페이스 노드를 찾는다."""
+        current = node.parent
+        while current is not None:
+            if current.type in self._class_types:
+                return current
+            current = current.parent
+        return None
+
+    def _class_index(self, root: Node) -> dict[str, Node]:
+        """파일 안의 클래스/인터페이스 이름 → 첫 선언 노드 매핑을 만든다."""
+        index: dict[str, Node] = {}
+        stack = [root]
+        while stack:
+            node = stack.pop()
+            if node.type in self._class_types:
+                name = self.declaration_name(node)
+                if name is not None:
+                    index.setdefault(name, node)
+            stack.extend(reversed(node.named_children))
+        return index
+
+    def _arity(self, method: Node) -> int:
+        """메서드의 파라미터 수를 반환한다."""
+        signature = self._signature_parser.parse(method)
+        return len(signature.parameters) if signature is not None else 0
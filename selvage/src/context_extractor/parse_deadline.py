@@ -0,0 +1,45 @@
+"""ParseDeadline: tree-sitter 파싱을 시간 제한으로 중단시키는 진행 콜백."""
+
+from __future__ import annotations
+
+import time
+from collections.abc import Callable
+
+
+class ParseDeadline:
+    """Parser.parse의 progress_callback으로 전달하는 마감 시각.
+
+    tree-sitter는 파싱 도중 주기적으로 콜백을 호출하며, 콜백이 True를
+    반환하면 파싱을 즉시 중단한다. 따라서 결과를 버리는 것이 아니라
+    실제로 파싱 작업 자체가 멈춘다.
+    """
+
+    def __init__(
+        self,
+        timeout_seconds: float,
+        clock: Callable[[], float] = time.monotonic,
+    ) -> None:
+        """마감 시각 초기화.
+
+        Args:
+            timeout_seconds: 지금부터 파싱을 허용할 시간 (초)
+            clock: 현재 시각을 반환하는 함수 (테스트용)
+        """
+        self.timeout_seconds = timeout_seconds
+        self._clock = clock
+        self._deadline = clock() + timeout_seconds
+        self.expired = False
+
+    def __call__(self, offset: int, has_error: bool) -> bool:
+        """파싱 진행 중 호출되어 중단 여부를 반환한다.
+
+        Args:
+            offset: 현재까지 파싱한 바이트 오프셋
+            has_error: 지금까지 구문 오류가 발견되었는지 여부
+
+        Returns:
+            마감 시각이 지났으면 True (파싱 중단)
+        """
+        if not self.expired and self._clock() >= self._deadline:
+            self.expired = True
+        return self.expired
@@ -0,0 +1,215 @@
+"""PascalScopeResolver: Pascal/Delphi unit의 루틴, 타입, 섹션 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class PascalScopeResolver(ScopeResolver):
+    """Pascal AST에서 변경을 감싸는 procedure/function, 타입 선언을 찾는다.
+
+    변경 라인을 감싸는 가장 가까운 루틴 정의(`procedure`/`function`/메서드 본문)
+    전체를 반환하며, 중첩 루틴 안의 변경은 안쪽 루틴만 반환한다. `class`/
+    `record` 타입 선언 안의 변경은 타입 선언 하나를, 그 밖의 `const`/`var`
+    선언은 선언 하나를 반환한다. `TStockList.Add`처럼 implementation 섹션의
+    메서드 본문은 같은 파일의 클래스 선언 라인을, 클래스 밖 루틴과 타입은
+    `unit` 선언 라인을 컨테이너 헤더로 함께 포함한다. 블록이 interface와
+    implementation 중 어느 섹션에 있는지는 section()으로 계산한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 본문이 있는 루틴 정의 노드 타입
+    ROUTINE_TYPES = frozenset({"defProc"})
+
+    # 루틴 선언(헤더) 노드 타입 (interface 섹션, 클래스 본문, forward 선언)
+    ROUTINE_DECLARATION_TYPES = frozenset({"declProc"})
+
+    # 이름 있는 타입 선언 노드 타입 (`TStock = class ... end;` 등)
+    TYPE_TYPES = frozenset({"declType"})
+
+    # unit/program/library 노드 타입
+    UNIT_TYPES = frozenset({"unit", "program", "library"})
+
+    # unit을 나누는 섹션 노드 타입
+    SECTION_TYPES = frozenset({"interface", "implementation"})
+
+    # 바로 아래 노드를 블록으로 반환하는 노드 타입
+    CONTAINER_TYPES = frozenset({"root"}) | UNIT_TYPES | SECTION_TYPES
+
+    # 여러 선언을 묶는 선언부 노드 타입 (`type`, `const`, `var` 섹션)
+    DECLARATION_GROUP_TYPES = frozenset({"declTypes", "declConsts", "declVars"})
+
+    # 이름이 `TStockList.Add`처럼 점으로 한정된 노드 타입
+    QUALIFIED_NAME_TYPES = frozenset({"genericDot"})
+
+    # 이름으로 쓰는 노드 타입
+    NAME_TYPES = frozenset({"identifier", "moduleName", "genericTpl"}) | (
+        QUALIFIED_NAME_TYPES
+    )
+
+    # 컨테이너(클래스/unit) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = ROUTINE_TYPES | ROUTINE_DECLARATION_TYPES | TYPE_TYPES
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 루틴 정의, 타입 선언 또는 섹션 바로 아래 선언을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 루틴 정의나 타입 선언, 그렇지 않으면 unit/섹션 바로
+            아래의 선언 노드 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.ROUTINE_TYPES | self.TYPE_TYPES:
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.type in self.CONTAINER_TYPES and current.type not in (
+                self.CONTAINER_TYPES
+            ):
+                return current
+            if (
+                parent.type in self.DECLARATION_GROUP_TYPES
+                and parent.parent is not None
+                and parent.parent.type in self.CONTAINER_TYPES
+            ):
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """메서드 본문이면 클래스 선언을, 그 밖에는 unit 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            클래스 타입 선언 또는 unit/program/library 노드 (없으면 None)
+        """
+        class_name = self._class_name(node)
+        if class_name is not None:
+            declaration = self._find_type(node, class_name)
+            if declaration is not None:
+                return declaration
+        current = node.parent
+        while current is not None:
+            if current.type in self.UNIT_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """클래스 선언(`TStockList = class`)이나 unit 선언의 첫 라인을 반환한다."""
+        return node_text(container).split("\n", 1)[0].rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 클래스 또는 unit 이름을 반환한다."""
+        return self.name(container)
+
+    def name(self, node: Node) -> str | None:
+        """루틴, 타입, unit의 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            루틴은 클래스 이름을 뺀 이름(`TStockList.Add`면 `Add`), 타입과
+            unit은 선언된 이름 (그 밖의 노드는 None)
+        """
+        name_node = self._name_node(node)
+        if name_node is None:
+            return None
+        return node_text(name_node).rsplit(".", 1)[-1].strip() or None
+
+    def section(self, node: Node) -> str | None:
+        """노드가 속한 unit 섹션("interface" 또는 "implementation")을 반환한다."""
+        current = node.parent
+        while current is not None:
+            if current.type in self.SECTION_TYPES:
+                return current.type
+            current = current.parent
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 unit, 클래스, 바깥 루틴 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (예: ("Inventory", "TStockList"))
+        """
+        # 메서드 본문은 AST 조상 대신 이름의 클래스를 소속 선언으로 사용
+        class_name = self._class_name(node)
+        segments = [class_name] if class_name is not None else []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            if current.type in self.ROUTINE_TYPES | self.TYPE_TYPES | self.UNIT_TYPES:
+                segment = self.name(current)
+                if segment:
+                    segments.append(segment)
+                outer_class_name = self._class_name(current)
+                if outer_class_name is not None:
+                    segments.append(outer_class_name)
+            current = current.parent
+        # 루틴 이름과 바깥 클래스 이름을 한 번에 모을 수 있으므로 limit개로 자름
+        return tuple(reversed(segments[:limit]))
+
+    def _class_name(self, node: Node) -> str | None:
+        """`TStockList.Add` 메서드 본문이면 클래스 이름(`TStockList`)을 반환한다."""
+        if node.type not in self.ROUTINE_TYPES:
+            return None
+        name_node = self._name_node(node)
+        if name_node is None:
+            return None
+        parts = node_text(name_node).rsplit(".", 1)
+        return parts[0].strip() or None if len(parts) == 2 else None
+
+    def _find_type(self, node: Node, type_name: str) -> Node | None:
+        """같은 파일에서 이름이 type_name인 타입 선언을 찾는다."""
+        root = node
+        while root.parent is not None:
+            root = root.parent
+        stack = [root]
+        while stack:
+            current = stack.pop()
+            if current.type in self.TYPE_TYPES and self.name(current) == type_name:
+                return current
+            if current.type not in self.ROUTINE_TYPES:
+                stack.extend(reversed(current.named_children))
+        return None
+
+    def _name_node(self, node: Node) -> Node | None:
+        """루틴 헤더, 타입, unit 선언의 이름 노드를 반환한다."""
+        target = node
+        if node.type in self.ROUTINE_TYPES:
+            target = node.child_by_field_name("header") or next(
+                (
+                    child
+                    for child in node.named_children
+                    if child.type in self.ROUTINE_DECLARATION_TYPES
+                ),
+                node,
+            )
+        elif node.type not in (
+            self.ROUTINE_DECLARATION_TYPES | self.TYPE_TYPES | self.UNIT_TYPES
+        ):
+            return None
+        name_node = target.child_by_field_name("name")
+        if name_node is not None:
+            return name_node
+        return next(
+            (child for child in target.named_children if child.type in self.NAME_TYPES),
+            None,
+        )
@@ -0,0 +1,216 @@
+"""PatchContextExtractor: 저장소 없이 patch 파일만으로 컨텍스트를 추출하는 추출기."""
+
+from __future__ import annotations
+
+import logging
+import textwrap
+from dataclasses import replace
+from pathlib import Path
+
+from selvage.src.diff_parser import parse_patch
+from selvage.src.diff_parser.constants import DELETED_FILE_PLACEHOLDER
+from selvage.src.diff_parser.models import FileDiff, Hunk
+from selvage.src.diff_parser.utils.hunk_line_calculator import HunkLineCalculator
+from selvage.src.exceptions import UnsupportedLanguageError
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .extracted_file_context import ExtractedFileContext
+from .extraction_options import ExtractionOptions
+from .language_filter import LanguageFilter
+from .line_range import LineRange
+
+logger = logging.getLogger(__name__)
+
+
+class PatchContextExtractor:
+    """unified diff(.patch/.diff) 텍스트에서 파일별 컨텍스트를 추출한다.
+
+    저장소를 체크아웃하지 않고 patch만 리뷰할 때 사용하며, 언어는 patch의
+    `+++ b/...` 경로로 감지한다.
+
+    주요 특징:
+    - 새로 추가된 파일은 patch로 복원한 파일 전체에서 평소처럼 추출
+    - 그 밖의 파일은 hunk마다 수정 후 코드 조각만 파싱하고, 블록 라인 번호를
+      수정 후 파일 기준으로 옮겨 extraction_mode가 "fragment"인 결과로 반환
+    - 삭제된 파일은 수정 후 내용이 없으므로 결과에 포함하지 않음
+    - 지원하지 않는 언어, 언어 허용/거부 목록으로 거른 파일, 추출 오류는
+      파일별 결과의 status로 기록
+    """
+
+    def __init__(
+        self,
+        options: ExtractionOptions | None = None,
+        language_filter: LanguageFilter | None = None,
+    ) -> None:
+        """추출기 초기화.
+
+        Args:
+            options: 파일별 추출기에 전달할 추출 옵션
+            language_filter: 추출할 언어의 허용/거부 목록. 거른 파일은 status가
+                "language-filtered"인 결과로 기록한다 (None이면 모든 지원 언어를
+                추출).
+        """
+        self._options = options or ExtractionOptions()
+        self._language_filter = language_filter or LanguageFilter()
+        self._extractors: dict[str, ContextExtractor] = {}
+
+    def extract(self, patch_text: str) -> dict[str, ExtractedFileContext]:
+        """patch에 포함된 파일들의 컨텍스트를 추출한다.
+
+        Args:
+            patch_text: patch 파일 내용 (여러 파일의 변경을 포함할 수 있음)
+
+        Returns:
+            파일 경로 → 파일 단위 결과 딕셔너리 (patch에 나온 순서)
+
+        Raises:
+            DiffParsingError: patch가 비어있거나 유효하지 않은 형식인 경우
+        """
+        results: dict[str, ExtractedFileContext] = {}
+        for file_diff in parse_patch(patch_text).files:
+            if file_diff.file_content == DELETED_FILE_PLACEHOLDER:
+                continue
+            result = self._extract_file(file_diff)
+            result.rename = file_diff.rename
+            results[file_diff.filename] = result
+        return results
+
+    def _extract_file(self, file_diff: FileDiff) -> ExtractedFileContext:
+        """파일 하나의 컨텍스트를 추출하고 오류는 결과 status로 기록한다.
+
+        Args:
+            file_diff: patch에서 파싱한 파일 변경 사항
+
+        Returns:
+            파일 단위 결과
+        """
+        path = file_diff.filename
+        language = file_diff.language
+        if language not in ContextExtractor.get_supported_languages():
+            return ExtractedFileContext.skipped(
+                path, language, ExtractedFileContext.UNSUPPORTED_STATUS
+            )
+        if not self._language_filter.allows(language):
+            return ExtractedFileContext.skipped(
+                path, language, ExtractedFileContext.LANGUAGE_FILTERED_STATUS
+            )
+        try:
+            extractor = self._extractor(language)
+            if file_diff.has_full_content:
+                return extractor.extract_file_context(
+                    path,
+                    file_diff.file_content,
+                    file_diff.get_change_ranges(),
+                    file_diff.get_line_changes(),
+                )
+            return self._extract_fragments(extractor, path, language, file_diff.hunks)
+        except (UnsupportedLanguageError, ValueError) as e:
+            logger.warning(f"{path}: 컨텍스트 추출 실패: {e}")
+            return ExtractedFileContext.skipped(
+                path, language, ExtractedFileContext.ERROR_STATUS, str(e)
+            )
+
+    def _extract_fragments(
+        self, extractor: ContextExtractor, path: str, language: str, hunks: list[Hunk]
+    ) -> ExtractedFileContext:
+        """hunk마다 수정 후 코드 조각을 파싱해 블록들을 모은다.
+
+        클래스 본문 안의 hunk처럼 들여쓴 조각도 최상위 코드로 파싱되도록 공통
+        들여쓰기를 제거하므로 블록 텍스트의 들여쓰기는 원본보다 얕을 수 있다.
+        조각 안의 라인 번호로 추출한 뒤 블록들을 hunk 시작 라인만큼 옮기며,
+        조각 하나라도 제한 시간 초과나 구문 오류로 실패하면 그 결과를 반환한다.
+
+        Args:
+            extractor: 파일 언어의 추출기
+            path: 파일 경로
+            language: 파일 언어
+            hunks: 파일의 hunk들
+
+        Returns:
+            extraction_mode가 "fragment"인 파일 단위 결과
+        """
+        blocks: list[ContextBlock] = []
+        for hunk in hunks:
+            fragment = textwrap.dedent(hunk.get_modified_text())
+            if not fragment.strip():
+                continue
+            result = extractor.extract_file_context(
+                path,
+                fragment,
+                HunkLineCalculator.calculate_change_ranges(hunk.content, 1),
+                HunkLineCalculator.calculate_line_changes(hunk.content, 1),
+            )
+            if result.status != ExtractedFileContext.OK_STATUS:
+                return result
+            offset = hunk.start_line_modified - 1
+            blocks.extend(self._shift_block(block, offset) for block in result.blocks)
+        return ExtractedFileContext(
+            file_path=path,
+            language=language,
+            blocks=blocks,
+            extraction_mode=ExtractedFileContext.FRAGMENT_MODE,
+        )
+
+    @staticmethod
+    def _shift_block(block: ContextBlock, offset: int) -> ContextBlock:
+        """조각 기준 라인 번호를 수정 후 파일 기준으로 옮긴 블록을 반환한다."""
+        if offset == 0:
+            return block
+        return replace(
+            block,
+            line_range=PatchContextExtractor._shift_range(block.line_range, offset),
+            changed_lines=tuple(line + offset for line in block.changed_lines),
+            changed_fields=tuple(
+                replace(
+                    struct_field,
+                    line_range=PatchContextExtractor._shift_range(
+                        struct_field.line_range, offset
+                    ),
+                )
+                for struct_field in block.changed_fields
+            ),
+            changed_cases=tuple(
+                replace(
+                    case,
+                    line_range=PatchContextExtractor._shift_range(
+                        case.line_range, offset
+                    ),
+                )
+                for case in block.changed_cases
+            ),
+        )
+
+    @staticmethod
+    def _shift_range(line_range: LineRange, offset: int) -> LineRange:
+        """라인 범위를 offset만큼 옮긴다."""
+        return LineRange(line_range.start_line + offset, line_range.end_line + offset)
+
+    def _extractor(self, language: str) -> ContextExtractor:
+        """언어별 추출기를 재사용한다."""
+        if language not in self._extractors:
+            self._extractors[language] = ContextExtractor(language, self._options)
+        return self._extractors[language]
+
+
+def extract_patch(
+    patch_path: str | Path,
+    options: ExtractionOptions | None = None,
+    language_filter: LanguageFilter | None = None,
+) -> dict[str, ExtractedFileContext]:
+    """patch 파일 하나의 컨텍스트를 한 번에 추출하는 편의 함수.
+
+    Args:
+        patch_path: patch/diff 파일 경로
+        options: 파일별 추출기에 전달할 추출 옵션
+        language_filter: 추출할 언어의 허용/거부 목록 (None이면 모든 지원 언어)
+
+    Returns:
+        파일 경로 → 파일 단위 결과 딕셔너리
+
+    Raises:
+        OSError: patch 파일을 읽을 수 없는 경우
+        DiffParsingError: patch가 비어있거나 유효하지 않은 형식인 경우
+    """
+    patch_text = Path(patch_path).read_text(encoding="utf-8")
+    return PatchContextExtractor(options, language_filter).extract(patch_text)
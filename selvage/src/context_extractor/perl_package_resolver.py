@@ -0,0 +1,122 @@
+"""PerlPackageResolver: Perl 서브루틴의 이름과 감싸는 package를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class PerlPackageResolver(ScopeResolver):
+    """Perl AST에서 서브루틴/BEGIN·END 블록의 package 한정 이름을 계산한다.
+
+    Perl의 `package Foo;` 문은 블록을 감싸지 않고 다음 package 문까지의
+    코드에 적용되므로, 노드 위쪽의 형제 노드들을 거슬러 올라가며 가장 가까운
+    package 문을 찾는다. `package Foo { ... }` 블록 형태는 감싸는 조상으로
+    찾는다. 이름 없는 서브루틴은 Perl 관례에 따라 `Foo::__ANON__`으로 표시한다.
+    """
+
+    # package 선언 노드 타입
+    PACKAGE_TYPES = frozenset({"package_statement"})
+
+    # 이름 있는 서브루틴 노드 타입
+    SUBROUTINE_TYPES = frozenset(
+        {"subroutine_declaration_statement", "method_declaration_statement"}
+    )
+
+    # 이름 없는 서브루틴 노드 타입
+    ANONYMOUS_SUBROUTINE_TYPES = frozenset(
+        {"anonymous_subroutine_expression", "anonymous_method_expression"}
+    )
+
+    # BEGIN/END 등 phase 블록 노드 타입
+    PHASER_TYPES = frozenset({"phaser_statement"})
+
+    # 컨테이너(package) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = SUBROUTINE_TYPES | ANONYMOUS_SUBROUTINE_TYPES | PHASER_TYPES
+
+    # package 문이 없을 때의 기본 package
+    DEFAULT_PACKAGE = "main"
+
+    def name(self, node: Node) -> str | None:
+        """Perl 노드의 표시용 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            서브루틴은 `Package::name`, 이름 없는 서브루틴은
+            `Package::__ANON__`, phase 블록은 `Package::BEGIN` 형태의 이름,
+            package 문은 package 이름 (해당하지 않으면 None)
+        """
+        if node.type in self.PACKAGE_TYPES:
+            return self._package_name(node)
+        if node.type not in self.MEMBER_TYPES:
+            return None
+
+        local_name = self._local_name(node)
+        if local_name is None or "::" in local_name:
+            # `sub Foo::bar { ... }`처럼 이미 한정된 이름은 그대로 사용
+            return local_name
+        package = self.find_container(node)
+        package_name = self._package_name(package) if package is not None else None
+        return f"{package_name or self.DEFAULT_PACKAGE}::{local_name}"
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드에 적용되는 가장 가까운 package 문을 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            package_statement 노드 (package 문이 없으면 None)
+        """
+        current = node
+        while current is not None:
+            sibling = current.prev_named_sibling
+            while sibling is not None:
+                # 블록 형태 package는 블록이 끝나면 적용 범위도 끝난다
+                is_package = sibling.type in self.PACKAGE_TYPES
+                if is_package and not self._has_block(sibling):
+                    return sibling
+                sibling = sibling.prev_named_sibling
+            parent = current.parent
+            if parent is not None and parent.type in self.PACKAGE_TYPES:
+                return parent
+            current = parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """package 문의 선언 부분(`package Foo;` 또는 `package Foo {`)을 반환한다."""
+        text = node_text(container).split("\n", 1)[0]
+        if "{" in text:
+            return text.split("{", 1)[0].rstrip() + " {"
+        return text.rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 package 이름을 반환한다."""
+        return self._package_name(container)
+
+    def _local_name(self, node: Node) -> str | None:
+        """package를 제외한 서브루틴/phase 블록 이름을 반환한다."""
+        if node.type in self.ANONYMOUS_SUBROUTINE_TYPES:
+            return "__ANON__"
+        if node.type in self.PHASER_TYPES:
+            words = node_text(node).split(None, 1)
+            return words[0] if words else None
+        name_node = node.child_by_field_name("name")
+        return node_text(name_node) if name_node is not None else None
+
+    @staticmethod
+    def _has_block(package: Node) -> bool:
+        """`package Foo { ... }` 블록 형태의 package 문인지 확인한다."""
+        return any(child.type == "block" for child in package.named_children)
+
+    def _package_name(self, package: Node) -> str | None:
+        """package 문의 package 이름을 반환한다."""
+        name_node = package.child_by_field_name("name")
+        if name_node is not None:
+            return node_text(name_node)
+        words = node_text(package).replace(";", " ").replace("{", " ").split()
+        return words[1] if len(words) > 1 else None
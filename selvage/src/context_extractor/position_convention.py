@@ -0,0 +1,96 @@
+"""PositionConvention: 결과의 라인 번호/바이트 오프셋 표기 규칙."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class PositionConvention:
+    """추출 결과의 위치를 외부 도구가 기대하는 기준으로 바꾸는 표기 규칙.
+
+    추출기 내부와 ContextBlock의 필드는 항상 라인은 1-based이고 끝 라인을
+    포함(inclusive)하는 LineRange로, 바이트 오프셋은 tree-sitter와 같이
+    0-based이고 끝 오프셋을 포함하지 않는(exclusive) 값으로 유지한다. 이
+    규칙은 결과를 내보낼 때만 적용하며, 기본값은 현재 동작과 같은 1-based
+    inclusive 라인과 0-based exclusive 바이트이다.
+
+    예를 들어 파일의 첫 두 라인(LineRange(1, 2))은 기본 규칙에서 (1, 2),
+    0-based exclusive 규칙(line_base=0, inclusive_end_line=False)에서
+    (0, 2)로 표기된다.
+
+    Attributes:
+        line_base: 첫 라인의 번호 (0 또는 1)
+        inclusive_end_line: 끝 라인 번호가 범위의 마지막 라인(True)인지,
+            마지막 라인 다음 라인(False)인지 여부
+        byte_base: 첫 바이트의 오프셋 (0 또는 1)
+        inclusive_end_byte: 끝 바이트 오프셋이 범위의 마지막 바이트(True)인지,
+            마지막 바이트 다음 위치(False)인지 여부
+    """
+
+    line_base: int = 1
+    inclusive_end_line: bool = True
+    byte_base: int = 0
+    inclusive_end_byte: bool = False
+
+    def __post_init__(self) -> None:
+        """유효성 검증을 수행한다."""
+        if self.line_base not in (0, 1):
+            raise ValueError("line_base는 0 또는 1이어야 합니다")
+        if self.byte_base not in (0, 1):
+            raise ValueError("byte_base는 0 또는 1이어야 합니다")
+
+    def line(self, line: int) -> int:
+        """1-based 라인 번호를 이 규칙의 라인 번호로 바꾼다."""
+        return line - 1 + self.line_base
+
+    def line_span(self, line_range: LineRange) -> tuple[int, int]:
+        """LineRange를 이 규칙의 (시작 라인, 끝 라인)으로 바꾼다.
+
+        Args:
+            line_range: 1-based inclusive 라인 범위
+
+        Returns:
+            (시작 라인, 끝 라인) 튜플
+        """
+        end_line = self.line(line_range.end_line)
+        if not self.inclusive_end_line:
+            end_line += 1
+        return self.line(line_range.start_line), end_line
+
+    def byte_span(self, start_byte: int, end_byte: int) -> tuple[int, int]:
+        """0-based exclusive 바이트 범위를 이 규칙의 (시작, 끝) 오프셋으로 바꾼다.
+
+        Args:
+            start_byte: 시작 바이트 오프셋 (0-based)
+            end_byte: 마지막 바이트 다음 위치의 오프셋 (0-based)
+
+        Returns:
+            (시작 오프셋, 끝 오프셋) 튜플
+        """
+        end = end_byte + self.byte_base
+        if self.inclusive_end_byte:
+            end -= 1
+        return start_byte + self.byte_base, end
+
+    def to_line_range(self, start_line: int, end_line: int) -> LineRange:
+        """이 규칙으로 표기된 라인 범위를 1-based inclusive LineRange로 바꾼다.
+
+        변경 범위를 다른 도구의 표기 그대로 받아 추출기에 넘길 때 사용한다.
+
+        Args:
+            start_line: 이 규칙의 시작 라인 번호
+            end_line: 이 규칙의 끝 라인 번호
+
+        Returns:
+            1-based inclusive LineRange
+
+        Raises:
+            ValueError: 변환한 범위가 비었거나 1 미만의 라인을 가리키는 경우
+        """
+        if not self.inclusive_end_line:
+            end_line -= 1
+        offset = 1 - self.line_base
+        return LineRange(start_line + offset, end_line + offset)
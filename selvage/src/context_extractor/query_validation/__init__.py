@@ -0,0 +1,12 @@
+"""사용자 정의 tree-sitter 쿼리 검증 패키지."""
+
+from .query_issue import QueryIssue
+from .query_validation_result import QueryValidationResult
+from .query_validator import QueryValidator, validate_query
+
+__all__ = [
+    "QueryIssue",
+    "QueryValidationResult",
+    "QueryValidator",
+    "validate_query",
+]
@@ -0,0 +1,38 @@
+"""QueryIssue: 쿼리 검증에서 발견된 문제 하나."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class QueryIssue:
+    """쿼리 검증 오류 또는 경고.
+
+    Attributes:
+        kind: 문제 종류 (QueryIssue.* 상수 중 하나)
+        message: 사람이 읽을 수 있는 설명
+        offset: 쿼리 텍스트 내 문자 오프셋 (위치를 알 수 없으면 None)
+        row: 0-based 행 번호 (위치를 알 수 없으면 None)
+        column: 0-based 열 번호 (위치를 알 수 없으면 None)
+    """
+
+    UNKNOWN_LANGUAGE = "unknown_language"
+    SYNTAX_ERROR = "syntax_error"
+    UNKNOWN_NODE_TYPE = "unknown_node_type"
+    UNKNOWN_FIELD = "unknown_field"
+    BAD_CAPTURE_NAME = "bad_capture_name"
+    UNDEFINED_CAPTURE = "undefined_capture"
+    INVALID_QUERY = "invalid_query"
+    MISSING_CAPTURE = "missing_capture"
+
+    kind: str
+    message: str
+    offset: int | None = None
+    row: int | None = None
+    column: int | None = None
+
+    def __str__(self) -> str:
+        if self.row is None or self.column is None:
+            return f"[{self.kind}] {self.message}"
+        return f"[{self.kind}] {self.message} (row {self.row}, column {self.column})"
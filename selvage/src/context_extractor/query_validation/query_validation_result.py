@@ -0,0 +1,29 @@
+"""QueryValidationResult: 쿼리 검증 결과."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+
+from .query_issue import QueryIssue
+
+
+@dataclass
+class QueryValidationResult:
+    """쿼리 하나에 대한 검증 결과.
+
+    errors가 있으면 쿼리를 컴파일할 수 없거나 의도대로 동작하지 않으며,
+    warnings는 컴파일은 되지만 기대하는 캡처가 빠진 경우 등을 나타낸다.
+    """
+
+    language: str
+    errors: list[QueryIssue] = field(default_factory=list)
+    warnings: list[QueryIssue] = field(default_factory=list)
+
+    @property
+    def is_valid(self) -> bool:
+        """오류 없이 컴파일 가능한 쿼리인지 반환한다."""
+        return not self.errors
+
+    def error_kinds(self) -> list[str]:
+        """오류 종류들을 발견 순서대로 반환한다."""
+        return [issue.kind for issue in self.errors]
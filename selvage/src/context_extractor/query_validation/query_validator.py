@@ -0,0 +1,353 @@
+"""QueryValidator: 사용자 정의 tree-sitter 쿼리를 로드된 문법 기준으로 검증한다."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Sequence
+from dataclasses import dataclass
+
+from tree_sitter import Language, Query
+from tree_sitter_language_pack import get_language
+
+from ..context_extractor import ContextExtractor
+from .query_issue import QueryIssue
+from .query_validation_result import QueryValidationResult
+
+
+@dataclass
+class _CaptureReference:
+    """predicate에서 참조하는 캡처 이름과 위치."""
+
+    name: str
+    offset: int
+
+
+class QueryValidator:
+    """쿼리를 실행 전에 검증하여 위치 정보가 포함된 상세 오류를 반환한다.
+
+    노드 타입/필드 집합을 바꾸거나 문법을 등록하는 팀이 런타임 실패 전에
+    쿼리를 확인할 수 있도록, 먼저 쿼리 텍스트를 직접 훑어 괄호 짝, 노드 타입,
+    필드 이름, 캡처 이름을 검사한 뒤 tree-sitter로 실제 컴파일을 시도한다.
+    """
+
+    # 기대 캡처 이름 기본값
+    DEFAULT_EXPECTED_CAPTURES = ("symbol", "symbol.name")
+
+    # 문법에 없어도 쿼리에서 허용되는 특수 노드 이름
+    SPECIAL_NODE_NAMES = frozenset({"_", "ERROR", "MISSING"})
+
+    _IDENTIFIER_PATTERN = re.compile(r"[A-Za-z_][\w.\-/]*")
+    _CAPTURE_PATTERN = re.compile(r"@([^\s()\[\]\"]*)")
+    _VALID_CAPTURE_NAME_PATTERN = re.compile(r"^[A-Za-z_][\w.\-]*$")
+    _ERROR_OFFSET_PATTERN = re.compile(r"offset (\d+)")
+    _ERROR_POSITION_PATTERN = re.compile(r"row:? (\d+),? column:? (\d+)")
+    _ERROR_KIND_KEYWORDS = (
+        ("node type", QueryIssue.UNKNOWN_NODE_TYPE),
+        ("field", QueryIssue.UNKNOWN_FIELD),
+        ("capture", QueryIssue.BAD_CAPTURE_NAME),
+        ("syntax", QueryIssue.SYNTAX_ERROR),
+    )
+
+    def validate(
+        self,
+        language: str,
+        query_text: str,
+        expected_captures: Sequence[str] | None = None,
+    ) -> QueryValidationResult:
+        """쿼리를 검증한다.
+
+        Args:
+            language: 언어 이름 (ContextExtractor 언어 이름 또는 tree-sitter 문법 이름)
+            query_text: 검증할 쿼리 텍스트
+            expected_captures: 쿼리에 있어야 하는 캡처 이름들
+                (기본값: DEFAULT_EXPECTED_CAPTURES, 빈 시퀀스면 검사하지 않음)
+
+        Returns:
+            오류와 경고 목록이 담긴 검증 결과
+        """
+        result = QueryValidationResult(language=language)
+        grammar_name = ContextExtractor.LANGUAGE_GRAMMAR_NAMES.get(language, language)
+        try:
+            grammar = get_language(grammar_name)
+        except Exception as e:
+            result.errors.append(
+                QueryIssue(
+                    QueryIssue.UNKNOWN_LANGUAGE,
+                    f"언어 '{language}'의 문법을 불러올 수 없습니다: {e}",
+                )
+            )
+            return result
+
+        captures = self._scan(grammar, query_text, result.errors)
+
+        if not result.errors:
+            try:
+                Query(grammar, query_text)
+            except Exception as e:
+                result.errors.append(self._issue_from_exception(query_text, e))
+
+        if expected_captures is None:
+            expected_captures = self.DEFAULT_EXPECTED_CAPTURES
+        for capture_name in expected_captures:
+            if capture_name not in captures:
+                result.warnings.append(
+                    QueryIssue(
+                        QueryIssue.MISSING_CAPTURE,
+                        f"기대하는 캡처 '@{capture_name}'가 쿼리에 없습니다",
+                    )
+                )
+        return result
+
+    def _scan(
+        self, grammar: Language, query_text: str, errors: list[QueryIssue]
+    ) -> set[str]:
+        """쿼리 텍스트를 훑어 정적 오류를 수집하고 정의된 캡처 이름을 반환한다.
+
+        Args:
+            grammar: 로드된 문법
+            query_text: 쿼리 텍스트
+            errors: 발견한 오류를 추가할 리스트
+
+        Returns:
+            패턴에서 정의된 캡처 이름 집합
+        """
+        # (여는 괄호 문자, 오프셋, predicate 여부) 스택
+        stack: list[tuple[str, int, bool]] = []
+        defined_captures: set[str] = set()
+        references: list[_CaptureReference] = []
+        index = 0
+        length = len(query_text)
+
+        while index < length:
+            char = query_text[index]
+            in_predicate = bool(stack) and stack[-1][2]
+
+            if char == ";":
+                newline = query_text.find("\n", index)
+                index = length if newline == -1 else newline + 1
+                continue
+
+            if char == '"':
+                end = self._find_string_end(query_text, index)
+                if end is None:
+                    errors.append(
+                        self._issue(
+                            query_text,
+                            QueryIssue.SYNTAX_ERROR,
+                            "닫히지 않은 문자열입니다",
+                            index,
+                        )
+                    )
+                    return defined_captures
+                if not in_predicate:
+                    self._check_anonymous_node(grammar, query_text, index, end, errors)
+                index = end + 1
+                continue
+
+            if char in "([":
+                is_predicate = False
+                if char == "(":
+                    next_index = self._skip_whitespace(query_text, index + 1)
+                    name_match = self._IDENTIFIER_PATTERN.match(query_text, next_index)
+                    if query_text.startswith("#", next_index):
+                        is_predicate = True
+                    elif name_match is not None and not in_predicate:
+                        self._check_node_type(grammar, query_text, name_match, errors)
+                stack.append((char, index, is_predicate or in_predicate))
+                index += 1
+                continue
+
+            if char in ")]":
+                expected_open = "(" if char == ")" else "["
+                if not stack or stack[-1][0] != expected_open:
+                    errors.append(
+                        self._issue(
+                            query_text,
+                            QueryIssue.SYNTAX_ERROR,
+                            f"짝이 맞지 않는 '{char}'입니다",
+                            index,
+                        )
+                    )
+                    return defined_captures
+                stack.pop()
+                index += 1
+                continue
+
+            if char == "@":
+                capture_match = self._CAPTURE_PATTERN.match(query_text, index)
+                name = capture_match.group(1) if capture_match else ""
+                if not self._VALID_CAPTURE_NAME_PATTERN.match(name):
+                    errors.append(
+                        self._issue(
+                            query_text,
+                            QueryIssue.BAD_CAPTURE_NAME,
+                            f"잘못된 캡처 이름 '@{name}'입니다",
+                            index,
+                        )
+                    )
+                elif in_predicate:
+                    references.append(_CaptureReference(name, index))
+                else:
+                    defined_captures.add(name)
+                index += 1 + len(name)
+                continue
+
+            identifier_match = self._IDENTIFIER_PATTERN.match(query_text, index)
+            if identifier_match is not None:
+                end = identifier_match.end()
+                if (
+                    not in_predicate
+                    and end < length
+                    and query_text[end] == ":"
+                    and not grammar.field_id_for_name(identifier_match.group(0))
+                ):
+                    errors.append(
+                        self._issue(
+                            query_text,
+                            QueryIssue.UNKNOWN_FIELD,
+                            f"알 수 없는 필드 이름 '{identifier_match.group(0)}'입니다",
+                            index,
+                        )
+                    )
+                index = end
+                continue
+
+            index += 1
+
+        if stack:
+            open_char, open_offset, _ = stack[-1]
+            errors.append(
+                self._issue(
+                    query_text,
+                    QueryIssue.SYNTAX_ERROR,
+                    f"닫히지 않은 '{open_char}'입니다",
+                    open_offset,
+                )
+            )
+
+        for reference in references:
+            if reference.name not in defined_captures:
+                errors.append(
+                    self._issue(
+                        query_text,
+                        QueryIssue.UNDEFINED_CAPTURE,
+                        f"predicate가 정의되지 않은 캡처 '@{reference.name}'를 "
+                        "참조합니다",
+                        reference.offset,
+                    )
+                )
+        return defined_captures
+
+    def _check_node_type(
+        self,
+        grammar: Language,
+        query_text: str,
+        name_match: re.Match[str],
+        errors: list[QueryIssue],
+    ) -> None:
+        """`(node_type` 형태의 이름 있는 노드 타입이 문법에 있는지 검사한다.
+
+        `(supertype/subtype)` 형태는 각 이름을 모두 검사한다.
+        """
+        for name in name_match.group(0).split("/"):
+            if name in self.SPECIAL_NODE_NAMES:
+                continue
+            if not grammar.id_for_node_kind(name, True):
+                errors.append(
+                    self._issue(
+                        query_text,
+                        QueryIssue.UNKNOWN_NODE_TYPE,
+                        f"알 수 없는 노드 타입 '{name}'입니다",
+                        name_match.start(),
+                    )
+                )
+
+    def _check_anonymous_node(
+        self,
+        grammar: Language,
+        query_text: str,
+        start: int,
+        end: int,
+        errors: list[QueryIssue],
+    ) -> None:
+        """패턴 안의 `"keyword"` 익명 노드가 문법에 있는지 검사한다."""
+        literal = query_text[start + 1 : end].replace('\\"', '"').replace("\\\\", "\\")
+        if literal and not grammar.id_for_node_kind(literal, False):
+            errors.append(
+                self._issue(
+                    query_text,
+                    QueryIssue.UNKNOWN_NODE_TYPE,
+                    f'알 수 없는 익명 노드 "{literal}"입니다',
+                    start,
+                )
+            )
+
+    def _issue_from_exception(self, query_text: str, error: Exception) -> QueryIssue:
+        """tree-sitter 쿼리 컴파일 예외를 위치 정보가 포함된 QueryIssue로 변환한다."""
+        message = str(error)
+        lowered = message.lower()
+        kind = next(
+            (kind for keyword, kind in self._ERROR_KIND_KEYWORDS if keyword in lowered),
+            QueryIssue.INVALID_QUERY,
+        )
+
+        offset_match = self._ERROR_OFFSET_PATTERN.search(message)
+        if offset_match is not None:
+            return self._issue(query_text, kind, message, int(offset_match.group(1)))
+
+        position_match = self._ERROR_POSITION_PATTERN.search(message)
+        if position_match is not None:
+            row, column = (int(value) for value in position_match.groups())
+            lines = query_text.split("\n")
+            offset = sum(len(line) + 1 for line in lines[:row]) + column
+            return QueryIssue(kind, message, offset, row, column)
+        return QueryIssue(kind, message)
+
+    def _issue(
+        self, query_text: str, kind: str, message: str, offset: int
+    ) -> QueryIssue:
+        """오프셋으로부터 행/열을 계산해 QueryIssue를 만든다."""
+        offset = min(max(offset, 0), len(query_text))
+        row = query_text.count("\n", 0, offset)
+        column = offset - (query_text.rfind("\n", 0, offset) + 1)
+        return QueryIssue(kind, message, offset, row, column)
+
+    @staticmethod
+    def _skip_whitespace(text: str, index: int) -> int:
+        """공백을 건너뛴 다음 위치를 반환한다."""
+        while index < len(text) and text[index].isspace():
+            index += 1
+        return index
+
+    @staticmethod
+    def _find_string_end(text: str, start: int) -> int | None:
+        """start 위치의 문자열 리터럴을 닫는 따옴표 위치를 반환한다."""
+        index = start + 1
+        while index < len(text):
+            if text[index] == "\\":
+                index += 2
+                continue
+            if text[index] == '"':
+                return index
+            if text[index] == "\n":
+                return None
+            index += 1
+        return None
+
+
+def validate_query(
+    language: str,
+    query_text: str,
+    expected_captures: Sequence[str] | None = None,
+) -> QueryValidationResult:
+    """사용자 정의 쿼리를 로드된 문법 기준으로 검증한다.
+
+    Args:
+        language: 언어 이름
+        query_text: 검증할 쿼리 텍스트
+        expected_captures: 쿼리에 있어야 하는 캡처 이름들
+
+    Returns:
+        오류와 경고 목록이 담긴 검증 결과
+    """
+    return QueryValidator().validate(language, query_text, expected_captures)
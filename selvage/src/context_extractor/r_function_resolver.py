@@ -0,0 +1,262 @@
+"""RFunctionResolver: R 함수 정의와 클래스 메서드의 이름을 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class RFunctionResolver(ScopeResolver):
+    """R AST에서 변경을 감싸는 이름 있는 함수 정의를 찾고 이름을 계산한다.
+
+    R 함수는 `foo <- function(x) { ... }`처럼 익명 함수를 변수에 대입해 정의하므로
+    대입문 전체를 함수 블록으로 사용한다. `lapply(xs, function(x) ...)`나 파이프
+    (`%>%`, `|>`) 안의 익명 함수는 별도 블록으로 보지 않고 감싸는 이름 있는 함수로
+    올라간다. R6(`R6Class`)/Reference class(`setRefClass`)의 메서드는
+    `Account$deposit`, S4 `setMethod("area", "Circle", ...)`는 `Circle$area`처럼
+    클래스 이름으로 한정한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 함수 정의 노드 타입
+    FUNCTION_TYPES = frozenset({"function_definition"})
+
+    # 대입 연산자 (`->`, `->>`는 우변이 대입 대상)
+    LEFT_ASSIGNMENT_OPERATORS = frozenset({"<-", "<<-", "="})
+    RIGHT_ASSIGNMENT_OPERATORS = frozenset({"->", "->>"})
+
+    # 메서드 목록(list)을 인자로 받는 클래스 생성 함수
+    CLASS_GENERATOR_FUNCTIONS = frozenset({"R6Class", "setRefClass"})
+
+    # 함수를 인자로 받아 S4 클래스에 메서드를 등록하는 함수
+    S4_METHOD_FUNCTIONS = frozenset({"setMethod", "setReplaceMethod", "setValidity"})
+
+    # 함수를 인자로 받아 S4 generic을 정의하는 함수
+    S4_GENERIC_FUNCTIONS = frozenset({"setGeneric"})
+
+    # 호출 전체를 함수 블록으로 사용하는 S4 등록 함수
+    S4_REGISTRATION_FUNCTIONS = S4_METHOD_FUNCTIONS | S4_GENERIC_FUNCTIONS
+
+    # S4 시그니처 문자열을 감싸는 호출 (`signature("Circle")`, `c("A", "B")`)
+    SIGNATURE_FUNCTIONS = frozenset({"signature", "c"})
+
+    # 파일 루트 노드 타입
+    ROOT_TYPE = "program"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 이름 있는 함수 정의 또는 최상위 문장을 찾는다.
+
+        익명 함수(`lapply`, 파이프 안의 `function(x)`/`\\(x)`)는 건너뛰고 감싸는
+        이름 있는 함수를 반환하며, 함수 밖의 변경은 파이프 체인 전체처럼 변경을
+        감싸는 최상위 문장을 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            함수 정의 블록 또는 최상위 문장 노드 (루트 노드면 None)
+        """
+        definition = self.find_function(node)
+        if definition is not None:
+            return definition
+        current = node
+        while current.parent is not None and current.parent.type != self.ROOT_TYPE:
+            current = current.parent
+        return current if current.type != self.ROOT_TYPE else None
+
+    def find_function(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 이름 있는 함수 정의 블록을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            함수 대입문, 클래스 메서드 인자 또는 S4 등록 호출 노드
+            (이름 있는 함수 밖이면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.FUNCTION_TYPES:
+                definition = self.definition(current)
+                if definition is not None:
+                    return definition
+            current = current.parent
+        return None
+
+    def definition(self, function: Node) -> Node | None:
+        """함수 정의 노드를 이름과 함께 감싸는 정의 블록을 반환한다.
+
+        Args:
+            function: function_definition 노드
+
+        Returns:
+            `name <- function` 대입문, 클래스 메서드 목록의 `name = function`
+            인자, 또는 S4 등록 호출 노드 (익명 함수면 None)
+        """
+        parent = function.parent
+        if parent is None:
+            return None
+        if parent.type == "binary_operator" and self._assigned_name(parent):
+            return parent
+        if parent.type != "argument":
+            return None
+        call = self._enclosing_call(parent)
+        if call is None:
+            return None
+        if self._call_name(call) in self.S4_REGISTRATION_FUNCTIONS:
+            return call
+        if parent.child_by_field_name("name") is not None:
+            if self._class_generator_call(call) is not None:
+                return parent
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """정의 블록 노드의 표시용 이름을 반환한다.
+
+        Args:
+            node: find_function이 반환한 정의 블록 노드
+
+        Returns:
+            함수 이름, `Class$method` 형태의 메서드 이름 또는 S4 generic 이름
+            (정의 블록이 아니면 None)
+        """
+        if node.type == "binary_operator":
+            return self._assigned_name(node)
+        if node.type == "argument":
+            method_name = node_text(node.child_by_field_name("name"))
+            list_call = self._enclosing_call(node)
+            generator = (
+                self._class_generator_call(list_call) if list_call is not None else None
+            )
+            class_name = self._class_name(generator) if generator is not None else None
+            return f"{class_name}${method_name}" if class_name else method_name or None
+        if node.type == "call":
+            return self._s4_name(node)
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """정의 블록을 감싸는 이름 있는 함수 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 정의 블록 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 함수 이름 튜플 (예: ("build_report",))
+        """
+        segments: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(segments) < limit):
+            definition = self.find_function(current)
+            if definition is None:
+                break
+            segment = self.name(definition)
+            if segment:
+                segments.append(segment)
+            current = definition.parent
+        return tuple(reversed(segments))
+
+    def _assigned_name(self, assignment: Node) -> str | None:
+        """함수가 대입되는 변수 이름을 반환한다 (함수 대입이 아니면 None)."""
+        operator = assignment.child_by_field_name("operator")
+        operator_text = node_text(operator)
+        lhs = assignment.child_by_field_name("lhs")
+        rhs = assignment.child_by_field_name("rhs")
+        if operator_text in self.LEFT_ASSIGNMENT_OPERATORS:
+            target, value = lhs, rhs
+        elif operator_text in self.RIGHT_ASSIGNMENT_OPERATORS:
+            target, value = rhs, lhs
+        else:
+            return None
+        if value is None or value.type not in self.FUNCTION_TYPES:
+            return None
+        return node_text(target).strip("`") or None
+
+    def _s4_name(self, call: Node) -> str | None:
+        """S4 등록 호출의 이름(`Class$generic` 또는 generic)을 반환한다."""
+        function_name = self._call_name(call)
+        strings = self._string_arguments(call)
+        if function_name in self.S4_GENERIC_FUNCTIONS:
+            return strings[0] if strings else None
+        if function_name == "setValidity":
+            return f"{strings[0]}$validity" if strings else None
+        if function_name in self.S4_METHOD_FUNCTIONS and strings:
+            generic = strings[0]
+            return f"{strings[1]}${generic}" if len(strings) > 1 else generic
+        return None
+
+    def _class_generator_call(self, list_call: Node) -> Node | None:
+        """`list(...)` 호출이 R6Class/setRefClass의 메서드 목록이면 생성 호출을 반환한다."""
+        if self._call_name(list_call) != "list":
+            return None
+        argument = list_call.parent
+        if argument is None or argument.type != "argument":
+            return None
+        generator = self._enclosing_call(argument)
+        if generator is None:
+            return None
+        if self._call_name(generator) not in self.CLASS_GENERATOR_FUNCTIONS:
+            return None
+        return generator
+
+    def _class_name(self, generator: Node) -> str | None:
+        """클래스 생성 호출의 클래스 이름을 반환한다.
+
+        첫 번째 문자열 인자(`R6Class("Account", ...)`)를 우선 사용하고, 없으면
+        생성 결과가 대입되는 변수 이름을 사용한다.
+        """
+        strings = self._string_arguments(generator)
+        if strings:
+            return strings[0]
+        parent = generator.parent
+        if parent is not None and parent.type == "binary_operator":
+            lhs = parent.child_by_field_name("lhs")
+            return node_text(lhs) or None
+        return None
+
+    def _string_arguments(self, call: Node) -> list[str]:
+        """호출의 문자열 인자들을 순서대로 반환한다.
+
+        `signature("Circle")`처럼 시그니처 호출에 담긴 첫 번째 문자열도 포함한다.
+        """
+        arguments = call.child_by_field_name("arguments")
+        if arguments is None:
+            return []
+        values: list[str] = []
+        for argument in arguments.named_children:
+            value = argument.child_by_field_name("value")
+            if value is None:
+                continue
+            if value.type == "string":
+                values.append(self._string_value(value))
+            elif (
+                value.type == "call"
+                and self._call_name(value) in self.SIGNATURE_FUNCTIONS
+            ):
+                nested = self._string_arguments(value)
+                if nested:
+                    values.append(nested[0])
+        return values
+
+    def _enclosing_call(self, argument: Node) -> Node | None:
+        """인자 노드가 속한 호출 노드를 반환한다."""
+        arguments = argument.parent
+        if arguments is None or arguments.type != "arguments":
+            return None
+        call = arguments.parent
+        return call if call is not None and call.type == "call" else None
+
+    def _call_name(self, call: Node) -> str:
+        """호출되는 함수 이름을 반환한다 (`pkg::fn`은 fn)."""
+        function = call.child_by_field_name("function")
+        return node_text(function).rsplit("::", 1)[-1]
+
+    def _string_value(self, string: Node) -> str:
+        """문자열 리터럴의 따옴표를 제외한 내용을 반환한다."""
+        return node_text(string)[1:-1]
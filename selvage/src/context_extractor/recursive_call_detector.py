@@ -0,0 +1,119 @@
+"""RecursiveCallDetector: 함수가 자기 자신을 직접 호출하는지 판별하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+
+from tree_sitter import Node
+
+from .signature_parser import SignatureParser
+from .text_lines import node_text
+
+
+class RecursiveCallDetector:
+    """함수 본문 안에 자기 이름과 같은 이름의 호출이 있는지로 직접 재귀를 판별한다.
+
+    호출 그래프를 분석하지 않는 단순한 이름 비교이므로, 메서드 호출은
+    receiver와 관계없이 마지막 이름(`c.Add`, `self.add`, `this.add`의 `add`)만
+    비교한다. 서로를 호출하는 상호 재귀(`isEven` ↔ `isOdd`)와 변수에 담긴
+    클로저의 자기 호출은 재귀로 보지 않는다.
+    """
+
+    # 언어별 함수 호출 노드 타입
+    LANGUAGE_CALL_TYPES = {
+        "python": frozenset({"call"}),
+        "javascript": frozenset({"call_expression"}),
+        "typescript": frozenset({"call_expression"}),
+        "java": frozenset({"method_invocation"}),
+        "kotlin": frozenset({"call_expression"}),
+        "go": frozenset({"call_expression"}),
+    }
+
+    # 호출 노드에서 호출 대상을 담는 필드 이름 (없으면 첫 자식 노드)
+    CALLEE_FIELDS = ("function", "name")
+
+    # 멤버 접근 노드에서 멤버 이름을 담는 필드 이름
+    MEMBER_NAME_FIELDS = ("field", "property", "attribute", "name")
+
+    def __init__(self, language: str) -> None:
+        """RecursiveCallDetector를 초기화한다.
+
+        Args:
+            language: 언어 이름
+        """
+        self._function_types = SignatureParser.LANGUAGE_FUNCTION_TYPES.get(
+            language, frozenset()
+        )
+        self._call_types = self.LANGUAGE_CALL_TYPES.get(language, frozenset())
+
+    def is_recursive(self, node: Node, name: str | None) -> bool:
+        """함수 노드가 본문에서 자기 이름을 직접 호출하는지 확인한다.
+
+        Args:
+            node: 함수/메서드 노드 (Python decorated_definition 포함)
+            name: 함수 이름 (없으면 None)
+
+        Returns:
+            직접 재귀 호출이 있으면 True (함수가 아니거나 이름이 없으면 False)
+        """
+        if not name or not self.is_function(node):
+            return False
+        stack = list(node.children)
+        while stack:
+            current = stack.pop()
+            if self.is_call(current) and self.callee_name(current) == name:
+                return True
+            stack.extend(current.children)
+        return False
+
+    def is_call(self, node: Node) -> bool:
+        """노드가 언어의 함수/메서드 호출 노드인지 확인한다."""
+        return node.type in self._call_types
+
+    def is_function(self, node: Node) -> bool:
+        """노드가 재귀/호출 대상이 될 수 있는 함수/메서드 선언 노드인지 확인한다."""
+        if node.type == "decorated_definition":
+            node = node.child_by_field_name("definition") or node
+        return node.type in self._function_types
+
+    def callee_names(self, nodes: Iterable[Node]) -> set[str]:
+        """노드들 안의 호출이 호출하는 함수/메서드의 마지막 이름들을 반환한다."""
+        names: set[str] = set()
+        stack = list(nodes)
+        while stack:
+            current = stack.pop()
+            if self.is_call(current):
+                name = self.callee_name(current)
+                if name:
+                    names.add(name)
+            stack.extend(current.children)
+        return names
+
+    def callee_name(self, call: Node) -> str | None:
+        """호출 노드가 호출하는 함수/메서드의 마지막 이름을 반환한다.
+
+        Args:
+            call: 호출 노드
+
+        Returns:
+            호출 대상 이름 (`c.Add(1)`이면 `Add`, 알 수 없으면 None)
+        """
+        callee = self._first_field(call, self.CALLEE_FIELDS)
+        if callee is None and call.named_children:
+            callee = call.named_children[0]
+        # 멤버 접근(`c.Add`, `self.add`)은 멤버 이름까지 내려간다
+        while callee is not None and callee.named_child_count:
+            member = self._first_field(callee, self.MEMBER_NAME_FIELDS)
+            callee = member if member is not None else callee.named_children[-1]
+        if callee is None or callee.text is None:
+            return None
+        return node_text(callee)
+
+    @staticmethod
+    def _first_field(node: Node, field_names: tuple[str, ...]) -> Node | None:
+        """주어진 필드 이름 중 처음으로 값이 있는 필드의 자식 노드를 반환한다."""
+        for field_name in field_names:
+            child = node.child_by_field_name(field_name)
+            if child is not None:
+                return child
+        return None
@@ -0,0 +1,42 @@
+"""RenderOptions: 컨텍스트 렌더링 옵션."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class RenderOptions:
+    """ContextRenderer의 출력 형식을 제어하는 옵션.
+
+    Attributes:
+        include_line_numbers: 각 라인 앞에 원본 파일 기준 라인 번호 gutter 표시 여부
+            (너비는 파일 안 블록들의 가장 큰 라인 번호에 맞춤)
+        max_chars: 전체 문서의 최대 문자 수 (None이면 제한 없음)
+        include_dependencies: 의존성(import) 블록 포함 여부
+        tab_width: 선행 들여쓰기를 파일의 감지된 단위(탭/공백)로 통일해 표시할 때
+            탭 한 칸의 너비. 표시 전용이며 블록 텍스트와 라인 범위는 바뀌지
+            않는다 (None이면 원본 그대로 표시)
+        group_by_declaration: 파일 안의 블록들을 최상위 선언(클래스, 구조체 등)
+            아래로 묶어 표시할지 여부. 블록의 scope_path 첫 이름을 소속 선언으로
+            보며, 소속 선언이 없는 블록은 최상위 블록으로 표시한다 (False면
+            라인 순서의 평면 목록)
+        share_imports: 여러 파일에 똑같이 나오는 import 라인을 문서 맨 앞의
+            공유 import 섹션으로 한 번만 표시하고 파일별 의존성 블록에서는
+            지울지 여부. 공유 섹션에는 라인별로 사용하는 파일들을 표시한다
+            (include_dependencies가 켜진 경우에만 적용)
+    """
+
+    include_line_numbers: bool = False
+    max_chars: int | None = None
+    include_dependencies: bool = True
+    tab_width: int | None = None
+    group_by_declaration: bool = False
+    share_imports: bool = False
+
+    def __post_init__(self) -> None:
+        """유효성 검증을 수행한다."""
+        if self.max_chars is not None and self.max_chars <= 0:
+            raise ValueError("max_chars는 1 이상이어야 합니다")
+        if self.tab_width is not None and self.tab_width <= 0:
+            raise ValueError("tab_width는 1 이상이어야 합니다")
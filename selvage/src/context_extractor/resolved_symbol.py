@@ -0,0 +1,24 @@
+"""ResolvedSymbol: 다른 파일에서 찾은 심볼 정의를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class ResolvedSymbol:
+    """SymbolResolver가 저장소 색인에서 찾아 반환하는 심볼 정의.
+
+    Attributes:
+        qualified_name: 조회에 사용된 (패키지 등으로 한정된) 심볼 이름
+        file_path: 정의가 있는 파일 경로
+        content: 정의 코드 텍스트
+        line_range: 정의 파일 기준 라인 범위
+    """
+
+    qualified_name: str
+    file_path: str
+    content: str
+    line_range: LineRange
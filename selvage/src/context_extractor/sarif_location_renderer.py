@@ -0,0 +1,204 @@
+"""SarifLocationRenderer: 추출된 심볼 위치를 SARIF location 객체로 직렬화하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Mapping, Sequence
+from pathlib import PurePath
+from typing import Any
+from urllib.parse import quote
+
+from .context_block import ContextBlock
+from .extracted_file_context import ExtractedFileContext
+from .text_lines import split_lines
+
+
+class SarifLocationRenderer:
+    """파일별 추출 결과의 심볼 위치를 SARIF 2.1.0 `location` 객체들로 변환한다.
+
+    리뷰 결과를 SARIF로 수집하는 도구가 지적 사항을 심볼의 정확한 범위에
+    붙일 수 있도록, 각 컨텍스트 블록을 `physicalLocation`(파일 URI와
+    `region`)으로 표현한다. 블록의 라인 범위만 직렬화하며 다시 파싱하지 않는다.
+
+    주요 특징:
+    - region의 startLine/endLine은 블록의 line_range (1-based)
+    - 파일 내용이 주어지면 startColumn/endColumn(1-based, endColumn은 마지막
+      문자 다음 위치)을 SARIF 기본값대로 UTF-16 코드 유닛으로 계산하고,
+      byteOffset/byteLength는 UTF-8 바이트 기준으로 계산
+    - 블록이 라인 중간에서 시작/끝나면(`f := func() {` 안의 함수 리터럴 등)
+      블록 텍스트의 첫/마지막 줄로 위치를 찾고, 텍스트가 원문과 다르면
+      (생략 표시, 익명화 등) 라인의 들여쓰기 뒤부터 끝까지를 사용
+    - 이름 있는 블록은 logicalLocations에 정규화된 이름과 종류를 기록
+    - 변경 상태가 분류된 블록은 properties에 hasAdditions/hasDeletions를 기록
+    - Go 동시성 구문을 사용하는 블록은 properties에
+      usesGoroutine/usesChannel/usesMutex를 기록
+    - 의존성 블록은 제외하며, 다른 파일에서 가져온 블록은 source_path를 사용
+    """
+
+    # 정규화된 이름의 구분자
+    NAME_SEPARATOR = "."
+
+    def render(
+        self,
+        results: Sequence[ExtractedFileContext],
+        file_contents: Mapping[str, str] | None = None,
+    ) -> list[dict[str, Any]]:
+        """파일별 추출 결과들을 SARIF location 객체 리스트로 변환한다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+            file_contents: 파일 경로 → 파일 내용 (컬럼과 바이트 오프셋 계산용,
+                없는 파일은 라인 범위만 기록)
+
+        Returns:
+            블록마다 하나인 SARIF location 딕셔너리 리스트 (파일 입력 순서,
+            파일 안에서는 라인 순서)
+        """
+        contents = file_contents or {}
+        lines_cache: dict[str, list[str]] = {}
+        locations: list[dict[str, Any]] = []
+        for result in results:
+            for block in result.context_blocks:
+                path = block.source_path or result.file_path
+                if path not in lines_cache and path in contents:
+                    lines_cache[path] = split_lines(contents[path])
+                locations.append(self.location(block, path, lines_cache.get(path)))
+        return locations
+
+    def location(
+        self, block: ContextBlock, path: str, lines: Sequence[str] | None = None
+    ) -> dict[str, Any]:
+        """블록 하나의 SARIF location 객체를 만든다.
+
+        Args:
+            block: 직렬화할 컨텍스트 블록
+            path: 블록이 있는 파일 경로
+            lines: 파일 라인들 (없으면 region에 라인 범위만 기록)
+
+        Returns:
+            physicalLocation(과 이름 있는 블록의 logicalLocations, 변경 상태가
+            분류되었거나 동시성 구문을 사용하는 블록의 properties)을 담은 딕셔너리
+        """
+        location: dict[str, Any] = {
+            "physicalLocation": {
+                "artifactLocation": {"uri": self.uri(path)},
+                "region": self.region(block, lines),
+            }
+        }
+        if block.name:
+            logical: dict[str, Any] = {
+                "name": block.name,
+                "fullyQualifiedName": self.NAME_SEPARATOR.join(
+                    (*block.scope_path, block.name)
+                ),
+            }
+            if block.block_type:
+                logical["kind"] = block.block_type
+            location["logicalLocations"] = [logical]
+        properties: dict[str, bool] = {}
+        if block.change_status is not None:
+            properties["hasAdditions"] = block.has_additions
+            properties["hasDeletions"] = block.has_deletions
+        if block.uses_goroutine or block.uses_channel or block.uses_mutex:
+            properties["usesGoroutine"] = block.uses_goroutine
+            properties["usesChannel"] = block.uses_channel
+            properties["usesMutex"] = block.uses_mutex
+        if properties:
+            location["properties"] = properties
+        return location
+
+    def region(
+        self, block: ContextBlock, lines: Sequence[str] | None = None
+    ) -> dict[str, Any]:
+        """블록의 SARIF region 객체를 만든다.
+
+        Args:
+            block: 직렬화할 컨텍스트 블록
+            lines: 파일 라인들 (없으면 라인 범위만 기록)
+
+        Returns:
+            startLine/endLine과 (파일 라인이 있으면) 컬럼, 바이트 오프셋 딕셔너리
+        """
+        start_line = block.line_range.start_line
+        end_line = block.line_range.end_line
+        region: dict[str, Any] = {"startLine": start_line, "endLine": end_line}
+        if lines is None or end_line > len(lines):
+            return region
+
+        text_lines = split_lines(block.text) or [""]
+        start_text = lines[start_line - 1]
+        end_text = lines[end_line - 1]
+        start_char = self._start_char(start_text, text_lines[0])
+        if start_line == end_line and start_text[start_char:].startswith(block.text):
+            end_char = start_char + len(block.text)
+        else:
+            end_char = self._end_char(end_text, text_lines[-1])
+
+        line_offsets = self._line_byte_offsets(lines, end_line)
+        start_byte = line_offsets[start_line - 1] + self._utf8_length(
+            start_text[:start_char]
+        )
+        end_byte = line_offsets[end_line - 1] + self._utf8_length(end_text[:end_char])
+        region.update(
+            {
+                "startColumn": self._utf16_length(start_text[:start_char]) + 1,
+                "endColumn": self._utf16_length(end_text[:end_char]) + 1,
+                "byteOffset": start_byte,
+                "byteLength": end_byte - start_byte,
+            }
+        )
+        return region
+
+    @staticmethod
+    def uri(path: str) -> str:
+        """파일 경로를 SARIF artifactLocation의 URI 참조로 변환한다."""
+        return quote(PurePath(path).as_posix())
+
+    @staticmethod
+    def _start_char(line: str, first_text_line: str) -> int:
+        """블록이 시작하는 라인 안의 문자 인덱스를 찾는다."""
+        if first_text_line and line.endswith(first_text_line):
+            return len(line) - len(first_text_line)
+        return len(line) - len(line.lstrip())
+
+    @staticmethod
+    def _end_char(line: str, last_text_line: str) -> int:
+        """블록이 끝나는 라인 안의 (마지막 문자 다음) 문자 인덱스를 찾는다."""
+        if last_text_line.strip() and line.startswith(last_text_line):
+            return len(last_text_line)
+        return len(line.rstrip())
+
+    @classmethod
+    def _line_byte_offsets(cls, lines: Sequence[str], count: int) -> list[int]:
+        """앞에서부터 count개 라인의 시작 바이트 오프셋을 반환한다."""
+        offsets: list[int] = []
+        offset = 0
+        for line in lines[:count]:
+            offsets.append(offset)
+            offset += cls._utf8_length(line) + 1  # `\n`
+        return offsets
+
+    @staticmethod
+    def _utf8_length(text: str) -> int:
+        """텍스트의 UTF-8 바이트 길이를 반환한다."""
+        return len(text.encode("utf-8"))
+
+    @staticmethod
+    def _utf16_length(text: str) -> int:
+        """텍스트의 UTF-16 코드 유닛 수를 반환한다 (BMP 밖 문자는 2)."""
+        return sum(2 if ord(char) > 0xFFFF else 1 for char in text)
+
+
+def render_sarif_locations(
+    results: Sequence[ExtractedFileContext],
+    file_contents: Mapping[str, str] | None = None,
+) -> list[dict[str, Any]]:
+    """파일별 추출 결과의 심볼 위치를 SARIF location 객체들로 변환한다.
+
+    Args:
+        results: 파일별 컨텍스트 추출 결과들
+        file_contents: 파일 경로 → 파일 내용 (컬럼과 바이트 오프셋 계산용)
+
+    Returns:
+        블록마다 하나인 SARIF location 딕셔너리 리스트 (json.dumps로 직렬화 가능)
+    """
+    return SarifLocationRenderer().render(results, file_contents)
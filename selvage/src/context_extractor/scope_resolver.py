@@ -0,0 +1,158 @@
+"""ScopeResolver: 언어별 블록/이름/조상 경로 계산 resolver의 기본 클래스."""
+
+from __future__ import annotations
+
+from collections.abc import Collection
+from typing import ClassVar
+
+from tree_sitter import Node
+
+from .struct_field import StructField
+from .table_test_case import TableTestCase
+from .text_lines import node_text
+
+
+class ScopeResolver:
+    """변경을 감싸는 블록, 심볼 이름, scope_path를 언어 규칙으로 계산한다.
+
+    기본 구현은 아무것도 계산하지 않아 ContextExtractor의 공통 규칙을 그대로
+    사용하게 하며, 언어별 resolver는 그 언어가 직접 계산하는 부분만
+    재정의한다. ScopeResolverRegistry에 언어 이름으로 등록해 사용한다.
+    """
+
+    # True면 find_scope()가 None이어도 공통 블록 탐색 규칙으로 보완하지 않음
+    OWNS_SCOPES: ClassVar[bool] = False
+
+    # True면 name()이 None이어도 name 필드를 찾는 공통 규칙으로 보완하지 않음
+    OWNS_NAMES: ClassVar[bool] = False
+
+    # True면 노드 타입 대신 is_dependency()로만 의존성 노드를 판별
+    OWNS_DEPENDENCIES: ClassVar[bool] = False
+
+    # True면 컨테이너의 바깥 컨테이너 헤더까지 모두 포함 (중첩 모듈, 함수 등)
+    NESTED_CONTAINERS: ClassVar[bool] = False
+
+    # 컨테이너(클래스, 모듈 등) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES: frozenset[str] = frozenset()
+
+    # 컨테이너 헤더 블록의 포함 사유 (언어와 관계없이 같은 값을 사용)
+    CONTAINER_REASON: ClassVar[str] = "enclosing-declaration"
+
+    # 같은 scope_path와 이름이면 하나로 묶을 메서드 블록 타입 (다중 디스패치)
+    METHOD_BLOCK_TYPES: frozenset[str] = frozenset()
+
+    # 같은 함수의 여러 메서드를 묶은 블록의 block_type
+    METHOD_GROUP_BLOCK_TYPE = "method_group"
+
+    # 하나의 심볼로 병합되는 선언들을 묶은 블록의 block_type
+    MERGED_BLOCK_TYPE = "merged_declaration"
+
+    # 메서드의 receiver 타입 명세 노드 타입 (headers_only 모드에서 이름 계산)
+    TYPE_SPEC_TYPES: frozenset[str] = frozenset()
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 컨텍스트 블록 노드를 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            언어 규칙으로 찾은 블록 노드 (기본 구현은 None)
+        """
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """블록 노드의 이름을 반환한다 (기본 구현은 None)."""
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 조상 선언 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 이름 튜플 (기본 구현은 빈 튜플)
+        """
+        return ()
+
+    def find_container(self, node: Node) -> Node | None:
+        """멤버를 감싸는 컨테이너 노드를 찾는다 (기본 구현은 None)."""
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """컨테이너의 헤더 라인을 반환한다 (기본 구현은 첫 라인)."""
+        return node_text(container).split("\n", 1)[0].strip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름을 반환한다 (기본 구현은 None)."""
+        return None
+
+    def is_dependency(self, node: Node) -> bool:
+        """OWNS_DEPENDENCIES인 언어에서 노드가 의존성 선언인지 확인한다."""
+        return False
+
+    def block_type(self, node: Node) -> str | None:
+        """블록의 block_type을 노드 타입 대신 정할 때 반환한다 (기본 구현은 None)."""
+        return None
+
+    def block_name(self, node: Node, name: str | None) -> str | None:
+        """블록 이름을 선언 이름 대신 정할 때 반환한다 (기본 구현은 name 그대로)."""
+        return name
+
+    def section(self, node: Node) -> str | None:
+        """노드가 속한 파일 구역(unit 섹션 등)을 반환한다 (기본 구현은 None)."""
+        return None
+
+    def key_path(self, node: Node) -> str | None:
+        """노드가 속한 키의 점 구분 경로를 반환한다 (기본 구현은 None)."""
+        return None
+
+    def struct_fields(self, node: Node) -> tuple[StructField, ...]:
+        """노드를 감싸는 구조체 필드들을 반환한다 (기본 구현은 빈 튜플)."""
+        return ()
+
+    def table_case(self, node: Node) -> tuple[Node, TableTestCase] | None:
+        """노드를 감싸는 테이블 테스트 케이스 항목을 반환한다 (기본 구현은 None)."""
+        return None
+
+    def concurrency_lines(
+        self, root: Node
+    ) -> tuple[set[int], set[int], set[int]] | None:
+        """goroutine, 채널, 뮤텍스 사용이 시작하는 라인들을 반환한다.
+
+        Args:
+            root: AST 루트 노드
+
+        Returns:
+            (goroutine 라인, 채널 라인, 뮤텍스 라인) 집합 튜플 (기본 구현은
+            동시성 구문을 지원하지 않는다는 의미의 None)
+        """
+        return None
+
+    def receiver_type(self, node: Node) -> str | None:
+        """메서드 선언의 receiver 타입 이름을 반환한다 (기본 구현은 None)."""
+        return None
+
+    def find_type_spec(self, root: Node, type_name: str) -> Node | None:
+        """파일에서 이름이 같은 타입 명세 노드를 찾는다 (기본 구현은 None)."""
+        return None
+
+    def applied_modifiers(self, node: Node) -> tuple[str, ...]:
+        """함수에 적용된 modifier 이름들을 반환한다 (기본 구현은 빈 튜플)."""
+        return ()
+
+    def find_modifier_definitions(
+        self, root: Node, names: Collection[str]
+    ) -> list[Node]:
+        """파일에서 주어진 이름의 modifier 정의 노드들을 찾는다 (기본 구현은 빈 리스트)."""
+        return []
+
+    def merged_parts(self, node: Node) -> list[Node]:
+        """노드와 하나의 심볼로 병합되는 선언들을 반환한다 (기본 구현은 빈 리스트)."""
+        return []
+
+    def merged_name(self, node: Node) -> str | None:
+        """병합되는 선언 묶음의 이름을 반환한다 (기본 구현은 None)."""
+        return None
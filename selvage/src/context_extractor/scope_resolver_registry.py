@@ -0,0 +1,76 @@
+"""ScopeResolverRegistry: 언어별 스코프 resolver 레지스트리."""
+
+from __future__ import annotations
+
+from typing import ClassVar
+
+from .clojure_form_resolver import ClojureFormResolver
+from .cmake_scope_resolver import CMakeScopeResolver
+from .css_selector_path_resolver import CssSelectorPathResolver
+from .erlang_form_resolver import ErlangFormResolver
+from .fortran_scope_resolver import FortranScopeResolver
+from .go_scope_resolver import GoScopeResolver
+from .haxe_scope_resolver import HaxeScopeResolver
+from .java_scope_resolver import JavaScopeResolver
+from .javascript_scope_resolver import JavaScriptScopeResolver
+from .julia_scope_resolver import JuliaScopeResolver
+from .makefile_rule_resolver import MakefileRuleResolver
+from .objc_symbol_resolver import ObjcSymbolResolver
+from .pascal_scope_resolver import PascalScopeResolver
+from .perl_package_resolver import PerlPackageResolver
+from .r_function_resolver import RFunctionResolver
+from .scope_resolver import ScopeResolver
+from .solidity_contract_resolver import SolidityContractResolver
+from .starlark_rule_resolver import StarlarkRuleResolver
+from .tcl_scope_resolver import TclScopeResolver
+from .toml_key_path_resolver import TomlKeyPathResolver
+from .typescript_scope_resolver import TypeScriptScopeResolver
+from .verilog_module_resolver import VerilogModuleResolver
+
+
+class ScopeResolverRegistry:
+    """언어 이름으로 블록/이름/scope_path를 계산하는 resolver를 등록/조회한다.
+
+    등록되지 않은 언어는 ContextExtractor의 공통 규칙을 사용하며,
+    register()로 교체할 수 있다.
+    """
+
+    _resolvers: ClassVar[dict[str, ScopeResolver]] = {
+        "toml": TomlKeyPathResolver(),
+        "objc": ObjcSymbolResolver(),
+        "css": CssSelectorPathResolver(),
+        "scss": CssSelectorPathResolver(),
+        "java": JavaScopeResolver(),
+        "go": GoScopeResolver(),
+        "javascript": JavaScriptScopeResolver(),
+        "typescript": TypeScriptScopeResolver(),
+        "perl": PerlPackageResolver(),
+        "r": RFunctionResolver(),
+        "clojure": ClojureFormResolver(),
+        "fortran": FortranScopeResolver(),
+        "solidity": SolidityContractResolver(),
+        "verilog": VerilogModuleResolver(),
+        "julia": JuliaScopeResolver(),
+        "erlang": ErlangFormResolver(),
+        "cmake": CMakeScopeResolver(),
+        "makefile": MakefileRuleResolver(),
+        "starlark": StarlarkRuleResolver(),
+        "tcl": TclScopeResolver(),
+        "pascal": PascalScopeResolver(),
+        "haxe": HaxeScopeResolver(),
+    }
+
+    @classmethod
+    def register(cls, language: str, resolver: ScopeResolver) -> None:
+        """언어의 스코프 resolver를 등록(교체)한다.
+
+        Args:
+            language: 언어 이름
+            resolver: 등록할 resolver
+        """
+        cls._resolvers[language] = resolver
+
+    @classmethod
+    def get(cls, language: str) -> ScopeResolver | None:
+        """언어의 스코프 resolver를 반환한다 (없으면 None)."""
+        return cls._resolvers.get(language)
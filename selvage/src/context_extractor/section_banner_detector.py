@@ -0,0 +1,120 @@
+"""SectionBannerDetector: 파일을 구역으로 나누는 배너/region 주석을 찾는 모듈."""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Sequence
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class SectionBannerDetector:
+    """한 줄짜리 주석 중 파일의 구역을 나누는 배너 주석을 찾고 이름을 계산한다.
+
+    `// ===== Handlers =====`처럼 구분 문자로 감싼 주석, `// region Handlers`/
+    `// MARK: - Handlers`/`#pragma mark Handlers` 같은 region 표시, `# HANDLERS`
+    처럼 대문자로만 된 주석을 배너로 보는 휴리스틱이다. 주석 기호를 뗀 주석
+    텍스트에서 패턴을 차례로 찾아(re.search) 처음 찾아진 패턴의 `label`
+    그룹(없으면 텍스트 전체)에서 앞뒤 구분 문자를 뗀 것을 구역 이름으로 사용하며, 패턴은 생성 시
+    교체할 수 있다. 구분 문자만 있는 줄(`// ==========`)은 이름이 없으므로
+    배너가 아니다.
+    """
+
+    # 기본 배너 패턴 (주석 기호를 뗀 텍스트 기준)
+    DEFAULT_PATTERNS = (
+        # `===== Handlers =====`, `Handlers ---------`
+        r"^(?:[=\-*#~/+_]{3,}.*|.*[=\-*#~/+_]{3,})$",
+        # `region Handlers`, `MARK: - Handlers`, `pragma mark Handlers`
+        r"^(?:#?region|MARK:|#?pragma\s+mark)\s*-?\s*(?P<label>.+)$",
+        # `HANDLERS`, `PUBLIC API` (`TODO` 같은 작업 표시는 제외)
+        r"^(?!(?:TODO|FIXME|XXX|HACK|NOTE)$)[A-Z][A-Z0-9 _&/-]*[A-Z0-9]$",
+    )
+
+    # 구역 이름 앞뒤에서 떼어낼 구분 문자
+    SEPARATOR_CHARS = "=-*#~/+_ "
+
+    # 주석 텍스트 앞뒤에서 떼어낼 주석 기호
+    COMMENT_PREFIX = re.compile(r"^(?://+|#+|--+|;+|%+|/\*+|\(\*|\{)")
+    COMMENT_SUFFIX = re.compile(r"(?:\*+/|\*\)|\})$")
+
+    def __init__(self, patterns: Sequence[str] | None = None) -> None:
+        """SectionBannerDetector를 초기화한다.
+
+        Args:
+            patterns: 배너로 볼 주석 텍스트의 정규식들 (None이면 DEFAULT_PATTERNS).
+                `label` 이름 그룹이 있으면 그 부분을 구역 이름으로 사용
+
+        Raises:
+            re.error: 정규식이 올바르지 않은 경우
+        """
+        self._patterns = [
+            re.compile(pattern)
+            for pattern in (self.DEFAULT_PATTERNS if patterns is None else patterns)
+        ]
+
+    def label(self, comment_text: str) -> str | None:
+        """한 줄 주석이 배너이면 구역 이름을 반환한다.
+
+        Args:
+            comment_text: 주석 기호를 포함한 주석 원문
+
+        Returns:
+            구역 이름 (여러 줄 주석이거나 배너가 아니면 None)
+        """
+        text = comment_text.strip()
+        if not text or "\n" in text:
+            return None
+        text = self.COMMENT_PREFIX.sub("", text, count=1)
+        text = self.COMMENT_SUFFIX.sub("", text, count=1).strip()
+        for pattern in self._patterns:
+            match = pattern.search(text)
+            if match is None:
+                continue
+            label = match.groupdict().get("label")
+            label = (text if label is None else label).strip(self.SEPARATOR_CHARS)
+            if label:
+                return label
+        return None
+
+    def find_banners(self, root: Node) -> list[tuple[int, str]]:
+        """파일의 모든 배너 주석을 위치 순으로 찾는다.
+
+        Args:
+            root: AST 루트 노드
+
+        Returns:
+            (배너 라인 번호(1-based), 구역 이름) 리스트 (라인 순)
+        """
+        banners: list[tuple[int, str]] = []
+        stack = [root]
+        while stack:
+            node = stack.pop()
+            if "comment" in node.type:
+                label = self.label(node_text(node))
+                if label is not None:
+                    banners.append((node.start_point[0] + 1, label))
+                continue
+            stack.extend(node.children)
+        return sorted(banners)
+
+    @staticmethod
+    def section_for(banners: Sequence[tuple[int, str]], line: int) -> str | None:
+        """기준 라인과 그 앞에서 가장 가까운 배너의 구역 이름을 반환한다.
+
+        블록이 배너 주석부터 시작하면(주석 연결 옵션 등) 그 배너를 사용한다.
+
+        Args:
+            banners: find_banners 결과
+            line: 기준 라인 번호 (1-based)
+
+        Returns:
+            구역 이름 (앞에 배너가 없으면 None)
+        """
+        section = None
+        for banner_line, label in banners:
+            if banner_line > line:
+                break
+            section = label
+        return section
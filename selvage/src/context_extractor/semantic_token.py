@@ -0,0 +1,33 @@
+"""SemanticToken: 변경 라인의 토큰 위치와 종류를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .semantic_token_kind import SemanticTokenKind
+
+
+@dataclass(frozen=True)
+class SemanticToken:
+    """SemanticTokenExtractor가 반환하는 토큰 하나.
+
+    라인은 1-based, 열은 0-based 문자(코드 포인트) 오프셋이며 end_column은
+    토큰 다음 문자의 위치이다. tree-sitter의 바이트 열을 문자 단위로 바꾸므로
+    한글 같은 멀티바이트 문자가 앞에 있어도 에디터의 열과 일치한다. 여러
+    라인에 걸친 문자열/주석은 start_line과 end_line이 다르다.
+
+    Attributes:
+        kind: 정규화된 토큰 종류
+        text: 토큰 텍스트
+        start_line: 시작 라인 번호
+        start_column: 시작 열
+        end_line: 끝 라인 번호
+        end_column: 끝 열 (토큰에 포함되지 않음)
+    """
+
+    kind: SemanticTokenKind
+    text: str
+    start_line: int
+    start_column: int
+    end_line: int
+    end_column: int
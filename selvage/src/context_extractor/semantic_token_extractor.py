@@ -0,0 +1,313 @@
+"""SemanticTokenExtractor: 변경 라인의 토큰을 종류별로 분류하는 추출기."""
+
+from __future__ import annotations
+
+from collections.abc import Iterator, Sequence
+
+from tree_sitter import Node, Query, QueryCursor
+from tree_sitter_language_pack import get_language, get_parser
+
+from selvage.src.exceptions import UnsupportedLanguageError
+from selvage.src.utils.language_detector import detect_language_from_filename
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .line_range import LineRange
+from .semantic_token import SemanticToken
+from .semantic_token_kind import SemanticTokenKind
+from .symbol_token import SymbolToken
+
+
+class SemanticTokenExtractor:
+    """tree-sitter 쿼리로 변경 라인의 식별자/토큰을 종류별로 분류한다.
+
+    심볼 블록을 추출하는 ContextExtractor와 별개의 API로, 시맨틱 하이라이팅처럼
+    토큰 위치와 정규화된 종류(SemanticTokenKind)만 반환한다. 쿼리의 캡처 이름이
+    곧 토큰 종류이며, 한 노드가 여러 캡처에 걸리면 KIND_PRIORITY에서 앞선
+    종류를 쓴다. 키워드는 문법마다 목록을 두는 대신 영문자로 된 이름 없는
+    (anonymous) 노드로 판별한다. 다른 토큰 안에 들어 있는 토큰(문자열 안의
+    보간 식 등)은 바깥 토큰 하나로 반환한다. tokenize()는 같은 분류로 심볼
+    블록 하나를 위치 없는 평탄한 토큰 열로 바꿔 임베딩/BM25 색인 입력으로 쓴다.
+    """
+
+    # 언어별 토큰 분류 쿼리 (캡처 이름은 SemanticTokenKind 값)
+    LANGUAGE_QUERIES = {
+        "python": """
+            (function_definition name: (identifier) @function-name)
+            (call function: (identifier) @function-name)
+            (call function: (attribute attribute: (identifier) @function-name))
+            (class_definition name: (identifier) @type)
+            (type (identifier) @type)
+            (generic_type (identifier) @type)
+            (attribute attribute: (identifier) @property)
+            (identifier) @variable
+            [(true) (false) (none)] @keyword
+            (string) @string
+            [(integer) (float)] @number
+            (comment) @comment
+        """,
+        "javascript": """
+            (function_declaration name: (identifier) @function-name)
+            (method_definition name: (property_identifier) @function-name)
+            (call_expression function: (identifier) @function-name)
+            (call_expression
+              function: (member_expression
+                property: (property_identifier) @function-name))
+            (class_declaration name: (identifier) @type)
+            (property_identifier) @property
+            (identifier) @variable
+            [(true) (false) (null) (undefined) (this)] @keyword
+            [(string) (template_string)] @string
+            (number) @number
+            (comment) @comment
+        """,
+        "typescript": """
+            (function_declaration name: (identifier) @function-name)
+            (method_definition name: (property_identifier) @function-name)
+            (call_expression function: (identifier) @function-name)
+            (call_expression
+              function: (member_expression
+                property: (property_identifier) @function-name))
+            [(type_identifier) (predefined_type)] @type
+            (property_identifier) @property
+            (identifier) @variable
+            [(true) (false) (null) (undefined) (this)] @keyword
+            [(string) (template_string)] @string
+            (number) @number
+            (comment) @comment
+        """,
+        "java": """
+            (method_declaration name: (identifier) @function-name)
+            (method_invocation name: (identifier) @function-name)
+            (class_declaration name: (identifier) @type)
+            (interface_declaration name: (identifier) @type)
+            [
+              (type_identifier)
+              (integral_type)
+              (floating_point_type)
+              (boolean_type)
+              (void_type)
+            ] @type
+            (field_access field: (identifier) @property)
+            (identifier) @variable
+            [(true) (false) (null_literal)] @keyword
+            (string_literal) @string
+            [(decimal_integer_literal) (decimal_floating_point_literal)] @number
+            [(line_comment) (block_comment)] @comment
+        """,
+        "go": """
+            (function_declaration name: (identifier) @function-name)
+            (method_declaration name: (field_identifier) @function-name)
+            (call_expression function: (identifier) @function-name)
+            (call_expression
+              function: (selector_expression field: (field_identifier) @function-name))
+            (type_identifier) @type
+            (field_identifier) @property
+            (identifier) @variable
+            [(true) (false) (nil)] @keyword
+            [(interpreted_string_literal) (raw_string_literal)] @string
+            [(int_literal) (float_literal)] @number
+            (comment) @comment
+        """,
+    }
+
+    # 한 노드가 여러 캡처에 걸렸을 때 우선하는 종류 순서
+    KIND_PRIORITY = (
+        SemanticTokenKind.FUNCTION_NAME,
+        SemanticTokenKind.TYPE,
+        SemanticTokenKind.PROPERTY,
+        SemanticTokenKind.KEYWORD,
+        SemanticTokenKind.STRING,
+        SemanticTokenKind.NUMBER,
+        SemanticTokenKind.COMMENT,
+        SemanticTokenKind.VARIABLE,
+    )
+
+    # 리터럴 정규화 시 원문 대신 쓰는 종류 자리표시자
+    LITERAL_PLACEHOLDERS = {
+        SemanticTokenKind.STRING: "<string>",
+        SemanticTokenKind.NUMBER: "<number>",
+    }
+
+    def __init__(self, language: str) -> None:
+        """추출기 초기화.
+
+        Args:
+            language: 언어 이름 (LANGUAGE_QUERIES에 있는 언어)
+
+        Raises:
+            UnsupportedLanguageError: 토큰 분류 쿼리가 없는 언어인 경우
+        """
+        if not self.is_supported(language):
+            raise UnsupportedLanguageError(language)
+        grammar_name = ContextExtractor.LANGUAGE_GRAMMAR_NAMES.get(language, language)
+        self._language = language
+        self._parser = get_parser(grammar_name)
+        self._query = Query(
+            get_language(grammar_name), self.LANGUAGE_QUERIES[language]
+        )
+
+    @classmethod
+    def is_supported(cls, language: str) -> bool:
+        """토큰 분류를 지원하는 언어인지 확인한다."""
+        return language in cls.LANGUAGE_QUERIES
+
+    def extract(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[SemanticToken]:
+        """변경된 라인 범위에 걸친 토큰들을 위치 순으로 반환한다.
+
+        Args:
+            file_content: 분석할 파일의 내용
+            changed_ranges: 토큰을 수집할 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            범위와 한 라인이라도 겹치는 토큰들의 리스트 (위치 순으로 정렬됨)
+        """
+        if not file_content or not changed_ranges:
+            return []
+        source = file_content.encode("utf-8")
+        root = self._parser.parse(source).root_node
+        kinds = self._classify(root)
+        for node in self._iter_keywords(root):
+            span = (node.start_byte, node.end_byte)
+            kinds.setdefault(span, (node, SemanticTokenKind.KEYWORD))
+
+        source_lines = source.split(b"\n")
+        tokens: list[SemanticToken] = []
+        outer_end = -1
+        # 시작 위치가 같으면 바깥(더 긴) 토큰이 먼저 오도록 정렬
+        ordered = sorted(kinds.items(), key=lambda item: (item[0][0], -item[0][1]))
+        for _, (node, kind) in ordered:
+            # 앞선 토큰 안에 들어 있는 토큰은 바깥 토큰으로 대신함
+            if node.end_byte <= outer_end:
+                continue
+            start_line = node.start_point[0] + 1
+            end_line = node.end_point[0] + 1
+            if not any(
+                changed.overlaps(LineRange(start_line, end_line))
+                for changed in changed_ranges
+            ):
+                continue
+            outer_end = node.end_byte
+            tokens.append(
+                SemanticToken(
+                    kind=kind,
+                    text=source[node.start_byte : node.end_byte].decode(
+                        "utf-8", errors="replace"
+                    ),
+                    start_line=start_line,
+                    start_column=self._column(source_lines, node.start_point),
+                    end_line=end_line,
+                    end_column=self._column(source_lines, node.end_point),
+                )
+            )
+        return tokens
+
+    def tokenize(
+        self,
+        file_content: str,
+        block: ContextBlock,
+        normalize_literals: bool = False,
+        include_comments: bool = False,
+    ) -> list[SymbolToken]:
+        """심볼 블록 하나를 위치 순의 평탄한 토큰 열로 바꾼다.
+
+        블록의 line_range에 걸친 토큰들을 extract()와 같이 분류하며, 괄호나
+        연산자 같은 구두점은 포함하지 않는다. 같은 입력에는 항상 같은 순서의
+        토큰 열을 반환한다.
+
+        Args:
+            file_content: 블록을 추출한 파일의 내용
+            block: 토큰으로 바꿀 심볼 블록
+            normalize_literals: 문자열/숫자 리터럴을 종류 자리표시자로 바꿀지 여부
+            include_comments: 주석 토큰을 포함할지 여부
+
+        Returns:
+            SymbolToken 리스트 (위치 순)
+        """
+        tokens: list[SymbolToken] = []
+        for token in self.extract(file_content, [block.line_range]):
+            if token.kind == SemanticTokenKind.COMMENT and not include_comments:
+                continue
+            text = token.text
+            if normalize_literals:
+                text = self.LITERAL_PLACEHOLDERS.get(token.kind, text)
+            tokens.append(SymbolToken(kind=token.kind, text=text))
+        return tokens
+
+    def _classify(
+        self, root: Node
+    ) -> dict[tuple[int, int], tuple[Node, SemanticTokenKind]]:
+        """쿼리 캡처를 (시작 바이트, 끝 바이트)별 노드와 종류로 정리한다."""
+        captures = QueryCursor(self._query).captures(root)
+        kinds: dict[tuple[int, int], tuple[Node, SemanticTokenKind]] = {}
+        for kind in self.KIND_PRIORITY:
+            for node in captures.get(kind.value, []):
+                kinds.setdefault((node.start_byte, node.end_byte), (node, kind))
+        return kinds
+
+    @staticmethod
+    def _iter_keywords(root: Node) -> Iterator[Node]:
+        """영문자로 된 이름 없는 노드(`def`, `return`, `func` 등)를 순회한다."""
+        stack = [root]
+        while stack:
+            node = stack.pop()
+            if not node.is_named and node.type.isalpha():
+                yield node
+            stack.extend(node.children)
+
+    @staticmethod
+    def _column(source_lines: list[bytes], point: tuple[int, int]) -> int:
+        """tree-sitter의 (행, 바이트 열)을 문자 단위 열로 바꾼다."""
+        row, byte_column = point
+        if row >= len(source_lines):
+            return 0
+        prefix = source_lines[row][:byte_column]
+        return len(prefix.decode("utf-8", errors="replace"))
+
+
+def semantic_tokens(
+    file_path: str, file_content: str, changed_ranges: Sequence[LineRange]
+) -> list[SemanticToken]:
+    """파일 경로로 언어를 감지해 변경 라인의 토큰들을 종류별로 반환한다.
+
+    Args:
+        file_path: 언어 감지에 사용할 파일 경로
+        file_content: 분석할 파일의 내용
+        changed_ranges: 토큰을 수집할 라인 범위들
+
+    Returns:
+        SemanticTokenExtractor.extract 결과
+
+    Raises:
+        UnsupportedLanguageError: 토큰 분류를 지원하지 않는 언어의 파일인 경우
+    """
+    language = detect_language_from_filename(file_path)
+    return SemanticTokenExtractor(language).extract(file_content, changed_ranges)
+
+
+def tokenize_symbol(
+    file_path: str,
+    file_content: str,
+    block: ContextBlock,
+    normalize_literals: bool = False,
+) -> list[SymbolToken]:
+    """파일 경로로 언어를 감지해 심볼 블록을 평탄한 토큰 열로 바꾼다.
+
+    Args:
+        file_path: 언어 감지에 사용할 파일 경로
+        file_content: 블록을 추출한 파일의 내용
+        block: 토큰으로 바꿀 심볼 블록
+        normalize_literals: 문자열/숫자 리터럴을 종류 자리표시자로 바꿀지 여부
+
+    Returns:
+        SemanticTokenExtractor.tokenize 결과
+
+    Raises:
+        UnsupportedLanguageError: 토큰 분류를 지원하지 않는 언어의 파일인 경우
+    """
+    language = detect_language_from_filename(file_path)
+    return SemanticTokenExtractor(language).tokenize(
+        file_content, block, normalize_literals=normalize_literals
+    )
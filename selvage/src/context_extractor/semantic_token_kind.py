@@ -0,0 +1,18 @@
+"""SemanticTokenKind: 언어와 무관하게 정규화된 시맨틱 토큰 종류."""
+
+from __future__ import annotations
+
+from enum import Enum
+
+
+class SemanticTokenKind(str, Enum):
+    """시맨틱 하이라이팅처럼 토큰을 분류하는 언어 공통 종류."""
+
+    FUNCTION_NAME = "function-name"  # 함수/메서드 선언 이름과 호출 대상 이름
+    TYPE = "type"  # 타입/클래스 이름
+    PROPERTY = "property"  # `obj.member`의 멤버, 구조체 필드 이름
+    VARIABLE = "variable"  # 그 밖의 식별자
+    KEYWORD = "keyword"  # 키워드와 `true`/`nil` 같은 내장 상수
+    STRING = "string"  # 문자열 리터럴
+    NUMBER = "number"  # 숫자 리터럴
+    COMMENT = "comment"  # 주석
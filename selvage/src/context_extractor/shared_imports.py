@@ -0,0 +1,93 @@
+"""SharedImports: 여러 파일에 공통으로 나오는 import 라인 묶음."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import dataclass, replace
+
+from .context_block import ContextBlock
+from .extracted_file_context import ExtractedFileContext
+
+
+@dataclass(frozen=True)
+class SharedImports:
+    """두 개 이상의 파일 의존성 블록에 똑같이 나오는 import 라인들.
+
+    라인은 앞뒤 공백을 지우고 연속 공백을 하나로 합친 형태로 비교한다.
+    `import (`, `)`처럼 여러 줄 import를 여닫기만 하는 구조 라인은 파일마다
+    그대로 남긴다.
+
+    Attributes:
+        groups: (사용 파일 경로들, 그 파일들이 공통으로 쓰는 import 라인들)
+            튜플들. 처음 등장한 순서로 정렬된다.
+    """
+
+    # 여러 줄 import를 여는 라인의 끝 문자
+    OPENING_BRACKETS = ("(", "{", "[")
+
+    groups: tuple[tuple[tuple[str, ...], tuple[str, ...]], ...] = ()
+
+    @classmethod
+    def collect(cls, results: Sequence[ExtractedFileContext]) -> SharedImports:
+        """파일별 추출 결과에서 여러 파일이 공유하는 import 라인을 모은다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+
+        Returns:
+            공유 import 묶음 (공유 라인이 없으면 groups가 빈 튜플)
+        """
+        files_by_line: dict[str, list[str]] = {}
+        for result in results:
+            for block in result.dependency_blocks:
+                for line in block.text.split("\n"):
+                    key = cls._normalize(line)
+                    if key is None:
+                        continue
+                    files = files_by_line.setdefault(key, [])
+                    if result.file_path not in files:
+                        files.append(result.file_path)
+
+        lines_by_files: dict[tuple[str, ...], list[str]] = {}
+        for line, files in files_by_line.items():
+            if len(files) > 1:
+                lines_by_files.setdefault(tuple(files), []).append(line)
+        return cls(
+            tuple((files, tuple(lines)) for files, lines in lines_by_files.items())
+        )
+
+    @property
+    def lines(self) -> frozenset[str]:
+        """공유 import 라인(정규화된 형태)들을 반환한다."""
+        return frozenset(line for _, lines in self.groups for line in lines)
+
+    def strip(self, block: ContextBlock) -> ContextBlock | None:
+        """의존성 블록에서 공유 import 라인을 지운 사본을 반환한다.
+
+        Args:
+            block: 파일의 의존성 블록
+
+        Returns:
+            공유 라인을 지운 블록 (import 라인이 하나도 남지 않으면 None)
+        """
+        shared = self.lines
+        remaining = [
+            line
+            for line in block.text.split("\n")
+            if self._normalize(line) not in shared
+        ]
+        if all(self._normalize(line) is None for line in remaining):
+            return None
+        if len(remaining) == len(block.text.split("\n")):
+            return block
+        return replace(block, text="\n".join(remaining))
+
+    @classmethod
+    def _normalize(cls, line: str) -> str | None:
+        """비교용으로 정규화한 라인을 반환한다 (구조 라인이나 빈 줄이면 None)."""
+        normalized = " ".join(line.split())
+        if not any(char.isalnum() for char in normalized):
+            return None
+        if normalized.endswith(cls.OPENING_BRACKETS):
+            return None
+        return normalized
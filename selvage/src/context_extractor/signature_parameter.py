@@ -0,0 +1,20 @@
+"""SignatureParameter: 함수 시그니처의 파라미터 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class SignatureParameter:
+    """함수 시그니처의 파라미터.
+
+    name과 type은 소스에 적힌 텍스트 그대로이다. 타입 주석이 없는 파라미터는
+    type이 None이고, 구조 분해 패턴은 패턴 텍스트를 name으로 사용한다.
+    가변 인자는 name에서 `...`/`*` 표기를 제외하고 is_variadic을 True로 설정한다.
+    """
+
+    name: str | None
+    type: str | None = None
+    is_variadic: bool = False
+    has_default: bool = False
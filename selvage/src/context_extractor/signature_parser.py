@@ -0,0 +1,400 @@
+"""SignatureParser: 함수 노드에서 구조화된 시그니처를 추출하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from tree_sitter import Node
+
+from .signature_parameter import SignatureParameter
+from .symbol_signature import SymbolSignature
+from .text_lines import node_text
+
+
+class SignatureParser:
+    """언어별 함수/메서드 노드의 파라미터, 반환 타입, 타입 파라미터를 파싱한다.
+
+    API 변경 리뷰와 하위 호환성 검사에 쓸 수 있도록 시그니처 문자열 대신
+    파라미터 이름/타입과 반환 타입 목록을 구조화된 형태로 반환한다.
+    타입은 소스에 적힌 텍스트 그대로 유지한다.
+    """
+
+    # 언어별 시그니처를 파싱할 함수 노드 타입
+    LANGUAGE_FUNCTION_TYPES = {
+        "python": frozenset({"function_definition", "async_function_definition"}),
+        "javascript": frozenset(
+            {
+                "function_declaration",
+                "function_expression",
+                "generator_function",
+                "generator_function_declaration",
+                "method_definition",
+                "arrow_function",
+            }
+        ),
+        "typescript": frozenset(
+            {
+                "function_declaration",
+                "function_expression",
+                "generator_function",
+                "generator_function_declaration",
+                "method_definition",
+                "method_signature",
+                "abstract_method_signature",
+                "function_signature",
+                "arrow_function",
+            }
+        ),
+        "java": frozenset({"method_declaration", "constructor_declaration"}),
+        "kotlin": frozenset({"function_declaration"}),
+        "go": frozenset({"function_declaration", "method_declaration"}),
+    }
+
+    # Kotlin 반환 타입으로 취급하는 노드 타입
+    KOTLIN_TYPE_NODE_TYPES = frozenset(
+        {
+            "user_type",
+            "nullable_type",
+            "function_type",
+            "parenthesized_type",
+            "non_nullable_type",
+            "dynamic_type",
+        }
+    )
+
+    # Java 가변 인자 파라미터에서 타입이 아닌 수식어 노드 타입
+    JAVA_MODIFIER_TYPES = frozenset({"modifiers", "annotation", "marker_annotation"})
+
+    # 파라미터 목록에서 무시하는 주석 노드 타입
+    COMMENT_TYPES = frozenset({"comment", "line_comment", "multiline_comment"})
+
+    def __init__(self, language: str) -> None:
+        """파서 초기화.
+
+        Args:
+            language: 대상 언어 이름
+        """
+        self._function_types = self.LANGUAGE_FUNCTION_TYPES.get(language, frozenset())
+        handlers: dict[str, Callable[[Node], SymbolSignature]] = {
+            "python": self._parse_python,
+            "javascript": self._parse_javascript,
+            "typescript": self._parse_javascript,
+            "java": self._parse_java,
+            "kotlin": self._parse_kotlin,
+            "go": self._parse_go,
+        }
+        self._handler = handlers.get(language)
+
+    def is_supported(self) -> bool:
+        """해당 언어에서 시그니처 파싱을 지원하는지 반환한다."""
+        return self._handler is not None
+
+    def parse(self, node: Node) -> SymbolSignature | None:
+        """함수 노드의 시그니처를 파싱한다.
+
+        Args:
+            node: 함수/메서드 노드 (Python decorated_definition 포함)
+
+        Returns:
+            구조화된 시그니처 (함수 노드가 아니거나 지원하지 않는 언어면 None)
+        """
+        if node.type == "decorated_definition":
+            node = node.child_by_field_name("definition") or node
+        if self._handler is None or node.type not in self._function_types:
+            return None
+        return self._handler(node)
+
+    def _parse_python(self, node: Node) -> SymbolSignature:
+        """Python 함수 시그니처를 파싱한다."""
+        parameters = node.child_by_field_name("parameters")
+        params = [
+            param
+            for child in self._named_children(parameters)
+            if (param := self._python_parameter(child)) is not None
+        ]
+        return SymbolSignature(
+            parameters=tuple(params),
+            returns=self._optional_text(node.child_by_field_name("return_type")),
+            type_parameters=self._type_parameters(node),
+        )
+
+    def _python_parameter(self, node: Node) -> SignatureParameter | None:
+        """Python 파라미터 노드 하나를 파싱한다 (`*`, `/` 구분자는 None)."""
+        if node.type == "identifier":
+            return SignatureParameter(self._text(node))
+        if node.type in ("list_splat_pattern", "dictionary_splat_pattern"):
+            return SignatureParameter(self._text(node).lstrip("*"), is_variadic=True)
+        if node.type in ("default_parameter", "typed_default_parameter"):
+            return SignatureParameter(
+                self._text(node.child_by_field_name("name")),
+                self._text_or_none(node.child_by_field_name("type")),
+                has_default=True,
+            )
+        if node.type == "typed_parameter":
+            type_node = node.child_by_field_name("type")
+            target = next(
+                (child for child in node.named_children if child != type_node), None
+            )
+            target_text = self._text(target)
+            return SignatureParameter(
+                target_text.lstrip("*"),
+                self._text_or_none(type_node),
+                is_variadic=target_text.startswith("*"),
+            )
+        return None
+
+    def _parse_javascript(self, node: Node) -> SymbolSignature:
+        """JavaScript/TypeScript 함수 시그니처를 파싱한다."""
+        parameters = node.child_by_field_name("parameters")
+        params: list[SignatureParameter] = []
+        if parameters is not None:
+            params = [
+                param
+                for child in self._named_children(parameters)
+                if (param := self._javascript_parameter(child)) is not None
+            ]
+        else:
+            # 괄호 없는 단일 파라미터 화살표 함수 (x => ...)
+            single = node.child_by_field_name("parameter")
+            if single is not None:
+                params = [SignatureParameter(self._text(single))]
+
+        return_type = node.child_by_field_name("return_type")
+        return SymbolSignature(
+            parameters=tuple(params),
+            returns=(self._annotation_text(return_type),) if return_type else (),
+            type_parameters=self._type_parameters(node),
+        )
+
+    def _javascript_parameter(self, node: Node) -> SignatureParameter | None:
+        """JavaScript/TypeScript 파라미터 노드 하나를 파싱한다."""
+        if node.type in ("required_parameter", "optional_parameter"):
+            pattern = node.child_by_field_name("pattern")
+            type_node = node.child_by_field_name("type")
+            is_variadic = pattern is not None and pattern.type == "rest_pattern"
+            return SignatureParameter(
+                self._text(pattern).lstrip("."),
+                self._annotation_text(type_node) if type_node else None,
+                is_variadic=is_variadic,
+                has_default=(
+                    node.type == "optional_parameter"
+                    or node.child_by_field_name("value") is not None
+                ),
+            )
+        if node.type == "rest_pattern":
+            return SignatureParameter(self._text(node).lstrip("."), is_variadic=True)
+        if node.type == "assignment_pattern":
+            return SignatureParameter(
+                self._text(node.child_by_field_name("left")), has_default=True
+            )
+        if node.type in self.COMMENT_TYPES:
+            return None
+        # identifier, object_pattern, array_pattern 등은 텍스트를 이름으로 사용
+        return SignatureParameter(self._text(node))
+
+    def _parse_java(self, node: Node) -> SymbolSignature:
+        """Java 메서드/생성자 시그니처를 파싱한다."""
+        params: list[SignatureParameter] = []
+        for child in self._named_children(node.child_by_field_name("parameters")):
+            if child.type == "formal_parameter":
+                type_text = self._text(child.child_by_field_name("type"))
+                dimensions = child.child_by_field_name("dimensions")
+                params.append(
+                    SignatureParameter(
+                        self._text(child.child_by_field_name("name")),
+                        type_text + self._text(dimensions),
+                    )
+                )
+            elif child.type == "spread_parameter":
+                params.append(self._java_spread_parameter(child))
+
+        return SymbolSignature(
+            parameters=tuple(params),
+            returns=self._optional_text(node.child_by_field_name("type")),
+            type_parameters=self._type_parameters(node),
+        )
+
+    def _java_spread_parameter(self, node: Node) -> SignatureParameter:
+        """Java 가변 인자(`String... args`) 파라미터를 파싱한다."""
+        declarator: Node | None = None
+        type_node: Node | None = None
+        for child in node.named_children:
+            if child.type == "variable_declarator":
+                declarator = child
+            elif type_node is None and child.type not in self.JAVA_MODIFIER_TYPES:
+                type_node = child
+        name_node = None
+        if declarator is not None:
+            name_node = declarator.child_by_field_name("name") or declarator
+        return SignatureParameter(
+            self._text(name_node) or None,
+            self._text_or_none(type_node),
+            is_variadic=True,
+        )
+
+    def _parse_kotlin(self, node: Node) -> SymbolSignature:
+        """Kotlin 함수 시그니처를 파싱한다.
+
+        Kotlin 문법은 필드 이름이 없으므로 자식 노드 타입과 순서로 판단한다.
+        파라미터 목록 뒤에 오는 타입 노드를 반환 타입으로 본다.
+        """
+        params: list[SignatureParameter] = []
+        returns: tuple[str, ...] = ()
+        type_parameters: tuple[str, ...] = ()
+        seen_parameters = False
+        for child in node.named_children:
+            if child.type == "type_parameters":
+                type_parameters = self._child_texts(child)
+            elif child.type == "function_value_parameters":
+                params = self._kotlin_parameters(child)
+                seen_parameters = True
+            elif seen_parameters and child.type in self.KOTLIN_TYPE_NODE_TYPES:
+                returns = (self._text(child),)
+        return SymbolSignature(
+            parameters=tuple(params),
+            returns=returns,
+            type_parameters=type_parameters,
+        )
+
+    def _kotlin_parameters(self, parameters: Node) -> list[SignatureParameter]:
+        """Kotlin function_value_parameters 노드를 파싱한다."""
+        # 문법 버전에 따라 function_value_parameter로 감싸진 경우를 펼친다
+        children: list[Node] = []
+        for child in self._named_children(parameters):
+            if child.type == "function_value_parameter":
+                children.extend(self._named_children(child))
+            else:
+                children.append(child)
+
+        params: list[SignatureParameter] = []
+        is_variadic = False
+        for index, child in enumerate(children):
+            if child.type == "parameter_modifiers":
+                is_variadic = "vararg" in self._text(child).split()
+                continue
+            if child.type != "parameter":
+                continue
+
+            name_node = next(
+                (c for c in child.named_children if c.type == "simple_identifier"),
+                None,
+            )
+            type_node = next(
+                (c for c in child.named_children if c.type != "simple_identifier"),
+                None,
+            )
+            # 파라미터 다음에 오는 표현식은 기본값 (`= expression`)
+            next_child = children[index + 1] if index + 1 < len(children) else None
+            has_default = next_child is not None and next_child.type not in (
+                "parameter",
+                "parameter_modifiers",
+            )
+            params.append(
+                SignatureParameter(
+                    self._text(name_node),
+                    self._text_or_none(type_node),
+                    is_variadic=is_variadic,
+                    has_default=has_default,
+                )
+            )
+            is_variadic = False
+        return params
+
+    def _parse_go(self, node: Node) -> SymbolSignature:
+        """Go 함수/메서드 시그니처를 파싱한다."""
+        receivers = self._go_parameters(node.child_by_field_name("receiver"))
+        result = node.child_by_field_name("result")
+        if result is None:
+            returns: tuple[str, ...] = ()
+        elif result.type == "parameter_list":
+            # (int, error) 또는 (n int, err error) 형태의 다중 반환
+            returns = tuple(
+                param.type for param in self._go_parameters(result) if param.type
+            )
+        else:
+            returns = (self._text(result),)
+
+        return SymbolSignature(
+            parameters=tuple(
+                self._go_parameters(node.child_by_field_name("parameters"))
+            ),
+            returns=returns,
+            type_parameters=self._go_type_parameters(
+                node.child_by_field_name("type_parameters")
+            ),
+            receiver=receivers[0] if receivers else None,
+        )
+
+    def _go_parameters(self, parameter_list: Node | None) -> list[SignatureParameter]:
+        """Go parameter_list를 파싱한다.
+
+        `a, b int`처럼 이름을 묶어 선언한 경우 이름마다 파라미터를 만든다.
+        """
+        params: list[SignatureParameter] = []
+        for child in self._named_children(parameter_list):
+            if child.type not in (
+                "parameter_declaration",
+                "variadic_parameter_declaration",
+            ):
+                continue
+            type_text = self._text_or_none(child.child_by_field_name("type"))
+            names: list[str | None] = [
+                self._text(name) for name in child.children_by_field_name("name")
+            ]
+            for name in names or [None]:
+                params.append(
+                    SignatureParameter(
+                        name,
+                        type_text,
+                        is_variadic=child.type == "variadic_parameter_declaration",
+                    )
+                )
+        return params
+
+    def _go_type_parameters(self, type_parameters: Node | None) -> tuple[str, ...]:
+        """Go 타입 파라미터 목록을 `T any` 형태의 문자열로 파싱한다.
+
+        `[K, V comparable]`처럼 묶어 선언한 경우 이름마다 제약을 붙인다.
+        """
+        results: list[str] = []
+        for child in self._named_children(type_parameters):
+            if child.type != "type_parameter_declaration":
+                continue
+            constraint = self._text(child.child_by_field_name("type"))
+            for name in child.children_by_field_name("name"):
+                results.append(f"{self._text(name)} {constraint}".strip())
+        return tuple(results)
+
+    def _named_children(self, node: Node | None) -> list[Node]:
+        """주석을 제외한 이름 있는 자식 노드를 반환한다."""
+        if node is None:
+            return []
+        return [
+            child
+            for child in node.named_children
+            if child.type not in self.COMMENT_TYPES
+        ]
+
+    def _type_parameters(self, node: Node) -> tuple[str, ...]:
+        """type_parameters 필드의 타입 파라미터들을 텍스트로 반환한다."""
+        return self._child_texts(node.child_by_field_name("type_parameters"))
+
+    def _child_texts(self, node: Node | None) -> tuple[str, ...]:
+        """이름 있는 자식 노드들의 텍스트를 반환한다 (타입 파라미터 목록용)."""
+        return tuple(self._text(child) for child in self._named_children(node))
+
+    def _optional_text(self, node: Node | None) -> tuple[str, ...]:
+        """노드가 있으면 텍스트 하나짜리 튜플, 없으면 빈 튜플을 반환한다."""
+        return (self._text(node),) if node is not None else ()
+
+    def _annotation_text(self, node: Node) -> str:
+        """TypeScript 타입 주석(`: T`)에서 타입 텍스트만 반환한다."""
+        return self._text(node).lstrip(":").strip()
+
+    def _text_or_none(self, node: Node | None) -> str | None:
+        """노드 텍스트를 반환한다 (노드가 없으면 None)."""
+        return self._text(node) if node is not None else None
+
+    def _text(self, node: Node | None) -> str:
+        """노드 텍스트를 공백을 정규화해 반환한다."""
+        return " ".join(node_text(node).split())
@@ -0,0 +1,201 @@
+"""SignatureTypeCollector: 함수 시그니처가 참조하는 타입 선언을 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections import deque
+from collections.abc import Generator, Iterable
+
+from tree_sitter import Node
+
+from .node_order import node_order_key
+from .text_lines import node_text
+
+
+class SignatureTypeCollector:
+    """함수 시그니처에 등장하는 타입의 같은 파일 내 선언을 수집한다.
+
+    파라미터/반환 타입에서 시작해 수집된 선언 내부에서 참조하는 타입까지
+    너비 우선으로 따라가며, 이미 방문한 타입은 다시 방문하지 않으므로
+    자기 참조 타입에서도 무한 반복하지 않는다. 파일 안에서 선언을 찾지 못한
+    타입 이름(`models.User`처럼 패키지로 한정된 이름 포함)은 외부 resolver에
+    넘길 수 있도록 따로 반환한다.
+    """
+
+    # 언어별 시그니처 타입이 위치하는 필드 이름
+    LANGUAGE_SIGNATURE_FIELDS = {
+        "go": ("parameters", "result"),
+    }
+
+    # 언어별 타입 선언 노드 타입
+    LANGUAGE_TYPE_DECLARATION_TYPES = {
+        "go": frozenset({"type_spec", "type_alias"}),
+    }
+
+    # 언어별 타입 이름 참조 노드 타입
+    LANGUAGE_TYPE_IDENTIFIER_TYPES = {
+        "go": frozenset({"type_identifier"}),
+    }
+
+    # 언어별 패키지 한정 타입 참조 노드 타입 (`models.User`)
+    LANGUAGE_QUALIFIED_TYPE_TYPES = {
+        "go": frozenset({"qualified_type"}),
+    }
+
+    # 언어별 내장 타입 이름 (선언이 없어도 찾지 못한 타입으로 보지 않음)
+    LANGUAGE_BUILTIN_TYPES = {
+        "go": frozenset(
+            {
+                "any",
+                "bool",
+                "byte",
+                "comparable",
+                "complex64",
+                "complex128",
+                "error",
+                "float32",
+                "float64",
+                "int",
+                "int8",
+                "int16",
+                "int32",
+                "int64",
+                "rune",
+                "string",
+                "uint",
+                "uint8",
+                "uint16",
+                "uint32",
+                "uint64",
+                "uintptr",
+            }
+        ),
+    }
+
+    def __init__(self, language: str) -> None:
+        """수집기 초기화.
+
+        Args:
+            language: 대상 언어 이름
+        """
+        self._signature_fields = self.LANGUAGE_SIGNATURE_FIELDS.get(language, ())
+        self._declaration_types = self.LANGUAGE_TYPE_DECLARATION_TYPES.get(
+            language, frozenset()
+        )
+        self._identifier_types = self.LANGUAGE_TYPE_IDENTIFIER_TYPES.get(
+            language, frozenset()
+        )
+        self._qualified_types = self.LANGUAGE_QUALIFIED_TYPE_TYPES.get(
+            language, frozenset()
+        )
+        self._builtin_types = self.LANGUAGE_BUILTIN_TYPES.get(language, frozenset())
+
+    def is_supported(self) -> bool:
+        """해당 언어에서 시그니처 타입 수집을 지원하는지 반환한다."""
+        return bool(self._signature_fields)
+
+    def collect(
+        self, root: Node, symbol_nodes: Iterable[Node], max_types: int
+    ) -> list[Node]:
+        """심볼들의 시그니처가 참조하는 타입 선언 노드들을 수집한다.
+
+        Args:
+            root: AST 루트 노드
+            symbol_nodes: 시그니처를 검사할 심볼(함수) 노드들
+            max_types: 수집할 최대 타입 선언 개수
+
+        Returns:
+            발견된 순서대로 정렬된 타입 선언 노드들의 리스트
+        """
+        collected, _ = self.collect_with_missing(root, symbol_nodes, max_types)
+        return collected
+
+    def collect_with_missing(
+        self, root: Node, symbol_nodes: Iterable[Node], max_types: int
+    ) -> tuple[list[Node], list[str]]:
+        """타입 선언 노드들과 파일 안에서 선언을 찾지 못한 타입 이름들을 함께 반환한다.
+
+        Args:
+            root: AST 루트 노드
+            symbol_nodes: 시그니처를 검사할 심볼(함수) 노드들
+            max_types: 수집할 최대 타입 선언 개수
+
+        Returns:
+            (발견된 순서대로의 타입 선언 노드들, 등장 순서대로의 찾지 못한
+            타입 이름들) 튜플. 내장 타입은 찾지 못한 이름에 포함하지 않는다.
+        """
+        if not self.is_supported() or max_types <= 0:
+            return [], []
+
+        declarations = self._index_type_declarations(root)
+        pending: deque[str] = deque()
+        for symbol_node in sorted(symbol_nodes, key=node_order_key):
+            pending.extend(self._signature_type_names(symbol_node))
+
+        visited: set[str] = set()
+        collected: list[Node] = []
+        missing: list[str] = []
+        while pending and len(collected) < max_types:
+            type_name = pending.popleft()
+            if type_name in visited:
+                continue
+            visited.add(type_name)
+
+            declaration = declarations.get(type_name)
+            if declaration is None:
+                if type_name not in self._builtin_types:
+                    missing.append(type_name)
+                continue
+            collected.append(declaration)
+            # 선언이 참조하는 타입도 이어서 탐색 (visited로 순환 방지)
+            pending.extend(self._type_names_in(declaration))
+
+        return collected, missing
+
+    def _signature_type_names(self, symbol_node: Node) -> list[str]:
+        """심볼 노드의 시그니처 필드에 등장하는 타입 이름들을 반환한다."""
+        names: list[str] = []
+        for field_name in self._signature_fields:
+            field_node = symbol_node.child_by_field_name(field_name)
+            if field_node is not None:
+                names.extend(self._type_names_in(field_node))
+        return names
+
+    def _type_names_in(self, node: Node) -> list[str]:
+        """노드 내부에서 참조하는 타입 이름들을 등장 순서대로 반환한다."""
+        names: list[str] = []
+        for child in self._iter_nodes(node):
+            if child.type in self._identifier_types and child.text:
+                parent = child.parent
+                if parent is not None and parent.type in self._qualified_types:
+                    # 다른 패키지의 타입은 패키지로 한정한 이름 사용
+                    names.append(node_text(parent))
+                else:
+                    names.append(node_text(child))
+        return names
+
+    def _index_type_declarations(self, root: Node) -> dict[str, Node]:
+        """파일 내 타입 선언을 이름으로 색인한다.
+
+        단일 선언(`type X struct {...}`)은 `type` 키워드를 포함한 선언 전체를,
+        그룹 선언(`type ( ... )`)은 해당 spec 노드만 반환하도록 색인한다.
+        """
+        declarations: dict[str, Node] = {}
+        for node in self._iter_nodes(root):
+            if node.type not in self._declaration_types:
+                continue
+            name_node = node.child_by_field_name("name")
+            if name_node is None or not name_node.text:
+                continue
+            name = node_text(name_node)
+            parent = node.parent
+            if parent is not None and parent.named_child_count == 1:
+                declarations.setdefault(name, parent)
+            else:
+                declarations.setdefault(name, node)
+        return declarations
+
+    def _iter_nodes(self, node: Node) -> Generator[Node, None, None]:
+        """DFS 방식으로 모든 노드를 순회한다."""
+        yield node
+        for child in node.children:
+            yield from self._iter_nodes(child)
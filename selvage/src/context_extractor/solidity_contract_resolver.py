@@ -0,0 +1,157 @@
+"""SolidityContractResolver: Solidity 멤버를 감싸는 contract와 modifier를 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Collection
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class SolidityContractResolver(ScopeResolver):
+    """Solidity AST에서 멤버를 감싸는 contract/interface/library를 찾는다.
+
+    함수, modifier, event, struct, 상태 변수 등 멤버 블록에는 감싸는 contract의
+    선언 라인(상속 목록 포함)을 컨테이너 헤더로 함께 포함한다. 함수 시그니처에
+    적용된 modifier(`onlyOwner`)는 같은 파일의 modifier 정의를 찾아 참고용
+    블록으로 포함할 수 있도록 이름을 알려준다.
+    """
+
+    # 멤버를 담는 contract 계열 노드 타입
+    CONTRACT_TYPES = frozenset(
+        {"contract_declaration", "interface_declaration", "library_declaration"}
+    )
+
+    # 컨테이너(contract) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = frozenset(
+        {
+            "function_definition",
+            "constructor_definition",
+            "fallback_receive_definition",
+            "modifier_definition",
+            "event_definition",
+            "error_declaration",
+            "struct_declaration",
+            "enum_declaration",
+            "state_variable_declaration",
+        }
+    )
+
+    # modifier를 적용할 수 있는 함수 노드 타입
+    FUNCTION_TYPES = frozenset(
+        {
+            "function_definition",
+            "constructor_definition",
+            "fallback_receive_definition",
+        }
+    )
+
+    # modifier 정의/적용 노드 타입
+    MODIFIER_DEFINITION_TYPE = "modifier_definition"
+    MODIFIER_INVOCATION_TYPE = "modifier_invocation"
+
+    # contract 본문 노드 타입 (헤더는 본문 직전까지)
+    CONTRACT_BODY_TYPE = "contract_body"
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 contract/interface/library 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            contract 계열 노드 (없으면 None)
+        """
+        current = node.parent
+        while current is not None:
+            if current.type in self.CONTRACT_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """contract 선언부(`contract Vault is Ownable, Pausable {`)를 한 줄로 반환한다.
+
+        여러 줄에 걸친 상속 목록은 공백 하나로 이어 붙인다.
+        """
+        raw = container.text or b""
+        body = next(
+            (
+                child
+                for child in container.children
+                if child.type == self.CONTRACT_BODY_TYPE
+            ),
+            None,
+        )
+        if body is not None:
+            raw = raw[: body.start_byte - container.start_byte]
+        text = raw.decode("utf-8", errors="replace")
+        return " ".join(text.split()) + " {"
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 contract 이름을 반환한다."""
+        name_node = container.child_by_field_name("name")
+        return node_text(name_node) or None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """멤버를 감싸는 contract 이름을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 사용하지 않음 (감싸는 contract 하나만 반환)
+
+        Returns:
+            contract 이름 튜플 (contract 밖이면 빈 튜플)
+        """
+        container = self.find_container(node)
+        if container is None:
+            return ()
+        name = self.container_name(container)
+        return (name,) if name else ()
+
+    def applied_modifiers(self, node: Node) -> tuple[str, ...]:
+        """함수 시그니처에 적용된 modifier 이름들을 순서대로 반환한다.
+
+        Args:
+            node: 함수 노드
+
+        Returns:
+            modifier 이름 튜플 (함수가 아니면 빈 튜플). 부모 contract 생성자
+            호출(`Ownable(msg.sender)`)도 같은 문법이므로 포함될 수 있다.
+        """
+        if node.type not in self.FUNCTION_TYPES:
+            return ()
+        names: list[str] = []
+        for child in node.children:
+            if child.type != self.MODIFIER_INVOCATION_TYPE or not child.named_children:
+                continue
+            name = node_text(child.named_children[0])
+            if name and name not in names:
+                names.append(name)
+        return tuple(names)
+
+    def find_modifier_definitions(
+        self, root: Node, names: Collection[str]
+    ) -> list[Node]:
+        """파일 안에서 주어진 이름의 modifier 정의 노드들을 찾는다.
+
+        Args:
+            root: AST 루트 노드
+            names: 찾을 modifier 이름들
+
+        Returns:
+            위치 순의 modifier_definition 노드 리스트
+        """
+        definitions: list[Node] = []
+        stack = [root]
+        while stack:
+            current = stack.pop()
+            if current.type == self.MODIFIER_DEFINITION_TYPE:
+                name_node = current.child_by_field_name("name")
+                if node_text(name_node) in names:
+                    definitions.append(current)
+                continue
+            stack.extend(current.children)
+        return sorted(definitions, key=lambda definition: definition.start_byte)
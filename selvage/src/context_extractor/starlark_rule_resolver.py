@@ -0,0 +1,161 @@
+"""StarlarkRuleResolver: Bazel BUILD/Starlark 파일의 규칙 호출과 정의 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class StarlarkRuleResolver(ScopeResolver):
+    """Starlark AST에서 변경을 감싸는 함수 정의, 규칙 호출, 대입을 찾는다.
+
+    `cc_library(...)` 같은 규칙 호출 안의 속성이 바뀌면 호출 문장 전체를
+    반환하며, 블록 이름은 규칙의 `name` 속성 값(없으면 `package`처럼 호출한
+    함수 이름)이다. `.bzl` 파일의 `def` 안의 변경은 함수 정의 전체를, 최상위
+    대입(`DEPS = [...]`)의 변경은 대입 문장 하나를 반환한다. 최상위
+    `load(...)` 문은 의존성으로 수집한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 의존성 노드는 노드 타입 대신 is_dependency()로 판별
+    OWNS_DEPENDENCIES = True
+
+    # 정의 전체를 반환하는 함수 정의 노드 타입
+    FUNCTION_TYPES = frozenset({"function_definition"})
+
+    # 최상위 문장 노드 타입 (규칙 호출과 대입을 감쌈)
+    STATEMENT_TYPES = frozenset({"expression_statement"})
+
+    # 호출 노드 타입
+    CALL_TYPE = "call"
+
+    # 대입 노드 타입
+    ASSIGNMENT_TYPES = frozenset({"assignment", "augmented_assignment"})
+
+    # 다른 .bzl 파일의 심볼을 가져오는 함수 이름과 전용 문장 노드 타입
+    LOAD_FUNCTION = "load"
+    LOAD_TYPES = frozenset({"load_statement"})
+
+    # 규칙의 이름 속성
+    NAME_ATTRIBUTE = "name"
+
+    # 규칙 호출과 최상위 대입 블록의 block_type
+    RULE_TYPE = "rule"
+    ASSIGNMENT_TYPE = "assignment"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 함수 정의 또는 최상위 문장을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            함수 정의, 그렇지 않으면 최상위 문장 노드 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.FUNCTION_TYPES:
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None:
+                return current
+            current = parent
+        return None
+
+    def is_dependency(self, node: Node) -> bool:
+        """노드가 최상위 `load(...)` 문인지 확인한다."""
+        if node.type in self.LOAD_TYPES:
+            return True
+        if node.parent is None or node.parent.parent is not None:
+            return False
+        call = self._call(node)
+        return call is not None and self._function_name(call) == self.LOAD_FUNCTION
+
+    def block_type(self, node: Node) -> str | None:
+        """최상위 문장이면 "rule" 또는 "assignment"를 반환한다 (그 밖은 None)."""
+        if node.type not in self.STATEMENT_TYPES:
+            return None
+        if self._call(node) is not None:
+            return self.RULE_TYPE
+        if self._assignment(node) is not None:
+            return self.ASSIGNMENT_TYPE
+        return None
+
+    def name(self, node: Node) -> str | None:
+        """함수, 규칙(`name` 속성), 대입된 변수 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            함수 정의는 함수 이름, 규칙 호출은 `name` 속성 값 또는 호출한 함수
+            이름, 대입은 대입된 변수 이름 (그 밖의 노드는 None)
+        """
+        if node.type in self.FUNCTION_TYPES:
+            name_node = node.child_by_field_name("name")
+            return node_text(name_node) or None if name_node else None
+        call = self._call(node)
+        if call is not None:
+            return self._rule_name(call) or self._function_name(call)
+        assignment = self._assignment(node)
+        if assignment is not None:
+            left = assignment.child_by_field_name("left")
+            return node_text(left).strip() or None if left else None
+        return None
+
+    def _rule_name(self, call: Node) -> str | None:
+        """규칙 호출의 `name = "..."` 속성 값을 반환한다."""
+        arguments = call.child_by_field_name("arguments")
+        if arguments is None:
+            return None
+        for argument in arguments.named_children:
+            if argument.type != "keyword_argument":
+                continue
+            key = argument.child_by_field_name("name")
+            value = argument.child_by_field_name("value")
+            if key is None or node_text(key) != self.NAME_ATTRIBUTE:
+                continue
+            if value is None or value.type != "string":
+                return None
+            return node_text(value).strip("\"'") or None
+        return None
+
+    def _function_name(self, call: Node) -> str | None:
+        """호출한 함수 이름을 반환한다 (`native.cc_library`는 전체 경로)."""
+        function = call.child_by_field_name("function")
+        if function is None:
+            return None
+        return node_text(function) or None
+
+    def _call(self, statement: Node) -> Node | None:
+        """문장이 감싸는 호출 노드를 반환한다."""
+        if statement.type not in self.STATEMENT_TYPES:
+            return None
+        return next(
+            (
+                child
+                for child in statement.named_children
+                if child.type == self.CALL_TYPE
+            ),
+            None,
+        )
+
+    def _assignment(self, statement: Node) -> Node | None:
+        """문장이 감싸는 대입 노드를 반환한다."""
+        if statement.type not in self.STATEMENT_TYPES:
+            return None
+        return next(
+            (
+                child
+                for child in statement.named_children
+                if child.type in self.ASSIGNMENT_TYPES
+            ),
+            None,
+        )
@@ -0,0 +1,23 @@
+"""StructField: 구조체 필드 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class StructField:
+    """구조체 선언 안의 필드.
+
+    type과 tag는 소스에 적힌 텍스트 그대로이며, tag는 Go 구조체 태그
+    (`` `json:"result"` ``)처럼 백틱을 포함한다. 태그가 없으면 tag는 None이다.
+    임베딩 필드(`sync.Mutex`)는 패키지를 뺀 타입 이름(`Mutex`)을 name으로 사용한다.
+    line_range는 필드 선언이 걸친 파일 기준 라인 범위이다.
+    """
+
+    name: str
+    type: str
+    line_range: LineRange
+    tag: str | None = None
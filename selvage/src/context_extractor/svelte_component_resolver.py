@@ -0,0 +1,254 @@
+"""SvelteComponentResolver: Svelte 컴포넌트를 script, 마크업, style로 나누는 모듈."""
+
+from __future__ import annotations
+
+import re
+from bisect import bisect_right
+
+from .line_range import LineRange
+from .svelte_section import SvelteSection
+from .template_block import TemplateBlock
+from .text_lines import split_lines
+
+
+class SvelteComponentResolver:
+    """Svelte 컴포넌트 파일의 영역과 마크업 블록 구조를 구분자로 계산한다.
+
+    tree-sitter Svelte 문법 없이 최상위 `<script>`/`<style>` 태그로 영역을
+    나누고, 나머지 마크업에서는 `{#if}`...`{/if}` 같은 블록 태그의 짝으로
+    블록을 만든다. instance script의 반응형 선언(`$:`)은 괄호 깊이로 문장
+    끝을 추정한다.
+    """
+
+    SECTION_PATTERN = re.compile(
+        r"<(?P<tag>script|style)\b(?P<attributes>[^>]*)>"
+        r"(?P<content>.*?)</(?P=tag)\s*>",
+        re.DOTALL | re.IGNORECASE,
+    )
+
+    # module script 속성 (`context="module"`, Svelte 5 `module`)
+    MODULE_ATTRIBUTE_PATTERN = re.compile(
+        r"\bcontext\s*=\s*[\"']?module\b|(?:^|\s)module(?:\s|$)"
+    )
+
+    # `lang`/`type` 속성 값 → 추출기 언어
+    LANG_ATTRIBUTE_PATTERN = re.compile(
+        r"\b(?:lang|type)\s*=\s*[\"']?(?:text/)?(?P<lang>[\w-]+)"
+    )
+
+    # 영역 종류별 기본 추출기 언어와 lang 속성 값별 추출기 언어
+    DEFAULT_LANGUAGES = {"script": "javascript", "style": "css"}
+    LANG_LANGUAGES = {"ts": "typescript", "typescript": "typescript", "scss": "scss"}
+
+    # 마크업 블록 태그 (`{#each items as item}`, `{/each}`, `{#snippet row(x)}`)
+    MARKUP_TAG_PATTERN = re.compile(
+        r"\{(?P<sigil>[#/])(?P<keyword>\w+)(?P<rest>[^}]*)\}"
+    )
+
+    # 이름 있는 마크업 블록 키워드
+    NAMED_MARKUP_KEYWORDS = frozenset({"snippet"})
+
+    # 반응형 선언의 시작 (`$: total = a + b`, `$: { ... }`)
+    REACTIVE_PATTERN = re.compile(r"^\s*\$:\s*")
+
+    # 반응형 선언이 대입하는 이름 (`$: total = ...`)
+    REACTIVE_NAME_PATTERN = re.compile(r"^\s*\$:\s*([A-Za-z_$][\w$]*)\s*=(?!=)")
+
+    # 괄호 깊이 계산에서 건너뛰는 문자열과 주석
+    SKIPPED_TOKEN_PATTERN = re.compile(
+        r"\"(?:\\.|[^\"\\\n])*\"|'(?:\\.|[^'\\\n])*'|`(?:\\.|[^`\\])*`"
+        r"|//[^\n]*|/\*.*?\*/",
+        re.DOTALL,
+    )
+
+    def sections(self, text: str) -> list[SvelteSection]:
+        """컴포넌트의 `<script>`/`<style>` 영역들을 위치 순으로 반환한다.
+
+        Args:
+            text: 컴포넌트 파일 내용
+
+        Returns:
+            SvelteSection 리스트
+        """
+        line_starts = self._line_starts(text)
+        sections: list[SvelteSection] = []
+        for match in self.SECTION_PATTERN.finditer(text):
+            tag = match.group("tag").lower()
+            attributes = match.group("attributes")
+            kind = "style"
+            if tag == "script":
+                is_module = self.MODULE_ATTRIBUTE_PATTERN.search(attributes)
+                kind = "module" if is_module else "instance"
+            lang = self.LANG_ATTRIBUTE_PATTERN.search(attributes)
+            language = self.DEFAULT_LANGUAGES[tag]
+            if lang is not None:
+                language = self.LANG_LANGUAGES.get(lang.group("lang").lower(), language)
+            content = match.group("content")
+            start_line = self._line_at(line_starts, match.start("content"))
+            sections.append(
+                SvelteSection(
+                    kind=kind,
+                    language=language,
+                    line_range=LineRange(start_line, start_line + content.count("\n")),
+                    content=content,
+                )
+            )
+        return sections
+
+    def markup_blocks(self, text: str) -> list[TemplateBlock]:
+        """`<script>`/`<style>` 영역 밖의 마크업 블록들을 시작 위치 순으로 반환한다.
+
+        Args:
+            text: 컴포넌트 파일 내용
+
+        Returns:
+            TemplateBlock 리스트 (keyword는 `if`, `each`, `await`, `key`,
+            `snippet` 등이며 `snippet`만 이름이 있음)
+        """
+        # 영역 안의 `{`가 블록 태그로 잘못 읽히지 않도록 라인 수만 남기고 지움
+        markup = self.SECTION_PATTERN.sub(
+            lambda match: "\n" * match.group(0).count("\n"), text
+        )
+        line_starts = self._line_starts(markup)
+        last_line = max(len(split_lines(markup)), 1)
+        open_blocks: list[tuple[str, str | None, int]] = []
+        blocks: list[TemplateBlock] = []
+        for match in self.MARKUP_TAG_PATTERN.finditer(markup):
+            keyword = match.group("keyword")
+            if match.group("sigil") == "#":
+                name = None
+                if keyword in self.NAMED_MARKUP_KEYWORDS:
+                    name_match = re.match(r"\s*(\w+)", match.group("rest"))
+                    name = name_match.group(1) if name_match else None
+                open_blocks.append(
+                    (keyword, name, self._line_at(line_starts, match.start()))
+                )
+                continue
+            # 짝이 맞지 않는 닫는 태그는 무시
+            if not any(opened == keyword for opened, _, _ in open_blocks):
+                continue
+            while open_blocks:
+                opened, name, start_line = open_blocks.pop()
+                end_line = self._line_at(line_starts, match.end() - 1)
+                blocks.append(
+                    self._block(opened, name, start_line, end_line, open_blocks)
+                )
+                if opened == keyword:
+                    break
+
+        while open_blocks:
+            keyword, name, start_line = open_blocks.pop()
+            blocks.append(
+                self._block(keyword, name, start_line, last_line, open_blocks)
+            )
+        return sorted(
+            blocks,
+            key=lambda block: (
+                block.line_range.start_line,
+                -block.line_range.end_line,
+            ),
+        )
+
+    def find_markup_block(
+        self, blocks: list[TemplateBlock], line_no: int
+    ) -> TemplateBlock | None:
+        """라인을 감싸는 가장 안쪽의 snippet 블록을 찾는다.
+
+        snippet 블록이 없으면 가장 안쪽의 제어 블록(`if`, `each` 등)을 반환한다.
+
+        Args:
+            blocks: markup_blocks()로 계산한 마크업 블록들
+            line_no: 1-based 라인 번호
+
+        Returns:
+            라인을 감싸는 블록 (없으면 None)
+        """
+        candidates = [block for block in blocks if block.line_range.contains(line_no)]
+        named = [block for block in candidates if block.is_named]
+        return min(
+            named or candidates,
+            key=lambda block: block.line_range.line_count(),
+            default=None,
+        )
+
+    def reactive_declarations(
+        self, content: str
+    ) -> list[tuple[LineRange, str | None]]:
+        """script 최상위의 반응형 선언(`$:`)들을 위치 순으로 반환한다.
+
+        Args:
+            content: instance script 영역 내용
+
+        Returns:
+            (content 기준 라인 범위, 대입하는 이름) 리스트. `$: { ... }`처럼
+            대입이 아닌 선언의 이름은 None이다.
+        """
+        lines = split_lines(content)
+        depths = self._line_depths(content, len(lines))
+        declarations: list[tuple[LineRange, str | None]] = []
+        line_no = 1
+        while line_no <= len(lines):
+            line = lines[line_no - 1]
+            if depths[line_no - 1] != 0 or not self.REACTIVE_PATTERN.match(line):
+                line_no += 1
+                continue
+            end_line = line_no
+            # 문장 안에서 연 괄호가 모두 닫히는 라인까지를 선언으로 봄
+            while end_line < len(lines) and depths[end_line] > 0:
+                end_line += 1
+            name_match = self.REACTIVE_NAME_PATTERN.match(line)
+            declarations.append(
+                (
+                    LineRange(line_no, end_line),
+                    name_match.group(1) if name_match else None,
+                )
+            )
+            line_no = end_line + 1
+        return declarations
+
+    def _line_depths(self, content: str, line_count: int) -> list[int]:
+        """각 라인이 시작할 때의 괄호 깊이를 반환한다 (문자열과 주석은 제외)."""
+        code = self.SKIPPED_TOKEN_PATTERN.sub(
+            lambda match: "\n" * match.group(0).count("\n"), content
+        )
+        depths = [0] * max(line_count, 1)
+        depth = 0
+        line_index = 0
+        for char in code:
+            if char == "\n":
+                line_index += 1
+                if line_index < len(depths):
+                    depths[line_index] = depth
+            elif char in "([{":
+                depth += 1
+            elif char in ")]}":
+                depth = max(depth - 1, 0)
+        return depths
+
+    @staticmethod
+    def _block(
+        keyword: str,
+        name: str | None,
+        start_line: int,
+        end_line: int,
+        open_blocks: list[tuple[str, str | None, int]],
+    ) -> TemplateBlock:
+        """아직 열려 있는 바깥 블록들의 이름으로 scope_path를 채운 블록을 만든다."""
+        return TemplateBlock(
+            keyword=keyword,
+            line_range=LineRange(start_line, end_line),
+            name=name,
+            scope_path=tuple(
+                outer_name for _, outer_name, _ in open_blocks if outer_name
+            ),
+        )
+
+    @staticmethod
+    def _line_starts(text: str) -> list[int]:
+        """각 라인의 시작 오프셋 목록을 반환한다."""
+        return [0, *(match.end() for match in re.finditer("\n", text))]
+
+    @staticmethod
+    def _line_at(line_starts: list[int], offset: int) -> int:
+        """오프셋이 속한 1-based 라인 번호를 반환한다."""
+        return bisect_right(line_starts, offset)
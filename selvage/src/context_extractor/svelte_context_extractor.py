@@ -0,0 +1,309 @@
+"""SvelteContextExtractor: Svelte 컴포넌트를 위한 영역별 컨텍스트 추출기."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import replace
+
+from selvage.src.exceptions import ParseTimeoutError, UnsupportedLanguageError
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .extraction_options import ExtractionOptions
+from .line_range import LineRange
+from .meaningless_change_filter import MeaninglessChangeFilter
+from .svelte_component_resolver import SvelteComponentResolver
+from .svelte_section import SvelteSection
+from .text_lines import split_lines
+
+
+class SvelteContextExtractor:
+    """Svelte 컴포넌트(`.svelte`)의 변경을 script, 마크업, style 영역별로 추출한다.
+
+    주요 특징:
+    - `<script>` 영역의 변경은 JavaScript 추출기(`lang="ts"`면 TypeScript)로,
+      `<style>` 영역의 변경은 CSS 추출기로 추출한 뒤 라인 번호를 파일 기준으로
+      되돌림 (함수 안의 변경은 함수 전체)
+    - `<script context="module">`은 instance script와 따로 추출하며, 블록의
+      scope_path 맨 앞에 MODULE_SCOPE를 붙여 구분
+    - instance script 최상위의 반응형 선언(`$:`)은 최상위 심볼처럼 선언 전체를
+      block_type이 "reactive_declaration"인 블록으로 반환
+    - 마크업의 변경은 감싸는 가장 안쪽의 `{#snippet}`, 없으면 제어 블록
+      (`{#if}`, `{#each}` 등), 블록 밖이면 앞뒤 CONTEXT_LINES 라인을 반환
+    - script의 import는 각 영역 추출기가 만든 의존성 블록으로 포함
+    """
+
+    SUPPORTED_LANGUAGES = ("svelte",)
+
+    # 마크업 블록 밖의 변경에 대해 앞뒤로 포함할 라인 수
+    CONTEXT_LINES = 5
+
+    # 반응형 선언 블록의 block_type
+    REACTIVE_BLOCK_TYPE = "reactive_declaration"
+
+    # module script 블록의 scope_path 맨 앞에 붙이는 이름
+    MODULE_SCOPE = "module"
+
+    def __init__(
+        self, language: str = "svelte", options: ExtractionOptions | None = None
+    ) -> None:
+        """추출기 초기화.
+
+        Args:
+            language: 언어 이름 ("svelte")
+            options: script/style 영역 추출기에 전달할 추출 옵션
+
+        Raises:
+            UnsupportedLanguageError: 지원하지 않는 언어인 경우
+        """
+        if not self.is_supported(language):
+            raise UnsupportedLanguageError(language)
+        self._resolver = SvelteComponentResolver()
+        self._options = options
+        # 마크업에서는 `#`, `--`로 시작하는 라인도 본문이므로 주석으로 보지 않음
+        self._filter = MeaninglessChangeFilter(detect_comments=False)
+
+    @classmethod
+    def is_supported(cls, language: str) -> bool:
+        """Svelte 추출기가 지원하는 언어인지 확인한다."""
+        return language in cls.SUPPORTED_LANGUAGES
+
+    def extract_contexts(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[str]:
+        """변경된 라인 범위들을 기반으로 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 컴포넌트 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            추출된 컨텍스트 코드 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        blocks = self.extract_context_blocks(file_content, changed_ranges)
+        return ContextBlock.format_blocks(blocks)
+
+    def extract_context_blocks(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 라인 범위들을 기반으로 구조화된 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 컴포넌트 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            의존성 블록들과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        if not changed_ranges:
+            return []
+        if not file_content:
+            raise ValueError("파일 내용이 비어있습니다")
+
+        lines = split_lines(file_content)
+        meaningful_ranges = self._filter.filter_meaningful_ranges_with_lines(
+            lines, changed_ranges
+        )
+        changed_lines = sorted(
+            {
+                line
+                for line_range in meaningful_ranges
+                for line in range(line_range.start_line, line_range.end_line + 1)
+                if line <= len(lines)
+            }
+        )
+        if not changed_lines:
+            return []
+
+        blocks: list[ContextBlock] = []
+        remaining = set(changed_lines)
+        for section in self._resolver.sections(file_content):
+            section_lines = sorted(
+                line for line in remaining if section.line_range.contains(line)
+            )
+            if not section_lines:
+                continue
+            if section.kind == "instance":
+                reactive_blocks, section_lines = self._create_reactive_blocks(
+                    section, section_lines
+                )
+                blocks.extend(reactive_blocks)
+                remaining.difference_update(
+                    line
+                    for block in reactive_blocks
+                    for line in block.changed_lines
+                )
+            if not section_lines:
+                continue
+            section_blocks = self._extract_section(section, section_lines)
+            if section_blocks is not None:
+                blocks.extend(section_blocks)
+                remaining.difference_update(section_lines)
+
+        blocks.extend(
+            self._create_markup_blocks(file_content, lines, sorted(remaining))
+        )
+        return sorted(
+            blocks,
+            key=lambda block: (not block.is_dependency, block.line_range.start_line),
+        )
+
+    def _create_reactive_blocks(
+        self, section: SvelteSection, changed_lines: list[int]
+    ) -> tuple[list[ContextBlock], list[int]]:
+        """instance script의 반응형 선언 안의 변경을 선언 블록으로 만든다.
+
+        Args:
+            section: instance script 영역
+            changed_lines: 영역 안의 변경 라인들 (파일 기준)
+
+        Returns:
+            (반응형 선언 블록 리스트, 선언 밖에 남은 변경 라인 리스트) 튜플
+        """
+        offset = section.line_offset
+        content_lines = split_lines(section.content)
+        blocks: list[ContextBlock] = []
+        remaining = list(changed_lines)
+        for local_range, name in self._resolver.reactive_declarations(
+            section.content
+        ):
+            line_range = LineRange(
+                local_range.start_line + offset, local_range.end_line + offset
+            )
+            declaration_lines = tuple(
+                line for line in remaining if line_range.contains(line)
+            )
+            if not declaration_lines:
+                continue
+            blocks.append(
+                ContextBlock(
+                    text=self._text(content_lines, local_range),
+                    line_range=line_range,
+                    block_type=self.REACTIVE_BLOCK_TYPE,
+                    name=name,
+                    changed_lines=declaration_lines,
+                )
+            )
+            remaining = [line for line in remaining if line not in declaration_lines]
+        return blocks, remaining
+
+    def _extract_section(
+        self, section: SvelteSection, changed_lines: list[int]
+    ) -> list[ContextBlock] | None:
+        """영역 안의 변경을 영역 언어 추출기로 추출하고 라인 번호를 되돌린다.
+
+        Args:
+            section: script/style 영역
+            changed_lines: 영역 안의 변경 라인들 (파일 기준)
+
+        Returns:
+            파일 기준 라인 번호의 블록들. 영역 추출기를 사용할 수 없거나
+            파싱이 시간 제한을 넘기면 변경을 마크업으로 처리하도록 None
+        """
+        offset = section.line_offset
+        try:
+            section_blocks = ContextExtractor(
+                section.language, self._options
+            ).extract_context_blocks(
+                section.content,
+                [LineRange(line - offset, line - offset) for line in changed_lines],
+            )
+        except (UnsupportedLanguageError, ValueError, ParseTimeoutError):
+            return None
+        scope_prefix = (self.MODULE_SCOPE,) if section.kind == "module" else ()
+        return [
+            replace(
+                block,
+                line_range=LineRange(
+                    block.line_range.start_line + offset,
+                    block.line_range.end_line + offset,
+                ),
+                changed_lines=tuple(line + offset for line in block.changed_lines),
+                scope_path=(
+                    block.scope_path
+                    if block.is_dependency
+                    else (*scope_prefix, *block.scope_path)
+                ),
+            )
+            for block in section_blocks
+        ]
+
+    def _create_markup_blocks(
+        self, file_content: str, lines: list[str], changed_lines: list[int]
+    ) -> list[ContextBlock]:
+        """마크업 변경 라인들을 감싸는 마크업 블록(없으면 주변 라인)을 만든다.
+
+        Args:
+            file_content: 컴포넌트 파일 내용
+            lines: 파일의 모든 라인들
+            changed_lines: script/style 영역 추출기로 처리되지 않은 변경 라인들
+
+        Returns:
+            라인 순으로 정렬된 컨텍스트 블록들
+        """
+        if not changed_lines:
+            return []
+        markup_blocks = self._resolver.markup_blocks(file_content)
+        blocks_by_range: dict[tuple[int, int], ContextBlock] = {}
+        outside_lines: list[int] = []
+        for line in changed_lines:
+            markup_block = self._resolver.find_markup_block(markup_blocks, line)
+            if markup_block is None:
+                outside_lines.append(line)
+                continue
+            line_range = markup_block.line_range
+            key = (line_range.start_line, line_range.end_line)
+            block = blocks_by_range.get(key)
+            if block is None:
+                block = blocks_by_range[key] = ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    block_type=markup_block.keyword,
+                    name=markup_block.name,
+                    scope_path=markup_block.scope_path,
+                )
+            block.changed_lines = (*block.changed_lines, line)
+
+        blocks = list(blocks_by_range.values())
+        for line_range in self._windows(outside_lines, len(lines)):
+            blocks.append(
+                ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    changed_lines=tuple(
+                        line for line in outside_lines if line_range.contains(line)
+                    ),
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _windows(self, lines: list[int], line_count: int) -> list[LineRange]:
+        """라인들을 앞뒤 CONTEXT_LINES 라인으로 확장하고 겹치는 범위를 병합한다.
+
+        Args:
+            lines: 정렬된 1-based 라인 번호들
+            line_count: 범위를 자를 전체 라인 수
+
+        Returns:
+            시작 라인 순의 병합된 범위들
+        """
+        windows: list[LineRange] = []
+        for line in lines:
+            start = max(1, line - self.CONTEXT_LINES)
+            end = min(line + self.CONTEXT_LINES, line_count)
+            if windows and start <= windows[-1].end_line + 1:
+                windows[-1] = LineRange(windows[-1].start_line, end)
+                continue
+            windows.append(LineRange(start, end))
+        return windows
+
+    @staticmethod
+    def _text(lines: list[str], line_range: LineRange) -> str:
+        """라인 범위의 원문 텍스트를 반환한다."""
+        return "\n".join(lines[line_range.start_line - 1 : line_range.end_line])
@@ -0,0 +1,29 @@
+"""SvelteSection: Svelte 컴포넌트의 `<script>`/`<style>` 영역 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class SvelteSection:
+    """Svelte 컴포넌트 안의 호스트 언어 코드 영역.
+
+    kind는 영역 종류("instance", "module", "style")이며, "module"은
+    `<script context="module">`(Svelte 5의 `<script module>`)이다. language는
+    영역을 추출할 ContextExtractor 언어(`lang="ts"`면 "typescript")이고,
+    content는 여는 태그와 닫는 태그 사이의 원문이다. line_range는 여는 태그가
+    끝나는 라인(content의 첫 라인)부터 닫는 태그가 시작하는 라인까지이다.
+    """
+
+    kind: str
+    language: str
+    line_range: LineRange
+    content: str
+
+    @property
+    def line_offset(self) -> int:
+        """content의 라인 번호를 파일 라인 번호로 바꿀 때 더할 값"""
+        return self.line_range.start_line - 1
@@ -0,0 +1,60 @@
+"""SymbolChangeClassifier: 추출된 심볼을 diff 변경 상태로 분류하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Iterable
+
+from .context_block import ContextBlock
+from .diff_line_changes import DiffLineChanges
+from .symbol_change_status import SymbolChangeStatus
+
+
+class SymbolChangeClassifier:
+    """블록의 라인 범위를 diff 추가/삭제 라인과 비교해 변경 상태를 붙인다.
+
+    - added: 블록의 모든 라인이 추가된 라인 (diff에서 새로 생긴 심볼)
+    - modified: 추가/삭제된 라인이 일부 포함됨
+    - context: 추가/삭제된 라인이 없음 (참조 타입 등 참고용 블록)
+    """
+
+    def __init__(self, line_changes: DiffLineChanges) -> None:
+        """분류기 초기화.
+
+        Args:
+            line_changes: 파일의 diff 추가/삭제 라인 정보
+        """
+        self._line_changes = line_changes
+
+    def annotate(self, blocks: Iterable[ContextBlock]) -> None:
+        """의존성 블록을 제외한 블록들에 변경 상태와 추가/삭제 라인 정보를 기록한다.
+
+        Args:
+            blocks: 분류할 블록들
+        """
+        for block in blocks:
+            if block.is_dependency:
+                continue
+            block.added_line_count = self._line_changes.count_added(block.line_range)
+            block.deleted_line_count = self._line_changes.count_deleted(
+                block.line_range
+            )
+            block.has_additions = block.added_line_count > 0
+            block.has_deletions = block.deleted_line_count > 0
+            block.change_status = self.classify(block)
+
+    def classify(self, block: ContextBlock) -> SymbolChangeStatus:
+        """블록 하나의 변경 상태를 계산한다.
+
+        Args:
+            block: 분류할 블록
+
+        Returns:
+            블록의 변경 상태
+        """
+        added = self._line_changes.count_added(block.line_range)
+        deleted = self._line_changes.count_deleted(block.line_range)
+        if added == 0 and deleted == 0:
+            return SymbolChangeStatus.CONTEXT
+        if added == block.line_range.line_count() and deleted == 0:
+            return SymbolChangeStatus.ADDED
+        return SymbolChangeStatus.MODIFIED
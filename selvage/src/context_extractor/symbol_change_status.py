@@ -0,0 +1,13 @@
+"""SymbolChangeStatus: diff 기준 심볼 변경 상태."""
+
+from __future__ import annotations
+
+from enum import Enum
+
+
+class SymbolChangeStatus(str, Enum):
+    """추출된 심볼이 diff에서 어떻게 변경되었는지를 나타낸다."""
+
+    ADDED = "added"  # 심볼의 모든 라인이 추가된 라인
+    MODIFIED = "modified"  # 추가/삭제와 기존 라인이 섞여 있음
+    CONTEXT = "context"  # 직접 변경되지 않고 참고용으로 포함됨
@@ -0,0 +1,36 @@
+"""SymbolCost: 심볼 블록을 프롬프트에 포함하는 비용 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import Any
+
+
+@dataclass(frozen=True)
+class SymbolCost:
+    """심볼 블록 하나의 크기 지표와 이를 가중합한 비용.
+
+    byte_size와 token_estimate는 블록 본문(package 선언 포함) 기준이고,
+    complexity는 분기(조건문, 반복문, case, 논리 연산자 등) 수에 1을 더한
+    순환 복잡도 근사값이며 가중치가 0이면 계산하지 않아 None이다. value는
+    SymbolCostWeights로 세 지표를 가중합한 값으로, 호출자가 변경 영향도를
+    비용으로 나눠 포함 순서를 정할 때 쓴다.
+    """
+
+    byte_size: int
+    token_estimate: int
+    complexity: int | None
+    value: float
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            byte_size/token_estimate/complexity/value 키를 가진 딕셔너리
+        """
+        return {
+            "byte_size": self.byte_size,
+            "token_estimate": self.token_estimate,
+            "complexity": self.complexity,
+            "value": round(self.value, 3),
+        }
@@ -0,0 +1,56 @@
+"""SymbolCostWeights: 심볼 비용 계산에 쓰는 지표별 가중치 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+
+@dataclass(frozen=True)
+class SymbolCostWeights:
+    """심볼 비용(SymbolCost.value)을 만드는 지표별 가중치.
+
+    비용은 `byte_size * byte_weight + token_estimate * token_weight +
+    complexity * complexity_weight`이다. complexity_weight가 0이면 복잡도를
+    계산하지 않는다.
+
+    Attributes:
+        byte_weight: UTF-8 바이트 하나당 비용
+        token_weight: 추정 토큰 하나당 비용
+        complexity_weight: 순환 복잡도 1당 비용
+    """
+
+    byte_weight: float = 0.1
+    token_weight: float = 1.0
+    complexity_weight: float = 0.0
+
+    def __post_init__(self) -> None:
+        """유효성 검증을 수행한다."""
+        if self.byte_weight < 0:
+            raise ValueError("byte_weight는 0 이상이어야 합니다")
+        if self.token_weight < 0:
+            raise ValueError("token_weight는 0 이상이어야 합니다")
+        if self.complexity_weight < 0:
+            raise ValueError("complexity_weight는 0 이상이어야 합니다")
+
+    @property
+    def includes_complexity(self) -> bool:
+        """비용에 복잡도를 반영하는지 여부"""
+        return self.complexity_weight > 0
+
+    def combine(
+        self, byte_size: int, token_estimate: int, complexity: int | None = None
+    ) -> float:
+        """지표들을 가중합해 비용을 계산한다.
+
+        Args:
+            byte_size: 블록 텍스트의 UTF-8 바이트 수
+            token_estimate: 블록 텍스트의 추정 토큰 수
+            complexity: 블록의 순환 복잡도 (계산하지 않았으면 None)
+
+        Returns:
+            가중합 비용
+        """
+        cost = byte_size * self.byte_weight + token_estimate * self.token_weight
+        if complexity is not None:
+            cost += complexity * self.complexity_weight
+        return cost
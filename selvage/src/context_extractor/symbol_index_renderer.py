@@ -0,0 +1,117 @@
+"""SymbolIndexRenderer: 추출된 심볼을 한 줄에 하나씩 탭으로 구분해 나열하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+
+from .context_block import ContextBlock
+from .extracted_file_context import ExtractedFileContext
+from .position_convention import PositionConvention
+
+
+class SymbolIndexRenderer:
+    """파일별 추출 결과를 ctags와 비슷한 라인 단위 심볼 인덱스로 렌더링한다.
+
+    각 라인은 `경로<TAB>시작-끝 라인<TAB>종류<TAB>정규화된 이름` 형식이며,
+    grep이나 편집기의 태그 이동처럼 가벼운 용도에 쓰인다.
+    DirectoryContextExtractor의 outline 결과와 함께 쓰는 것을 전제로 한다.
+
+    주요 특징:
+    - 이름 있는 컨텍스트 블록만 나열 (의존성 블록, 이름 변경 전 블록 제외)
+    - 정규화된 이름은 scope_path가 있으면 이를, 없으면 같은 파일에서 라인
+      범위로 감싸는 심볼 이름들을 바깥쪽부터 `.`으로 이은 것
+    - 필드 안의 `\\`, 탭, 줄바꿈은 `\\\\`, `\\t`, `\\n`, `\\r`로 이스케이프
+    - 파일은 입력 순서, 파일 안에서는 라인 순서로 출력
+    - 라인 범위는 PositionConvention으로 표기 (기본값은 1-based inclusive)
+    """
+
+    FIELD_SEPARATOR = "\t"
+    NAME_SEPARATOR = "."
+
+    # 필드 안에서 이스케이프할 문자 (`\\`를 가장 먼저 바꿔야 함)
+    ESCAPES = (("\\", "\\\\"), ("\t", "\\t"), ("\n", "\\n"), ("\r", "\\r"))
+
+    def __init__(self, convention: PositionConvention | None = None) -> None:
+        """SymbolIndexRenderer를 초기화한다.
+
+        Args:
+            convention: 라인 범위 표기 규칙 (None이면 1-based inclusive)
+        """
+        self._convention = convention or PositionConvention()
+
+    def render(self, results: Sequence[ExtractedFileContext]) -> str:
+        """파일별 추출 결과들을 심볼 인덱스 문서로 렌더링한다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+
+        Returns:
+            심볼마다 한 줄인 문서 문자열 (심볼이 없으면 빈 문자열)
+        """
+        lines: list[str] = []
+        for result in results:
+            blocks = [block for block in result.context_blocks if block.name]
+            for block in blocks:
+                lines.append(self._render_line(result.file_path, block, blocks))
+        return "\n".join(lines)
+
+    def _render_line(
+        self, file_path: str, block: ContextBlock, blocks: Sequence[ContextBlock]
+    ) -> str:
+        """블록 하나의 인덱스 라인을 만든다."""
+        start_line, end_line = block.line_span(self._convention)
+        fields = (
+            block.source_path or file_path,
+            f"{start_line}-{end_line}",
+            block.block_type or "",
+            self.NAME_SEPARATOR.join(self._qualified_parts(block, blocks)),
+        )
+        return self.FIELD_SEPARATOR.join(self._escape(field) for field in fields)
+
+    @staticmethod
+    def _qualified_parts(
+        block: ContextBlock, blocks: Sequence[ContextBlock]
+    ) -> tuple[str, ...]:
+        """블록의 조상 이름들과 자신의 이름을 바깥쪽부터 반환한다."""
+        name = block.name or ""
+        if block.scope_path:
+            return (*block.scope_path, name)
+        ancestors = sorted(
+            (
+                other
+                for other in blocks
+                if other is not block
+                and other.source_path == block.source_path
+                and other.line_range.start_line <= block.line_range.start_line
+                and block.line_range.end_line <= other.line_range.end_line
+                and other.line_range != block.line_range
+            ),
+            key=lambda other: (
+                other.line_range.start_line,
+                -other.line_range.end_line,
+            ),
+        )
+        return (*(other.name or "" for other in ancestors), name)
+
+    @classmethod
+    def _escape(cls, field: str) -> str:
+        """필드 안의 구분 문자들을 이스케이프한다."""
+        for char, escaped in cls.ESCAPES:
+            field = field.replace(char, escaped)
+        return field
+
+
+def render_symbol_index(
+    results: Sequence[ExtractedFileContext],
+    convention: PositionConvention | None = None,
+) -> str:
+    """파일별 추출 결과를 라인 단위 심볼 인덱스로 렌더링한다.
+
+    Args:
+        results: 파일별 컨텍스트 추출 결과들
+        convention: 라인 범위 표기 규칙 (None이면 1-based inclusive)
+
+    Returns:
+        `경로<TAB>시작-끝<TAB>종류<TAB>정규화된 이름` 라인들
+    """
+    return SymbolIndexRenderer(convention).render(results)
@@ -0,0 +1,42 @@
+"""SymbolLineMetrics: 심볼 블록의 라인 수와 주석 비율 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import Any
+
+
+@dataclass(frozen=True)
+class SymbolLineMetrics:
+    """심볼 블록 하나의 코드/주석/빈 라인 수.
+
+    code_lines는 주석이 아닌 토큰이 하나라도 있는 라인 수(LOC)이며, 코드
+    뒤에 주석이 붙은 라인도 코드 라인으로 센다. comment_lines는 주석만 있는
+    라인 수, blank_lines는 공백만 있는 라인 수이며 세 값의 합은 블록의
+    라인 수와 같다.
+    """
+
+    code_lines: int
+    comment_lines: int
+    blank_lines: int
+
+    @property
+    def comment_ratio(self) -> float:
+        """빈 라인을 제외한 라인 중 주석 라인의 비율 (0.0 ~ 1.0)"""
+        non_blank_lines = self.code_lines + self.comment_lines
+        if non_blank_lines == 0:
+            return 0.0
+        return self.comment_lines / non_blank_lines
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            code_lines/comment_lines/blank_lines/comment_ratio 키를 가진 딕셔너리
+        """
+        return {
+            "code_lines": self.code_lines,
+            "comment_lines": self.comment_lines,
+            "blank_lines": self.blank_lines,
+            "comment_ratio": round(self.comment_ratio, 3),
+        }
@@ -0,0 +1,40 @@
+"""SymbolNameFormatter: 심볼 블록의 표시 이름을 만드는 언어별 포맷터."""
+
+from __future__ import annotations
+
+from .symbol_name_parts import SymbolNameParts
+
+
+class SymbolNameFormatter:
+    """심볼 이름 구성 요소로 블록의 name과 qualified_name을 만든다.
+
+    기본 구현은 name에 선언 이름만, qualified_name에 감싸는 선언 이름들과
+    선언 이름을 `.`으로 이은 값(find_symbol의 정규화된 이름)을 사용한다.
+    receiver나 package로 한정된 이름이 필요하면 하위 클래스에서 메서드를
+    재정의해 ExtractionOptions.name_formatters에 언어별로 등록한다.
+    """
+
+    # 기본 qualified_name에서 이름 조각들을 잇는 구분자
+    SEPARATOR = "."
+
+    def display_name(self, parts: SymbolNameParts) -> str:
+        """블록의 name에 기록할 표시 이름을 반환한다.
+
+        Args:
+            parts: 심볼 이름 구성 요소
+
+        Returns:
+            표시 이름 (기본값: 선언 이름)
+        """
+        return parts.name
+
+    def qualified_name(self, parts: SymbolNameParts) -> str:
+        """블록의 qualified_name에 기록할 한정 이름을 반환한다.
+
+        Args:
+            parts: 심볼 이름 구성 요소
+
+        Returns:
+            한정 이름 (기본값: 감싸는 선언 이름들과 선언 이름을 `.`으로 이은 값)
+        """
+        return self.SEPARATOR.join((*parts.owner_types, parts.name))
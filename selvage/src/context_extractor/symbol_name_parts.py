@@ -0,0 +1,27 @@
+"""SymbolNameParts: 심볼 표시 이름을 만드는 데 쓰는 이름 구성 요소."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .symbol_signature import SymbolSignature
+
+
+@dataclass(frozen=True)
+class SymbolNameParts:
+    """SymbolNameFormatter에 전달되는 심볼 이름의 구성 요소.
+
+    Attributes:
+        name: 선언 자체의 이름 (예: Go 메서드 `AddNumbers`)
+        package: 파일의 package 이름 (Go `main`, Java `com.example`; package
+            선언이 없는 언어/파일이면 None)
+        owner_types: 심볼을 감싸는 선언 이름들 (블록의 scope_path와 같으며,
+            Go 메서드는 receiver 타입)
+        signature: include_signatures 옵션이 켜진 경우 함수/메서드의 구조화된
+            시그니처 (그 외에는 None)
+    """
+
+    name: str
+    package: str | None = None
+    owner_types: tuple[str, ...] = ()
+    signature: SymbolSignature | None = None
@@ -0,0 +1,27 @@
+"""SymbolResolver: 파일 밖 심볼 정의를 찾는 외부 resolver 인터페이스."""
+
+from __future__ import annotations
+
+from abc import ABC, abstractmethod
+
+from .resolved_symbol import ResolvedSymbol
+
+
+class SymbolResolver(ABC):
+    """저장소 전체 심볼 색인 등으로 다른 파일의 정의를 찾아주는 resolver.
+
+    추출기는 참조된 심볼의 정의를 현재 파일에서 찾지 못한 경우에만 호출하며,
+    반환된 정의는 출처 파일 경로와 함께 cross-file 참조 블록으로 포함한다.
+    """
+
+    @abstractmethod
+    def resolve(self, qualified_name: str, language: str) -> ResolvedSymbol | None:
+        """한정 이름의 심볼 정의를 찾는다.
+
+        Args:
+            qualified_name: 심볼 이름 (Go `models.User`처럼 패키지로 한정될 수 있음)
+            language: 참조가 등장한 파일의 언어
+
+        Returns:
+            정의 내용과 위치 (찾지 못하면 None)
+        """
@@ -0,0 +1,101 @@
+"""SymbolRevisionMatcher: 새 리비전의 심볼에 대응하는 이전 리비전 심볼을 찾는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from difflib import SequenceMatcher
+
+from .context_block import ContextBlock
+from .symbol_revision_pair import SymbolRevisionPair
+
+
+class SymbolRevisionMatcher:
+    """이름, 위치, 내용 유사도로 심볼의 이전 리비전을 찾는다.
+
+    같은 이름의 심볼을 먼저 찾고 (여러 개면 블록 타입이 같고 새 위치와 가장
+    가까운 것), 없으면 이름이 바뀐 것으로 보고 새 리비전에 더 이상 존재하지
+    않는 이름의 같은 타입 심볼 중 텍스트 유사도가 가장 높은 것을 선택한다.
+    유사도가 같으면 위치가 가까운 심볼을 우선한다.
+    """
+
+    # 이름이 바뀐 심볼로 인정하는 최소 텍스트 유사도
+    DEFAULT_RENAME_THRESHOLD = 0.6
+
+    def __init__(self, rename_threshold: float = DEFAULT_RENAME_THRESHOLD) -> None:
+        """매처 초기화.
+
+        Args:
+            rename_threshold: 이름이 바뀐 심볼로 인정하는 최소 유사도 (0.0~1.0)
+
+        Raises:
+            ValueError: rename_threshold가 0.0~1.0 범위를 벗어난 경우
+        """
+        if not 0.0 <= rename_threshold <= 1.0:
+            raise ValueError("rename_threshold는 0.0 이상 1.0 이하여야 합니다")
+        self._rename_threshold = rename_threshold
+
+    def match(
+        self,
+        new_block: ContextBlock,
+        old_blocks: Sequence[ContextBlock],
+        new_names: frozenset[str] | set[str] = frozenset(),
+    ) -> SymbolRevisionPair:
+        """새 리비전 심볼의 이전 리비전 블록을 찾는다.
+
+        Args:
+            new_block: 새 리비전의 심볼 블록
+            old_blocks: 이전 리비전의 심볼 블록 후보들
+            new_names: 새 리비전에 존재하는 심볼 이름들 (이름 변경 후보에서 제외)
+
+        Returns:
+            이전/이후 블록과 매칭 방식을 담은 SymbolRevisionPair
+        """
+        named = [block for block in old_blocks if block.name == new_block.name]
+        if new_block.name is not None and named:
+            # 데코레이터 추가 등으로 블록 타입이 바뀐 경우도 같은 심볼로 본다
+            old_block = min(
+                named,
+                key=lambda block: (
+                    block.block_type != new_block.block_type,
+                    self._distance(block, new_block),
+                ),
+            )
+            return SymbolRevisionPair(
+                new_block=new_block,
+                old_block=old_block,
+                match_kind=SymbolRevisionPair.NAME_MATCH,
+                similarity=self._similarity(old_block, new_block),
+            )
+
+        best: tuple[float, int, ContextBlock] | None = None
+        for block in old_blocks:
+            if block.block_type != new_block.block_type:
+                continue
+            if block.name is not None and block.name in new_names:
+                continue
+            candidate = (
+                self._similarity(block, new_block),
+                -self._distance(block, new_block),
+                block,
+            )
+            if best is None or candidate[:2] > best[:2]:
+                best = candidate
+
+        if best is None or best[0] < self._rename_threshold:
+            return SymbolRevisionPair(new_block=new_block)
+        return SymbolRevisionPair(
+            new_block=new_block,
+            old_block=best[2],
+            match_kind=SymbolRevisionPair.SIMILARITY_MATCH,
+            similarity=best[0],
+        )
+
+    @staticmethod
+    def _similarity(old_block: ContextBlock, new_block: ContextBlock) -> float:
+        """두 블록 텍스트의 유사도를 반환한다."""
+        return SequenceMatcher(None, old_block.text, new_block.text).ratio()
+
+    @staticmethod
+    def _distance(old_block: ContextBlock, new_block: ContextBlock) -> int:
+        """두 블록 시작 라인 사이의 거리를 반환한다."""
+        return abs(old_block.line_range.start_line - new_block.line_range.start_line)
@@ -0,0 +1,72 @@
+"""SymbolRevisionPair: 같은 심볼의 이전/이후 버전을 묶은 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .context_block import ContextBlock
+
+
+@dataclass(frozen=True)
+class SymbolRevisionPair:
+    """하나의 심볼에 대한 이전 리비전과 새 리비전 블록.
+
+    match_kind는 이전 버전을 찾은 방식으로, 이름이 같으면 NAME_MATCH,
+    이름이 바뀌었지만 위치/내용 유사도로 찾았으면 SIMILARITY_MATCH이며,
+    이전 버전을 찾지 못했으면 (새로 추가된 심볼) NO_MATCH이고 old_block은
+    None이다. similarity는 두 블록 텍스트의 유사도(0.0~1.0)이다.
+    """
+
+    NAME_MATCH = "name"
+    SIMILARITY_MATCH = "similarity"
+    NO_MATCH = "none"
+
+    new_block: ContextBlock
+    old_block: ContextBlock | None = None
+    match_kind: str = NO_MATCH
+    similarity: float | None = None
+
+    @property
+    def has_old_counterpart(self) -> bool:
+        """이전 리비전에서 대응하는 심볼을 찾았는지 여부"""
+        return self.old_block is not None
+
+    @property
+    def is_renamed(self) -> bool:
+        """이름이 바뀐 심볼로 매칭되었는지 여부"""
+        return self.match_kind == self.SIMILARITY_MATCH
+
+    @property
+    def old_text(self) -> str | None:
+        """이전 리비전의 심볼 텍스트 (없으면 None)"""
+        return self.old_block.text if self.old_block is not None else None
+
+    @property
+    def new_text(self) -> str:
+        """새 리비전의 심볼 텍스트"""
+        return self.new_block.text
+
+    def format(self) -> str:
+        """LLM 프롬프트용으로 이전/이후 버전을 나란히 포맷팅한다.
+
+        Returns:
+            이전 버전과 새 버전이 헤더와 함께 이어진 문자열
+        """
+        sections: list[str] = []
+        if self.old_block is not None:
+            header = self._header("Before", self.old_block)
+            sections.append(f"{header}\n{self.old_block.text}")
+        else:
+            sections.append("---- Before: (no previous version) ----")
+        sections.append(f"{self._header('After', self.new_block)}\n{self.new_text}")
+        return "\n".join(sections)
+
+    @staticmethod
+    def _header(label: str, block: ContextBlock) -> str:
+        """리비전 블록의 구분선 헤더를 만든다."""
+        line_range = block.line_range
+        header = (
+            f"---- {label}: {block.name or '<anonymous>'} "
+            f"(Lines {line_range.start_line}-{line_range.end_line})"
+        )
+        return f"{header} ----"
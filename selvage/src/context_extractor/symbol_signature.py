@@ -0,0 +1,47 @@
+"""SymbolSignature: 함수/메서드의 구조화된 시그니처 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import Any
+
+from .signature_parameter import SignatureParameter
+
+
+@dataclass(frozen=True)
+class SymbolSignature:
+    """함수/메서드의 파라미터 목록, 반환 타입, 제네릭 타입 파라미터.
+
+    returns는 선언된 반환 타입들이며 (Go의 다중 반환은 여러 개),
+    반환 타입이 선언되지 않았으면 빈 튜플이다. receiver는 Go 메서드의
+    receiver 파라미터이다.
+    """
+
+    parameters: tuple[SignatureParameter, ...] = ()
+    returns: tuple[str, ...] = ()
+    type_parameters: tuple[str, ...] = ()
+    receiver: SignatureParameter | None = None
+
+    def to_dict(self) -> dict[str, Any]:
+        """직렬화 가능한 딕셔너리로 변환한다.
+
+        Returns:
+            parameters/returns/type_parameters/receiver 키를 가진 딕셔너리
+        """
+        receiver = self.receiver
+        return {
+            "parameters": [_parameter_dict(param) for param in self.parameters],
+            "returns": list(self.returns),
+            "type_parameters": list(self.type_parameters),
+            "receiver": _parameter_dict(receiver) if receiver is not None else None,
+        }
+
+
+def _parameter_dict(parameter: SignatureParameter) -> dict[str, Any]:
+    """파라미터를 딕셔너리로 변환한다."""
+    return {
+        "name": parameter.name,
+        "type": parameter.type,
+        "is_variadic": parameter.is_variadic,
+        "has_default": parameter.has_default,
+    }
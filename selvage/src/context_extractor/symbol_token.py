@@ -0,0 +1,24 @@
+"""SymbolToken: 심볼 하나를 평탄화한 토큰 열의 토큰."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .semantic_token_kind import SemanticTokenKind
+
+
+@dataclass(frozen=True)
+class SymbolToken:
+    """SemanticTokenExtractor.tokenize가 반환하는 위치 없는 토큰 하나.
+
+    임베딩 모델이나 BM25 색인의 입력으로 쓰도록 위치 정보를 뺀 토큰이다.
+    리터럴 정규화 옵션이 켜지면 문자열/숫자 리터럴의 text는 원문 대신
+    종류 자리표시자(`<string>`, `<number>`)이다.
+
+    Attributes:
+        kind: 정규화된 토큰 종류
+        text: 토큰 텍스트 (정규화된 리터럴은 자리표시자)
+    """
+
+    kind: SemanticTokenKind
+    text: str
@@ -0,0 +1,84 @@
+"""SymbolVisibilityResolver: 심볼이 외부로 공개(export)되는지 판별하는 모듈."""
+
+from __future__ import annotations
+
+from typing import ClassVar
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class SymbolVisibilityResolver:
+    """언어별 공개 규칙으로 선언 노드가 외부에 공개되는 API인지 판별한다.
+
+    언어별 규칙:
+    - Go: 이름이 대문자로 시작하면 공개 (`NewSampleCalculator`)
+    - Python: 이름이 `_`로 시작하지 않으면 공개
+    - Java: `public`/`protected` 수정자가 있으면 공개
+    - Kotlin: `private`/`internal` 가시성 수정자가 없으면 공개 (기본값 public)
+    - JavaScript/TypeScript: `export` 문으로 감싸져 있으면 공개
+
+    규칙이 없는 언어의 심볼은 공개로 보지 않는다.
+    """
+
+    # Java에서 외부로 공개되는 접근 수정자
+    JAVA_PUBLIC_MODIFIERS: ClassVar[frozenset[str]] = frozenset(
+        {"public", "protected"}
+    )
+
+    # Kotlin에서 모듈 밖으로 공개되지 않는 가시성 수정자
+    KOTLIN_HIDDEN_MODIFIERS: ClassVar[frozenset[str]] = frozenset(
+        {"private", "internal"}
+    )
+
+    def __init__(self, language: str) -> None:
+        """SymbolVisibilityResolver를 초기화한다.
+
+        Args:
+            language: 언어 이름
+        """
+        self._language = language
+
+    def is_exported(self, node: Node, name: str | None) -> bool:
+        """선언 노드가 언어 관례상 외부로 공개되는 심볼인지 확인한다.
+
+        Args:
+            node: 함수/타입 등의 선언 노드
+            name: 선언된 심볼 이름 (없으면 None)
+
+        Returns:
+            공개 심볼이면 True
+        """
+        if self._language == "go":
+            return bool(name) and name[0].isupper()
+        if self._language == "python":
+            return bool(name) and not name.startswith("_")
+        if self._language == "java":
+            return bool(
+                self._modifier_texts(node, "modifiers") & self.JAVA_PUBLIC_MODIFIERS
+            )
+        if self._language == "kotlin":
+            return not (
+                self._modifier_texts(node, "modifiers") & self.KOTLIN_HIDDEN_MODIFIERS
+            )
+        if self._language in ("javascript", "typescript"):
+            return node.type == "export_statement" or (
+                node.parent is not None and node.parent.type == "export_statement"
+            )
+        return False
+
+    @staticmethod
+    def _modifier_texts(node: Node, modifiers_type: str) -> set[str]:
+        """선언의 수정자 노드 아래 모든 토큰 텍스트를 반환한다."""
+        texts: set[str] = set()
+        for child in node.children:
+            if child.type != modifiers_type:
+                continue
+            stack = list(child.children)
+            while stack:
+                current = stack.pop()
+                if current.child_count == 0 and current.text is not None:
+                    texts.add(node_text(current))
+                stack.extend(current.children)
+        return texts
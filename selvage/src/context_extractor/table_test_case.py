@@ -0,0 +1,28 @@
+"""TableTestCase: 테이블 기반 테스트의 케이스 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class TableTestCase:
+    """테이블 기반 테스트(`tests := []struct{...}{...}`)의 케이스 항목.
+
+    name은 케이스의 `name:` 필드(또는 첫 위치 인자, map 테이블의 키) 문자열
+    리터럴 값이며, 찾지 못하면 None이다. index는 테이블 안에서의 0-based 순서,
+    table_name은 테이블을 담은 변수 이름(`tests`)이고 변수 없이 쓰인 테이블이면
+    None이다. line_range는 케이스 항목이 걸친 파일 기준 라인 범위이다.
+    """
+
+    index: int
+    line_range: LineRange
+    name: str | None = None
+    table_name: str | None = None
+
+    @property
+    def label(self) -> str:
+        """헤더 표시용 이름 (이름이 없으면 `#인덱스`)."""
+        return self.name if self.name is not None else f"#{self.index}"
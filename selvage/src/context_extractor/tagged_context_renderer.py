@@ -0,0 +1,88 @@
+"""TaggedContextRenderer: 추출된 컨텍스트를 `<file>` 태그로 감싸 렌더링하는 모듈."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from html import escape
+
+from .context_block import ContextBlock
+from .extracted_file_context import ExtractedFileContext
+
+
+class TaggedContextRenderer:
+    """파일별 추출 결과를 블록마다 XML 형태의 `<file>` 태그로 감싸 렌더링한다.
+
+    프롬프트 안에서 모델이 각 코드 조각의 출처를 안정적으로 구분할 수 있도록
+    블록 하나를 다음 형식의 태그 하나로 감싼다.
+
+        <file path="..." lang="..." symbol="..." lines="시작-끝" kind="...">
+        (이스케이프된 블록 본문)
+        </file>
+
+    주요 특징:
+    - 속성은 항상 `path`, `lang`, `symbol`, `lines`, `kind` 순서로 모두 출력
+      (값이 없으면 빈 문자열)
+    - `symbol`은 블록의 qualified_name (언어별 SymbolNameFormatter로 만든
+      한정 이름)
+    - 의존성 블록은 `kind="dependencies"`, 다른 파일에서 가져온 블록은
+      `path`가 그 블록의 원본 경로
+    - 본문의 `&`, `<`, `>`와 속성 값의 따옴표까지 이스케이프
+    - 파일은 입력 순서, 파일 안에서는 의존성 블록 다음에 라인 순서로 출력
+      (이름 변경 전 블록은 라인 번호 기준이 달라 제외)
+    """
+
+    TAG_NAME = "file"
+    DEPENDENCY_KIND = "dependencies"
+
+    def render(self, results: Sequence[ExtractedFileContext]) -> str:
+        """파일별 추출 결과들을 태그로 감싼 문서로 렌더링한다.
+
+        Args:
+            results: 파일별 컨텍스트 추출 결과들
+
+        Returns:
+            블록마다 `<file>` 태그 하나인 문서 문자열 (블록이 없으면 빈 문자열)
+        """
+        elements: list[str] = []
+        for result in results:
+            for block in (*result.dependency_blocks, *result.context_blocks):
+                elements.append(self._render_block(result, block))
+        return "\n".join(elements)
+
+    def _render_block(self, result: ExtractedFileContext, block: ContextBlock) -> str:
+        """블록 하나를 `<file>` 태그로 감싼다."""
+        line_range = block.line_range
+        attributes = (
+            ("path", block.source_path or result.file_path),
+            ("lang", result.language),
+            ("symbol", self._symbol(block)),
+            ("lines", f"{line_range.start_line}-{line_range.end_line}"),
+            (
+                "kind",
+                self.DEPENDENCY_KIND if block.is_dependency else block.block_type or "",
+            ),
+        )
+        rendered_attributes = " ".join(
+            f'{name}="{escape(value, quote=True)}"' for name, value in attributes
+        )
+        body = escape(block.body(), quote=False)
+        return f"<{self.TAG_NAME} {rendered_attributes}>\n{body}\n</{self.TAG_NAME}>"
+
+    @staticmethod
+    def _symbol(block: ContextBlock) -> str:
+        """블록의 한정 이름을 반환한다 (의존성 블록이나 이름이 없으면 빈 문자열)."""
+        if block.is_dependency or block.qualified_name is None:
+            return ""
+        return block.qualified_name
+
+
+def render_tagged_context(results: Sequence[ExtractedFileContext]) -> str:
+    """파일별 추출 결과를 블록마다 `<file>` 태그로 감싼 문서로 렌더링한다.
+
+    Args:
+        results: 파일별 컨텍스트 추출 결과들
+
+    Returns:
+        `<file path=... lang=... symbol=... lines=... kind=...>` 태그들
+    """
+    return TaggedContextRenderer().render(results)
@@ -0,0 +1,205 @@
+"""TclScopeResolver: Tcl proc, apply 람다와 namespace 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class TclScopeResolver(ScopeResolver):
+    """Tcl AST에서 변경을 감싸는 proc, apply 람다, 문장을 찾는다.
+
+    Tcl은 모든 문법이 단어(word)의 나열이고 중괄호 단어 안도 명령으로 파싱되므로,
+    데이터를 담은 `{...}` 안의 변경이 그 안의 임의 명령으로 잡히지 않도록
+    감싸는 proc(중첩 proc이면 가장 안쪽 proc), `apply {{args} {body}}` 람다
+    호출, 그렇지 않으면 최상위 또는 `namespace eval` 본문 바로 아래의 문장
+    하나를 반환한다. 변경이 `namespace eval` 안에 있으면 여는 라인
+    (`namespace eval ::inventory {`)을 컨테이너 헤더로 함께 포함한다.
+    최상위의 `package require`와 `source` 명령은 의존성으로 수집한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 의존성 노드는 노드 타입 대신 is_dependency()로 판별
+    OWNS_DEPENDENCIES = True
+
+    # 정의 전체를 반환하는 proc 노드 타입
+    PROCEDURE_TYPES = frozenset({"procedure"})
+
+    # namespace 명령 노드 타입 (`namespace eval`, `namespace export` 등)
+    NAMESPACE_TYPES = frozenset({"namespace"})
+
+    # 일반 명령 호출 노드 타입
+    COMMAND_TYPES = frozenset({"command"})
+
+    # 명령 인자 목록과 본문 중괄호 단어 노드 타입
+    WORD_LIST_TYPE = "word_list"
+    BRACED_WORD_TYPE = "braced_word"
+
+    # 본문을 namespace 안에서 실행하는 namespace 하위 명령
+    NAMESPACE_EVAL = "eval"
+
+    # 익명 함수(람다)를 호출하는 명령 이름
+    LAMBDA_COMMAND = "apply"
+
+    # 의존성으로 수집할 명령 (`package require Foo`, `source util.tcl`)
+    PACKAGE_COMMAND = ("package", "require")
+    SOURCE_COMMAND = "source"
+
+    # 컨테이너(namespace) 헤더를 함께 포함할 멤버 노드 타입
+    MEMBER_TYPES = PROCEDURE_TYPES | COMMAND_TYPES
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 proc, apply 람다 호출 또는 문장을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 proc 또는 apply 명령, 그렇지 않으면 최상위나
+            `namespace eval` 본문 바로 아래의 문장 노드 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.PROCEDURE_TYPES or self._is_lambda(current):
+                return current
+            parent = current.parent
+            if parent is None:
+                return None
+            if parent.parent is None or self._is_namespace_body(parent):
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 `namespace eval` 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            namespace 노드 (namespace 밖이면 None)
+        """
+        current = node.parent
+        while current is not None:
+            if self._is_namespace_eval(current):
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """`namespace eval ::name {` 여는 라인을 반환한다."""
+        text = node_text(container).split("\n", 1)[0]
+        if "{" in text:
+            return text.split("{", 1)[0].rstrip() + " {"
+        return text.rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 namespace 이름을 반환한다."""
+        return self.name(container)
+
+    def name(self, node: Node) -> str | None:
+        """proc 이름 또는 `namespace eval`의 namespace 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            proc 이름(`::inventory::add`처럼 한정된 이름은 그대로),
+            namespace 이름 (apply 람다와 그 밖의 명령은 None)
+        """
+        if node.type in self.PROCEDURE_TYPES:
+            name_node = node.child_by_field_name("name")
+            if name_node is not None:
+                return node_text(name_node) or None
+            words = node_text(node).split()
+            return words[1] if len(words) > 1 else None
+        if self._is_namespace_eval(node):
+            words = self._namespace_words(node)
+            return node_text(words[1]) or None if len(words) > 1 else None
+        return None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 namespace와 proc 이름들을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 모을 최대 이름 수. 가장 안쪽 선언부터 이 개수를 모으면 바깥쪽
+                조상은 더 보지 않는다 (None이면 제한 없음)
+
+        Returns:
+            바깥쪽부터 순서대로의 namespace/proc 이름 튜플
+        """
+        path: list[str] = []
+        current = node.parent
+        while current is not None and (limit is None or len(path) < limit):
+            if current.type in self.PROCEDURE_TYPES or self._is_namespace_eval(
+                current
+            ):
+                name = self.name(current)
+                if name:
+                    path.append(name)
+            current = current.parent
+        return tuple(reversed(path))
+
+    def is_dependency(self, node: Node) -> bool:
+        """노드가 최상위 `package require` 또는 `source` 명령인지 확인한다."""
+        if node.type not in self.COMMAND_TYPES or node.parent is None:
+            return False
+        parent = node.parent
+        if parent.parent is not None and not self._is_namespace_body(parent):
+            return False
+        words = node_text(node).split()
+        return (
+            tuple(words[:2]) == self.PACKAGE_COMMAND
+            or words[:1] == [self.SOURCE_COMMAND]
+        )
+
+    def _is_lambda(self, node: Node) -> bool:
+        """`apply {{args} {body}} ...` 람다 호출 명령인지 확인한다."""
+        return (
+            node.type in self.COMMAND_TYPES
+            and self._command_name(node) == self.LAMBDA_COMMAND
+        )
+
+    def _is_namespace_eval(self, node: Node) -> bool:
+        """`namespace eval name {...}` 명령인지 확인한다."""
+        if node.type not in self.NAMESPACE_TYPES:
+            return False
+        words = self._namespace_words(node)
+        return bool(words) and node_text(words[0]) == self.NAMESPACE_EVAL
+
+    def _is_namespace_body(self, node: Node) -> bool:
+        """노드가 `namespace eval`의 본문 중괄호 단어인지 확인한다."""
+        if node.type != self.BRACED_WORD_TYPE or node.parent is None:
+            return False
+        namespace = node.parent
+        if namespace.type == self.WORD_LIST_TYPE:
+            namespace = namespace.parent
+        return namespace is not None and self._is_namespace_eval(namespace)
+
+    def _namespace_words(self, namespace: Node) -> list[Node]:
+        """namespace 명령의 하위 명령과 인자 단어들을 반환한다."""
+        word_list = next(
+            (
+                child
+                for child in namespace.named_children
+                if child.type == self.WORD_LIST_TYPE
+            ),
+            None,
+        )
+        if word_list is None:
+            return list(namespace.named_children)
+        return list(word_list.named_children)
+
+    def _command_name(self, command: Node) -> str | None:
+        """명령 호출의 명령 이름을 반환한다."""
+        name_node = command.child_by_field_name("name")
+        if name_node is not None:
+            return node_text(name_node)
+        words = node_text(command).split(None, 1)
+        return words[0] if words else None
@@ -0,0 +1,29 @@
+"""TemplateBlock: 템플릿 파일 안의 블록 구조 하나를 나타내는 데이터 클래스."""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from .line_range import LineRange
+
+
+@dataclass(frozen=True)
+class TemplateBlock:
+    """여는 태그부터 닫는 태그까지의 템플릿 블록.
+
+    keyword는 여는 태그의 키워드(`define`, `block`, `macro`, `range`, `if` 등)
+    이며, name은 `{{define "x"}}`, `{% block x %}`처럼 이름 있는 블록의
+    이름이다 (제어 블록이면 None). line_range는 여는 태그의 첫 라인부터
+    닫는 태그의 마지막 라인까지이고, scope_path는 이 블록을 감싸는 이름
+    있는 블록 이름들을 바깥쪽부터 나열한 것이다.
+    """
+
+    keyword: str
+    line_range: LineRange
+    name: str | None = None
+    scope_path: tuple[str, ...] = ()
+
+    @property
+    def is_named(self) -> bool:
+        """이름 있는 블록(`define`, `block`, `macro` 등)인지 여부"""
+        return self.name is not None
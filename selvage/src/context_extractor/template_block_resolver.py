@@ -0,0 +1,273 @@
+"""TemplateBlockResolver: 템플릿 언어의 블록 경계를 구분자로 계산하는 모듈."""
+
+from __future__ import annotations
+
+import re
+from bisect import bisect_right
+from collections.abc import Iterator
+
+from .line_range import LineRange
+from .template_block import TemplateBlock
+from .text_lines import split_lines
+
+
+class TemplateBlockResolver:
+    """Go 템플릿, Jinja, ERB 파일에서 태그 구분자로 블록 구조를 계산한다.
+
+    tree-sitter 문법이 없는 템플릿 언어를 위해 문장 태그(`{{ ... }}`,
+    `{% ... %}`, `<% ... %>`)만 스캔하며, 여는 태그와 닫는 태그의 짝으로
+    블록을 만든다. 템플릿 주석 안의 태그는 무시한다. 닫히지 않은 블록은
+    파일 끝까지로 보고, 짝이 없는 닫는 태그는 무시한다.
+    """
+
+    SUPPORTED_LANGUAGES = ("gotemplate", "jinja", "erb")
+
+    # 언어별 태그 정규식 (comment 그룹이 있으면 주석, 아니면 body가 태그 내용)
+    LANGUAGE_TAG_PATTERNS = {
+        "gotemplate": re.compile(
+            r"\{\{-?\s*(?:(?P<comment>/\*.*?\*/)|(?P<body>.*?))\s*-?\}\}", re.DOTALL
+        ),
+        "jinja": re.compile(
+            r"(?P<comment>\{#.*?#\})|\{%[-+]?\s*(?P<body>.*?)\s*[-+]?%\}", re.DOTALL
+        ),
+        "erb": re.compile(
+            r"<%(?!%)(?:(?P<comment>#.*?)|[-=]{0,2}\s*(?P<body>.*?))\s*-?%>",
+            re.DOTALL,
+        ),
+    }
+
+    # 언어별 이름 있는 블록을 여는 키워드
+    LANGUAGE_NAMED_KEYWORDS = {
+        "gotemplate": frozenset({"define", "block"}),
+        "jinja": frozenset({"block", "macro"}),
+        "erb": frozenset({"content_for"}),
+    }
+
+    # 언어별 이름 없는 제어 블록을 여는 키워드
+    LANGUAGE_BLOCK_KEYWORDS = {
+        "gotemplate": frozenset({"if", "range", "with"}),
+        "jinja": frozenset(
+            {"for", "if", "with", "filter", "call", "raw", "autoescape", "trans", "set"}
+        ),
+        "erb": frozenset({"if", "unless", "while", "until", "case", "for", "begin"}),
+    }
+
+    # 언어별 다른 템플릿을 가져오는 태그 키워드 (의존성 블록으로 수집)
+    LANGUAGE_DEPENDENCY_KEYWORDS = {
+        "gotemplate": frozenset({"template"}),
+        "jinja": frozenset({"extends", "import", "from", "include"}),
+        "erb": frozenset({"render"}),
+    }
+
+    # 언어별 이름 있는 블록의 이름 정규식 (첫 그룹이 이름)
+    LANGUAGE_NAME_PATTERNS = {
+        "gotemplate": re.compile(r"^\w+\s+[\"`]([^\"`]+)"),
+        "jinja": re.compile(r"^\w+\s+(\w+)"),
+        "erb": re.compile(r"^content_for\s*\(?\s*[:\"']([\w-]+)"),
+    }
+
+    # ERB(Ruby)에서 `do` 블록을 여는 태그 내용
+    ERB_DO_PATTERN = re.compile(r"\bdo\s*(?:\|[^|]*\|)?$")
+
+    # 호스트 언어 코드가 들어 있는 HTML 영역 태그 → 추출기 언어
+    SCRIPT_REGION_LANGUAGES = {"script": "javascript", "style": "css"}
+
+    SCRIPT_REGION_PATTERN = re.compile(
+        r"<(?P<tag>script|style)\b(?P<attributes>[^>]*)>"
+        r"(?P<content>.*?)</(?P=tag)\s*>",
+        re.DOTALL | re.IGNORECASE,
+    )
+
+    # 자바스크립트로 추출하지 않는 script 태그 속성 (외부 파일, 데이터/템플릿 타입)
+    SCRIPT_SKIP_PATTERN = re.compile(
+        r"\bsrc\s*=|\btype\s*=\s*[\"']?(?!(?:text/javascript|module)\b)",
+        re.IGNORECASE,
+    )
+
+    def __init__(self, language: str) -> None:
+        """resolver 초기화.
+
+        Args:
+            language: 템플릿 언어 ("gotemplate", "jinja", "erb")
+
+        Raises:
+            ValueError: 지원하지 않는 템플릿 언어인 경우
+        """
+        if language not in self.SUPPORTED_LANGUAGES:
+            raise ValueError(f"지원하지 않는 템플릿 언어입니다: {language}")
+        self._language = language
+        self._tag_pattern = self.LANGUAGE_TAG_PATTERNS[language]
+
+    def blocks(self, text: str) -> list[TemplateBlock]:
+        """파일 안의 모든 템플릿 블록을 시작 위치 순으로 반환한다.
+
+        Args:
+            text: 템플릿 파일 내용
+
+        Returns:
+            TemplateBlock 리스트 (바깥 블록이 안쪽 블록보다 앞에 옴)
+        """
+        line_starts = self._line_starts(text)
+        last_line = max(len(split_lines(text)), 1)
+        open_blocks: list[tuple[str, str | None, int]] = []
+        blocks: list[TemplateBlock] = []
+        for body, start, end in self._tags(text):
+            if self._is_end_tag(body):
+                if not open_blocks:
+                    continue
+                keyword, name, start_line = open_blocks.pop()
+                end_line = self._line_at(line_starts, end - 1)
+                blocks.append(
+                    self._block(keyword, name, start_line, end_line, open_blocks)
+                )
+                continue
+            opened = self._open_tag(body)
+            if opened is not None:
+                keyword, name = opened
+                open_blocks.append((keyword, name, self._line_at(line_starts, start)))
+
+        while open_blocks:
+            keyword, name, start_line = open_blocks.pop()
+            blocks.append(
+                self._block(keyword, name, start_line, last_line, open_blocks)
+            )
+        return sorted(
+            blocks,
+            key=lambda block: (
+                block.line_range.start_line,
+                -block.line_range.end_line,
+            ),
+        )
+
+    def find_block(
+        self, blocks: list[TemplateBlock], line_no: int
+    ) -> TemplateBlock | None:
+        """라인을 감싸는 가장 안쪽의 이름 있는 블록을 찾는다.
+
+        이름 있는 블록이 없으면 가장 안쪽의 제어 블록을 반환한다.
+
+        Args:
+            blocks: blocks()로 계산한 템플릿 블록들
+            line_no: 1-based 라인 번호
+
+        Returns:
+            라인을 감싸는 블록 (없으면 None)
+        """
+        candidates = [block for block in blocks if block.line_range.contains(line_no)]
+        named = [block for block in candidates if block.is_named]
+        return min(
+            named or candidates,
+            key=lambda block: block.line_range.line_count(),
+            default=None,
+        )
+
+    def dependencies(self, text: str) -> list[tuple[int, str]]:
+        """다른 템플릿을 가져오는 태그들을 (라인 번호, 태그 텍스트)로 반환한다.
+
+        Args:
+            text: 템플릿 파일 내용
+
+        Returns:
+            위치 순의 (1-based 라인 번호, 태그 원문) 리스트
+        """
+        line_starts = self._line_starts(text)
+        dependencies: list[tuple[int, str]] = []
+        for body, start, end in self._tags(text):
+            if self._keyword(body) in self.LANGUAGE_DEPENDENCY_KEYWORDS[self._language]:
+                dependencies.append(
+                    (self._line_at(line_starts, start), text[start:end])
+                )
+        return dependencies
+
+    def script_regions(self, text: str) -> list[tuple[str, int, str]]:
+        """호스트 언어 코드가 들어 있는 `<script>`/`<style>` 영역을 반환한다.
+
+        Args:
+            text: 템플릿 파일 내용
+
+        Returns:
+            (추출기 언어, 영역 첫 라인 번호, 영역 내용) 리스트. 영역 내용의
+            첫 라인은 여는 태그가 끝나는 라인이다.
+        """
+        line_starts = self._line_starts(text)
+        regions: list[tuple[str, int, str]] = []
+        for match in self.SCRIPT_REGION_PATTERN.finditer(text):
+            tag = match.group("tag").lower()
+            if tag == "script" and self.SCRIPT_SKIP_PATTERN.search(
+                match.group("attributes")
+            ):
+                continue
+            content_start = match.start("content")
+            regions.append(
+                (
+                    self.SCRIPT_REGION_LANGUAGES[tag],
+                    self._line_at(line_starts, content_start),
+                    match.group("content"),
+                )
+            )
+        return regions
+
+    def _tags(self, text: str) -> Iterator[tuple[str, int, int]]:
+        """주석을 제외한 문장 태그들의 (내용, 시작 오프셋, 끝 오프셋)을 반환한다."""
+        for match in self._tag_pattern.finditer(text):
+            if match.group("comment") is not None:
+                continue
+            yield match.group("body") or "", match.start(), match.end()
+
+    def _open_tag(self, body: str) -> tuple[str, str | None] | None:
+        """블록을 여는 태그면 (키워드, 이름)을, 아니면 None을 반환한다."""
+        keyword = self._keyword(body)
+        if keyword in self.LANGUAGE_NAMED_KEYWORDS[self._language]:
+            match = self.LANGUAGE_NAME_PATTERNS[self._language].match(body)
+            return keyword, match.group(1) if match else None
+        if keyword in self.LANGUAGE_BLOCK_KEYWORDS[self._language]:
+            if self._language == "jinja" and keyword == "set" and "=" in body:
+                # `{% set x = 1 %}`은 한 줄 대입, `{% set x %}...{% endset %}`만 블록
+                return None
+            if self._language == "erb" and re.search(r"\bend$", body):
+                return None
+            return keyword, None
+        if self._language == "erb" and self.ERB_DO_PATTERN.search(body):
+            return "do", None
+        return None
+
+    def _is_end_tag(self, body: str) -> bool:
+        """블록을 닫는 태그인지 확인한다."""
+        keyword = self._keyword(body)
+        if self._language == "jinja":
+            return keyword.startswith("end")
+        return keyword == "end"
+
+    @staticmethod
+    def _keyword(body: str) -> str:
+        """태그 내용의 첫 단어를 반환한다 (`macro render(x)`의 "macro" 등)."""
+        match = re.match(r"[A-Za-z_]\w*", body)
+        return match.group(0) if match else ""
+
+    @staticmethod
+    def _block(
+        keyword: str,
+        name: str | None,
+        start_line: int,
+        end_line: int,
+        open_blocks: list[tuple[str, str | None, int]],
+    ) -> TemplateBlock:
+        """아직 열려 있는 바깥 블록들의 이름으로 scope_path를 채운 블록을 만든다."""
+        return TemplateBlock(
+            keyword=keyword,
+            line_range=LineRange(start_line, end_line),
+            name=name,
+            scope_path=tuple(
+                outer_name for _, outer_name, _ in open_blocks if outer_name
+            ),
+        )
+
+    @staticmethod
+    def _line_starts(text: str) -> list[int]:
+        """각 라인의 시작 오프셋 목록을 반환한다."""
+        return [0, *(match.end() for match in re.finditer("\n", text))]
+
+    @staticmethod
+    def _line_at(line_starts: list[int], offset: int) -> int:
+        """오프셋이 속한 1-based 라인 번호를 반환한다."""
+        return bisect_right(line_starts, offset)
@@ -0,0 +1,279 @@
+"""TemplateContextExtractor: 템플릿 파일을 위한 구분자 기반 컨텍스트 추출기."""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import replace
+
+from selvage.src.exceptions import ParseTimeoutError, UnsupportedLanguageError
+
+from .context_block import ContextBlock
+from .context_extractor import ContextExtractor
+from .extraction_options import ExtractionOptions
+from .line_range import LineRange
+from .meaningless_change_filter import MeaninglessChangeFilter
+from .template_block_resolver import TemplateBlockResolver
+from .text_lines import split_lines
+
+
+class TemplateContextExtractor:
+    """Go 템플릿, Jinja, ERB 파일의 변경을 감싸는 템플릿 블록을 추출한다.
+
+    tree-sitter 문법이 없는 템플릿 언어를 위해 태그 구분자로 블록 경계를
+    계산한다.
+
+    주요 특징:
+    - 변경 라인을 감싸는 가장 안쪽의 이름 있는 블록(`{{define "x"}}`,
+      `{% block x %}`, `{% macro x() %}`, `content_for :x`)을 통째로 반환
+    - 이름 있는 블록이 없으면 가장 안쪽의 제어 블록(`range`, `for`, `if` 등),
+      블록 밖이면 앞뒤 CONTEXT_LINES 라인을 반환
+    - 블록 텍스트는 원문 그대로이므로 `{{ .Title }}` 같은 표현식이 보존됨
+    - 다른 템플릿을 가져오는 태그(`{{template}}`, `{% extends %}`,
+      `{% include %}`, `render`)를 의존성 블록으로 수집
+    - 선택적으로 `<script>`/`<style>` 영역의 변경은 해당 호스트 언어
+      (JavaScript/CSS) 추출기로 추출
+    """
+
+    SUPPORTED_LANGUAGES = TemplateBlockResolver.SUPPORTED_LANGUAGES
+
+    # 템플릿 블록 밖의 변경에 대해 앞뒤로 포함할 라인 수
+    CONTEXT_LINES = 5
+
+    def __init__(
+        self,
+        language: str,
+        extract_scripts: bool = False,
+        options: ExtractionOptions | None = None,
+    ) -> None:
+        """추출기 초기화.
+
+        Args:
+            language: 템플릿 언어 ("gotemplate", "jinja", "erb")
+            extract_scripts: `<script>`/`<style>` 영역의 변경을 호스트 언어
+                추출기로 추출할지 여부
+            options: 호스트 언어 추출기에 전달할 추출 옵션
+
+        Raises:
+            UnsupportedLanguageError: 지원하지 않는 템플릿 언어인 경우
+        """
+        if not self.is_supported(language):
+            raise UnsupportedLanguageError(language)
+        self._language = language
+        self._resolver = TemplateBlockResolver(language)
+        self._extract_scripts = extract_scripts
+        self._options = options
+        # 템플릿에서는 `#`, `--`로 시작하는 라인도 본문이므로 주석으로 보지 않음
+        self._filter = MeaninglessChangeFilter(detect_comments=False)
+
+    @classmethod
+    def is_supported(cls, language: str) -> bool:
+        """템플릿 추출기가 지원하는 언어인지 확인한다."""
+        return language in cls.SUPPORTED_LANGUAGES
+
+    def extract_contexts(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[str]:
+        """변경된 라인 범위들을 기반으로 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 템플릿 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            추출된 컨텍스트 코드 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        blocks = self.extract_context_blocks(file_content, changed_ranges)
+        return ContextBlock.format_blocks(blocks)
+
+    def extract_context_blocks(
+        self, file_content: str, changed_ranges: Sequence[LineRange]
+    ) -> list[ContextBlock]:
+        """변경된 라인 범위들을 기반으로 구조화된 컨텍스트 블록들을 추출한다.
+
+        Args:
+            file_content: 분석할 템플릿 파일의 내용
+            changed_ranges: 변경된 라인 범위들 (LineRange 객체들)
+
+        Returns:
+            의존성 블록(있는 경우)과 라인 순으로 정렬된 컨텍스트 블록들의 리스트
+
+        Raises:
+            ValueError: 파일 내용이 없는 경우
+        """
+        if not changed_ranges:
+            return []
+        if not file_content:
+            raise ValueError("파일 내용이 비어있습니다")
+
+        lines = split_lines(file_content)
+        meaningful_ranges = self._filter.filter_meaningful_ranges_with_lines(
+            lines, changed_ranges
+        )
+        changed_lines = sorted(
+            {
+                line
+                for line_range in meaningful_ranges
+                for line in range(line_range.start_line, line_range.end_line + 1)
+                if line <= len(lines)
+            }
+        )
+        if not changed_lines:
+            return []
+
+        blocks: list[ContextBlock] = []
+        if self._extract_scripts:
+            script_blocks, changed_lines = self._create_script_blocks(
+                file_content, changed_lines
+            )
+            blocks.extend(script_blocks)
+        blocks.extend(self._create_template_blocks(file_content, lines, changed_lines))
+        if not blocks:
+            return []
+
+        dependencies = self._resolver.dependencies(file_content)
+        if dependencies:
+            line_numbers = [line_number for line_number, _ in dependencies]
+            blocks.append(
+                ContextBlock(
+                    text="\n".join(tag for _, tag in dependencies),
+                    line_range=LineRange(min(line_numbers), max(line_numbers)),
+                    is_dependency=True,
+                )
+            )
+        return sorted(
+            blocks,
+            key=lambda block: (not block.is_dependency, block.line_range.start_line),
+        )
+
+    def _create_template_blocks(
+        self, file_content: str, lines: list[str], changed_lines: list[int]
+    ) -> list[ContextBlock]:
+        """변경 라인들을 감싸는 템플릿 블록(없으면 주변 라인)을 만든다.
+
+        Args:
+            file_content: 템플릿 파일 내용
+            lines: 파일의 모든 라인들
+            changed_lines: 호스트 언어 영역에서 처리되지 않은 변경 라인들
+
+        Returns:
+            라인 순으로 정렬된 컨텍스트 블록들
+        """
+        template_blocks = self._resolver.blocks(file_content)
+        blocks_by_range: dict[tuple[int, int], ContextBlock] = {}
+        outside_lines: list[int] = []
+        for line in changed_lines:
+            template_block = self._resolver.find_block(template_blocks, line)
+            if template_block is None:
+                outside_lines.append(line)
+                continue
+            line_range = template_block.line_range
+            key = (line_range.start_line, line_range.end_line)
+            block = blocks_by_range.get(key)
+            if block is None:
+                block = blocks_by_range[key] = ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    block_type=template_block.keyword,
+                    name=template_block.name,
+                    scope_path=template_block.scope_path,
+                )
+            block.changed_lines = (*block.changed_lines, line)
+
+        blocks = list(blocks_by_range.values())
+        for line_range in self._windows(outside_lines, len(lines)):
+            blocks.append(
+                ContextBlock(
+                    text=self._text(lines, line_range),
+                    line_range=line_range,
+                    changed_lines=tuple(
+                        line for line in outside_lines if line_range.contains(line)
+                    ),
+                )
+            )
+        return sorted(blocks, key=lambda block: block.line_range.start_line)
+
+    def _create_script_blocks(
+        self, file_content: str, changed_lines: list[int]
+    ) -> tuple[list[ContextBlock], list[int]]:
+        """`<script>`/`<style>` 영역 안의 변경을 호스트 언어 추출기로 추출한다.
+
+        영역 안의 라인 번호를 파일 기준으로 되돌린 블록을 반환한다. 호스트
+        언어 추출기를 사용할 수 없거나 파싱이 시간 제한을 넘긴 영역의 변경은
+        템플릿 블록으로 처리하도록 남겨 둔다.
+
+        Args:
+            file_content: 템플릿 파일 내용
+            changed_lines: 변경 라인들
+
+        Returns:
+            (호스트 언어 블록 리스트, 처리되지 않고 남은 변경 라인 리스트) 튜플
+        """
+        blocks: list[ContextBlock] = []
+        remaining = set(changed_lines)
+        for language, first_line, content in self._resolver.script_regions(
+            file_content
+        ):
+            region = LineRange(first_line, first_line + content.count("\n"))
+            region_lines = [line for line in changed_lines if region.contains(line)]
+            if not region_lines:
+                continue
+            offset = first_line - 1
+            local_ranges = self._windows(
+                [line - offset for line in region_lines],
+                region.line_count(),
+                context_lines=0,
+            )
+            try:
+                region_blocks = ContextExtractor(
+                    language, self._options
+                ).extract_context_blocks(content, local_ranges)
+            except (UnsupportedLanguageError, ValueError, ParseTimeoutError):
+                continue
+            for block in region_blocks:
+                blocks.append(
+                    replace(
+                        block,
+                        line_range=LineRange(
+                            block.line_range.start_line + offset,
+                            block.line_range.end_line + offset,
+                        ),
+                        changed_lines=tuple(
+                            line + offset for line in block.changed_lines
+                        ),
+                    )
+                )
+            remaining.difference_update(region_lines)
+        return blocks, sorted(remaining)
+
+    def _windows(
+        self, lines: list[int], line_count: int, context_lines: int | None = None
+    ) -> list[LineRange]:
+        """라인들을 앞뒤로 확장하고 겹치거나 인접한 범위를 병합한다.
+
+        Args:
+            lines: 정렬된 1-based 라인 번호들
+            line_count: 범위를 자를 전체 라인 수
+            context_lines: 앞뒤로 확장할 라인 수 (기본값: CONTEXT_LINES)
+
+        Returns:
+            시작 라인 순의 병합된 범위들
+        """
+        if context_lines is None:
+            context_lines = self.CONTEXT_LINES
+        windows: list[LineRange] = []
+        for line in lines:
+            start = max(1, line - context_lines)
+            end = min(line + context_lines, line_count)
+            if windows and start <= windows[-1].end_line + 1:
+                windows[-1] = LineRange(windows[-1].start_line, end)
+                continue
+            windows.append(LineRange(start, end))
+        return windows
+
+    @staticmethod
+    def _text(lines: list[str], line_range: LineRange) -> str:
+        """라인 범위의 원문 텍스트를 반환한다."""
+        return "\n".join(lines[line_range.start_line - 1 : line_range.end_line])
@@ -0,0 +1,39 @@
+"""git과 같은 기준으로 텍스트를 라인 단위로 나누고 노드 텍스트를 얻는 함수 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+
+def split_lines(text: str) -> list[str]:
+    """`\\n`만 라인 경계로 보고 텍스트를 라인들로 나눈다.
+
+    str.splitlines()는 단독 `\\r`, form feed(`\\x0c`), `\\u2028` 등에서도
+    라인을 나누므로 `\\n`/`\\r\\n`이 섞인 파일이나 제어 문자가 있는 파일에서
+    git과 tree-sitter의 라인 번호와 어긋난다. 각 라인 끝의 `\\r`은 그대로
+    남기므로 `"\\n".join()`으로 원문을 바이트 단위로 복원할 수 있다.
+
+    Args:
+        text: 나눌 텍스트
+
+    Returns:
+        라인 리스트 (마지막 `\\n` 뒤의 빈 문자열은 라인으로 세지 않음)
+    """
+    lines = text.split("\n")
+    if lines[-1] == "":
+        lines.pop()
+    return lines
+
+
+def node_text(node: Node | None) -> str:
+    """노드 텍스트를 UTF-8로 디코딩한다.
+
+    Args:
+        node: 텍스트를 얻을 노드
+
+    Returns:
+        노드 텍스트 (노드나 텍스트가 없으면 빈 문자열, 잘못된 바이트는 대체 문자)
+    """
+    if node is None or node.text is None:
+        return ""
+    return node.text.decode("utf-8", errors="replace")
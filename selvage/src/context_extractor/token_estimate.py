@@ -0,0 +1,12 @@
+"""텍스트 길이 기반 토큰 수 추정 함수 모듈."""
+
+from __future__ import annotations
+
+
+def estimate_tokens(text: str) -> int:
+    """문자열 길이 기반으로 토큰 수를 추정한다.
+
+    코드는 영어 문장보다 토큰 밀도가 높으므로 1토큰 ≈ 3.5자로 계산하며,
+    같은 입력에 대해 항상 같은 값을 반환하도록 정수 연산만 사용한다.
+    """
+    return len(text) * 2 // 7
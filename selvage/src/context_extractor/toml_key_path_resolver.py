@@ -0,0 +1,128 @@
+"""TomlKeyPathResolver: TOML 노드의 점(.) 구분 키 경로를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class TomlKeyPathResolver(ScopeResolver):
+    """TOML AST에서 섹션 경로와 변경된 키의 전체 경로를 계산한다.
+
+    섹션 판단은 AST 기준이므로 여러 줄 문자열 안의 `[...]` 텍스트는
+    섹션 헤더로 오인되지 않는다.
+    """
+
+    # 변경을 감싸는 블록은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+
+    # [table] / [[array-of-tables]] 섹션 노드 타입
+    SECTION_TYPES = frozenset({"table", "table_array_element"})
+
+    # 키 노드 타입
+    KEY_TYPES = frozenset({"bare_key", "quoted_key", "dotted_key"})
+
+    # 파일 루트 노드 타입
+    ROOT_TYPE = "document"
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 섹션 또는 최상위 key/value 쌍을 찾는다.
+
+        최상위 키의 값이 인라인 테이블이나 여러 줄 배열/문자열이어도
+        key/value 쌍 전체를 반환한다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            섹션 또는 최상위 pair 노드, 둘 다 아니면 노드 자신 (루트 노드면 None)
+        """
+        current: Node | None = node
+        while current is not None and current.type != self.ROOT_TYPE:
+            if current.type in self.SECTION_TYPES:
+                return current
+            if (
+                current.type == "pair"
+                and current.parent is not None
+                and current.parent.type == self.ROOT_TYPE
+            ):
+                return current
+            current = current.parent
+        return node if node.type != self.ROOT_TYPE else None
+
+    def name(self, node: Node) -> str | None:
+        """섹션은 섹션 경로를, key/value 쌍은 키의 전체 경로를 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            점으로 구분된 경로 (섹션이나 pair가 아니면 None)
+        """
+        if node.type in self.SECTION_TYPES:
+            return self.section_path(node)
+        if node.type == "pair":
+            return self.key_path(node)
+        return None
+
+    def section_path(self, section: Node) -> str | None:
+        """섹션 헤더의 키 경로를 반환한다 (예: `[tool.poetry]` → "tool.poetry").
+
+        Args:
+            section: table 또는 table_array_element 노드
+
+        Returns:
+            섹션 키 경로 (헤더 키가 없으면 None)
+        """
+        key_node = self._first_key_child(section)
+        return self._key_text(key_node) if key_node is not None else None
+
+    def key_path(self, node: Node) -> str | None:
+        """노드가 속한 키의 전체 경로를 반환한다.
+
+        인라인 테이블 안의 키는 바깥 키 경로에 이어 붙이고, 섹션 안의 키는
+        섹션 경로를 접두어로 붙인다. 섹션 헤더 자체는 섹션 경로를 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드 (보통 변경 라인의 최소 노드)
+
+        Returns:
+            점으로 구분된 키 경로 (키에 속하지 않으면 None)
+        """
+        parts: list[str] = []
+        current: Node | None = node
+        while current is not None:
+            if current.type == "pair":
+                key_node = self._first_key_child(current)
+                if key_node is not None:
+                    parts.insert(0, self._key_text(key_node))
+            elif current.type in self.SECTION_TYPES:
+                section_path = self.section_path(current)
+                if section_path:
+                    parts.insert(0, section_path)
+                break
+            current = current.parent
+        return ".".join(parts) if parts else None
+
+    def _first_key_child(self, node: Node) -> Node | None:
+        """노드의 첫 번째 키 자식을 반환한다."""
+        return next(
+            (child for child in node.named_children if child.type in self.KEY_TYPES),
+            None,
+        )
+
+    def _key_text(self, key_node: Node) -> str:
+        """키 노드를 공백 없는 점 구분 문자열로 변환한다.
+
+        `a . "b.c"` 같은 dotted key도 개별 키 조각을 이어 붙이므로 인용된 키 안의
+        점은 그대로 유지된다.
+        """
+        if key_node.type == "dotted_key":
+            return ".".join(
+                self._key_text(child)
+                for child in key_node.named_children
+                if child.type in self.KEY_TYPES
+            )
+        return node_text(key_node)
@@ -0,0 +1,100 @@
+"""TypeScriptDeclarationMergeResolver: TypeScript 선언 병합 대상을 찾는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .text_lines import node_text
+
+
+class TypeScriptDeclarationMergeResolver:
+    """TypeScript AST에서 하나의 심볼로 병합되는 같은 이름의 선언들을 찾는다.
+
+    TypeScript는 같은 범위에 같은 이름으로 여러 번 선언된 interface,
+    namespace, enum을 하나의 심볼로 병합하고, namespace는 같은 이름의
+    class/function/enum에도 병합된다. 병합되는 선언 중 하나가 바뀌면 같은
+    선언 범위(파일, namespace 본문)의 나머지 선언을 모두 반환한다. 같은 이름의
+    function 선언만 있는 경우(오버로드)나 class만 있는 경우는 병합이 아니므로
+    제외한다.
+    """
+
+    # 선언 병합에 참여할 수 있는 선언 노드 타입
+    MERGEABLE_TYPES = frozenset(
+        {
+            "interface_declaration",
+            "internal_module",
+            "module",
+            "enum_declaration",
+            "class_declaration",
+            "abstract_class_declaration",
+            "function_declaration",
+        }
+    )
+
+    # 같은 이름으로 다시 열어 병합할 수 있는 선언 노드 타입 (그룹에 하나 이상 필요)
+    REOPENABLE_TYPES = frozenset(
+        {"interface_declaration", "internal_module", "module", "enum_declaration"}
+    )
+
+    # 선언을 감싸는 노드 타입 (`export interface`, `declare namespace`, 문장
+    # 위치의 `namespace`)
+    WRAPPER_TYPES = frozenset(
+        {"export_statement", "ambient_declaration", "expression_statement"}
+    )
+
+    # 병합된 선언들을 묶은 블록의 block_type
+    GROUP_BLOCK_TYPE = "merged_declaration"
+
+    def merged_parts(self, node: Node) -> list[Node]:
+        """노드와 하나의 심볼로 병합되는 같은 범위의 선언들을 반환한다.
+
+        Args:
+            node: 변경과 겹치는 컨텍스트 노드
+
+        Returns:
+            노드 자신을 포함한 위치 순의 선언 노드 리스트 (병합 대상이 아니면
+            빈 리스트)
+        """
+        if node.type not in self.MERGEABLE_TYPES:
+            return []
+        name = self.name(node)
+        scope = node.parent
+        while scope is not None and scope.type in self.WRAPPER_TYPES:
+            scope = scope.parent
+        if name is None or scope is None:
+            return []
+
+        parts = [
+            declaration
+            for child in scope.named_children
+            if (declaration := self._declaration(child)) is not None
+            and self.name(declaration) == name
+        ]
+        if len(parts) < 2 or not any(
+            part.type in self.REOPENABLE_TYPES for part in parts
+        ):
+            return []
+        return sorted(parts, key=lambda part: part.start_byte)
+
+    def name(self, node: Node) -> str | None:
+        """선언 이름(`Shapes.Geometry`처럼 점으로 이은 이름은 그대로)을 반환한다."""
+        name_node = node.child_by_field_name("name")
+        if name_node is None or name_node.text is None:
+            return None
+        return node_text(name_node) or None
+
+    def _declaration(self, node: Node) -> Node | None:
+        """노드가 (export 등으로 감싼) 병합 가능한 선언이면 그 선언을 반환한다."""
+        current: Node | None = node
+        while current is not None and current.type in self.WRAPPER_TYPES:
+            current = next(
+                (
+                    child
+                    for child in current.named_children
+                    if child.type in self.MERGEABLE_TYPES | self.WRAPPER_TYPES
+                ),
+                None,
+            )
+        if current is None or current.type not in self.MERGEABLE_TYPES:
+            return None
+        return current
@@ -0,0 +1,34 @@
+"""TypeScriptScopeResolver: TypeScript 스코프 경로와 선언 병합을 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .javascript_scope_resolver import JavaScriptScopeResolver
+from .typescript_declaration_merge_resolver import (
+    TypeScriptDeclarationMergeResolver,
+)
+
+
+class TypeScriptScopeResolver(JavaScriptScopeResolver):
+    """JavaScript 규칙에 TypeScript 선언 병합(declaration merging)을 더한 resolver.
+
+    scope_path는 JavaScriptScopeResolver와 같고, 하나의 심볼로 병합되는
+    interface/namespace 등의 선언 묶음은 TypeScriptDeclarationMergeResolver로
+    계산한다.
+    """
+
+    # 병합된 선언들을 묶은 블록의 block_type
+    MERGED_BLOCK_TYPE = TypeScriptDeclarationMergeResolver.GROUP_BLOCK_TYPE
+
+    def __init__(self) -> None:
+        """선언 병합 helper를 생성한다."""
+        self._merge_resolver = TypeScriptDeclarationMergeResolver()
+
+    def merged_parts(self, node: Node) -> list[Node]:
+        """노드와 하나의 심볼로 병합되는 같은 범위의 선언들을 반환한다."""
+        return self._merge_resolver.merged_parts(node)
+
+    def merged_name(self, node: Node) -> str | None:
+        """병합되는 선언의 이름을 반환한다."""
+        return self._merge_resolver.name(node)
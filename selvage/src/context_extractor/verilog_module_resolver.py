@@ -0,0 +1,157 @@
+"""VerilogModuleResolver: Verilog/SystemVerilog 모듈과 모듈 항목 범위를 계산하는 모듈."""
+
+from __future__ import annotations
+
+from tree_sitter import Node
+
+from .scope_resolver import ScopeResolver
+from .text_lines import node_text
+
+
+class VerilogModuleResolver(ScopeResolver):
+    """Verilog AST에서 변경을 감싸는 모듈 항목(always, function, task 등)을 찾는다.
+
+    변경 라인을 감싸는 가장 가까운 `always`/`function`/`task` 블록 전체를
+    반환하며, 그 밖의 모듈 본문 변경(`assign`, 신호 선언, 인스턴스 등)은
+    해당 모듈 항목만 반환한다. 모듈 헤더(포트 목록, 파라미터 포함)의
+    변경은 헤더만 반환한다. 모듈 항목 블록은 감싸는 모듈의 선언 라인을
+    컨테이너 헤더로 함께 포함하고 모듈 이름을 scope_path로 기록한다.
+    """
+
+    # 변경을 감싸는 블록과 블록 이름은 공통 규칙 없이 이 resolver로만 계산
+    OWNS_SCOPES = True
+    OWNS_NAMES = True
+
+    # 모듈 노드 타입
+    MODULE_TYPES = frozenset({"module_declaration"})
+
+    # 모듈 헤더 노드 타입 (ANSI 스타일 포트 선언 포함)
+    HEADER_TYPES = frozenset({"module_ansi_header", "module_nonansi_header"})
+
+    # 모듈 안에서 그 자체를 블록으로 반환하는 노드 타입
+    ITEM_TYPES = frozenset(
+        {"always_construct", "function_declaration", "task_declaration"}
+    )
+
+    # 컨테이너(모듈) 헤더를 함께 포함할 멤버 노드 타입 (모듈 바로 아래 항목)
+    MEMBER_TYPES = ITEM_TYPES | frozenset(
+        {
+            "module_item",
+            "non_port_module_item",
+            "module_or_generate_item",
+            "port_declaration",
+            "parameter_declaration",
+            "local_parameter_declaration",
+        }
+    )
+
+    # 이름을 찾을 때 우선하는 선언별 식별자 노드 타입 (없으면 첫 simple_identifier)
+    NAME_IDENTIFIER_TYPES = {
+        "module_declaration": "module_identifier",
+        "function_declaration": "function_identifier",
+        "task_declaration": "task_identifier",
+    }
+
+    def find_scope(self, node: Node) -> Node | None:
+        """노드를 감싸는 always/function/task, 모듈 헤더 또는 모듈 항목을 찾는다.
+
+        Args:
+            node: 변경 라인을 가장 작게 감싸는 노드
+
+        Returns:
+            가장 가까운 always/function/task 또는 모듈 헤더 노드, 그 밖의
+            모듈 본문이면 모듈 바로 아래 항목 노드 (모듈 밖이면 None)
+        """
+        current: Node | None = node
+        while current is not None:
+            if current.type in self.ITEM_TYPES | self.HEADER_TYPES:
+                return current
+            parent = current.parent
+            if parent is not None and parent.type in self.MODULE_TYPES:
+                # `endmodule` 같은 모듈 자체의 토큰은 모듈 전체로 처리
+                return current if current.is_named else parent
+            if current.type in self.MODULE_TYPES:
+                return current
+            current = parent
+        return None
+
+    def find_container(self, node: Node) -> Node | None:
+        """노드를 감싸는 가장 가까운 모듈 노드를 찾는다.
+
+        Args:
+            node: 기준 노드
+
+        Returns:
+            module_declaration 노드 (없으면 None)
+        """
+        current = node.parent
+        while current is not None:
+            if current.type in self.MODULE_TYPES:
+                return current
+            current = current.parent
+        return None
+
+    def container_header(self, container: Node) -> str:
+        """모듈 선언 라인(`module counter #(` 등)의 첫 줄을 반환한다."""
+        return node_text(container).split("\n", 1)[0].rstrip()
+
+    def container_name(self, container: Node) -> str | None:
+        """컨테이너 이름으로 모듈 이름을 반환한다."""
+        return self.name(container)
+
+    def name(self, node: Node) -> str | None:
+        """모듈/모듈 헤더/function/task의 이름을 반환한다.
+
+        Args:
+            node: 이름을 계산할 노드
+
+        Returns:
+            선언에 적힌 이름 (always 블록처럼 이름이 없는 노드면 None)
+        """
+        if node.type in self.HEADER_TYPES:
+            identifier_type = self.NAME_IDENTIFIER_TYPES["module_declaration"]
+        elif node.type in self.NAME_IDENTIFIER_TYPES:
+            identifier_type = self.NAME_IDENTIFIER_TYPES[node.type]
+        else:
+            return None
+        target = self._header(node) if node.type in self.MODULE_TYPES else node
+        identifier = self._find_descendant(target, identifier_type)
+        if identifier is None:
+            identifier = self._find_descendant(target, "simple_identifier")
+        if identifier is None:
+            return None
+        return node_text(identifier) or None
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """노드를 감싸는 모듈 이름을 반환한다.
+
+        Args:
+            node: 경로를 계산할 노드
+            limit: 사용하지 않음 (감싸는 모듈 하나만 반환)
+
+        Returns:
+            모듈 이름 튜플 (모듈 밖이면 빈 튜플)
+        """
+        container = self.find_container(node)
+        if container is None:
+            return ()
+        name = self.name(container)
+        return (name,) if name else ()
+
+    def _header(self, module: Node) -> Node:
+        """모듈의 헤더 노드를 반환한다 (없으면 모듈 자체)."""
+        return next(
+            (child for child in module.children if child.type in self.HEADER_TYPES),
+            module,
+        )
+
+    @staticmethod
+    def _find_descendant(node: Node, node_type: str) -> Node | None:
+        """전위 순회로 처음 만나는 주어진 타입의 자손 노드를 반환한다."""
+        stack = [node]
+        while stack:
+            current = stack.pop()
+            if current.type == node_type:
+                return current
+            stack.extend(reversed(current.children))
+        return None
@@ -3,10 +3,11 @@ Git diff 파싱 모듈
 """
 
 from .models import DiffResult, FileDiff, Hunk
-from .parser import parse_git_diff
+from .parser import parse_git_diff, parse_patch
 
 __all__ = [
     "parse_git_diff",
+    "parse_patch",
     "Hunk",
     "FileDiff",
     "DiffResult",
@@ -1,6 +1,16 @@
 from dataclasses import dataclass, field
 
-from selvage.src.utils.language_detector import detect_language_from_filename
+from selvage.src.context_extractor.diff_line_changes import DiffLineChanges
+from selvage.src.context_extractor.file_rename import FileRename
+from selvage.src.context_extractor.line_range import LineRange
+from selvage.src.utils.git_attributes import GitAttributes
+from selvage.src.utils.language_detector import (
+    CONTENT_SNIFF_BYTES,
+    detect_language_from_content,
+    detect_language_from_filename,
+    detect_language_from_shebang,
+    detect_template_language,
+)
 
 from ..constants import DELETED_FILE_PLACEHOLDER
 from .hunk import Hunk
@@ -8,7 +18,19 @@ from .hunk import Hunk
 
 @dataclass
 class FileDiff:
-    """Git diff의 파일 변경사항을 나타내는 클래스"""
+    """Git diff의 파일 변경사항을 나타내는 클래스
+
+    has_full_content는 file_content가 수정 후 파일 전체인지 여부입니다.
+    저장소 없이 patch 파일만 파싱한 경우 새로 추가된 파일이 아니면 hunk
+    밖의 내용을 알 수 없으므로 False이며, 이때 file_content는 비어 있습니다.
+    """
+
+    # 언어 감지 출처
+    LANGUAGE_SOURCE_EXTENSION = "extension"
+    LANGUAGE_SOURCE_GITATTRIBUTES = "gitattributes"
+    LANGUAGE_SOURCE_SHEBANG = "shebang"
+    LANGUAGE_SOURCE_CONTENT_CLASSIFIER = "content-classifier"
+    LANGUAGE_SOURCE_TEMPLATE_DELIMITERS = "template-delimiters"
 
     filename: str
     file_content: str
@@ -17,6 +39,10 @@ class FileDiff:
     additions: int = 0
     deletions: int = 0
     line_count: int = 0
+    language_source: str = LANGUAGE_SOURCE_EXTENSION
+    is_generated: bool = False
+    rename: FileRename | None = None
+    has_full_content: bool = True
 
     def calculate_changes(self) -> None:
         """파일의 추가/삭제 라인 수를 계산합니다."""
@@ -30,9 +56,77 @@ class FileDiff:
                 elif line.startswith("-") and not line.startswith("---"):
                     self.deletions += 1
 
-    def detect_language(self) -> None:
-        """파일 확장자를 기반으로 언어를 감지합니다."""
+    def get_line_changes(self) -> DiffLineChanges:
+        """모든 hunk의 추가/삭제 라인 정보를 합쳐 반환합니다."""
+        return DiffLineChanges.combine(hunk.get_line_changes() for hunk in self.hunks)
+
+    def get_change_ranges(self) -> list[LineRange]:
+        """모든 hunk에서 diff 컨텍스트 라인을 제외한 실제 변경 라인 범위를 반환합니다."""
+        return [
+            line_range for hunk in self.hunks for line_range in hunk.get_change_ranges()
+        ]
+
+    def get_deleted_original_ranges(self) -> list[LineRange]:
+        """모든 hunk에서 삭제된 라인 범위를 원본(이름 변경 전) 파일 기준으로 반환합니다."""
+        return [
+            line_range
+            for hunk in self.hunks
+            for line_range in hunk.get_deleted_original_ranges()
+        ]
+
+    @property
+    def is_renamed(self) -> bool:
+        """git이 파일 이름 변경(rename)으로 보고했는지 여부"""
+        return self.rename is not None
+
+    def detect_language(self, git_attributes: GitAttributes | None = None) -> None:
+        """파일 확장자를 기반으로 언어를 감지합니다.
+
+        `.gitattributes`의 `linguist-language` 설정이 있으면 확장자보다 우선하며,
+        `linguist-generated` 설정은 is_generated에 기록합니다. 확장자로 언어를
+        알 수 없으면 등록된 내용 분류기(register_content_classifier)에 파일
+        앞부분을 먼저 전달하고, 분류기가 판단하지 않으면 파일 첫 줄의
+        shebang(`#!/usr/bin/env perl` 등)을 확인하고,
+        HTML 파일은 템플릿 구분자(`{% %}`, `<% %>`, `{{define}}` 등)로 Jinja/ERB/
+        Go 템플릿인지 확인합니다.
+
+        Args:
+            git_attributes: 저장소의 `.gitattributes` 해석기 (None이면 확장자만 사용)
+        """
+        override = None
+        if git_attributes is not None:
+            override = git_attributes.linguist_language(self.filename)
+            self.is_generated = git_attributes.is_generated(self.filename)
+
+        if override:
+            self.language = override
+            self.language_source = self.LANGUAGE_SOURCE_GITATTRIBUTES
+            return
+
         self.language = detect_language_from_filename(self.filename)
+        self.language_source = self.LANGUAGE_SOURCE_EXTENSION
+        if self.language == "text":
+            # 분류기에는 앞부분만 전달하므로 파일 전체를 인코딩하지 않음
+            head = self.file_content[:CONTENT_SNIFF_BYTES].encode("utf-8")
+            content_language = detect_language_from_content(head)
+            if content_language is not None:
+                self.language = content_language
+                self.language_source = self.LANGUAGE_SOURCE_CONTENT_CLASSIFIER
+                return
+            shebang_language = detect_language_from_shebang(self.file_content)
+            if shebang_language is not None:
+                self.language = shebang_language
+                self.language_source = self.LANGUAGE_SOURCE_SHEBANG
+        elif self.language == "html":
+            template_language = detect_template_language(self.file_content)
+            if template_language is not None:
+                self.language = template_language
+                self.language_source = self.LANGUAGE_SOURCE_TEMPLATE_DELIMITERS
+
+    @property
+    def is_language_from_gitattributes(self) -> bool:
+        """언어가 `.gitattributes`의 linguist-language 설정으로 결정되었는지 여부"""
+        return self.language_source == self.LANGUAGE_SOURCE_GITATTRIBUTES
 
     def calculate_line_count(self) -> None:
         """파일의 총 라인 수를 계산합니다."""
@@ -1,7 +1,9 @@
 import re
 from dataclasses import dataclass
 
+from selvage.src.context_extractor.diff_line_changes import DiffLineChanges
 from selvage.src.context_extractor.line_range import LineRange
+from selvage.src.context_extractor.text_lines import split_lines
 from selvage.src.diff_parser.utils.hunk_line_calculator import HunkLineCalculator
 
 
@@ -39,6 +41,52 @@ class Hunk:
         """
         return self.after_code
 
+    def get_modified_text(self) -> str:
+        """수정 후 파일 기준의 hunk 라인들을 diff 접두사 없이 반환합니다.
+
+        추가(`+`)/컨텍스트(` `) 라인만 포함하므로 결과의 첫 라인은 수정 후
+        파일의 start_line_modified 라인에 해당합니다.
+
+        Returns:
+            str: 수정 후 hunk 코드
+        """
+        return "\n".join(
+            line[1:] for line in split_lines(self.content) if line[:1] in ("+", " ")
+        )
+
+    def get_line_changes(self) -> DiffLineChanges:
+        """수정 후 파일 기준의 추가/삭제 라인 정보를 반환합니다.
+
+        Returns:
+            DiffLineChanges: 추가/삭제 라인 정보
+        """
+        return HunkLineCalculator.calculate_line_changes(
+            self.content, self.start_line_modified
+        )
+
+    def get_change_ranges(self) -> list[LineRange]:
+        """diff 컨텍스트 라인을 제외한 실제 변경 라인 범위들을 반환합니다.
+
+        change_line은 hunk 위치를 찾는 용도로 첫 변경부터 마지막 변경까지를
+        포함하므로, 변경된 심볼 판단에는 이 범위들을 사용합니다.
+
+        Returns:
+            list[LineRange]: 수정 후 파일 기준 변경 라인 범위들
+        """
+        return HunkLineCalculator.calculate_change_ranges(
+            self.content, self.start_line_modified
+        )
+
+    def get_deleted_original_ranges(self) -> list[LineRange]:
+        """원본 파일 기준으로 삭제된 라인 범위들을 반환합니다.
+
+        Returns:
+            list[LineRange]: 원본 파일 기준 삭제 라인 범위들
+        """
+        return HunkLineCalculator.calculate_deleted_original_ranges(
+            self.content, self.start_line_original
+        )
+
     @staticmethod
     def from_hunk_text(hunk_text: str) -> "Hunk":
         """hunk 텍스트로부터 Hunk 객체를 생성합니다.
@@ -80,18 +128,19 @@ class Hunk:
         """hunk 헤더 문자열을 파싱하여 시작 줄 번호와 줄 수를 추출합니다.
 
         Args:
-            header: git diff 형식의 hunk 헤더 문자열 (예: "@@ -3,6 +40,7 @@")
+            header: git diff 형식의 hunk 헤더 문자열 (예: "@@ -3,6 +40,7 @@").
+                줄 수가 생략된 헤더(예: "@@ -1 +1 @@")는 줄 수를 1로 봅니다.
 
         Returns:
             tuple[int, int, int, int]: (original 시작 줄, original 줄 수,
                                        modified 시작 줄, modified 줄 수)
         """
-        match = re.match(r"@@ -(\d+),(\d+) \+(\d+),(\d+) @@", header)
+        match = re.match(r"@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@", header)
         if match:
             start_line_original = int(match.group(1))
-            line_count_original = int(match.group(2))
+            line_count_original = int(match.group(2) or 1)
             start_line_modified = int(match.group(3))
-            line_count_modified = int(match.group(4))
+            line_count_modified = int(match.group(4) or 1)
         else:
             start_line_original = 0
             line_count_original = 0
@@ -117,7 +166,7 @@ class Hunk:
         original_lines = []
         modified_lines = []
 
-        for line in content.splitlines():
+        for line in split_lines(content):
             prefix = line[0] if line else ""
             code_part = line if line else ""
 
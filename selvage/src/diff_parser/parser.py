@@ -1,7 +1,9 @@
 import re
 
+from selvage.src.context_extractor.file_rename import FileRename
 from selvage.src.exceptions.diff_parsing_error import DiffParsingError
-from selvage.src.utils import load_file_content
+from selvage.src.utils import console, load_file_content
+from selvage.src.utils.git_attributes import GitAttributes
 
 from .constants import DELETED_FILE_PLACEHOLDER
 from .models import DiffResult, FileDiff, Hunk
@@ -10,14 +12,27 @@ _PATTERN_DIFF_SPLIT = re.compile(r"(?=^diff --git)", flags=re.MULTILINE)
 _PATTERN_HUNK_SPLIT = re.compile(r"(?=^@@ )", flags=re.MULTILINE)
 _PATTERN_FILE_HEADER = re.compile(r"^diff --git a/(\S+) b/(\S+)", flags=re.MULTILINE)
 _DELETED_FILE_PATTERN = re.compile(r"^--- a/.*\n^\+\+\+ /dev/null$", flags=re.MULTILINE)
+_PATTERN_RENAME_FROM = re.compile(r"^rename from (.+)$", flags=re.MULTILINE)
+_PATTERN_RENAME_TO = re.compile(r"^rename to (.+)$", flags=re.MULTILINE)
+_PATTERN_SIMILARITY = re.compile(r"^similarity index (\d+)%$", flags=re.MULTILINE)
+_PATTERN_PATCH_FILE_SPLIT = re.compile(r"(?=^--- .*\n\+\+\+ )", flags=re.MULTILINE)
+_PATTERN_PATCH_FILE_HEADER = re.compile(
+    r"^--- (?P<old>.+)\n\+\+\+ (?P<new>.+)$", flags=re.MULTILINE
+)
+_PATTERN_HUNK_COUNTS = re.compile(r"^@@ -\d+(?:,(\d+))? \+\d+(?:,(\d+))? @@")
+_DEV_NULL = "/dev/null"
 
 
-def _parse_single_file_diff(raw_diff: str, repo_path: str) -> FileDiff | None:
+def _parse_single_file_diff(
+    raw_diff: str, repo_path: str, git_attributes: GitAttributes | None = None
+) -> FileDiff | None:
     """단일 파일 diff 텍스트를 파싱하여 FileDiff 객체를 반환합니다.
 
     Args:
         raw_diff (str): 단일 파일에 대한 git diff 텍스트.
         repo_path (str): Git 저장소 경로.
+        git_attributes (GitAttributes | None): 언어/생성 파일 판단에 사용할
+            `.gitattributes` 해석기.
 
     Returns:
         FileDiff | None: 파싱된 FileDiff 객체 또는 파싱할 수 없는 경우 None.
@@ -51,9 +66,17 @@ def _parse_single_file_diff(raw_diff: str, repo_path: str) -> FileDiff | None:
             )
 
     parsed_diff = FileDiff(
-        filename=filename, file_content=file_content, hunks=hunk_list
+        filename=filename,
+        file_content=file_content,
+        hunks=hunk_list,
+        rename=_parse_rename(raw_diff),
     )
-    parsed_diff.detect_language()
+    parsed_diff.detect_language(git_attributes)
+    if parsed_diff.is_language_from_gitattributes:
+        console.log_info(
+            f"{filename}: .gitattributes의 linguist-language 설정에 따라 "
+            f"'{parsed_diff.language}' 언어로 처리합니다."
+        )
     parsed_diff.calculate_changes()
     parsed_diff.calculate_line_count()
     return parsed_diff
@@ -77,9 +100,10 @@ def parse_git_diff(diff_text: str, repo_path: str) -> DiffResult:
 
     file_diffs = _PATTERN_DIFF_SPLIT.split(diff_text)
     result = DiffResult()
+    git_attributes = GitAttributes(repo_path)
 
     for raw_diff in file_diffs:
-        file_diff = _parse_single_file_diff(raw_diff, repo_path)
+        file_diff = _parse_single_file_diff(raw_diff, repo_path, git_attributes)
         if file_diff:
             result.files.append(file_diff)
 
@@ -89,6 +113,168 @@ def parse_git_diff(diff_text: str, repo_path: str) -> DiffResult:
     return result
 
 
+def parse_patch(patch_text: str) -> DiffResult:
+    """저장소 없이 unified diff(.patch/.diff) 텍스트만으로 DiffResult를 만듭니다.
+
+    `diff --git` 헤더가 있는 git 형식과 `---`/`+++` 헤더만 있는 일반 unified
+    diff 형식을 모두 지원하며, 파일 경로는 `+++ b/...` 경로를 사용합니다.
+    새로 추가된 파일은 hunk의 추가 라인들로 파일 전체 내용을 복원하고, 그 밖의
+    파일은 hunk 밖의 내용을 알 수 없으므로 file_content를 비우고
+    has_full_content를 False로 둡니다. `---`/`+++` 헤더가 없는 파일(바이너리,
+    권한 변경, 내용 변경 없는 이름 변경)은 결과에 포함하지 않습니다.
+
+    Args:
+        patch_text (str): patch 파일 내용
+
+    Returns:
+        DiffResult: patch에 포함된 파일별 변경 사항
+
+    Raises:
+        DiffParsingError: patch가 비어있거나 파일 변경을 하나도 찾을 수 없는 경우
+    """
+    if not patch_text:
+        raise DiffParsingError("빈 patch가 제공되었습니다.")
+
+    if _PATTERN_FILE_HEADER.search(patch_text):
+        raw_diffs = _PATTERN_DIFF_SPLIT.split(patch_text)
+    else:
+        raw_diffs = _PATTERN_PATCH_FILE_SPLIT.split(patch_text)
+    result = DiffResult()
+    for raw_diff in raw_diffs:
+        file_diff = _parse_single_patch_file(raw_diff)
+        if file_diff:
+            result.files.append(file_diff)
+
+    if not result.files:
+        raise DiffParsingError("유효하지 않은 patch 형식입니다.")
+
+    return result
+
+
+def _parse_single_patch_file(raw_diff: str) -> FileDiff | None:
+    """patch의 단일 파일 부분을 파싱하여 FileDiff 객체를 반환합니다.
+
+    Args:
+        raw_diff (str): 단일 파일에 대한 patch 텍스트.
+
+    Returns:
+        FileDiff | None: 파싱된 FileDiff 객체 (`---`/`+++` 헤더가 없으면 None)
+    """
+    header_match = _PATTERN_PATCH_FILE_HEADER.search(raw_diff)
+    if not header_match:
+        return None
+    old_path = _patch_header_path(header_match.group("old"))
+    new_path = _patch_header_path(header_match.group("new"))
+
+    hunk_list = [
+        Hunk.from_hunk_text(_trim_hunk_text(h))
+        for h in _PATTERN_HUNK_SPLIT.split(raw_diff[header_match.end() :])
+        if h.startswith("@@")
+    ]
+
+    has_full_content = True
+    if new_path == _DEV_NULL:
+        filename = old_path
+        file_content = DELETED_FILE_PLACEHOLDER
+    elif old_path == _DEV_NULL:
+        filename = new_path
+        file_content = "".join(f"{hunk.get_modified_text()}\n" for hunk in hunk_list)
+    else:
+        filename = new_path
+        file_content = ""
+        has_full_content = False
+
+    parsed_diff = FileDiff(
+        filename=filename,
+        file_content=file_content,
+        hunks=hunk_list,
+        rename=_parse_rename(raw_diff),
+        has_full_content=has_full_content,
+    )
+    parsed_diff.detect_language()
+    parsed_diff.calculate_changes()
+    parsed_diff.calculate_line_count()
+    return parsed_diff
+
+
+def _patch_header_path(header_value: str) -> str:
+    """`---`/`+++` 헤더 값에서 `a/`, `b/` 접두사와 타임스탬프를 뗀 경로를 반환합니다.
+
+    Args:
+        header_value (str): 헤더의 `---`/`+++` 뒤 값 (예: "b/src/main.go")
+
+    Returns:
+        str: 파일 경로 (파일이 없는 쪽이면 "/dev/null")
+    """
+    path = header_value.split("\t", 1)[0].rstrip()
+    if path.startswith(("a/", "b/")):
+        return path[2:]
+    return path
+
+
+def _trim_hunk_text(hunk_text: str) -> str:
+    """hunk 헤더의 줄 수만큼만 남기고 뒤따르는 patch 부가 텍스트를 잘라냅니다.
+
+    `git format-patch` 결과 끝의 서명(`-- ` 라인과 git 버전)처럼 마지막 hunk
+    뒤에 붙은 텍스트가 삭제 라인으로 해석되지 않게 합니다.
+
+    Args:
+        hunk_text (str): `@@`로 시작하는 hunk 텍스트
+
+    Returns:
+        str: 헤더와 헤더의 줄 수에 해당하는 라인들만 남긴 hunk 텍스트
+    """
+    lines = hunk_text.split("\n")
+    match = _PATTERN_HUNK_COUNTS.match(lines[0])
+    if not match:
+        return hunk_text
+    remaining_original = int(match.group(1) or 1)
+    remaining_modified = int(match.group(2) or 1)
+    kept = [lines[0]]
+    for line in lines[1:]:
+        if remaining_original <= 0 and remaining_modified <= 0:
+            # `\ No newline at end of file` 표시는 마지막 라인 뒤에 올 수 있음
+            if not line.startswith("\\"):
+                break
+        elif line.startswith("-"):
+            remaining_original -= 1
+        elif line.startswith("+"):
+            remaining_modified -= 1
+        elif line.startswith(" "):
+            remaining_original -= 1
+            remaining_modified -= 1
+        elif not line.startswith("\\"):
+            break
+        kept.append(line)
+    return "\n".join(kept)
+
+
+def _parse_rename(raw_diff: str) -> FileRename | None:
+    """diff 확장 헤더에서 파일 이름 변경 정보를 파싱합니다.
+
+    Git diff에서 이름이 바뀐 파일은 `rename from`/`rename to` 헤더와
+    `similarity index N%` 헤더를 가집니다. diff 본문(hunk) 이전의 헤더
+    영역만 확인합니다.
+
+    Args:
+        raw_diff (str): 단일 파일에 대한 git diff 텍스트.
+
+    Returns:
+        FileRename | None: 이름 변경 정보 (이름 변경이 아니면 None)
+    """
+    header = _PATTERN_HUNK_SPLIT.split(raw_diff, maxsplit=1)[0]
+    rename_from = _PATTERN_RENAME_FROM.search(header)
+    rename_to = _PATTERN_RENAME_TO.search(header)
+    if not rename_from or not rename_to:
+        return None
+    similarity = _PATTERN_SIMILARITY.search(header)
+    return FileRename.from_similarity_index(
+        rename_from.group(1),
+        rename_to.group(1),
+        int(similarity.group(1)) if similarity else None,
+    )
+
+
 def _is_deleted_file(raw_diff: str) -> bool:
     """파일이 삭제된 경우 True를 반환합니다.
 
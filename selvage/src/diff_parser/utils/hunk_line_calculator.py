@@ -3,7 +3,9 @@
 from dataclasses import dataclass
 from enum import Enum
 
+from selvage.src.context_extractor.diff_line_changes import DiffLineChanges
 from selvage.src.context_extractor.line_range import LineRange
+from selvage.src.context_extractor.text_lines import split_lines
 
 
 class LineType(Enum):
@@ -41,7 +43,7 @@ class HunkLineCalculator:
         Returns:
             LineRange: 실제 변경이 발생한 라인 범위
         """
-        lines = content.splitlines()
+        lines = split_lines(content)
         tracker = ChangeTracker(current_line=start_line_modified)
 
         for line in lines:
@@ -58,6 +60,117 @@ class HunkLineCalculator:
 
         return HunkLineCalculator._finalize_change_range(tracker, start_line_modified)
 
+    @staticmethod
+    def calculate_line_changes(
+        content: str, start_line_modified: int
+    ) -> DiffLineChanges:
+        """hunk content에서 추가/삭제된 라인 위치를 수정 후 파일 기준으로 계산합니다.
+
+        삭제된 라인은 삭제가 일어난 위치의 수정 후 파일 라인 번호로 기록합니다.
+
+        Args:
+            content: git diff 형식의 hunk 내용 문자열
+            start_line_modified: modified 파일에서의 시작 라인 번호
+
+        Returns:
+            DiffLineChanges: 추가/삭제 라인 정보
+        """
+        added_lines: set[int] = set()
+        deleted_lines: list[int] = []
+        current_line = start_line_modified
+
+        for line in split_lines(content):
+            line_type = HunkLineCalculator._parse_diff_line(line)
+            if line_type == LineType.ADDED:
+                added_lines.add(current_line)
+                current_line += 1
+            elif line_type == LineType.DELETED:
+                deleted_lines.append(current_line)
+            elif line_type == LineType.CONTEXT:
+                current_line += 1
+
+        return DiffLineChanges(frozenset(added_lines), tuple(deleted_lines))
+
+    @staticmethod
+    def calculate_change_ranges(
+        content: str, start_line_modified: int
+    ) -> list[LineRange]:
+        """hunk content에서 실제 추가/삭제된 라인만 수정 후 파일 기준 연속 범위로 계산합니다.
+
+        calculate_actual_change_lines는 첫 변경부터 마지막 변경까지를 하나의
+        범위로 보므로, 변경 사이의 diff 컨텍스트(` `) 라인도 포함합니다. 이 메서드는
+        컨텍스트 라인을 제외하여 컨텍스트에만 등장하는 심볼이 변경된 심볼로
+        잡히지 않게 합니다.
+
+        Args:
+            content: git diff 형식의 hunk 내용 문자열
+            start_line_modified: modified 파일에서의 시작 라인 번호
+
+        Returns:
+            list[LineRange]: 변경 라인 범위들 (라인 순). 변경이 없으면
+                calculate_actual_change_lines와 같은 기본 범위 하나
+        """
+        line_changes = HunkLineCalculator.calculate_line_changes(
+            content, start_line_modified
+        )
+        changed_lines = sorted(
+            set(line_changes.added_lines)
+            | {max(line, 1) for line in line_changes.deleted_lines}
+        )
+        if not changed_lines:
+            return [
+                HunkLineCalculator.calculate_actual_change_lines(
+                    content, start_line_modified
+                )
+            ]
+
+        ranges: list[LineRange] = []
+        range_start = range_end = changed_lines[0]
+        for line in changed_lines[1:]:
+            if line == range_end + 1:
+                range_end = line
+                continue
+            ranges.append(LineRange(range_start, range_end))
+            range_start = range_end = line
+        ranges.append(LineRange(range_start, range_end))
+        return ranges
+
+    @staticmethod
+    def calculate_deleted_original_ranges(
+        content: str, start_line_original: int
+    ) -> list[LineRange]:
+        """hunk content에서 삭제된 라인들을 원본 파일 기준 연속 범위로 계산합니다.
+
+        Args:
+            content: git diff 형식의 hunk 내용 문자열
+            start_line_original: original 파일에서의 시작 라인 번호
+
+        Returns:
+            list[LineRange]: 원본 파일 기준 삭제 라인 범위들 (라인 순)
+        """
+        ranges: list[LineRange] = []
+        range_start: int | None = None
+        current_line = start_line_original
+
+        for line in split_lines(content):
+            line_type = HunkLineCalculator._parse_diff_line(line)
+            if line_type == LineType.DELETED:
+                if range_start is None:
+                    range_start = current_line
+                current_line += 1
+                continue
+            if line_type == LineType.ADDED:
+                continue
+            if range_start is not None:
+                ranges.append(LineRange(range_start, current_line - 1))
+                range_start = None
+            if line_type == LineType.CONTEXT:
+                current_line += 1
+
+        if range_start is not None:
+            ranges.append(LineRange(range_start, current_line - 1))
+        return ranges
+
     @staticmethod
     def _parse_diff_line(line: str) -> LineType | None:
         """Diff 라인에서 라인 타입을 파싱합니다."""
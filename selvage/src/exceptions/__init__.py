@@ -4,7 +4,11 @@
 
 from selvage.src.exceptions.api_key_not_found_error import APIKeyNotFoundError
 from selvage.src.exceptions.context_extraction_error import (
+    AmbiguousSymbolError,
     ContextExtractionError,
+    ParseTimeoutError,
+    StrictParseError,
+    SymbolNotFoundError,
     TreeSitterError,
     UnsupportedLanguageError,
 )
@@ -32,4 +36,8 @@ __all__ = [
     "ContextExtractionError",
     "UnsupportedLanguageError",
     "TreeSitterError",
+    "ParseTimeoutError",
+    "StrictParseError",
+    "SymbolNotFoundError",
+    "AmbiguousSymbolError",
 ]
@@ -2,6 +2,8 @@
 컨텍스트 추출 관련 예외 클래스 정의 모듈입니다.
 """
 
+from collections.abc import Sequence
+
 
 class ContextExtractionError(Exception):
     """컨텍스트 추출 중 발생하는 일반적인 예외"""
@@ -24,3 +26,46 @@ class TreeSitterError(ContextExtractionError):
 
     def __init__(self, message: str) -> None:
         super().__init__(f"Tree-sitter 오류: {message}")
+
+
+class ParseTimeoutError(ContextExtractionError):
+    """파일 파싱이 제한 시간을 넘겨 중단되었을 때의 예외"""
+
+    def __init__(self, timeout_seconds: float, file_path: str | None = None) -> None:
+        self.timeout_seconds = timeout_seconds
+        self.file_path = file_path
+        target = f"{file_path} " if file_path else ""
+        super().__init__(f"{target}파싱 시간 초과 ({timeout_seconds:g}초)")
+
+
+class StrictParseError(ContextExtractionError):
+    """strict 모드에서 변경된 심볼 안에 구문 오류가 있을 때의 예외"""
+
+    def __init__(
+        self, locations: Sequence[tuple[int, int]], file_path: str | None = None
+    ) -> None:
+        self.locations = tuple(locations)
+        self.file_path = file_path
+        target = f"{file_path} " if file_path else ""
+        positions = ", ".join(f"{line}:{column}" for line, column in self.locations)
+        super().__init__(f"{target}변경된 심볼에 구문 오류가 있습니다 ({positions})")
+
+
+class SymbolNotFoundError(ContextExtractionError):
+    """정규화된 이름에 해당하는 심볼을 찾지 못했을 때의 예외"""
+
+    def __init__(self, qualified_name: str) -> None:
+        self.qualified_name = qualified_name
+        super().__init__(f"심볼 '{qualified_name}'을(를) 찾을 수 없습니다")
+
+
+class AmbiguousSymbolError(ContextExtractionError):
+    """정규화된 이름에 해당하는 심볼이 여러 개일 때의 예외"""
+
+    def __init__(self, qualified_name: str, candidates: Sequence[str]) -> None:
+        self.qualified_name = qualified_name
+        self.candidates = tuple(candidates)
+        super().__init__(
+            f"심볼 '{qualified_name}'이(가) 여러 개입니다: "
+            f"{', '.join(self.candidates)}"
+        )
@@ -0,0 +1,163 @@
+"""`CODEOWNERS` 파일로 경로의 소유자를 찾는 모듈."""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+from pathlib import Path, PurePosixPath
+
+from selvage.src.utils.git_attributes import translate_glob_pattern
+
+# 저장소 루트의 CODEOWNERS 위치 (GitHub와 같이 먼저 찾은 파일 하나만 사용)
+ROOT_CODE_OWNERS_PATHS = (".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS")
+
+_CODE_OWNERS_FILE_NAME = "CODEOWNERS"
+
+
+@dataclass(frozen=True)
+class CodeOwner:
+    """`CODEOWNERS`에 적힌 소유자 하나.
+
+    name은 파일에 적힌 그대로의 값(`@org/team`, `@user`, `user@example.com`)
+    이다.
+    """
+
+    name: str
+
+    @property
+    def is_team(self) -> bool:
+        """`@org/team` 형태의 팀 소유자인지 여부"""
+        return self.name.startswith("@") and "/" in self.name
+
+    @property
+    def is_user(self) -> bool:
+        """`@user` 형태의 사용자 소유자인지 여부"""
+        return self.name.startswith("@") and "/" not in self.name
+
+    @property
+    def is_email(self) -> bool:
+        """이메일 주소로 적힌 소유자인지 여부"""
+        return not self.name.startswith("@") and "@" in self.name
+
+
+@dataclass(frozen=True)
+class _OwnerRule:
+    """`CODEOWNERS`의 한 줄(패턴과 소유자 목록)."""
+
+    pattern: re.Pattern[str]
+    # 소유자가 없는 줄은 앞선 규칙의 소유자를 지우는 "소유자 없음" 규칙
+    owners: tuple[CodeOwner, ...]
+
+    def matches(self, relative_path: str) -> bool:
+        """규칙이 정의된 디렉토리 기준 상대 경로가 패턴과 일치하는지 확인합니다."""
+        return self.pattern.fullmatch(relative_path) is not None
+
+
+class CodeOwners:
+    """저장소의 `CODEOWNERS` 파일들로 파일 경로의 소유자를 계산합니다.
+
+    루트 규칙은 `.github/CODEOWNERS`, `CODEOWNERS`, `docs/CODEOWNERS` 중 먼저
+    찾은 파일 하나이며, 하위 디렉토리의 `CODEOWNERS`는 그 디렉토리 기준
+    패턴으로 더 깊을수록 우선합니다. 같은 파일 안에서는 GitHub와 같이 마지막으로
+    일치한 줄이 이기며, 소유자 없이 패턴만 있는 줄이 마지막으로 일치하면
+    소유자가 없습니다. 파일은 필요할 때 디렉토리별로 한 번만 읽습니다.
+    """
+
+    def __init__(self, repo_path: str | Path) -> None:
+        """CodeOwners 인스턴스를 초기화합니다.
+
+        Args:
+            repo_path: Git 저장소 루트 경로
+        """
+        self.repo_path = Path(repo_path)
+        self._rules_cache: dict[str, list[_OwnerRule]] = {}
+
+    def owners_for(self, file_path: str) -> tuple[CodeOwner, ...]:
+        """파일에 적용되는 소유자들을 반환합니다.
+
+        Args:
+            file_path: 저장소 루트 기준 파일 경로 (`/` 구분)
+
+        Returns:
+            가장 깊은 `CODEOWNERS`에서 마지막으로 일치한 줄의 소유자들
+            (일치하는 줄이 없으면 빈 튜플)
+        """
+        path = PurePosixPath(file_path.lstrip("/"))
+        sources = [(PurePosixPath(), self._root_rules())]
+        for depth in range(1, len(path.parts)):
+            directory = PurePosixPath(*path.parts[:depth])
+            owners_path = directory / _CODE_OWNERS_FILE_NAME
+            # `docs/CODEOWNERS`, `.github/CODEOWNERS`는 루트 기준 파일로만 사용
+            if owners_path.as_posix() not in ROOT_CODE_OWNERS_PATHS:
+                sources.append((directory, self._rules_in(owners_path)))
+
+        # 깊은 디렉토리의 CODEOWNERS부터 확인하고, 파일 안에서는 마지막 줄부터 확인
+        for directory, rules in reversed(sources):
+            relative_path = path.relative_to(directory).as_posix()
+            for rule in reversed(rules):
+                if rule.matches(relative_path):
+                    return rule.owners
+        return ()
+
+    def _root_rules(self) -> list[_OwnerRule]:
+        """루트 CODEOWNERS 위치 중 먼저 찾은 파일의 규칙 목록을 반환합니다."""
+        for candidate in ROOT_CODE_OWNERS_PATHS:
+            if (self.repo_path / candidate).is_file():
+                return self._rules_in(PurePosixPath(candidate))
+        return []
+
+    def _rules_in(self, owners_path: PurePosixPath) -> list[_OwnerRule]:
+        """CODEOWNERS 파일을 읽어 규칙 목록을 반환합니다 (없거나 읽을 수 없으면 빈 목록)."""
+        key = owners_path.as_posix()
+        if key not in self._rules_cache:
+            try:
+                content = (self.repo_path / key).read_text(encoding="utf-8")
+            except (OSError, UnicodeDecodeError):
+                content = ""
+            self._rules_cache[key] = parse_code_owners(content)
+        return self._rules_cache[key]
+
+
+def parse_code_owners(content: str) -> list[_OwnerRule]:
+    """`CODEOWNERS` 파일 내용을 규칙 목록으로 파싱합니다.
+
+    주석(`#`)과 빈 줄, GitLab 섹션 헤더(`[Section]`)는 무시하며, 줄 끝의
+    `#` 주석은 소유자에서 제외합니다.
+
+    Args:
+        content: `CODEOWNERS` 파일 내용
+
+    Returns:
+        파일에 나타난 순서대로의 규칙 목록
+    """
+    rules: list[_OwnerRule] = []
+    for line in content.splitlines():
+        fields = line.split("#", 1)[0].split()
+        if not fields or fields[0].startswith(("[", "^[")):
+            continue
+        rules.append(
+            _OwnerRule(
+                pattern=re.compile(translate_owner_pattern(fields[0])),
+                owners=tuple(CodeOwner(owner) for owner in fields[1:]),
+            )
+        )
+    return rules
+
+
+def translate_owner_pattern(pattern: str) -> str:
+    """CODEOWNERS 패턴을 디렉토리 기준 상대 경로에 대한 정규식으로 변환합니다.
+
+    gitignore와 같이 앞이나 중간에 `/`가 있는 패턴은 디렉토리 기준으로
+    고정되고, 그렇지 않으면 어느 깊이에서든 일치합니다. 마지막 경로 조각에
+    와일드카드가 없는 패턴(`apps/`, `/build/logs`)은 해당 디렉토리 아래 모든
+    파일에 일치하며, `docs/*`처럼 와일드카드로 끝나는 패턴은 바로 아래 파일에만
+    일치합니다.
+    """
+    anchored = "/" in pattern.rstrip("/")
+    body = pattern.strip("/")
+    regex = translate_glob_pattern(body)
+    if not anchored:
+        regex = "(?:.*/)?" + regex
+    if not any(char in body.rsplit("/", 1)[-1] for char in "*?["):
+        regex += "(?:/.*)?"
+    return regex
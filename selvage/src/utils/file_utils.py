@@ -181,9 +181,9 @@ def load_file_content(filename: str, repo_path: str) -> str:
         ):  # is_ignore_file은 같은 파일 내에 있으므로 바로 사용
             return f"[제외 파일: {filename}]"
 
-        # UTF-8로 파일 읽기 시도
+        # UTF-8로 파일 읽기 시도 (라인 번호가 git과 맞도록 줄바꿈은 변환하지 않음)
         try:
-            with open(file_path, encoding="utf-8") as f:
+            with open(file_path, encoding="utf-8", newline="") as f:
                 return f.read()
         except UnicodeDecodeError:
             # 인코딩 오류 시 바이너리 파일로 간주
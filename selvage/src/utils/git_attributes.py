@@ -0,0 +1,233 @@
+"""`.gitattributes`의 linguist 속성을 해석하는 모듈."""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+from pathlib import Path, PurePosixPath
+
+# 속성 값: True(설정), False(해제), 문자열(값 지정)
+AttributeValue = bool | str
+
+# GitHub linguist 언어 이름 → Selvage 언어 이름
+LINGUIST_LANGUAGE_NAMES = {
+    "python": "python",
+    "javascript": "javascript",
+    "typescript": "typescript",
+    "java": "java",
+    "kotlin": "kotlin",
+    "go": "go",
+    "ruby": "ruby",
+    "php": "php",
+    "c#": "csharp",
+    "csharp": "csharp",
+    "c++": "cpp",
+    "cpp": "cpp",
+    "c": "c",
+    "objective-c": "objc",
+    "objective-c++": "objc",
+    "objc": "objc",
+    "html": "html",
+    "css": "css",
+    "scss": "scss",
+    "markdown": "markdown",
+    "json": "json",
+    "xml": "xml",
+    "yaml": "yaml",
+    "toml": "toml",
+    "shell": "shell",
+    "bash": "shell",
+    "sh": "shell",
+    "sql": "sql",
+}
+
+_LINGUIST_LANGUAGE = "linguist-language"
+_LINGUIST_GENERATED = "linguist-generated"
+
+
+@dataclass(frozen=True)
+class _AttributeRule:
+    """`.gitattributes`의 한 줄(패턴과 속성 목록)."""
+
+    pattern: re.Pattern[str]
+    match_basename: bool
+    # (속성 이름, 값) 목록. 값이 None이면 `!attr`(미지정으로 되돌림)
+    attributes: tuple[tuple[str, AttributeValue | None], ...]
+
+    def matches(self, relative_path: str) -> bool:
+        """규칙이 정의된 디렉토리 기준 상대 경로가 패턴과 일치하는지 확인합니다."""
+        if self.match_basename:
+            relative_path = relative_path.rsplit("/", 1)[-1]
+        return self.pattern.fullmatch(relative_path) is not None
+
+
+class GitAttributes:
+    """저장소의 `.gitattributes` 파일들을 git 우선순위 규칙에 따라 해석합니다.
+
+    우선순위는 git과 동일하게 낮은 것부터 루트 `.gitattributes`, 하위 디렉토리의
+    `.gitattributes`(깊을수록 우선), `.git/info/attributes` 순이며, 같은 파일
+    안에서는 뒤에 오는 줄이 앞의 줄을 덮어씁니다. 파일은 필요할 때 디렉토리별로
+    한 번만 읽습니다.
+    """
+
+    def __init__(self, repo_path: str | Path) -> None:
+        """GitAttributes 인스턴스를 초기화합니다.
+
+        Args:
+            repo_path: Git 저장소 루트 경로
+        """
+        self.repo_path = Path(repo_path)
+        self._rules_cache: dict[str, list[_AttributeRule]] = {}
+
+    def attributes_for(self, file_path: str) -> dict[str, AttributeValue]:
+        """파일에 적용되는 최종 속성을 반환합니다.
+
+        Args:
+            file_path: 저장소 루트 기준 파일 경로 (`/` 구분)
+
+        Returns:
+            속성 이름 → 값 딕셔너리 (미지정 속성은 포함되지 않음)
+        """
+        path = PurePosixPath(file_path.lstrip("/"))
+        directories = [
+            PurePosixPath(*path.parts[:depth]) for depth in range(len(path.parts))
+        ]
+
+        sources = [
+            (directory, self._rules_in(directory / ".gitattributes"))
+            for directory in directories
+        ]
+        sources.append(
+            (PurePosixPath(), self._rules_in(PurePosixPath(".git/info/attributes")))
+        )
+
+        attributes: dict[str, AttributeValue] = {}
+        for directory, rules in sources:
+            relative_path = path.relative_to(directory).as_posix()
+            for rule in rules:
+                if not rule.matches(relative_path):
+                    continue
+                for name, value in rule.attributes:
+                    if value is None:
+                        attributes.pop(name, None)
+                    else:
+                        attributes[name] = value
+        return attributes
+
+    def linguist_language(self, file_path: str) -> str | None:
+        """`linguist-language` 속성으로 지정된 언어를 Selvage 언어 이름으로 반환합니다.
+
+        Args:
+            file_path: 저장소 루트 기준 파일 경로
+
+        Returns:
+            언어 이름 (속성이 없으면 None). 알려지지 않은 linguist 언어 이름은
+            소문자로 바꾸고 공백을 `-`로 치환해 반환합니다.
+        """
+        value = self.attributes_for(file_path).get(_LINGUIST_LANGUAGE)
+        if not isinstance(value, str) or not value:
+            return None
+        normalized = value.strip().lower().replace(" ", "-")
+        return LINGUIST_LANGUAGE_NAMES.get(normalized, normalized)
+
+    def is_generated(self, file_path: str) -> bool:
+        """`linguist-generated` 속성으로 생성된 파일로 표시되었는지 확인합니다.
+
+        Args:
+            file_path: 저장소 루트 기준 파일 경로
+
+        Returns:
+            `linguist-generated` 또는 `linguist-generated=true`이면 True
+        """
+        value = self.attributes_for(file_path).get(_LINGUIST_GENERATED)
+        if isinstance(value, str):
+            return value.lower() == "true"
+        return value is True
+
+    def _rules_in(self, attributes_path: PurePosixPath) -> list[_AttributeRule]:
+        """속성 파일을 읽어 규칙 목록을 반환합니다 (없거나 읽을 수 없으면 빈 목록)."""
+        key = attributes_path.as_posix()
+        if key not in self._rules_cache:
+            try:
+                content = (self.repo_path / key).read_text(encoding="utf-8")
+            except (OSError, UnicodeDecodeError):
+                content = ""
+            self._rules_cache[key] = parse_git_attributes(content)
+        return self._rules_cache[key]
+
+
+def parse_git_attributes(content: str) -> list[_AttributeRule]:
+    """`.gitattributes` 파일 내용을 규칙 목록으로 파싱합니다.
+
+    주석, 빈 줄, 매크로 정의(`[attr]`), git이 허용하지 않는 부정 패턴(`!`)은
+    무시합니다.
+
+    Args:
+        content: `.gitattributes` 파일 내용
+
+    Returns:
+        파일에 나타난 순서대로의 규칙 목록
+    """
+    rules: list[_AttributeRule] = []
+    for line in content.splitlines():
+        fields = line.split()
+        if len(fields) < 2 or fields[0].startswith(("#", "[attr]", "!")):
+            continue
+
+        pattern = fields[0]
+        match_basename = "/" not in pattern.rstrip("/")
+        rules.append(
+            _AttributeRule(
+                pattern=re.compile(translate_glob_pattern(pattern.strip("/"))),
+                match_basename=match_basename,
+                attributes=tuple(_parse_attribute(field) for field in fields[1:]),
+            )
+        )
+    return rules
+
+
+def _parse_attribute(field: str) -> tuple[str, AttributeValue | None]:
+    """`attr`, `-attr`, `!attr`, `attr=value` 형태의 속성을 파싱합니다."""
+    if field.startswith("-"):
+        return field[1:], False
+    if field.startswith("!"):
+        return field[1:], None
+    name, separator, value = field.partition("=")
+    return (name, value) if separator else (name, True)
+
+
+def translate_glob_pattern(pattern: str) -> str:
+    """gitignore 스타일 glob 패턴을 정규식으로 변환합니다.
+
+    `*`와 `?`는 `/`를 넘지 않으며, `**/`, `/**`, `**`는 여러 디렉토리에
+    일치합니다.
+    """
+    result: list[str] = []
+    index = 0
+    while index < len(pattern):
+        if pattern.startswith("**/", index):
+            result.append("(?:.*/)?")
+            index += 3
+        elif pattern.startswith("/**", index) and index + 3 == len(pattern):
+            result.append("/.*")
+            index += 3
+        elif pattern.startswith("**", index):
+            result.append(".*")
+            index += 2
+        elif pattern[index] == "*":
+            result.append("[^/]*")
+            index += 1
+        elif pattern[index] == "?":
+            result.append("[^/]")
+            index += 1
+        elif pattern[index] == "[" and "]" in pattern[index + 2 :]:
+            end = pattern.index("]", index + 2)
+            content = pattern[index + 1 : end].replace("\\", "\\\\")
+            if content.startswith("!"):
+                content = "^" + content[1:]
+            result.append(f"[{content}]")
+            index = end + 1
+        else:
+            result.append(re.escape(pattern[index]))
+            index += 1
+    return "".join(result)
@@ -1,4 +1,6 @@
 import os
+import re
+from collections.abc import Callable
 
 SUPPORTED_EXTENSIONS = {
     ".py": "python",
@@ -15,6 +17,8 @@ SUPPORTED_EXTENSIONS = {
     ".c": "c",
     ".h": "c",
     ".hpp": "cpp",
+    ".m": "objc",
+    ".mm": "objc",
     ".html": "html",
     ".css": "css",
     ".scss": "scss",
@@ -23,20 +27,225 @@ SUPPORTED_EXTENSIONS = {
     ".xml": "xml",
     ".yaml": "yaml",
     ".yml": "yaml",
+    ".toml": "toml",
     ".sh": "shell",
     ".bash": "shell",
     ".sql": "sql",
+    ".pl": "perl",
+    ".pm": "perl",
+    ".r": "r",
+    ".nim": "nim",
+    ".nims": "nim",
+    ".s": "assembly",
+    ".asm": "assembly",
+    ".dockerfile": "dockerfile",
+    ".tmpl": "gotemplate",
+    ".gotmpl": "gotemplate",
+    ".gohtml": "gotemplate",
+    ".j2": "jinja",
+    ".jinja": "jinja",
+    ".jinja2": "jinja",
+    ".erb": "erb",
+    ".svelte": "svelte",
+    ".clj": "clojure",
+    ".cljs": "clojure",
+    ".cljc": "clojure",
+    ".edn": "clojure",
+    # 자유 형식(free-form) Fortran
+    ".f90": "fortran",
+    ".f95": "fortran",
+    ".f03": "fortran",
+    ".f08": "fortran",
+    # 고정 형식(fixed-form) Fortran: 열 위치 규칙 때문에 AST 추출 대상이 아님
+    ".f": "fortranfixed",
+    ".for": "fortranfixed",
+    ".f77": "fortranfixed",
+    ".sol": "solidity",
+    # SystemVerilog 문법이 Verilog-2005를 포함하므로 같은 언어로 처리
+    ".v": "verilog",
+    ".sv": "verilog",
+    ".jl": "julia",
+    ".cmake": "cmake",
+    ".mk": "makefile",
+    # 모듈(.erl)과 헤더(.hrl)를 같은 언어로 처리 (헤더에는 `-module` 속성이 없음)
+    ".erl": "erlang",
+    ".hrl": "erlang",
+    # Bazel: `BUILD.bazel`, `WORKSPACE.bazel`, `MODULE.bazel`과 확장 파일(.bzl)
+    ".bzl": "starlark",
+    ".bazel": "starlark",
+    ".tcl": "tcl",
+    ".pas": "pascal",
+    ".dpr": "pascal",
+    ".hx": "haxe",
+    ".gleam": "gleam",
+    ".adoc": "asciidoc",
+    ".asciidoc": "asciidoc",
+    ".rst": "rst",
 }
 
+# 확장자 없이 파일 이름으로 언어를 알 수 있는 파일 (소문자로 비교)
+SUPPORTED_FILENAMES = {
+    "dockerfile": "dockerfile",
+    "cmakelists.txt": "cmake",
+    "makefile": "makefile",
+    "gnumakefile": "makefile",
+}
+
+# 확장자 없는 Bazel 파일 이름 (대소문자를 구분해 `build` 스크립트와 구분)
+BAZEL_FILENAMES = frozenset({"BUILD", "WORKSPACE"})
+
+# GitHub Actions 워크플로 파일이 있는 디렉터리 (하위 디렉터리는 GitHub이 읽지 않음)
+GITHUB_WORKFLOW_DIRECTORY = ".github/workflows"
+
+# GitHub Actions 워크플로 파일 확장자
+GITHUB_WORKFLOW_EXTENSIONS = frozenset({".yml", ".yaml"})
+
+# shebang 인터프리터 이름 → 언어 (버전 접미사는 제거 후 비교)
+SHEBANG_INTERPRETERS = {
+    "perl": "perl",
+    "python": "python",
+    "node": "javascript",
+    "sh": "shell",
+    "bash": "shell",
+}
+
+# 템플릿 구분자 패턴 → 템플릿 언어 (HTML 파일 안의 템플릿 문법 감지용, 순서대로 확인)
+TEMPLATE_DELIMITER_PATTERNS = (
+    (re.compile(r"<%(?!%)"), "erb"),
+    (re.compile(r"\{%-?\s*\w+|\{#.*?#\}"), "jinja"),
+    (
+        re.compile(r"\{\{-?\s*(?:\.|/\*|(?:define|template|block|range|with|end)\b)"),
+        "gotemplate",
+    ),
+)
+
+# 내용 분류기에 전달하는 파일 앞부분의 최대 바이트 수
+CONTENT_SNIFF_BYTES = 4096
+
+# 내용 분류기가 판단하지 않고 다음 감지 단계로 넘길 때 반환하는 값
+UNKNOWN_LANGUAGE = "unknown"
+
+# 파일 앞부분 바이트를 받아 언어 이름(또는 UNKNOWN_LANGUAGE)을 반환하는 분류기
+ContentClassifier = Callable[[bytes], str]
+
+_content_classifiers: list[ContentClassifier] = []
+
+_SHEBANG_PATTERN = re.compile(r"#!\s*(\S+)(?:\s+(\S+))?")
+_INTERPRETER_PATTERN = re.compile(r"[a-z]+")
+
 
 def detect_language_from_filename(filename: str) -> str:
     """파일 확장자를 기반으로 언어를 감지합니다.
 
+    `Dockerfile`, Bazel `BUILD`처럼 확장자 없이 이름으로 알 수 있는 파일은
+    이름으로 감지하고, `.github/workflows/` 바로 아래의 YAML 파일은 GitHub
+    Actions 워크플로로 감지합니다.
+
     Args:
         filename: 언어를 감지할 파일의 이름입니다.
 
     Returns:
         감지된 언어를 나타내는 문자열입니다. 알려지지 않은 확장자의 경우 'text'를 반환합니다.
     """
+    basename = os.path.basename(filename)
+    if basename in BAZEL_FILENAMES:
+        return "starlark"
+    if basename.lower() in SUPPORTED_FILENAMES:
+        return SUPPORTED_FILENAMES[basename.lower()]
     _, ext = os.path.splitext(filename)
+    directory = os.path.dirname(filename.replace("\\", "/"))
+    if ext.lower() in GITHUB_WORKFLOW_EXTENSIONS and (
+        directory == GITHUB_WORKFLOW_DIRECTORY
+        or directory.endswith(f"/{GITHUB_WORKFLOW_DIRECTORY}")
+    ):
+        return "githubactions"
     return SUPPORTED_EXTENSIONS.get(ext.lower(), "text")
+
+
+def detect_language_from_shebang(content: str) -> str | None:
+    """파일 첫 줄의 shebang(`#!`)에서 인터프리터 언어를 감지합니다.
+
+    `#!/usr/bin/perl -w`, `#!/usr/bin/env perl`, `#!/usr/bin/python3.11`처럼
+    경로, env 경유, 버전 접미사가 붙은 형태를 모두 인식합니다.
+
+    Args:
+        content: 파일 내용
+
+    Returns:
+        감지된 언어 (shebang이 없거나 알려지지 않은 인터프리터면 None)
+    """
+    first_line = content.split("\n", 1)[0]
+    match = _SHEBANG_PATTERN.match(first_line)
+    if match is None:
+        return None
+
+    command, argument = match.groups()
+    interpreter = os.path.basename(command)
+    if interpreter == "env" and argument is not None:
+        interpreter = argument
+    name = _INTERPRETER_PATTERN.match(interpreter)
+    if name is None:
+        return None
+    return SHEBANG_INTERPRETERS.get(name.group(0))
+
+
+def detect_template_language(content: str) -> str | None:
+    """파일 내용의 템플릿 구분자로 템플릿 언어를 감지합니다.
+
+    `.html`처럼 확장자만으로는 템플릿인지 알 수 없는 파일에서 ERB(`<% %>`),
+    Jinja(`{% %}`, `{# #}`), Go 템플릿(`{{define}}`, `{{.Field}}` 등)의
+    구분자를 순서대로 확인합니다. `{{ name }}`처럼 여러 템플릿 엔진이 함께
+    쓰는 표현식만 있는 파일은 감지하지 않습니다.
+
+    Args:
+        content: 파일 내용
+
+    Returns:
+        감지된 템플릿 언어 (템플릿 구분자가 없으면 None)
+    """
+    for pattern, language in TEMPLATE_DELIMITER_PATTERNS:
+        if pattern.search(content):
+            return language
+    return None
+
+
+def register_content_classifier(classifier: ContentClassifier) -> None:
+    """확장자로 언어를 알 수 없는 파일에 적용할 내용 분류기를 등록합니다.
+
+    확장자 없는 파일이나 여러 언어로 해석되는 스크립트처럼 확장자와 shebang만으로
+    판단하기 어려운 파일에 저장소별 규칙을 적용할 때 사용합니다. 등록된 순서대로
+    호출되며, 처음으로 UNKNOWN_LANGUAGE가 아닌 값을 반환한 분류기의 결과를
+    사용합니다.
+
+    Args:
+        classifier: 파일 앞부분(최대 CONTENT_SNIFF_BYTES 바이트)을 받아 언어 이름
+            또는 UNKNOWN_LANGUAGE를 반환하는 함수
+    """
+    _content_classifiers.append(classifier)
+
+
+def unregister_content_classifier(classifier: ContentClassifier) -> None:
+    """등록된 내용 분류기를 제거합니다 (등록되지 않았으면 무시).
+
+    Args:
+        classifier: 제거할 분류기
+    """
+    if classifier in _content_classifiers:
+        _content_classifiers.remove(classifier)
+
+
+def detect_language_from_content(head: bytes) -> str | None:
+    """등록된 내용 분류기로 파일 앞부분에서 언어를 감지합니다.
+
+    Args:
+        head: 파일 앞부분 바이트 (CONTENT_SNIFF_BYTES를 넘는 부분은 잘라서 전달)
+
+    Returns:
+        감지된 언어 (분류기가 없거나 모두 UNKNOWN_LANGUAGE를 반환하면 None)
+    """
+    head = head[:CONTENT_SNIFF_BYTES]
+    for classifier in list(_content_classifiers):
+        language = classifier(head)
+        if language and language != UNKNOWN_LANGUAGE:
+            return language
+    return None
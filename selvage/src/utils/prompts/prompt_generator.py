@@ -4,10 +4,19 @@ import importlib.resources
 
 from selvage.src.config import get_default_language
 from selvage.src.context_extractor.context_extractor import ContextExtractor
+from selvage.src.context_extractor.document_context_extractor import (
+    DocumentContextExtractor,
+)
 from selvage.src.context_extractor.fallback_context_extractor import (
     FallbackContextExtractor,
 )
-from selvage.src.exceptions import UnsupportedLanguageError
+from selvage.src.context_extractor.svelte_context_extractor import (
+    SvelteContextExtractor,
+)
+from selvage.src.context_extractor.template_context_extractor import (
+    TemplateContextExtractor,
+)
+from selvage.src.exceptions import ParseTimeoutError, UnsupportedLanguageError
 from selvage.src.utils.base_console import console
 from selvage.src.utils.file_utils import is_ignore_file
 from selvage.src.utils.smart_context_utils import SmartContextUtils
@@ -95,22 +104,49 @@ class PromptGenerator:
                 # 바이너리 파일은 처리하지 않고 건너뜁니다
                 continue
 
+            if file.is_generated:
+                # .gitattributes에서 linguist-generated로 표시된 파일은 건너뜁니다
+                console.log_info(
+                    f"linguist-generated 파일을 리뷰 대상에서 제외합니다: {file.filename}"
+                )
+                continue
+
             try:
                 # 파일 컨텍스트 생성
                 if SmartContextUtils.use_smart_context(file):
                     try:
-                        contexts = ContextExtractor(file.language).extract_contexts(
-                            file.file_content, [hunk.change_line for hunk in file.hunks]
+                        extractor: (
+                            ContextExtractor
+                            | TemplateContextExtractor
+                            | DocumentContextExtractor
+                            | SvelteContextExtractor
+                        )
+                        if TemplateContextExtractor.is_supported(file.language):
+                            extractor = TemplateContextExtractor(file.language)
+                        elif SvelteContextExtractor.is_supported(file.language):
+                            extractor = SvelteContextExtractor(file.language)
+                        elif DocumentContextExtractor.is_supported(file.language):
+                            extractor = DocumentContextExtractor(file.language)
+                        else:
+                            extractor = ContextExtractor(file.language)
+                        contexts = extractor.extract_contexts(
+                            file.file_content, file.get_change_ranges()
                         )
                         file_context = FileContextInfo.create_smart_context(contexts)
                     except Exception as e:
-                        if not isinstance(e, UnsupportedLanguageError):
+                        if isinstance(e, ParseTimeoutError):
+                            # 파싱 시간 초과 파일은 진단 메시지로 보고하고 계속 진행
+                            console.warning(
+                                f"{file.filename}: 파싱 시간 초과"
+                                f"({e.timeout_seconds:g}초), fall back 사용"
+                            )
+                        elif not isinstance(e, UnsupportedLanguageError):
                             # UnsupportedLanguageError가 아닌 다른 예외일 때만 경고
                             console.warning(f"컨텍스트 추출 실패, fall back 사용: {e}")
 
                         # 모든 예외에 대해 공통적으로 fall back 로직을 실행합니다.
                         contexts = FallbackContextExtractor().extract_contexts(
-                            file.file_content, [hunk.change_line for hunk in file.hunks]
+                            file.file_content, file.get_change_ranges()
                         )
                         file_context = FileContextInfo.create_fallback_context(contexts)
                 elif not file.file_content:
@@ -78,6 +78,17 @@ def _detect_language_from_filename(filename: str) -> str:
     if not filename:
         return "text"
 
+    # 확장자 없이 파일 이름으로 알 수 있는 파일
+    filename_map = {
+        "dockerfile": "docker",
+        "cmakelists.txt": "cmake",
+        "makefile": "make",
+        "gnumakefile": "make",
+    }
+    name = Path(filename).name.lower()
+    if name in filename_map:
+        return filename_map[name]
+
     ext = Path(filename).suffix.lower()
     language_map = {
         ".py": "python",
@@ -109,8 +120,51 @@ def _detect_language_from_filename(filename: str) -> str:
         ".yml": "yaml",
         ".toml": "toml",
         ".md": "markdown",
+        ".rst": "rst",
         ".sh": "bash",
         ".bash": "bash",
+        ".pl": "perl",
+        ".pm": "perl",
+        ".r": "r",
+        ".nim": "nim",
+        ".nims": "nim",
+        ".clj": "clojure",
+        ".cljs": "clojure",
+        ".cljc": "clojure",
+        ".edn": "clojure",
+        ".f90": "fortran",
+        ".f95": "fortran",
+        ".f03": "fortran",
+        ".f08": "fortran",
+        ".f": "fortranfixed",
+        ".for": "fortranfixed",
+        ".f77": "fortranfixed",
+        ".sol": "solidity",
+        ".v": "verilog",
+        ".sv": "systemverilog",
+        ".jl": "julia",
+        ".cmake": "cmake",
+        ".mk": "make",
+        ".erl": "erlang",
+        ".hrl": "erlang",
+        ".bzl": "starlark",
+        ".bazel": "starlark",
+        ".tcl": "tcl",
+        ".pas": "delphi",
+        ".dpr": "delphi",
+        ".hx": "haxe",
+        ".gleam": "gleam",
+        ".s": "gas",
+        ".asm": "nasm",
+        ".dockerfile": "docker",
+        ".tmpl": "html",
+        ".gotmpl": "html",
+        ".gohtml": "html",
+        ".j2": "html+jinja",
+        ".jinja": "html+jinja",
+        ".jinja2": "html+jinja",
+        ".erb": "rhtml",
+        ".svelte": "html",
         ".zsh": "zsh",
         ".fish": "fish",
     }
@@ -0,0 +1,121 @@
+"""`.selvageignore`의 제외 패턴을 해석하는 모듈."""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+from pathlib import Path, PurePosixPath
+
+from selvage.src.utils.git_attributes import translate_glob_pattern
+
+IGNORE_FILE_NAME = ".selvageignore"
+
+
+@dataclass(frozen=True)
+class _IgnoreRule:
+    """`.selvageignore`의 한 줄(패턴과 부정/디렉토리 전용 여부)."""
+
+    pattern: re.Pattern[str]
+    match_basename: bool
+    negated: bool
+    directory_only: bool
+
+    def matches(self, relative_path: str, is_dir: bool) -> bool:
+        """규칙이 정의된 디렉토리 기준 상대 경로가 패턴과 일치하는지 확인합니다."""
+        if self.directory_only and not is_dir:
+            return False
+        if self.match_basename:
+            relative_path = relative_path.rsplit("/", 1)[-1]
+        return self.pattern.fullmatch(relative_path) is not None
+
+
+class SelvageIgnore:
+    """디렉토리별 `.selvageignore` 파일을 gitignore 규칙에 따라 해석합니다.
+
+    루트부터 깊은 디렉토리 순으로 규칙을 적용하며 나중에 일치한 규칙이
+    우선합니다. `!pattern`은 제외를 취소하고, `/`로 끝나는 패턴은 디렉토리에만
+    일치합니다. 제외된 디렉토리 아래의 경로는 모두 제외됩니다. 파일은 필요할
+    때 디렉토리별로 한 번만 읽습니다.
+    """
+
+    def __init__(self, root_path: str | Path) -> None:
+        """SelvageIgnore 인스턴스를 초기화합니다.
+
+        Args:
+            root_path: 패턴을 해석할 루트 디렉토리 경로
+        """
+        self.root_path = Path(root_path)
+        self._rules_cache: dict[str, list[_IgnoreRule]] = {}
+
+    def is_ignored(self, relative_path: str, is_dir: bool = False) -> bool:
+        """경로가 `.selvageignore` 규칙으로 제외되는지 확인합니다.
+
+        Args:
+            relative_path: 루트 기준 경로 (`/` 구분)
+            is_dir: 경로가 디렉토리인지 여부
+
+        Returns:
+            제외 대상이면 True
+        """
+        path = PurePosixPath(relative_path.lstrip("/"))
+        for depth in range(1, len(path.parts)):
+            if self._matches(PurePosixPath(*path.parts[:depth]), is_dir=True):
+                return True
+        return self._matches(path, is_dir)
+
+    def _matches(self, path: PurePosixPath, is_dir: bool) -> bool:
+        """상위 디렉토리를 고려하지 않고 경로 자체가 제외되는지 확인합니다."""
+        ignored = False
+        for depth in range(len(path.parts)):
+            directory = PurePosixPath(*path.parts[:depth])
+            relative_path = path.relative_to(directory).as_posix()
+            for rule in self._rules_in(directory):
+                if rule.matches(relative_path, is_dir):
+                    ignored = not rule.negated
+        return ignored
+
+    def _rules_in(self, directory: PurePosixPath) -> list[_IgnoreRule]:
+        """디렉토리의 제외 파일을 읽어 규칙 목록을 반환합니다 (없으면 빈 목록)."""
+        key = (directory / IGNORE_FILE_NAME).as_posix()
+        if key not in self._rules_cache:
+            try:
+                content = (self.root_path / key).read_text(encoding="utf-8")
+            except (OSError, UnicodeDecodeError):
+                content = ""
+            self._rules_cache[key] = parse_ignore_patterns(content)
+        return self._rules_cache[key]
+
+
+def parse_ignore_patterns(content: str) -> list[_IgnoreRule]:
+    """`.selvageignore` 파일 내용을 규칙 목록으로 파싱합니다.
+
+    빈 줄과 `#`으로 시작하는 주석은 무시합니다.
+
+    Args:
+        content: `.selvageignore` 파일 내용
+
+    Returns:
+        파일에 나타난 순서대로의 규칙 목록
+    """
+    rules: list[_IgnoreRule] = []
+    for line in content.splitlines():
+        pattern = line.strip()
+        if not pattern or pattern.startswith("#"):
+            continue
+
+        negated = pattern.startswith("!")
+        if negated:
+            pattern = pattern[1:]
+        directory_only = pattern.endswith("/")
+        pattern = pattern.rstrip("/")
+        if not pattern:
+            continue
+        rules.append(
+            _IgnoreRule(
+                pattern=re.compile(translate_glob_pattern(pattern.lstrip("/"))),
+                match_basename="/" not in pattern,
+                negated=negated,
+                directory_only=directory_only,
+            )
+        )
+    return rules
@@ -0,0 +1,27 @@
+# checksum.s: byte checksum helpers
+	.section .rodata
+greeting:
+	.asciz "hello"
+
+	.text
+# Sum every byte in the buffer.
+# rdi = buffer, rsi = length
+	.globl checksum
+	.type checksum, @function
+checksum:
+	xorl %eax, %eax
+	testq %rsi, %rsi
+	je .Ldone
+.Lloop:
+	movzbl (%rdi), %ecx
+	addl %ecx, %eax
+	incq %rdi
+	decq %rsi
+	jne .Lloop
+.Ldone:
+	ret
+
+	.globl checksum_reset
+checksum_reset:
+	xorl %eax, %eax
+	ret
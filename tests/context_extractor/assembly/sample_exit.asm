@@ -0,0 +1,17 @@
+section .data
+message: db "bye", 10
+
+section .text
+global _start
+_start:
+    mov rdi, message
+    call print
+.exit:
+    mov eax, 60
+    xor edi, edi
+    syscall
+
+; Write a newline-terminated string.
+print:
+    mov rax, 1
+    ret
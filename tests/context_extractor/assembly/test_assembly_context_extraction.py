@@ -0,0 +1,156 @@
+"""ContextExtractor Assembly 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "assembly"
+SAMPLE_FILE = "sample_checksum.s"
+SECTION_REASON = "enclosing-section"
+
+
+@pytest.fixture
+def intel_file_content() -> str:
+    """Intel(NASM) 문법 샘플 파일 내용을 반환합니다."""
+    return (Path(__file__).parent / "sample_exit.asm").read_text(encoding="utf-8")
+
+
+class TestAttSyntaxExtraction:
+    """AT&T(GAS) 문법 레이블 블록 추출 테스트."""
+
+    def test_label_block_with_section(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """명령어 변경 시 섹션 지시어와 전역 레이블 블록을 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(17, 17)])
+
+        assert (blocks[0].text, blocks[0].reason) == (".text", SECTION_REASON)
+        assert blocks[0].line_range == LineRange(6, 6)
+        assert blocks[1].name == "checksum"
+        assert blocks[1].line_range == LineRange(9, 22)
+        assert blocks[1].scope_path == (".text",)
+        assert blocks[1].changed_lines == (17,)
+
+    def test_local_labels_do_not_split_block(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`.L` 지역 레이블 뒤의 변경도 감싸는 전역 레이블 블록을 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(22, 22)])
+
+        assert [block.name for block in blocks] == [".text", "checksum"]
+        assert blocks[1].text.endswith(".Ldone:\n\tret")
+
+    def test_declaration_directives_belong_to_label(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """레이블 위의 `.globl` 지시어가 이전 블록이 아닌 레이블 블록에 속하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(26, 26)])
+
+        assert blocks[1].name == "checksum_reset"
+        assert blocks[1].line_range == LineRange(24, 27)
+        assert blocks[1].text.startswith("\t.globl checksum_reset\nchecksum_reset:")
+
+    def test_named_section_directive(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`.section .rodata` 지시어의 섹션 이름이 기록되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(4, 4)])
+
+        assert [(block.text, block.name) for block in blocks] == [
+            (".section .rodata", ".rodata"),
+            ('greeting:\n\t.asciz "hello"', "greeting"),
+        ]
+        assert blocks[1].scope_path == (".rodata",)
+
+    def test_comment_not_attached_by_default(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """주석 연결 옵션이 꺼져 있으면 레이블 위 주석을 포함하지 않는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(17, 17)])
+
+        assert blocks[1].doc_comment is None
+        assert blocks[1].text.startswith("\t.globl checksum")
+
+    def test_comment_attached_with_option(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """주석 연결 옵션이 켜지면 레이블 위 주석을 함께 포함하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(17, 17)],
+            options=ExtractionOptions(include_comments=True),
+        )
+
+        assert blocks[1].line_range == LineRange(7, 22)
+        assert blocks[1].doc_comment == (
+            "# Sum every byte in the buffer.\n# rdi = buffer, rsi = length"
+        )
+
+    def test_changed_comment_is_included(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """레이블 위 주석이 변경되면 옵션 없이도 주석부터 포함하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(8, 12)])
+
+        assert [block.name for block in blocks] == [".text", "checksum"]
+        assert blocks[1].line_range == LineRange(7, 22)
+        assert blocks[1].doc_comment is None
+
+
+class TestIntelSyntaxExtraction:
+    """Intel(NASM) 문법 레이블 블록 추출 테스트."""
+
+    def test_label_block_with_section(
+        self, intel_file_content: str, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """`section .text` 지시어와 `.exit` 지역 레이블을 포함한 블록을 반환하는지 테스트."""
+        blocks = extract_blocks(intel_file_content, [LineRange(11, 11)])
+
+        assert (blocks[0].text, blocks[0].reason) == ("section .text", SECTION_REASON)
+        assert blocks[1].name == "_start"
+        assert blocks[1].line_range == LineRange(5, 12)
+        assert blocks[1].text.startswith("global _start\n_start:")
+
+    def test_data_label(
+        self, intel_file_content: str, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """데이터 섹션의 레이블 변경 시 `.data` 섹션과 레이블을 반환하는지 테스트."""
+        blocks = extract_blocks(intel_file_content, [LineRange(2, 2)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            (".data", LineRange(1, 1)),
+            ("message", LineRange(2, 2)),
+        ]
+
+    def test_comment_attached_with_option(
+        self, intel_file_content: str, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """`;` 주석이 옵션에 따라 레이블 블록에 연결되는지 테스트."""
+        blocks = extract_blocks(
+            intel_file_content,
+            [LineRange(16, 16)],
+            options=ExtractionOptions(include_comments=True),
+        )
+
+        assert blocks[1].name == "print"
+        assert blocks[1].line_range == LineRange(14, 17)
+        assert blocks[1].doc_comment == "; Write a newline-terminated string."
@@ -0,0 +1,42 @@
+(ns sample.inventory
+  (:require [clojure.string :as str]
+            [clojure.set :as set]))
+
+(def ^:const max-items 16)
+
+(defonce ^:private registry (atom {}))
+
+(defn restock
+  "재고 수량을 늘린다."
+  [inventory item amount]
+  (let [current (get inventory item 0)
+        total (+ current amount)]
+    (when (> total max-items)
+      (throw (ex-info "too many" {:item item})))
+    (assoc inventory item total)))
+
+(defn- summarize [inventory]
+  (->> inventory
+       (map (fn [[item qty]]
+              (str (name item) "=" qty)))
+       (str/join ", ")))
+
+(defmacro with-registry [& body]
+  `(binding [*registry* @registry]
+     ~@body))
+
+(defmulti area :shape)
+
+(defmethod area :circle [{:keys [radius]}]
+  (* Math/PI radius radius))
+
+#_(defn unused [] nil)
+
+(comment
+  (restock {} :apple 3)
+  (summarize {:apple 3}))
+
+(def handlers
+  {:count #(count %)
+   :tags #{:fresh :sale}
+   :pattern #"\d+"})
@@ -0,0 +1,115 @@
+"""ContextExtractor Clojure 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "clojure"
+SAMPLE_FILE = "sample_inventory.clj"
+
+
+class TestClojureFormExtraction:
+    """Clojure 정의 form 추출 테스트."""
+
+    def test_defn_with_namespace(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """defn 안의 변경 시 form 전체와 ns form이 함께 반환되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(16, 16)])
+
+        assert blocks[0].is_dependency
+        assert blocks[0].line_range == LineRange(1, 3)
+        assert blocks[0].text.startswith("(ns sample.inventory")
+        assert [(block.name, block.line_range) for block in blocks[1:]] == [
+            ("restock", LineRange(9, 16))
+        ]
+
+    def test_let_binding_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """let 바인딩 안의 변경은 감싸는 defn 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(13, 13)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["restock"]
+        assert blocks[0].text.startswith("(defn restock\n")
+
+    def test_anonymous_fn_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """스레딩 매크로 안 fn 본문의 변경은 감싸는 defn-을 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(21, 21)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("summarize", LineRange(18, 22))
+        ]
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (5, "max-items", LineRange(5, 5)),
+            (7, "registry", LineRange(7, 7)),
+            (25, "with-registry", LineRange(24, 26)),
+            (28, "area", LineRange(28, 28)),
+            (31, "area :circle", LineRange(30, 31)),
+        ],
+    )
+    def test_definition_forms_with_metadata(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메타데이터가 붙은 def와 defmacro/defmulti/defmethod 이름 계산 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            (expected_name, expected_range)
+        ]
+
+    def test_reader_macros_do_not_break_parsing(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`#(...)`, `#{}`, 정규식 리터럴이 있는 def도 form 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(41, 41)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("handlers", LineRange(39, 42))
+        ]
+
+    def test_top_level_form_outside_definition(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """정의 밖 `(comment ...)` form 안의 변경은 최상위 form을 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(36, 36)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            (None, LineRange(35, 37))
+        ]
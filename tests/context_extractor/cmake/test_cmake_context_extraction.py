@@ -0,0 +1,46 @@
+"""ContextExtractor CMake 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "cmake"
+SAMPLE_FILE = "CMakeLists.txt"
+
+
+class TestCMakeScopeExtraction:
+    """CMake 블록/명령 추출 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_type", "expected_name", "expected_range"),
+        [
+            (11, "function_def", "add_geometry_test", LineRange(9, 13)),
+            (10, "function_def", "add_geometry_test", LineRange(9, 13)),
+            (16, "macro_def", "enable_warnings", LineRange(15, 17)),
+            (20, "normal_command", "geometry", LineRange(19, 21)),
+            (5, "normal_command", "GEOMETRY_SOURCES", LineRange(4, 7)),
+            (24, "if_condition", None, LineRange(23, 27)),
+            (26, "normal_command", "geometry_bench", LineRange(26, 26)),
+        ],
+    )
+    def test_enclosing_scope(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_type: str,
+        expected_name: str | None,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수/매크로, 타깃 정의, 변수 대입, if 블록 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(changed_line, changed_line)]
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [(expected_type, expected_name, expected_range)]
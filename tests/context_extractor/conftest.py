@@ -0,0 +1,75 @@
+"""언어별 ContextExtractor 테스트가 공유하는 pytest 픽스처.
+
+언어별 테스트 모듈은 모듈 상수로 대상 언어와 샘플 파일을 선언하고,
+아래 픽스처로 샘플 내용을 읽고 추출 결과를 얻는다.
+
+    LANGUAGE = "julia"
+    SAMPLE_FILE = "sample_inventory.jl"
+
+여러 언어를 다루는 모듈은 테스트 클래스 속성으로 같은 이름의 상수를 선언해
+모듈 상수를 덮어쓸 수 있다.
+"""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+
+def _test_constant(request: pytest.FixtureRequest, name: str) -> str:
+    """테스트 클래스 속성 또는 테스트 모듈 상수 값을 반환합니다."""
+    value = getattr(request.cls, name, None)
+    if value is None:
+        value = getattr(request.module, name)
+    return value
+
+
+@pytest.fixture
+def sample_file_content(request: pytest.FixtureRequest) -> str:
+    """테스트 모듈의 SAMPLE_FILE 내용을 반환합니다."""
+    sample_file = _test_constant(request, "SAMPLE_FILE")
+    file_path = Path(request.module.__file__).parent / sample_file
+    return file_path.read_text(encoding="utf-8")
+
+
+@pytest.fixture
+def extract_blocks(
+    request: pytest.FixtureRequest,
+) -> Callable[..., list[ContextBlock]]:
+    """테스트 모듈의 LANGUAGE로 블록을 추출하는 함수를 반환합니다.
+
+    반환된 함수는 (file_content, changed_ranges)를 받아 추출 결과 블록들을
+    라인 순으로 반환하며, include_dependencies=False이면 의존성 블록을
+    제외한다. options로 추출 옵션을 지정할 수 있다.
+    """
+    language = _test_constant(request, "LANGUAGE")
+
+    def extract(
+        file_content: str,
+        changed_ranges: list[LineRange],
+        *,
+        include_dependencies: bool = True,
+        options: ExtractionOptions | None = None,
+    ) -> list[ContextBlock]:
+        blocks = ContextExtractor(language, options).extract_context_blocks(
+            file_content, changed_ranges
+        )
+        return sorted(
+            (
+                block
+                for block in blocks
+                if include_dependencies or not block.is_dependency
+            ),
+            key=lambda block: block.line_range.start_line,
+        )
+
+    return extract
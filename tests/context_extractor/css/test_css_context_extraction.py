@@ -0,0 +1,142 @@
+"""ContextExtractor CSS/SCSS 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+from selvage.src.context_extractor.css_selector_path_resolver import (
+    CssSelectorPathResolver,
+)
+
+LANGUAGE = "scss"
+SAMPLE_FILE = "sample_components.scss"
+CSS_SOURCE = """:root {
+  --brand-color: #0055ff;
+  --spacing: 8px;
+}
+
+.button {
+  color: var(--brand-color);
+}
+"""
+
+
+def _css_blocks(changed_ranges: list[LineRange]) -> list[ContextBlock]:
+    """CSS_SOURCE 추출 결과에서 컨텍스트 블록(의존성 제외)만 반환한다."""
+    blocks = ContextExtractor("css").extract_context_blocks(CSS_SOURCE, changed_ranges)
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestScssRuleExtraction:
+    """SCSS 규칙 블록 추출 테스트."""
+
+    def test_nested_rule_has_full_selector_path(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 규칙 변경 시 안쪽 규칙과 전체 선택자 경로 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(10, 10)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == ".card .title"
+        assert blocks[0].line_range == LineRange(9, 12)
+
+    def test_parent_selector_reference(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`&` 선택자가 바깥 선택자로 치환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(16, 16)], include_dependencies=False
+        )
+
+        assert blocks[0].name == ".card:hover, .card.is-active"
+        assert blocks[0].line_range == LineRange(14, 17)
+
+    def test_variable_declaration(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """SCSS 변수 변경 시 변수 선언만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(3, 3)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "$primary-color"
+        assert blocks[0].text.startswith("$primary-color: #0055ff")
+
+    def test_media_query_is_enclosing_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """@media 안의 규칙 변경 시 미디어 쿼리 헤더를 스코프로 포함하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(22, 22)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == [
+            "@media (max-width: 600px)",
+            ".card",
+        ]
+        assert blocks[0].reason == CssSelectorPathResolver.CONTAINER_REASON
+        assert blocks[0].text == "@media (max-width: 600px)"
+        assert blocks[1].line_range == LineRange(21, 23)
+
+    def test_use_statement_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """@use 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(10, 10)])
+
+        assert blocks[0].is_dependency
+        assert '@use "tokens"' in blocks[0].text
+
+
+class TestCssRuleExtraction:
+    """CSS 규칙 블록 추출 테스트."""
+
+    def test_changed_declaration_returns_rule(self) -> None:
+        """일반 속성 변경 시 감싸는 규칙 반환 테스트."""
+        blocks = _css_blocks([LineRange(7, 7)])
+
+        assert len(blocks) == 1
+        assert blocks[0].name == ".button"
+        assert blocks[0].line_range == LineRange(6, 8)
+
+    def test_custom_property_returns_declaration(self) -> None:
+        """사용자 정의 속성(--foo) 변경 시 선언만 반환하는지 테스트."""
+        blocks = _css_blocks([LineRange(2, 2)])
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "--brand-color"
+        assert blocks[0].line_range == LineRange(2, 2)
+
+
+class TestSelectorCombination:
+    """선택자 결합 규칙 테스트."""
+
+    def test_split_keeps_commas_inside_parentheses(self) -> None:
+        """괄호 안의 쉼표로 선택자를 나누지 않는지 테스트."""
+        selectors = CssSelectorPathResolver._split_selectors(
+            "a:is(.x, .y),\n  b > c"
+        )
+
+        assert selectors == ["a:is(.x, .y)", "b > c"]
+
+    def test_combine_with_and_without_parent_reference(self) -> None:
+        """`&` 유무에 따른 선택자 결합 테스트."""
+        combined = CssSelectorPathResolver._combine(
+            [".nav", ".menu"], ["&-item", "a"]
+        )
+
+        assert combined == [".nav-item", ".nav a", ".menu-item", ".menu a"]
@@ -0,0 +1,114 @@
+"""ContextExtractor Dockerfile 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "dockerfile"
+SAMPLE_FILE = "Dockerfile"
+STAGE_REASON = "enclosing-stage"
+
+
+class TestDockerfileStageExtraction:
+    """Dockerfile 스테이지 단위 추출 테스트."""
+
+    def test_small_stage_is_returned_whole(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """제한 이내의 스테이지는 FROM부터 스테이지 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(7, 7)])
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(4, 11)
+        assert blocks[0].block_type == "run_instruction"
+        assert blocks[0].name == "build"
+        assert blocks[0].scope_path == ("build",)
+        assert blocks[0].changed_lines == (7,)
+
+    def test_nearby_instructions_with_stage_header(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """제한을 넘는 스테이지는 주변 명령어와 스테이지 헤더를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(7, 7)],
+            options=ExtractionOptions(max_top_level_statement_lines=3),
+        )
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(4, 4), STAGE_REASON),
+            (LineRange(6, 8), None),
+        ]
+        assert blocks[0].text == "FROM golang:${GO_VERSION} AS build"
+        assert blocks[1].text == "COPY go.mod go.sum ./\nRUN go mod download\nCOPY . ."
+
+    def test_change_maps_to_later_stage(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """두 번째 스테이지의 변경이 해당 스테이지 헤더와 함께 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(16, 16)],
+            options=ExtractionOptions(max_top_level_statement_lines=0),
+        )
+
+        assert [(block.text, block.name) for block in blocks] == [
+            ("FROM alpine:3.19", "1"),
+            ("USER nobody", "1"),
+        ]
+        assert blocks[1].block_type == "user_instruction"
+
+    def test_multiline_instruction(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """줄 이어쓰기 라인의 변경은 명령어 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(10, 10)],
+            options=ExtractionOptions(max_top_level_statement_lines=0),
+        )
+
+        assert blocks[1].line_range == LineRange(9, 10)
+        assert blocks[1].block_type == "run_instruction"
+
+    def test_changes_in_multiple_stages(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """여러 스테이지의 변경이 각 스테이지로 나뉘어 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(11, 11), LineRange(15, 15)],
+            options=ExtractionOptions(max_top_level_statement_lines=0),
+        )
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(4, 4), "build"),
+            (LineRange(11, 11), "build"),
+            (LineRange(13, 13), "1"),
+            (LineRange(15, 15), "1"),
+        ]
+
+    def test_global_instruction_before_first_stage(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """첫 FROM 앞의 전역 ARG는 스테이지 없이 반환되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(2, 2)])
+
+        assert [(block.text, block.name) for block in blocks] == [
+            ("ARG GO_VERSION=1.22", None)
+        ]
+        assert blocks[0].scope_path == ()
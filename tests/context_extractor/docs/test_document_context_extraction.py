@@ -0,0 +1,146 @@
+"""DocumentContextExtractor(AsciiDoc/reStructuredText) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    DocumentContextExtractor,
+    LineRange,
+)
+from selvage.src.exceptions import UnsupportedLanguageError
+
+TITLE = "Selvage 사용 안내"
+
+
+def _read(file_name: str) -> str:
+    """문서 fixture 파일 내용을 반환한다."""
+    return (Path(__file__).parent / file_name).read_text(encoding="utf-8")
+
+
+def _context_blocks(
+    language: str, file_name: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """의존성 블록을 제외한 추출 블록들을 반환한다."""
+    blocks = DocumentContextExtractor(language).extract_context_blocks(
+        _read(file_name), changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+def _summary(
+    blocks: list[ContextBlock],
+) -> list[tuple[LineRange, str | None, str | None, tuple[str, ...]]]:
+    return [
+        (block.line_range, block.block_type, block.name, block.scope_path)
+        for block in blocks
+    ]
+
+
+class TestRestructuredTextExtraction:
+    """reStructuredText 섹션/지시자 추출 테스트."""
+
+    def test_prose_change_returns_enclosing_section(self) -> None:
+        """본문 변경은 하위 섹션을 제외한 섹션과 상위 제목 경로를 반환하는지 테스트."""
+        blocks = _context_blocks("rst", "guide.rst", [LineRange(12, 12)])
+
+        assert _summary(blocks) == [(LineRange(9, 17), "section", "설치", (TITLE,))]
+        assert blocks[0].text.startswith("설치\n====")
+        assert "설정 파일" not in blocks[0].text
+
+    def test_heading_levels_follow_adornment_order(self) -> None:
+        """밑줄 장식이 처음 나온 순서로 레벨이 정해지는지 테스트."""
+        blocks = _context_blocks(
+            "rst", "guide.rst", [LineRange(5, 5), LineRange(22, 22), LineRange(37, 37)]
+        )
+
+        assert _summary(blocks) == [
+            (LineRange(1, 7), "section", TITLE, ()),
+            (LineRange(22, 25), "code", "literal", (TITLE, "설치", "설정 파일")),
+            (LineRange(34, 37), "section", "사용법", (TITLE,)),
+        ]
+
+    def test_admonition_containing_change(self) -> None:
+        """`.. note::` 본문의 변경은 지시자 전체를 반환하는지 테스트."""
+        blocks = _context_blocks("rst", "guide.rst", [LineRange(17, 17)])
+
+        assert _summary(blocks) == [
+            (LineRange(14, 17), "admonition", "note", (TITLE, "설치"))
+        ]
+        assert blocks[0].changed_lines == (17,)
+
+    def test_code_block_uses_host_extractor(self) -> None:
+        """`.. code-block:: python` 안의 변경은 Python 함수 블록을 원문 들여쓰기로 반환하는지 테스트."""
+        blocks = _context_blocks("rst", "guide.rst", [LineRange(31, 31)])
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(30, 32), "load_config")
+        ]
+        assert blocks[0].text.startswith("   def load_config(path):")
+        assert blocks[0].changed_lines == (31,)
+
+    def test_include_is_dependency(self) -> None:
+        """`.. include::` 지시자가 의존성 블록으로 수집되는지 테스트."""
+        blocks = DocumentContextExtractor("rst").extract_context_blocks(
+            _read("guide.rst"), [LineRange(12, 12)]
+        )
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text == ".. include:: ../shared/links.rst"
+
+
+class TestAsciiDocExtraction:
+    """AsciiDoc 섹션/블록 추출 테스트."""
+
+    def test_prose_change_returns_enclosing_section(self) -> None:
+        """`==` 제목 계층으로 본문 변경을 감싸는 섹션을 반환하는지 테스트."""
+        blocks = _context_blocks("asciidoc", "guide.adoc", [LineRange(8, 8)])
+
+        assert _summary(blocks) == [(LineRange(6, 11), "section", "설치", (TITLE,))]
+
+    def test_admonition_paragraph_and_block(self) -> None:
+        """`NOTE:` 문단과 `[WARNING]` 구분 블록이 경고문으로 반환되는지 테스트."""
+        blocks = _context_blocks(
+            "asciidoc", "guide.adoc", [LineRange(11, 11), LineRange(24, 24)]
+        )
+
+        assert _summary(blocks) == [
+            (LineRange(10, 11), "admonition", "note", (TITLE, "설치")),
+            (LineRange(22, 25), "admonition", "warning", (TITLE, "설치", "설정 파일")),
+        ]
+
+    def test_title_like_line_inside_block_is_not_a_section(self) -> None:
+        """리터럴 블록 안의 `==` 라인은 섹션을 나누지 않는지 테스트."""
+        blocks = _context_blocks(
+            "asciidoc", "guide.adoc", [LineRange(28, 28), LineRange(33, 33)]
+        )
+
+        assert _summary(blocks) == [
+            (LineRange(27, 29), "code", "literal", (TITLE, "설치", "설정 파일")),
+            (LineRange(31, 33), "section", "사용법", (TITLE,)),
+        ]
+
+    def test_source_block_uses_host_extractor(self) -> None:
+        """`[source,python]` 블록 안의 변경은 Python 함수 블록을 반환하는지 테스트."""
+        blocks = _context_blocks("asciidoc", "guide.adoc", [LineRange(18, 18)])
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(17, 19), "load_config")
+        ]
+
+    def test_include_line_belongs_to_document_title_section(self) -> None:
+        """include 라인의 변경은 의존성 블록과 함께 문서 제목 섹션을 반환하는지 테스트."""
+        blocks = DocumentContextExtractor("asciidoc").extract_context_blocks(
+            _read("guide.adoc"), [LineRange(4, 4)]
+        )
+
+        assert [block.is_dependency for block in blocks] == [True, False]
+        assert _summary(blocks[1:]) == [(LineRange(1, 4), "section", TITLE, ())]
+
+    def test_unsupported_language(self) -> None:
+        """문서 형식이 아닌 언어는 UnsupportedLanguageError인지 테스트."""
+        with pytest.raises(UnsupportedLanguageError):
+            DocumentContextExtractor("markdown")
@@ -0,0 +1,32 @@
+%% 간단한 키-값 캐시 서버
+-module(cache_server).
+-behaviour(gen_server).
+
+-include("cache.hrl").
+
+-export([start_link/0, lookup/1, handle_call/3]).
+
+-record(state, {entries = #{} :: map(), hits = 0 :: integer()}).
+
+start_link() ->
+    gen_server:start_link({local, ?MODULE}, ?MODULE, [], []).
+
+lookup(Key) ->
+    gen_server:call(?MODULE, {lookup, Key}).
+
+handle_call({lookup, Key}, _From, State = #state{entries = Entries}) ->
+    Reply = maps:get(Key, Entries, undefined),
+    {reply, Reply, State#state{hits = State#state.hits + 1}};
+handle_call({store, Key, Value}, _From, State) ->
+    Entries = maps:put(Key, Value, State#state.entries),
+    {reply, ok, State#state{entries = Entries}};
+handle_call(_Request, _From, State) ->
+    {reply, {error, unknown}, State}.
+
+expire(Entries, Now) ->
+    maps:filter(
+        fun(_Key, #entry{expires_at = ExpiresAt}) ->
+            ExpiresAt > Now
+        end,
+        Entries
+    ).
@@ -0,0 +1,92 @@
+"""ContextExtractor Erlang 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+from pathlib import Path
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "erlang"
+SAMPLE_FILE = "cache_server.erl"
+CONTAINER_REASON = "enclosing-declaration"
+
+
+def _read(file_name: str) -> str:
+    """테스트용 Erlang 샘플 파일 내용을 반환한다."""
+    return (Path(__file__).parent / file_name).read_text(encoding="utf-8")
+
+
+class TestErlangFormExtraction:
+    """Erlang 함수 선언과 속성 form 추출 테스트."""
+
+    def test_clause_returns_all_clauses(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """절 하나의 변경 시 같은 이름/arity의 모든 절과 -module 속성이 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(21, 21)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("cache_server", LineRange(2, 2), CONTAINER_REASON),
+            ("handle_call/3", LineRange(17, 24), None),
+        ]
+        assert blocks[0].text == "-module(cache_server)."
+        assert blocks[1].block_type == "fun_decl"
+        assert blocks[1].scope_path == ("cache_server",)
+
+    def test_anonymous_fun_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """익명 함수 안의 변경은 익명 함수만 내부 스코프로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(29, 29)], include_dependencies=False
+        )
+
+        assert blocks[-1].block_type == "anonymous_fun"
+        assert blocks[-1].line_range == LineRange(28, 30)
+        assert blocks[-1].scope_path == ("cache_server", "expire/2")
+
+    def test_record_and_export_attributes(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """-export와 -record 속성 변경이 속성 form 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(7, 9)], include_dependencies=False
+        )
+
+        assert [(block.block_type, block.name) for block in blocks] == [
+            ("module_attribute", "cache_server"),
+            ("export_attribute", None),
+            ("record_decl", "state"),
+        ]
+
+    def test_include_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """-include 속성이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(15, 15)])
+
+        dependency = next(block for block in blocks if block.is_dependency)
+        assert dependency.text == '-include("cache.hrl").'
+
+    def test_header_file_has_no_module_attribute(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """-module 속성이 없는 .hrl 헤더는 컨테이너 헤더 없이 form만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            _read("cache.hrl"), [LineRange(2, 2)], include_dependencies=False
+        )
+
+        assert [(block.block_type, block.name, block.reason) for block in blocks] == [
+            ("record_decl", "entry", None)
+        ]
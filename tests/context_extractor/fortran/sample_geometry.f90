@@ -0,0 +1,43 @@
+module geometry
+  use iso_fortran_env, only: real64
+  implicit none
+
+  real(real64), parameter :: pi = 3.141592653589793_real64
+
+contains
+
+  subroutine scale_points(points, factor)
+    real(real64), intent(inout) :: points(:, :)
+    real(real64), intent(in) :: factor
+    integer :: i
+
+    do i = 1, size(points, 2)
+      points(:, i) = clamp(points(:, i) * factor)
+    end do
+
+  contains
+
+    function clamp(values) result(clamped)
+      real(real64), intent(in) :: values(:)
+      real(real64) :: clamped(size(values))
+
+      clamped = min(max(values, -1.0e6_real64), 1.0e6_real64)
+    end function clamp
+
+  end subroutine scale_points
+
+  function circle_area(radius) result(area)
+    real(real64), intent(in) :: radius
+    real(real64) :: area
+
+    area = pi * radius**2
+  end function circle_area
+
+end module geometry
+
+program main
+  use geometry
+  implicit none
+
+  print *, circle_area(2.0_real64)
+end program main
@@ -0,0 +1,102 @@
+"""ContextExtractor Fortran 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+from selvage.src.utils.language_detector import detect_language_from_filename
+
+LANGUAGE = "fortran"
+SAMPLE_FILE = "sample_geometry.f90"
+
+
+class TestFortranScopeExtraction:
+    """Fortran 프로시저/모듈 범위 추출 테스트."""
+
+    def test_subroutine_with_enclosing_module(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """subroutine 안의 변경 시 subroutine 전체와 모듈 헤더가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(14, 14)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("geometry", LineRange(1, 1), "enclosing-declaration"),
+            ("scale_points", LineRange(9, 27), None),
+        ]
+        assert blocks[0].text == "module geometry"
+        assert blocks[1].text.startswith("subroutine scale_points(points, factor)")
+        assert blocks[1].text.endswith("end subroutine scale_points")
+
+    def test_nested_procedure_in_contains(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`contains` 아래 내부 함수의 변경은 내부 함수만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(24, 24)], include_dependencies=False
+        )
+        procedure_blocks = [block for block in blocks if block.reason is None]
+
+        assert [(block.name, block.line_range) for block in procedure_blocks] == [
+            ("clamp", LineRange(20, 25))
+        ]
+        assert procedure_blocks[0].scope_path == ("geometry", "scale_points")
+
+    def test_module_variable_declaration(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """모듈 명세부 선언의 변경은 해당 선언문만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(5, 5)], include_dependencies=False
+        )
+
+        assert [(block.block_type, block.line_range) for block in blocks] == [
+            ("module", LineRange(1, 1)),
+            ("variable_declaration", LineRange(5, 5)),
+        ]
+
+    def test_program_unit(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """program 본문의 변경은 program 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(42, 42)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("main", LineRange(38, 43))
+        ]
+
+
+class TestFortranSourceForm:
+    """확장자 기반 Fortran 소스 형식 감지 테스트."""
+
+    @pytest.mark.parametrize(
+        ("filename", "expected"),
+        [
+            ("solver.f90", "fortran"),
+            ("solver.F90", "fortran"),
+            ("solver.f", "fortranfixed"),
+            ("solver.FOR", "fortranfixed"),
+        ],
+    )
+    def test_detect_source_form(self, filename: str, expected: str) -> None:
+        """자유 형식과 고정 형식이 서로 다른 언어로 감지되는지 테스트."""
+        assert detect_language_from_filename(filename) == expected
+
+    def test_fixed_form_is_not_ast_supported(self) -> None:
+        """고정 형식 Fortran은 AST 추출 대상이 아닌지 테스트."""
+        assert "fortran" in ContextExtractor.get_supported_languages()
+        assert "fortranfixed" not in ContextExtractor.get_supported_languages()
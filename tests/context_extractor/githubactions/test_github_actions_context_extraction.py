@@ -0,0 +1,97 @@
+"""ContextExtractor GitHub Actions 워크플로 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "githubactions"
+SAMPLE_FILE = "ci.yml"
+JOB_REASON = "enclosing-job"
+
+
+class TestGitHubActionsStepExtraction:
+    """GitHub Actions job/step 단위 추출 테스트."""
+
+    def test_run_script_returns_whole_step(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`run:` 스크립트 변경은 step 전체와 job 헤더를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(21, 21)])
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(8, 13), JOB_REASON),
+            (LineRange(19, 22), None),
+        ]
+        assert blocks[0].name == "test"
+        assert blocks[1].block_type == "step"
+        assert blocks[1].name == "Run tests"
+        assert blocks[1].scope_path == ("test",)
+        assert blocks[1].changed_lines == (21,)
+
+    def test_job_setting_returns_job_header(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """step 밖 job 설정 변경은 job 헤더만 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(9, 9)])
+
+        assert [(block.block_type, block.name, block.reason) for block in blocks] == [
+            ("job", "test", None)
+        ]
+        assert blocks[0].line_range == LineRange(8, 13)
+
+    def test_change_maps_to_later_job(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """두 번째 job의 변경이 해당 job과 step id로 보고되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(29, 29)])
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(24, 27), JOB_REASON),
+            (LineRange(28, 29), None),
+        ]
+        assert blocks[0].text.splitlines()[1] == "    needs: test"
+        assert (blocks[1].scope_path, blocks[1].name) == (("release",), "build")
+
+    def test_unnamed_step_uses_position(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """name/uses/id가 없는 step은 위치로 이름을 붙이는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(30, 30)])
+
+        assert blocks[-1].name == "steps[1]"
+
+    def test_uses_labels_step(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """name이 없는 step은 uses 값으로 이름을 붙이고 job 헤더는 한 번만 포함."""
+        blocks = extract_blocks(sample_file_content, [LineRange(14, 16)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("test", LineRange(8, 13)),
+            ("actions/checkout@v4", LineRange(14, 14)),
+            ("Set up Python", LineRange(15, 18)),
+        ]
+
+    def test_top_level_setting(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """job 밖의 최상위 설정 변경은 해당 키 하나를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(5, 5)])
+
+        assert len(blocks) == 1
+        assert (blocks[0].block_type, blocks[0].name) == ("setting", "on")
+        assert blocks[0].line_range == LineRange(3, 5)
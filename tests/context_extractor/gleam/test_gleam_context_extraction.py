@@ -0,0 +1,115 @@
+"""ContextExtractor Gleam 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+LANGUAGE = "gleam"
+SAMPLE_FILE = "shapes.gleam"
+
+
+class TestGleamFunctionExtraction:
+    """fn/pub fn 추출 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "name", "expected_range", "first_line"),
+        [
+            (19, "area", LineRange(15, 23), "pub fn area(shape: Shape) -> Area {"),
+            (26, "describe", LineRange(25, 27), "fn describe(shape: Shape)"),
+            (30, "count_label", LineRange(29, 31), "pub fn count_label("),
+        ],
+    )
+    def test_function_with_signature(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        name: str,
+        expected_range: LineRange,
+        first_line: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """본문 변경 시 가시성과 시그니처를 포함한 함수 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [block.name for block in blocks] == [name]
+        assert blocks[0].line_range == expected_range
+        assert blocks[0].text.startswith(first_line)
+
+    def test_import_is_dependency(self, sample_file_content: str) -> None:
+        """import 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = ContextExtractor("gleam").extract_context_blocks(
+            sample_file_content, [LineRange(30, 30)]
+        )
+
+        dependency_blocks = [block for block in blocks if block.is_dependency]
+        assert [block.text for block in dependency_blocks] == [
+            "import gleam/float",
+            "import gleam/int",
+        ]
+
+
+class TestGleamTypeExtraction:
+    """type 정의 추출 테스트."""
+
+    def test_custom_type_constructor(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """생성자 변경 시 해당 타입 정의 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(7, 7)], include_dependencies=False
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(5, 8)]
+        assert blocks[0].text.startswith("pub type Shape {")
+
+    def test_type_alias(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """타입 별칭 변경 시 별칭 정의 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(11, 11)], include_dependencies=False
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(10, 11)]
+
+
+class TestGleamMinimalBlock:
+    """Gleam minimal_block 모드의 case 절 추출 테스트."""
+
+    def test_case_clause(self, sample_file_content: str) -> None:
+        """case 절 안의 변경은 해당 절만 반환하는지 테스트."""
+        extractor = ContextExtractor("gleam", ExtractionOptions(minimal_block=True))
+
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(17, 17)]
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(17, 17)]
+        assert blocks[0].text.strip().startswith("Circle(radius) ->")
+
+    def test_case_clause_with_block_body(self, sample_file_content: str) -> None:
+        """블록 본문을 가진 case 절은 절 전체를 반환하는지 테스트."""
+        extractor = ContextExtractor("gleam", ExtractionOptions(minimal_block=True))
+
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(19, 19)]
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(18, 21)]
@@ -0,0 +1,44 @@
+package worker
+
+import "sync"
+
+type Counter struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (c *Counter) Increment() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.count++
+}
+
+func Fanout(jobs []int) <-chan int {
+	results := make(chan int, len(jobs))
+	for _, job := range jobs {
+		go func(n int) {
+			results <- n * 2
+		}(job)
+	}
+	return results
+}
+
+func Drain(results <-chan int, done chan struct{}) int {
+	total := 0
+	for {
+		select {
+		case n := <-results:
+			total += n
+		case <-done:
+			return total
+		}
+	}
+}
+
+func Sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
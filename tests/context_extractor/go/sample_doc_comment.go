@@ -0,0 +1,14 @@
+package main
+
+// Greeting은 인사말을 만든다.
+// 이름이 비어 있으면 기본 인사말을 반환한다.
+func Greeting(name string) string {
+	if name == "" {
+		return "안녕하세요"
+	}
+	return name + "님, 안녕하세요"
+}
+
+// 어떤 선언에도 연결되지 않은 주석
+
+var defaultName = "손님"
@@ -0,0 +1,27 @@
+package store
+
+import "database/sql"
+
+type UserStore struct {
+	db *sql.DB
+}
+
+func (s *UserStore) FindActive(limit int) (*sql.Rows, error) {
+	query := `
+SELECT id, name
+FROM users
+WHERE active = TRUE
+ORDER BY name
+LIMIT $1;`
+	return s.db.Query(query, limit)
+}
+
+func (s *UserStore) Ping() error {
+	// language=sql
+	_, err := s.db.Exec("SELECT 1")
+	return err
+}
+
+func greeting(name string) string {
+	return "Hello, " + name
+}
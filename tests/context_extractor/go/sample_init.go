@@ -0,0 +1,19 @@
+package main
+
+import "fmt"
+
+var registry = map[string]int{}
+
+func init() {
+	registry["alpha"] = 1
+	registry["beta"] = 2
+}
+
+func Lookup(key string) int {
+	return registry[key]
+}
+
+func init() {
+	registry["gamma"] = 3
+	fmt.Println("registry ready")
+}
@@ -0,0 +1,36 @@
+package calculator
+
+// Factorial은 n!을 재귀로 계산한다.
+func Factorial(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * Factorial(n-1)
+}
+
+// IsEven과 IsOdd는 서로를 호출하는 상호 재귀이다.
+func IsEven(n int) bool {
+	if n == 0 {
+		return true
+	}
+	return IsOdd(n - 1)
+}
+
+func IsOdd(n int) bool {
+	if n == 0 {
+		return false
+	}
+	return IsEven(n - 1)
+}
+
+type Node struct {
+	Left, Right *Node
+}
+
+// Depth는 receiver를 통해 자기 자신을 호출하는 재귀 메서드이다.
+func (n *Node) Depth() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + max(n.Left.Depth(), n.Right.Depth())
+}
@@ -0,0 +1,43 @@
+package calculator
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{name: "positive", a: 1, b: 2, want: 3},
+		{
+			name: "negative",
+			a:    -4,
+			b:    -6,
+			want: -10,
+		},
+		// 0 더하기는 항등원
+		{name: "zero", a: 0, b: 7, want: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a + tt.b; got != tt.want {
+				t.Errorf("Add(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDivide(t *testing.T) {
+	for _, tt := range []struct {
+		a, b int
+		want int
+	}{
+		{10, 2, 5},
+		{9, 3, 3},
+	} {
+		if got := tt.a / tt.b; got != tt.want {
+			t.Errorf("Divide(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
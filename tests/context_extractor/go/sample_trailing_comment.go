@@ -0,0 +1,26 @@
+package main
+
+import "fmt"
+
+func Retry(attempts int) error {
+	for i := 0; i < attempts; i++ {
+		if err := call(); err == nil {
+			return nil
+		}
+	} // end of retry loop
+	return fmt.Errorf("failed after %d attempts", attempts)
+} // end of Retry
+// 재시도가 모두 실패하면 마지막 오류 대신 요약 오류를 반환한다.
+// 호출자는 attempts를 1 이상으로 넘겨야 한다.
+
+func call() error {
+	return nil
+} // end of call
+// call은 항상 성공하는 테스트용 구현이다.
+
+// Describe는 설명 문자열을 반환한다.
+func Describe() string {
+	return "describe"
+}
+// Next의 선행 주석이므로 Describe에 붙지 않는다.
+func Next() {}
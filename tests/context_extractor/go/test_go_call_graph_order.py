@@ -0,0 +1,33 @@
+"""Go 같은 파일 호출 관계 순서 배치 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import ContextExtractor, ExtractionOptions, LineRange
+
+SAMPLE_FILE = "SampleCalculator.go"
+
+
+def _symbol_names(file_content: str, options: ExtractionOptions) -> list[str | None]:
+    """NewSampleCalculator와 AdvancedCalculatorFactory 변경 시 블록 이름 순서를 반환한다."""
+    blocks = ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, [LineRange(47, 47), LineRange(179, 179)]
+    )
+    return [block.name for block in blocks if not block.is_dependency]
+
+
+class TestCallGraphOrder:
+    """order_by_call_graph 옵션 테스트."""
+
+    def test_caller_comes_first(self, sample_file_content: str) -> None:
+        """호출하는 팩토리 함수가 호출 대상 생성자보다 앞에 배치되는지 테스트."""
+        names = _symbol_names(
+            sample_file_content, ExtractionOptions(order_by_call_graph=True)
+        )
+
+        assert names == ["AdvancedCalculatorFactory", "NewSampleCalculator"]
+
+    def test_source_order_by_default(self, sample_file_content: str) -> None:
+        """옵션이 꺼져 있으면 소스 순서를 유지하는지 테스트."""
+        names = _symbol_names(sample_file_content, ExtractionOptions())
+
+        assert names == ["NewSampleCalculator", "AdvancedCalculatorFactory"]
@@ -0,0 +1,55 @@
+"""Go 같은 파일 호출 위치 컨텍스트 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SAMPLE_FILE = "SampleCalculator.go"
+
+
+def _call_site_blocks(
+    file_content: str, options: ExtractionOptions | None = None
+) -> list[ContextBlock]:
+    """NewSampleCalculator 변경 시 추출된 호출 위치 블록들을 반환한다."""
+    blocks = ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, [LineRange(47, 47)]
+    )
+    return [block for block in blocks if block.reason == "call-site"]
+
+
+class TestCallSiteContext:
+    """include_call_sites 옵션 테스트."""
+
+    def test_call_site_in_closure(self, sample_file_content: str) -> None:
+        """클로저 안의 호출 문장이 감싸는 함수 이름과 함께 반환되는지 테스트."""
+        blocks = _call_site_blocks(
+            sample_file_content, ExtractionOptions(include_call_sites=True)
+        )
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(179, 179), "AdvancedCalculatorFactory")
+        ]
+        assert blocks[0].text == "calc := NewSampleCalculator(0)"
+        assert blocks[0].block_type == "short_var_declaration"
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """옵션이 꺼져 있으면 호출 위치 블록이 없는지 테스트."""
+        assert _call_site_blocks(sample_file_content) == []
+
+    def test_max_call_sites_zero(self, sample_file_content: str) -> None:
+        """max_call_sites가 0이면 호출 위치 블록이 없는지 테스트."""
+        options = ExtractionOptions(include_call_sites=True, max_call_sites=0)
+
+        assert _call_site_blocks(sample_file_content, options) == []
+
+    def test_negative_max_call_sites_is_rejected(self) -> None:
+        """max_call_sites가 음수이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="max_call_sites"):
+            ExtractionOptions(max_call_sites=-1)
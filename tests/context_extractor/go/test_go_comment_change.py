@@ -0,0 +1,75 @@
+"""Go 주석만 바뀐 변경의 선언 해석 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+FIXTURE_DIR = Path(__file__).parent
+
+
+def _symbol_blocks(
+    file_name: str,
+    changed_ranges: list[LineRange],
+    include_comment_changes: bool = True,
+) -> list[ContextBlock]:
+    """fixture 파일에서 의존성 블록을 제외한 추출 결과 블록들을 반환한다."""
+    file_content = (FIXTURE_DIR / file_name).read_text(encoding="utf-8")
+    options = ExtractionOptions(include_comment_changes=include_comment_changes)
+    blocks = ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestCommentChange:
+    """include_comment_changes 옵션 테스트."""
+
+    def test_leading_doc_comment_change(self) -> None:
+        """선언 위 문서 주석만 바뀌면 주석과 선언이 함께 반환되는지 테스트."""
+        blocks = _symbol_blocks("sample_doc_comment.go", [LineRange(4, 4)])
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("Greeting", LineRange(3, 10), "comment-change")
+        ]
+        assert blocks[0].text.startswith("// Greeting은 인사말을 만든다.\n")
+        assert blocks[0].doc_comment == (
+            "// Greeting은 인사말을 만든다.\n"
+            "// 이름이 비어 있으면 기본 인사말을 반환한다."
+        )
+
+    def test_body_doc_comment_change(self) -> None:
+        """본문 첫 줄의 문서 주석(AddNumbers)만 바뀌면 메서드가 반환되는지 테스트."""
+        blocks = _symbol_blocks("SampleCalculator.go", [LineRange(55, 55)])
+
+        assert [(block.name, block.reason) for block in blocks] == [
+            ("AddNumbers", "comment-change")
+        ]
+        assert blocks[0].line_range.start_line == 53
+        assert "두 수를 더하는 메소드" in (blocks[0].doc_comment or "")
+
+    def test_code_change_takes_precedence(self) -> None:
+        """같은 선언의 코드도 바뀌면 comment-change 태그 없이 한 번만 반환되는지 테스트."""
+        blocks = _symbol_blocks(
+            "sample_doc_comment.go", [LineRange(4, 4), LineRange(7, 7)]
+        )
+
+        assert [(block.name, block.reason) for block in blocks] == [("Greeting", None)]
+
+    def test_unattached_comment_is_ignored(self) -> None:
+        """어떤 선언에도 연결되지 않은 주석의 변경은 무시되는지 테스트."""
+        assert _symbol_blocks("sample_doc_comment.go", [LineRange(12, 12)]) == []
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 주석 한 줄 변경이 무시되는지 테스트."""
+        blocks = _symbol_blocks(
+            "sample_doc_comment.go", [LineRange(4, 4)], include_comment_changes=False
+        )
+
+        assert blocks == []
@@ -0,0 +1,59 @@
+"""Go goroutine/채널/뮤텍스 사용 표시 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SAMPLE_FILE = "sample_concurrency.go"
+
+
+def _blocks(
+    file_content: str, options: ExtractionOptions | None = None
+) -> dict[str, ContextBlock]:
+    """각 함수의 본문 한 줄씩을 바꿨을 때의 블록들을 이름별로 반환한다."""
+    blocks = ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, [LineRange(line, line) for line in (7, 13, 20, 31, 41)]
+    )
+    return {block.name: block for block in blocks if block.name}
+
+
+def _flags(block: ContextBlock) -> tuple[bool, bool, bool]:
+    return block.uses_goroutine, block.uses_channel, block.uses_mutex
+
+
+class TestGoConcurrencyFlags:
+    """include_concurrency_flags 옵션 테스트."""
+
+    def test_flags_per_symbol(self, sample_file_content: str) -> None:
+        """함수마다 goroutine/채널/뮤텍스 사용 여부가 기록되는지 테스트."""
+        blocks = _blocks(
+            sample_file_content, ExtractionOptions(include_concurrency_flags=True)
+        )
+
+        assert _flags(blocks["Counter"]) == (False, False, True)
+        assert _flags(blocks["Increment"]) == (False, False, True)
+        assert _flags(blocks["Fanout"]) == (True, True, False)
+        assert _flags(blocks["Drain"]) == (False, True, False)
+        assert _flags(blocks["Sum"]) == (False, False, False)
+
+    def test_flags_in_header(self, sample_file_content: str) -> None:
+        """사용하는 동시성 구문이 헤더에 표시되는지 테스트."""
+        blocks = _blocks(
+            sample_file_content, ExtractionOptions(include_concurrency_flags=True)
+        )
+
+        assert blocks["Fanout"].header(1).endswith(
+            "[concurrency: goroutine, channel] ----"
+        )
+        assert "concurrency" not in blocks["Sum"].header(1)
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """옵션이 꺼져 있으면 모든 표시가 False인지 테스트."""
+        blocks = _blocks(sample_file_content)
+
+        assert all(_flags(block) == (False, False, False) for block in blocks.values())
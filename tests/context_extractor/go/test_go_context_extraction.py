@@ -2,25 +2,23 @@
 
 from __future__ import annotations
 
+from collections.abc import Callable
 from pathlib import Path
 
 import pytest
 
-from selvage.src.context_extractor import LineRange
+from selvage.src.context_extractor import ContextBlock, LineRange, StructField
 from selvage.src.context_extractor.fallback_context_extractor import (
     FallbackContextExtractor,
 )
 
+LANGUAGE = "go"
+SAMPLE_FILE = "SampleCalculator.go"
+
 
 class TestBasicFunctionExtraction:
     """기본 함수/클래스 추출 기능 테스트."""
 
-    @pytest.fixture
-    def sample_file_content(self) -> str:
-        """테스트용 샘플 파일 내용을 반환합니다."""
-        file_path = Path(__file__).parent / "SampleCalculator.go"
-        return file_path.read_text(encoding="utf-8")
-
     @pytest.fixture
     def extractor(self) -> FallbackContextExtractor:
         """Go용 ContextExtractor 인스턴스를 반환합니다."""
@@ -148,3 +146,70 @@ class TestBasicFunctionExtraction:
         ]
         contexts = extractor.extract_contexts(sample_file_content, changed_ranges)
         assert len(contexts) == 0
+
+
+class TestGoReceiverScope:
+    """Go 메서드 receiver 타입 scope_path 테스트."""
+
+    def test_method_scope_is_receiver_type(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드는 receiver 타입, 함수와 타입 선언은 빈 scope_path인지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(33, 33), LineRange(55, 55), LineRange(156, 156)],
+            include_dependencies=False,
+        )
+
+        assert [(block.name, block.scope_path) for block in blocks] == [
+            ("SampleCalculator", ()),
+            ("AddNumbers", ("SampleCalculator",)),
+            ("HelperFunction", ()),
+        ]
+
+
+class TestGoStructFields:
+    """Go 구조체 필드 변경 표시 테스트."""
+
+    @pytest.fixture
+    def sample_file_content(self) -> str:
+        """FormattedResult에 태그 있는 필드를 추가한 샘플 파일 내용을 반환합니다."""
+        file_path = Path(__file__).parent / "SampleCalculator.go"
+        lines = file_path.read_text(encoding="utf-8").split("\n")
+        lines.insert(29, '\tRounded   bool   `json:"rounded,omitempty"`')
+        return "\n".join(lines)
+
+    def test_added_field_is_marked(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """추가된 필드 라인이 구조체 블록 안에서 이름, 타입, 태그와 함께 표시되는지 테스트."""
+        struct_blocks = extract_blocks(
+            sample_file_content, [LineRange(30, 30)], include_dependencies=False
+        )
+
+        assert [block.name for block in struct_blocks] == ["FormattedResult"]
+        assert struct_blocks[0].line_range == LineRange(26, 32)
+        assert struct_blocks[0].changed_lines == (30,)
+        assert struct_blocks[0].changed_fields == (
+            StructField(
+                name="Rounded",
+                type="bool",
+                line_range=LineRange(30, 30),
+                tag='`json:"rounded,omitempty"`',
+            ),
+        )
+        assert "[fields: Rounded]" in struct_blocks[0].header(1)
+
+    def test_function_change_has_no_fields(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """구조체 밖 함수 변경에는 필드 정보가 기록되지 않는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(162, 162)])
+
+        assert all(block.changed_fields == () for block in blocks)
@@ -0,0 +1,122 @@
+"""외부 SymbolResolver를 통한 Go 파일 밖 타입 정의 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    ResolvedSymbol,
+    SymbolResolver,
+)
+
+SOURCE = """package service
+
+import "example.com/app/models"
+
+type Order struct {
+	owner   models.User
+	invoice Invoice
+}
+
+func Place(order Order, user models.User) (Receipt, error) {
+	return Receipt{}, nil
+}
+"""
+
+
+class DictSymbolResolver(SymbolResolver):
+    """이름 → 정의 딕셔너리로 동작하며 조회 이름을 기록하는 테스트용 resolver."""
+
+    def __init__(self, symbols: dict[str, ResolvedSymbol]) -> None:
+        self.symbols = symbols
+        self.queries: list[str] = []
+
+    def resolve(self, qualified_name: str, language: str) -> ResolvedSymbol | None:
+        self.queries.append(qualified_name)
+        return self.symbols.get(qualified_name)
+
+
+def _symbol(name: str, file_path: str, start_line: int) -> ResolvedSymbol:
+    """한 줄짜리 타입 정의 ResolvedSymbol을 만든다."""
+    short_name = name.rsplit(".", 1)[-1]
+    return ResolvedSymbol(
+        qualified_name=name,
+        file_path=file_path,
+        content=f"type {short_name} struct{{}}",
+        line_range=LineRange(start_line, start_line),
+    )
+
+
+def _reference_blocks(
+    resolver: SymbolResolver, max_signature_types: int = 5
+) -> list[ContextBlock]:
+    """resolver를 설정해 Place 함수 변경 시 포함된 참조 블록들을 반환한다."""
+    options = ExtractionOptions(
+        include_signature_types=True,
+        max_signature_types=max_signature_types,
+        symbol_resolver=resolver,
+    )
+    blocks = ContextExtractor("go", options).extract_context_blocks(
+        SOURCE, [LineRange(11, 11)]
+    )
+    return [block for block in blocks if block.reason is not None]
+
+
+class TestCrossFileSymbolResolution:
+    """파일 밖 타입 정의 조회 테스트."""
+
+    def test_missing_types_are_resolved_and_tagged(self) -> None:
+        """파일 안에 없는 타입만 resolver로 조회하고 출처를 기록하는지 테스트."""
+        resolver = DictSymbolResolver(
+            {
+                "models.User": _symbol("models.User", "models/user.go", 7),
+                "Receipt": _symbol("Receipt", "service/receipt.go", 3),
+            }
+        )
+
+        blocks = _reference_blocks(resolver)
+
+        assert resolver.queries == ["models.User", "Receipt", "Invoice"]
+        assert [(block.name, block.reason) for block in blocks] == [
+            ("Order", "referenced-type"),
+            ("models.User", ContextExtractor.CROSS_FILE_REASON),
+            ("Receipt", ContextExtractor.CROSS_FILE_REASON),
+        ]
+        assert blocks[1].source_path == "models/user.go"
+        assert "[from models/user.go]" in blocks[1].header(1, include_name=True)
+
+    def test_budget_is_shared_with_local_types(self) -> None:
+        """max_signature_types가 같은 파일과 다른 파일의 정의에 함께 적용되는지 테스트."""
+        resolver = DictSymbolResolver(
+            {"models.User": _symbol("models.User", "models/user.go", 7)}
+        )
+
+        blocks = _reference_blocks(resolver, max_signature_types=1)
+
+        assert [block.name for block in blocks] == ["Order"]
+        assert resolver.queries == []
+
+    def test_same_definition_is_included_once(self) -> None:
+        """여러 이름이 같은 정의를 가리키면 한 번만 포함되는지 테스트."""
+        shared = _symbol("models.User", "models/user.go", 7)
+        resolver = DictSymbolResolver({"models.User": shared, "Receipt": shared})
+
+        blocks = _reference_blocks(resolver)
+
+        cross_file = [block for block in blocks if block.source_path is not None]
+        assert [block.name for block in cross_file] == ["models.User"]
+
+    def test_resolver_errors_are_ignored(self) -> None:
+        """resolver 예외가 추출을 중단시키지 않는지 테스트."""
+
+        class FailingResolver(SymbolResolver):
+            def resolve(
+                self, qualified_name: str, language: str
+            ) -> ResolvedSymbol | None:
+                raise RuntimeError("index unavailable")
+
+        blocks = _reference_blocks(FailingResolver())
+
+        assert [block.name for block in blocks] == ["Order"]
@@ -0,0 +1,91 @@
+"""Go 문자열 리터럴 안의 SQL 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SAMPLE_FILE = "sample_embedded_sql.go"
+EMBEDDED_SQL_REASON = "embedded-sql"
+
+
+def _sql_blocks(
+    file_content: str, line: int, options: ExtractionOptions | None = None
+) -> list[ContextBlock]:
+    """한 라인 변경 시 추출된 SQL 문장 블록들을 반환한다."""
+    blocks = ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, [LineRange(line, line)]
+    )
+    return [block for block in blocks if block.reason == EMBEDDED_SQL_REASON]
+
+
+class TestGoEmbeddedSql:
+    """extract_embedded_sql 옵션 테스트."""
+
+    def test_changed_query_returns_statement(self, sample_file_content: str) -> None:
+        """raw 문자열 쿼리의 변경이 파일 기준 라인의 SQL 문장으로 반환되는지 테스트."""
+        blocks = _sql_blocks(
+            sample_file_content, 13, ExtractionOptions(extract_embedded_sql=True)
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].block_type == "statement"
+        assert blocks[0].line_range == LineRange(11, 15)
+        assert blocks[0].text.splitlines()[0] == "SELECT id, name"
+        assert blocks[0].changed_lines == (13,)
+        assert blocks[0].scope_path == ("UserStore", "FindActive")
+
+    def test_language_marker_comment(self, sample_file_content: str) -> None:
+        """`// language=sql` 주석이 붙은 리터럴은 휴리스틱과 무관하게 추출되는지."""
+        blocks = _sql_blocks(
+            sample_file_content, 21, ExtractionOptions(extract_embedded_sql=True)
+        )
+
+        assert [(block.line_range, block.text) for block in blocks] == [
+            (LineRange(21, 21), "SELECT 1")
+        ]
+
+    def test_plain_string_is_ignored(self, sample_file_content: str) -> None:
+        """SQL이 아닌 문자열 변경에는 SQL 블록이 없는지 테스트."""
+        blocks = _sql_blocks(
+            sample_file_content, 26, ExtractionOptions(extract_embedded_sql=True)
+        )
+
+        assert blocks == []
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """옵션이 꺼져 있으면 SQL 블록이 없는지 테스트."""
+        assert _sql_blocks(sample_file_content, 13) == []
+
+    def test_sql_extractor_is_reused(
+        self, sample_file_content: str, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """변경된 리터럴이 여러 개여도 SQL 추출기를 한 번만 만드는지 테스트."""
+        created: list[str] = []
+        original_init = ContextExtractor.__init__
+
+        def counting_init(
+            extractor: ContextExtractor,
+            language: str,
+            options: ExtractionOptions | None = None,
+        ) -> None:
+            created.append(language)
+            original_init(extractor, language, options)
+
+        monkeypatch.setattr(ContextExtractor, "__init__", counting_init)
+        extractor = ContextExtractor(
+            "go", options=ExtractionOptions(extract_embedded_sql=True)
+        )
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(13, 13), LineRange(21, 21)]
+        )
+
+        sql_blocks = [block for block in blocks if block.reason == EMBEDDED_SQL_REASON]
+        assert len(sql_blocks) == 2
+        assert created == ["go", "sql"]
@@ -0,0 +1,81 @@
+"""include_file_outline 옵션(파일 최상위 심볼 개요) 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SAMPLE_FILE = "SampleCalculator.go"
+OUTLINE = ExtractionOptions(include_file_outline=True)
+
+EXPECTED_OUTLINE = (
+    "type_declaration FormattedResult (line 26)\n"
+    "type_declaration SampleCalculator (line 33)\n"
+    "function_declaration NewSampleCalculator (line 42)\n"
+    "method_declaration AddNumbers (line 53)\n"
+    "method_declaration MultiplyAndFormat (line 84)\n"
+    "method_declaration CalculateCircleArea (line 135)\n"
+    "function_declaration HelperFunction (line 154)\n"
+    "function_declaration AdvancedCalculatorFactory (line 172)"
+)
+
+
+def _blocks(
+    file_content: str,
+    changed_ranges: list[LineRange],
+    options: ExtractionOptions = OUTLINE,
+) -> list[ContextBlock]:
+    return ContextExtractor("go", options=options).extract_context_blocks(
+        file_content, changed_ranges
+    )
+
+
+class TestGoFileOutline:
+    """파일 개요 블록 테스트."""
+
+    def test_outline_lists_top_level_symbols(self, sample_file_content: str) -> None:
+        """구조체, 메서드, 패키지 함수가 종류/이름/라인으로 나열되는지 테스트."""
+        blocks = _blocks(sample_file_content, [LineRange(126, 126)])
+
+        outline = blocks[0]
+        assert outline.block_type == ContextExtractor.FILE_OUTLINE_BLOCK_TYPE
+        assert outline.reason == ContextExtractor.FILE_OUTLINE_REASON
+        assert outline.text == EXPECTED_OUTLINE
+        assert outline.line_range == LineRange(26, 201)
+
+    def test_closures_are_not_listed(self, sample_file_content: str) -> None:
+        """메서드 안의 클로저는 최상위 심볼이 아니므로 개요에 없는지 테스트."""
+        blocks = _blocks(sample_file_content, [LineRange(66, 66)])
+
+        assert "logOperation" not in blocks[0].text
+
+    def test_outline_once_per_file(self, sample_file_content: str) -> None:
+        """여러 심볼이 바뀌어도 개요 블록은 하나인지 테스트."""
+        blocks = _blocks(
+            sample_file_content, [LineRange(76, 76), LineRange(126, 126)]
+        )
+
+        outlines = [
+            block
+            for block in blocks
+            if block.block_type == ContextExtractor.FILE_OUTLINE_BLOCK_TYPE
+        ]
+        assert len(outlines) == 1
+        assert [block.name for block in blocks if block.reason is None][-2:] == [
+            "AddNumbers",
+            "MultiplyAndFormat",
+        ]
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """옵션이 꺼져 있으면 개요 블록이 없는지 테스트."""
+        blocks = _blocks(
+            sample_file_content, [LineRange(126, 126)], ExtractionOptions()
+        )
+
+        assert all(
+            block.reason != ContextExtractor.FILE_OUTLINE_REASON for block in blocks
+        )
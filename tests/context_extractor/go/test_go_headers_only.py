@@ -0,0 +1,65 @@
+"""headers_only 모드(감싸는 선언의 여는 라인 경로) 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "go"
+SAMPLE_FILE = "SampleCalculator.go"
+HEADERS_ONLY = ExtractionOptions(headers_only=True)
+
+
+class TestGoHeadersOnly:
+    """headers_only 옵션 테스트."""
+
+    def test_breadcrumb_for_closure_change(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """클로저 안의 변경에 receiver 타입, 메서드, 클로저의 여는 라인만 반환."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(66, 66)], options=HEADERS_ONLY
+        )
+
+        assert [(block.line_range, block.text) for block in blocks] == [
+            (LineRange(33, 33), "type SampleCalculator struct {"),
+            (
+                LineRange(53, 53),
+                "func (calc *SampleCalculator) AddNumbers(a, b int) (int, error) {",
+            ),
+            (
+                LineRange(64, 64),
+                "\tlogOperation := func(operation string, result int) {",
+            ),
+        ]
+        assert blocks[0].name == "SampleCalculator"
+
+    def test_shared_scopes_are_not_repeated(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """같은 메서드 안의 여러 변경 라인은 헤더를 한 번만 포함하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(76, 76), LineRange(78, 78)],
+            options=HEADERS_ONLY,
+        )
+
+        assert [block.line_range.start_line for block in blocks] == [33, 53]
+
+    def test_bodies_and_closing_braces_are_elided(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """블록 텍스트에 본문과 닫는 중괄호가 없는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(66, 66)], options=HEADERS_ONLY
+        )
+
+        assert all("\n" not in block.text for block in blocks)
+        assert all(not block.text.strip().startswith("}") for block in blocks)
@@ -0,0 +1,72 @@
+"""Go init 함수 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+
+LANGUAGE = "go"
+SAMPLE_FILE = "sample_init.go"
+
+
+class TestGoInitFunctions:
+    """Go init 함수 구분 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (8, "init#1", LineRange(7, 10)),
+            (18, "init#2", LineRange(16, 19)),
+        ],
+    )
+    def test_init_function_kind_and_index(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """init 함수가 전용 block_type과 선언 순서를 붙인 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("init_function", expected_name, expected_range)]
+
+    def test_indices_are_stable_across_changes(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """두 init 함수가 함께 바뀌어도 각각의 번호가 유지되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(8, 8), LineRange(13, 13), LineRange(17, 17)],
+            include_dependencies=False,
+        )
+
+        assert [(block.block_type, block.name) for block in blocks] == [
+            ("init_function", "init#1"),
+            ("function_declaration", "Lookup"),
+            ("init_function", "init#2"),
+        ]
+
+    def test_symbol_outline_lists_each_init(self, sample_file_content: str) -> None:
+        """심볼 outline에서 init 함수들이 번호로 구분되는지 테스트."""
+        result = ContextExtractor("go").extract_symbol_outline(
+            "sample_init.go", sample_file_content
+        )
+
+        assert [
+            block.name
+            for block in result.blocks
+            if block.block_type == "init_function"
+        ] == ["init#1", "init#2"]
@@ -0,0 +1,36 @@
+"""Go 코드에서 max_nesting_depth 옵션이 출력에 영향을 주지 않는지 테스트."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "go"
+SAMPLE_FILE = "SampleCalculator.go"
+
+
+class TestGoNestingDepthLimit:
+    """receiver 타입만 scope_path로 갖는 Go 메서드의 중첩 깊이 제한 테스트."""
+
+    def test_minimum_limit_is_noop(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """가장 작은 제한에서도 메서드 블록이 기본 옵션과 같게 추출되는지 테스트."""
+        changed_ranges = [LineRange(76, 77)]  # AddNumbers 본문
+
+        default_blocks = extract_blocks(sample_file_content, changed_ranges)
+        limited_blocks = extract_blocks(
+            sample_file_content,
+            changed_ranges,
+            options=ExtractionOptions(max_nesting_depth=1),
+        )
+
+        assert limited_blocks == default_blocks
+        method_block = next(
+            block for block in limited_blocks if block.name == "AddNumbers"
+        )
+        assert method_block.scope_path == ("SampleCalculator",)
+        assert not any(block.depth_limited for block in limited_blocks)
@@ -0,0 +1,57 @@
+"""Go 함수 직접 재귀 감지 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+
+FIXTURE_DIR = Path(__file__).parent
+
+
+def _symbol_blocks(file_name: str, changed_line: int) -> list[ContextBlock]:
+    """fixture 파일에서 의존성 블록을 제외한 추출 결과 블록들을 반환한다."""
+    file_content = (FIXTURE_DIR / file_name).read_text(encoding="utf-8")
+    blocks = ContextExtractor("go").extract_context_blocks(
+        file_content, [LineRange(changed_line, changed_line)]
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestRecursionDetection:
+    """recursive 메타데이터 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_recursive"),
+        [
+            (8, "Factorial", True),
+            (35, "Depth", True),
+            (16, "IsEven", False),
+            (23, "IsOdd", False),
+        ],
+    )
+    def test_direct_recursion(
+        self, changed_line: int, expected_name: str, expected_recursive: bool
+    ) -> None:
+        """자기 이름 호출은 재귀로, 상호 재귀는 재귀가 아닌 것으로 보는지 테스트."""
+        blocks = _symbol_blocks("sample_recursion.go", changed_line)
+
+        assert [(block.name, block.recursive) for block in blocks] == [
+            (expected_name, expected_recursive)
+        ]
+
+    def test_recursive_closure_is_not_detected(self) -> None:
+        """변수에 담긴 클로저(multiplyRecursive)의 자기 호출은 감지하지 않는지 테스트."""
+        blocks = _symbol_blocks("SampleCalculator.go", 101)
+
+        assert [(block.line_range, block.recursive) for block in blocks] == [
+            (LineRange(97, 102), False)
+        ]
+
+    def test_struct_is_not_recursive(self) -> None:
+        """자기 타입을 참조하는 구조체 선언은 함수가 아니므로 재귀가 아닌지 테스트."""
+        blocks = _symbol_blocks("sample_recursion.go", 27)
+
+        assert [block.recursive for block in blocks] == [False]
@@ -0,0 +1,93 @@
+"""Go 배너 주석 구역 이름(section) 기록 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "go"
+SAMPLE_FILE = "SampleCalculator.go"
+
+BANNER_SOURCE = """package handlers
+
+// ===== Handlers =====
+
+func Create() {
+\tsave()
+}
+
+// MARK: - Helpers
+
+func save() {
+\tflush()
+}
+"""
+
+
+class TestGoSectionLabels:
+    """include_section_labels 옵션 테스트."""
+
+    def test_default_banners(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """구분 문자 배너와 MARK 표시 아래 함수에 가장 가까운 구역 이름이 기록되는지 테스트."""
+        blocks = extract_blocks(
+            BANNER_SOURCE,
+            [LineRange(6, 6), LineRange(12, 12)],
+            include_dependencies=False,
+            options=ExtractionOptions(include_section_labels=True),
+        )
+
+        assert [(block.name, block.section) for block in blocks] == [
+            ("Create", "Handlers"),
+            ("save", "Helpers"),
+        ]
+        assert "[section: Handlers]" in blocks[0].header(1)
+
+    def test_custom_banner_pattern(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """설정한 패턴으로 `// 모듈 레벨 상수` 배너 아래 선언들에 구역 이름이 붙는지 테스트."""
+        options = ExtractionOptions(
+            include_section_labels=True, section_banner_patterns=(r"^모듈 레벨",)
+        )
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(204, 204), LineRange(207, 207)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert [block.section for block in blocks] == ["모듈 레벨 상수"] * len(blocks)
+
+    def test_korean_comment_is_not_default_banner(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """기본 휴리스틱에서는 일반 주석이 배너로 보이지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(207, 207)],
+            include_dependencies=False,
+            options=ExtractionOptions(include_section_labels=True),
+        )
+
+        assert all(block.section is None for block in blocks)
+
+    def test_disabled_by_default(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """옵션이 꺼져 있으면 구역 이름을 기록하지 않는지 테스트."""
+        blocks = extract_blocks(
+            BANNER_SOURCE,
+            [LineRange(6, 6)],
+            include_dependencies=False,
+            options=ExtractionOptions(),
+        )
+
+        assert blocks[0].section is None
@@ -0,0 +1,91 @@
+"""Go 시그니처 참조 타입 선언 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import ContextExtractor, ExtractionOptions, LineRange
+
+SAMPLE_FILE = "SampleCalculator.go"
+
+SELF_REFERENTIAL_SOURCE = """package main
+
+type Node struct {
+	next  *Node
+	value Value
+}
+
+type Value struct {
+	owner *Node
+}
+
+func Walk(node *Node) Value {
+	return node.value
+}
+"""
+
+
+class TestSignatureTypeExtraction:
+    """시그니처에 등장하는 타입 선언 포함 옵션 테스트."""
+
+    @pytest.fixture
+    def extractor(self) -> ContextExtractor:
+        """시그니처 타입 포함 옵션이 켜진 Go ContextExtractor를 반환합니다."""
+        return ContextExtractor(
+            "go", ExtractionOptions(include_signature_types=True)
+        )
+
+    def test_includes_return_type_declaration(
+        self,
+        extractor: ContextExtractor,
+        sample_file_content: str,
+    ) -> None:
+        """MultiplyAndFormat 변경 시 반환 타입 FormattedResult 선언 포함 테스트."""
+        changed_ranges = [LineRange(126, 128)]
+        blocks = extractor.extract_context_blocks(sample_file_content, changed_ranges)
+        context_blocks = [block for block in blocks if not block.is_dependency]
+
+        assert [block.name for block in context_blocks] == [
+            "FormattedResult",
+            "MultiplyAndFormat",
+        ]
+        type_block, method_block = context_blocks
+        assert type_block.reason == "referenced-type"
+        assert type_block.line_range == LineRange(26, 31)
+        assert type_block.text.startswith("type FormattedResult struct {")
+        assert method_block.reason is None
+        assert method_block.line_range == LineRange(84, 133)
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """기본 옵션에서는 타입 선언이 포함되지 않는지 테스트."""
+        extractor = ContextExtractor("go")
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(126, 128)]
+        )
+
+        assert all(block.reason is None for block in blocks)
+        assert "FormattedResult" not in [block.name for block in blocks]
+
+    def test_self_referential_types_are_included_once(
+        self, extractor: ContextExtractor
+    ) -> None:
+        """자기/상호 참조 타입에서도 각 선언이 한 번만 포함되는지 테스트."""
+        blocks = extractor.extract_context_blocks(
+            SELF_REFERENTIAL_SOURCE, [LineRange(13, 13)]
+        )
+        reference_names = [block.name for block in blocks if block.reason]
+
+        assert reference_names == ["Node", "Value"]
+
+    def test_respects_max_signature_types(self) -> None:
+        """max_signature_types 제한이 적용되는지 테스트."""
+        extractor = ContextExtractor(
+            "go",
+            ExtractionOptions(include_signature_types=True, max_signature_types=1),
+        )
+        blocks = extractor.extract_context_blocks(
+            SELF_REFERENTIAL_SOURCE, [LineRange(13, 13)]
+        )
+        reference_names = [block.name for block in blocks if block.reason]
+
+        assert reference_names == ["Node"]
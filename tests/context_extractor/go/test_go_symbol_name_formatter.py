@@ -0,0 +1,107 @@
+"""Go 심볼 이름 포맷터 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractionOptions,
+    LineRange,
+    SymbolNameFormatter,
+    SymbolNameParts,
+)
+
+LANGUAGE = "go"
+SAMPLE_FILE = "SampleCalculator.go"
+
+
+class ColonQualifiedFormatter(SymbolNameFormatter):
+    """`pkg::Type::method` 형식으로 이름을 만드는 테스트용 포맷터."""
+
+    def display_name(self, parts: SymbolNameParts) -> str:
+        """package와 receiver 타입으로 한정한 이름을 반환한다."""
+        return "::".join(filter(None, (parts.package, *parts.owner_types, parts.name)))
+
+    def qualified_name(self, parts: SymbolNameParts) -> str:
+        """표시 이름과 같은 한정 이름을 반환한다."""
+        return self.display_name(parts)
+
+
+class TestSymbolNameFormatter:
+    """name_formatters 옵션 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name"),
+        [
+            (55, "main::SampleCalculator::AddNumbers"),
+            (156, "main::HelperFunction"),
+        ],
+    )
+    def test_custom_formatter(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """등록한 포맷터가 package와 receiver로 한정한 이름을 만드는지 테스트."""
+        options = ExtractionOptions(name_formatters={"go": ColonQualifiedFormatter()})
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert [(block.name, block.qualified_name) for block in blocks] == [
+            (expected_name, expected_name)
+        ]
+
+    def test_default_formatter(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """기본 포맷터는 선언 이름과 `.` 한정 이름을 쓰는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(55, 55)], include_dependencies=False
+        )
+
+        assert [(block.name, block.qualified_name) for block in blocks] == [
+            ("AddNumbers", "SampleCalculator.AddNumbers")
+        ]
+
+    def test_formatter_for_other_language_is_ignored(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """다른 언어에 등록한 포맷터는 적용되지 않는지 테스트."""
+        options = ExtractionOptions(name_formatters={"java": ColonQualifiedFormatter()})
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(55, 55)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert [block.name for block in blocks] == ["AddNumbers"]
+
+
+class TestDefaultSymbolNameFormatter:
+    """기본 SymbolNameFormatter 단위 테스트."""
+
+    def test_names_from_parts(self) -> None:
+        """선언 이름과 감싸는 선언 이름들로 기본 이름을 만드는지 테스트."""
+        parts = SymbolNameParts(
+            name="AddNumbers", package="main", owner_types=("SampleCalculator",)
+        )
+        formatter = SymbolNameFormatter()
+
+        assert formatter.display_name(parts) == "AddNumbers"
+        assert formatter.qualified_name(parts) == "SampleCalculator.AddNumbers"
@@ -0,0 +1,133 @@
+"""Go 테이블 기반 테스트 케이스 인식 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    TableTestCase,
+)
+
+LANGUAGE = "go"
+SAMPLE_FILE = "sample_table_test.go"
+
+
+class TestTableCaseAnnotation:
+    """테스트 함수 블록의 변경된 케이스 표시 테스트."""
+
+    def test_keyed_case_is_highlighted(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """여러 줄 케이스의 변경 시 함수 전체와 변경된 케이스가 기록되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(15, 15)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("TestAdd", LineRange(5, 29))
+        ]
+        assert blocks[0].changed_cases == (
+            TableTestCase(
+                index=1,
+                line_range=LineRange(12, 17),
+                name="negative",
+                table_name="tests",
+            ),
+        )
+        assert blocks[0].changed_lines == (15,)
+        assert "[cases: negative]" in blocks[0].header(1)
+
+    def test_added_cases_after_comment(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """주석을 건너뛰고 케이스 순서와 이름을 계산하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(11, 11), LineRange(18, 19)],
+            include_dependencies=False,
+        )
+
+        assert [case.label for case in blocks[0].changed_cases] == [
+            "positive",
+            "zero",
+        ]
+        assert blocks[0].changed_cases[1].index == 2
+
+    def test_positional_case_in_range_clause(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """range 절에 바로 쓴 이름 없는 테이블은 인덱스로 표시되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(37, 37)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["TestDivide"]
+        assert blocks[0].changed_cases == (
+            TableTestCase(index=1, line_range=LineRange(37, 37)),
+        )
+        assert "[cases: #1]" in blocks[0].header(1)
+
+    def test_change_outside_table(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """테이블 밖 테스트 본문의 변경에는 케이스가 기록되지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(25, 25)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["TestAdd"]
+        assert blocks[0].changed_cases == ()
+
+
+class TestTableCaseOnly:
+    """table_case_only 옵션 테스트."""
+
+    def test_only_changed_case_is_returned(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """변경된 케이스 항목만 함수/테이블 scope_path와 함께 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(14, 14)],
+            include_dependencies=False,
+            options=ExtractionOptions(table_case_only=True),
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("negative", LineRange(12, 17))
+        ]
+        assert blocks[0].block_type == ContextExtractor.TABLE_CASE_BLOCK_TYPE
+        assert blocks[0].scope_path == ("TestAdd", "tests")
+        assert blocks[0].text.startswith('{\n\t\t\tname: "negative",')
+        assert blocks[0].changed_lines == (14,)
+
+    def test_function_kept_for_changes_outside_table(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """테이블 밖 변경이 있는 함수는 그대로 함수 블록으로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(40, 40)],
+            include_dependencies=False,
+            options=ExtractionOptions(table_case_only=True),
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("TestDivide", LineRange(31, 43))
+        ]
@@ -0,0 +1,85 @@
+"""Go 닫는 중괄호 뒤 주석(trailing comment) 포함 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "go"
+SAMPLE_FILE = "sample_trailing_comment.go"
+TRAILING_COMMENTS = ExtractionOptions(include_trailing_comments=True)
+
+
+class TestTrailingComment:
+    """include_trailing_comments 옵션 테스트."""
+
+    def test_same_line_and_following_comments(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """닫는 중괄호 뒤 주석과 빈 줄 전까지의 아래 주석이 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(11, 11)],
+            include_dependencies=False,
+            options=TRAILING_COMMENTS,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Retry", LineRange(5, 14))
+        ]
+        assert "} // end of Retry\n// 재시도가 모두 실패하면" in blocks[0].text
+        assert blocks[0].text.endswith("// 호출자는 attempts를 1 이상으로 넘겨야 한다.")
+
+    def test_stops_at_blank_line(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """빈 줄 뒤의 다음 선언 문서 주석은 포함하지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(17, 17)],
+            include_dependencies=False,
+            options=TRAILING_COMMENTS,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("call", LineRange(16, 19))
+        ]
+        assert blocks[0].text.endswith("// call은 항상 성공하는 테스트용 구현이다.")
+
+    def test_comment_attached_to_next_statement(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """다음 선언에 바로 붙은 주석은 그 선언의 선행 주석으로 남는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(23, 23)],
+            include_dependencies=False,
+            options=TRAILING_COMMENTS,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Describe", LineRange(22, 24))
+        ]
+        assert blocks[0].text.endswith("}")
+
+    def test_option_disabled(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """옵션이 꺼져 있으면 닫는 중괄호에서 블록이 끝나는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(11, 11)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Retry", LineRange(5, 12))
+        ]
+        assert blocks[0].text.endswith("}")
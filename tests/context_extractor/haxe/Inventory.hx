@@ -0,0 +1,51 @@
+package game.inventory;
+
+import haxe.ds.StringMap;
+using StringTools;
+
+typedef ItemData = {
+  var name:String;
+  var count:Int;
+}
+
+@:keep
+class Inventory extends Container {
+  var items:StringMap<ItemData>;
+
+  public function new() {
+    super();
+    items = new StringMap();
+  }
+
+  public function restock(names:Array<String>):Void {
+    names.iter(function(name) {
+      var item = items.get(name.trim());
+      item.count += 1;
+    });
+  }
+
+  #if debug
+  public function dump():String {
+    return items.toString();
+  }
+  #end
+
+  macro static function build():Array<haxe.macro.Expr.Field> {
+    return haxe.macro.Context.getBuildFields();
+  }
+}
+
+enum Rarity {
+  Common;
+  Rare(multiplier:Float);
+}
+
+interface Storable {
+  function store():Void;
+}
+
+abstract Gold(Int) from Int {
+  public function add(amount:Int):Gold {
+    return this + amount;
+  }
+}
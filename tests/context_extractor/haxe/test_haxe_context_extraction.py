@@ -0,0 +1,130 @@
+"""ContextExtractor Haxe 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "haxe"
+SAMPLE_FILE = "Inventory.hx"
+CONTAINER_REASON = "enclosing-declaration"
+
+
+class TestHaxeScopeExtraction:
+    """Haxe 함수, 타입 선언과 익명 함수 추출 테스트."""
+
+    def test_method_with_enclosing_class(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드 본문의 변경 시 메서드 전체와 클래스 선언 라인이 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(17, 17)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("Inventory", LineRange(12, 12), CONTAINER_REASON),
+            ("new", LineRange(15, 18), None),
+        ]
+        assert blocks[0].text == "class Inventory extends Container {"
+        assert blocks[1].scope_path == ("Inventory",)
+
+    def test_anonymous_function_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """익명 함수 안의 변경 시 익명 함수와 감싸는 메서드/클래스 헤더가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(23, 23)], include_dependencies=False
+        )
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(12, 12), CONTAINER_REASON),
+            (LineRange(20, 20), CONTAINER_REASON),
+            (LineRange(21, 24), None),
+        ]
+        assert blocks[1].text == "public function restock(names:Array<String>):Void {"
+        assert blocks[2].name == "<anonymous>"
+        assert blocks[2].scope_path == ("Inventory", "restock")
+
+    def test_conditional_compilation(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`#if` 안 메서드의 변경도 메서드 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(29, 29)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "dump"
+        assert blocks[-1].line_range == LineRange(28, 30)
+
+    def test_macro_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """macro 함수의 변경이 함수 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(34, 34)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "build"
+        assert blocks[-1].line_range == LineRange(33, 35)
+
+    @pytest.mark.parametrize(
+        ("line", "name", "line_range"),
+        [
+            (7, "ItemData", LineRange(6, 9)),
+            (40, "Rarity", LineRange(38, 41)),
+            (44, "Storable", LineRange(43, 45)),
+        ],
+    )
+    def test_type_declarations(
+        self,
+        sample_file_content: str,
+        line: int,
+        name: str,
+        line_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """typedef, enum, interface 안의 변경이 선언 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(line, line)], include_dependencies=False
+        )
+
+        assert (blocks[-1].name, blocks[-1].line_range) == (name, line_range)
+
+    def test_abstract_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """abstract 메서드의 변경 시 abstract 선언 라인이 헤더로 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(49, 49)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Gold", LineRange(47, 47)),
+            ("add", LineRange(48, 50)),
+        ]
+
+    def test_import_and_using_are_dependencies(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`import`와 `using` 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(17, 17)])
+
+        assert [block.line_range for block in blocks if block.is_dependency] == [
+            LineRange(3, 3),
+            LineRange(4, 4),
+        ]
@@ -0,0 +1,43 @@
+package com.example.orders;
+
+import java.util.List;
+import java.util.stream.Collectors;
+
+public class OrderProcessor {
+
+    private final Runnable auditTask = () -> {
+        System.out.println("audit");
+    };
+
+    public List<String> activeOrderIds(List<Order> orders) {
+        return orders.stream()
+            .filter(order -> {
+                boolean active = order.isActive();
+                return active && !order.isArchived();
+            })
+            .map(Order::getId)
+            .collect(Collectors.toList());
+    }
+
+    public void register(EventBus bus) {
+        bus.addListener(new OrderListener() {
+            @Override
+            public void onOrderCreated(Order order) {
+                System.out.println("created " + order.getId());
+            }
+        });
+    }
+
+    public static class Order {
+        private final String id;
+
+        public Order(String id) {
+            this.id = id;
+        }
+
+        @Override
+        public String toString() {
+            return "Order(" + id + ")";
+        }
+    }
+}
@@ -0,0 +1,23 @@
+package com.example.orders;
+
+import java.util.List;
+
+public class OrderValidator {
+    private final int maxItems;
+
+    public OrderValidator(int maxItems) {
+        this.maxItems = maxItems;
+    }
+
+    public boolean validate(List<String> items) {
+        if (items.isEmpty()) {
+            return false;
+        }
+        for (String item : items) {
+            if (item.isBlank()) {
+                return false;
+            }
+        }
+        return items.size() <= maxItems;
+    }
+}
@@ -0,0 +1,179 @@
+"""Java 람다/익명 클래스/중첩 클래스 스코프 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "java"
+SAMPLE_FILE = "SampleInnerScopes.java"
+
+
+class TestJavaInnerScopes:
+    """람다와 익명 클래스를 내부 스코프로 추출하는 테스트."""
+
+    def test_stream_lambda_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """스트림 람다 본문 변경 시 람다와 감싸는 메서드 헤더 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(16, 16)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == [
+            "OrderProcessor.activeOrderIds",
+            "OrderProcessor.activeOrderIds.<lambda>",
+        ]
+        header, lambda_block = blocks
+        assert header.reason == "enclosing-declaration"
+        assert header.text == "public List<String> activeOrderIds(List<Order> orders)"
+        assert header.line_range == LineRange(12, 12)
+        assert lambda_block.line_range == LineRange(14, 17)
+        assert lambda_block.text.startswith("order -> {")
+        assert lambda_block.scope_path == ("OrderProcessor", "activeOrderIds")
+
+    def test_anonymous_listener_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """익명 클래스 안의 메서드 변경 시 익명 클래스 전체 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(26, 26)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == [
+            "OrderProcessor.register",
+            "OrderProcessor.register.<anonymous OrderListener>",
+        ]
+        header, anonymous_block = blocks
+        assert header.text == "public void register(EventBus bus)"
+        assert anonymous_block.line_range == LineRange(23, 28)
+        assert anonymous_block.text.startswith("new OrderListener() {")
+        assert "@Override" in anonymous_block.text
+        assert anonymous_block.scope_path == ("OrderProcessor", "register")
+
+    def test_field_initializer_lambda(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """필드 초기화 람다는 감싸는 메서드 없이 필드 경로로 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(9, 9)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "OrderProcessor.auditTask.<lambda>"
+        assert blocks[0].line_range == LineRange(8, 10)
+
+    def test_expression_lambda_returns_enclosing_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """블록 본문이 없는 람다/메서드 참조 변경 시 메서드 전체 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(18, 18)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "activeOrderIds"
+        assert blocks[0].line_range == LineRange(12, 20)
+
+    def test_method_change_contains_lambda(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드와 람다를 함께 변경하면 메서드 블록만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(13, 13), LineRange(16, 16)],
+            include_dependencies=False,
+        )
+
+        assert [block.name for block in blocks] == ["activeOrderIds"]
+
+
+class TestJavaNestedClasses:
+    """중첩 클래스 이름 한정과 애너테이션 포함 테스트."""
+
+    def test_nested_class_is_qualified(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 클래스 필드 변경 시 `Outer.Inner` 이름 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(32, 32)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "OrderProcessor.Order"
+        assert blocks[0].scope_path == ("OrderProcessor",)
+
+    def test_override_annotation_attaches_to_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 클래스 메서드 변경 시 @Override가 메서드 블록에 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(40, 40)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "toString"
+        assert blocks[0].line_range == LineRange(38, 41)
+        assert blocks[0].text.startswith("@Override")
+        assert blocks[0].scope_path == ("OrderProcessor", "Order")
+
+
+class TestJavaNestingDepthLimit:
+    """max_nesting_depth 옵션 테스트."""
+
+    def test_scope_path_is_truncated(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """제한보다 깊은 scope_path는 가장 안쪽 선언만 남기고 표시되는지 테스트."""
+        options = ExtractionOptions(max_nesting_depth=1)
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(16, 16)],
+            include_dependencies=False,
+            options=options,
+        )
+        lambda_block = blocks[-1]
+
+        assert lambda_block.scope_path == ("activeOrderIds",)
+        assert lambda_block.depth_limited
+        assert "[depth-limited]" in lambda_block.header(1)
+
+    def test_default_limit_has_no_effect(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """기본 제한에서는 일반적인 중첩이 잘리지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(16, 16)], include_dependencies=False
+        )
+        lambda_block = blocks[-1]
+
+        assert lambda_block.scope_path == ("OrderProcessor", "activeOrderIds")
+        assert not lambda_block.depth_limited
+        assert "[depth-limited]" not in lambda_block.header(1)
+
+    def test_invalid_limit(self) -> None:
+        """max_nesting_depth가 1보다 작으면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="max_nesting_depth"):
+            ExtractionOptions(max_nesting_depth=0)
@@ -0,0 +1,116 @@
+"""Java 재정의 대상 상위 타입 메서드 컨텍스트 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    ResolvedSymbol,
+    SymbolResolver,
+)
+
+OVERRIDDEN_REASON = "overridden-method"
+
+JAVA_SOURCE = """interface Shape {
+    double area();
+}
+
+abstract class Polygon implements Shape {
+    public String describe(String prefix) {
+        return prefix + area();
+    }
+}
+
+class Square extends Polygon implements Comparable<Square> {
+    private final double side;
+
+    @Override
+    public double area() {
+        return side * side;
+    }
+
+    @Override
+    public String describe(String prefix) {
+        return prefix + " square";
+    }
+
+    @Override
+    public int compareTo(Square other) {
+        return Double.compare(side, other.side);
+    }
+}
+"""
+
+
+class StubResolver(SymbolResolver):
+    """한정 이름별로 고정된 정의를 반환하는 테스트용 resolver."""
+
+    def __init__(self, symbols: dict[str, ResolvedSymbol]) -> None:
+        self.symbols = symbols
+        self.queries: list[str] = []
+
+    def resolve(self, qualified_name: str, language: str) -> ResolvedSymbol | None:
+        self.queries.append(qualified_name)
+        return self.symbols.get(qualified_name)
+
+
+def _overridden_blocks(
+    changed_line: int, options: ExtractionOptions | None = None
+) -> list[ContextBlock]:
+    """변경된 라인에 대해 추출된 재정의 대상 블록들을 반환한다."""
+    blocks = ContextExtractor("java", options=options).extract_context_blocks(
+        JAVA_SOURCE, [LineRange(changed_line, changed_line)]
+    )
+    return [block for block in blocks if block.reason == OVERRIDDEN_REASON]
+
+
+class TestOverriddenMethods:
+    """include_overridden_methods 옵션 테스트."""
+
+    def test_superclass_method_signature(self) -> None:
+        """상위 클래스 메서드는 본문을 뺀 시그니처만 포함되는지 테스트."""
+        blocks = _overridden_blocks(
+            21, ExtractionOptions(include_overridden_methods=True)
+        )
+
+        assert [(block.text, block.line_range) for block in blocks] == [
+            ("public String describe(String prefix)", LineRange(6, 6))
+        ]
+        assert blocks[0].name == "describe"
+
+    def test_interface_through_superclass(self) -> None:
+        """상위 클래스에 없으면 그 상위 인터페이스의 선언을 찾는지 테스트."""
+        blocks = _overridden_blocks(
+            16, ExtractionOptions(include_overridden_methods=True)
+        )
+
+        assert [(block.text, block.line_range) for block in blocks] == [
+            ("double area();", LineRange(2, 2))
+        ]
+
+    def test_external_supertype_uses_resolver(self) -> None:
+        """파일 밖 상위 타입의 메서드는 resolver로 조회하는지 테스트."""
+        symbol = ResolvedSymbol(
+            qualified_name="Comparable.compareTo",
+            file_path="java/lang/Comparable.java",
+            content="int compareTo(T o);",
+            line_range=LineRange(40, 40),
+        )
+        resolver = StubResolver({"Comparable.compareTo": symbol})
+        blocks = _overridden_blocks(
+            26,
+            ExtractionOptions(
+                include_overridden_methods=True, symbol_resolver=resolver
+            ),
+        )
+
+        assert "Comparable.compareTo" in resolver.queries
+        assert [(block.text, block.source_path) for block in blocks] == [
+            ("int compareTo(T o);", "java/lang/Comparable.java")
+        ]
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 재정의 대상 블록이 없는지 테스트."""
+        assert _overridden_blocks(21) == []
@@ -0,0 +1,98 @@
+"""Java 같은 이름 오버로드 컨텍스트 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "java"
+SIBLING_REASON = "sibling-overload"
+
+JAVA_SOURCE = """public class Formatter {
+    public String format(int value) {
+        return Integer.toString(value);
+    }
+
+    public String format(double value) {
+        return String.format("%.2f", value);
+    }
+
+    public String format(String value, int width) {
+        String padded = value;
+        while (padded.length() < width) {
+            padded = " " + padded;
+        }
+        return padded;
+    }
+
+    public String trim(String value) {
+        return value.trim();
+    }
+}
+"""
+
+
+class TestSiblingOverloads:
+    """include_sibling_overloads 옵션 테스트."""
+
+    def test_other_overloads_are_included(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """같은 이름의 다른 오버로드만 참고용 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(
+            JAVA_SOURCE,
+            [LineRange(7, 7)],
+            options=ExtractionOptions(include_sibling_overloads=True),
+        )
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(2, 4), SIBLING_REASON),
+            (LineRange(6, 8), None),
+            (LineRange(10, 16), SIBLING_REASON),
+        ]
+        assert {block.name for block in blocks} == {"format"}
+
+    def test_disabled_by_default(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """옵션이 꺼져 있으면 오버로드 블록이 없는지 테스트."""
+        blocks = extract_blocks(JAVA_SOURCE, [LineRange(7, 7)])
+
+        assert [block.reason for block in blocks] == [None]
+
+    def test_count_and_line_budget(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """개수와 총 라인 수 상한 안에서만 오버로드를 포함하는지 테스트."""
+        by_count = extract_blocks(
+            JAVA_SOURCE,
+            [LineRange(7, 7)],
+            options=ExtractionOptions(
+                include_sibling_overloads=True, max_sibling_overloads=1
+            ),
+        )
+        by_lines = extract_blocks(
+            JAVA_SOURCE,
+            [LineRange(7, 7)],
+            options=ExtractionOptions(
+                include_sibling_overloads=True, max_sibling_overload_lines=5
+            ),
+        )
+
+        assert [block.line_range for block in by_count if block.reason] == [
+            LineRange(2, 4)
+        ]
+        # 7줄짜리 format(String, int)는 남은 라인 수를 넘어 건너뜀
+        assert [block.line_range for block in by_lines if block.reason] == [
+            LineRange(2, 4)
+        ]
+
+    def test_negative_limits_are_rejected(self) -> None:
+        """상한 값이 음수이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="max_sibling_overloads"):
+            ExtractionOptions(max_sibling_overloads=-1)
+        with pytest.raises(ValueError, match="max_sibling_overload_lines"):
+            ExtractionOptions(max_sibling_overload_lines=-1)
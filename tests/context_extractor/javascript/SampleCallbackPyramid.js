@@ -0,0 +1,18 @@
+function loadDashboard(userId, done) {
+    fetchUser(userId, function (err, user) {
+        if (err) return done(err);
+        fetchTeam(user.teamId, (err, team) => {
+            if (err) return done(err);
+            fetchProjects(team.id, function onProjects(err, projects) {
+                if (err) return done(err);
+                fetchTasks(projects, (err, tasks) => {
+                    if (err) return done(err);
+                    const summary = tasks.map((task) => task.title).join(", ");
+                    done(null, { user, team, summary });
+                });
+            });
+        });
+    });
+}
+
+module.exports = { loadDashboard };
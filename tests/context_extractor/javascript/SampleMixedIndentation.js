@@ -0,0 +1,13 @@
+const DEFAULT_RATE = 0.1;
+
+function applyDiscount(order) {
+    if (order.total > 100) {
+		return order.total * (1 - DEFAULT_RATE);
+    }
+	return order.total;
+}
+
+function formatTotal(order) {
+    const total = applyDiscount(order);
+    return `${total.toFixed(2)}\t${order.currency}`;
+}
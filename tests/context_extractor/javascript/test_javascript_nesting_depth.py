@@ -0,0 +1,66 @@
+"""JavaScript 콜백 피라미드의 max_nesting_depth 옵션 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SAMPLE_FILE = "SampleCallbackPyramid.js"
+
+
+def _callback_block(
+    file_content: str, options: ExtractionOptions | None = None
+) -> ContextBlock:
+    """가장 안쪽 콜백(`(err, tasks) => {...}`) 본문 변경의 추출 블록을 반환한다."""
+    blocks = ContextExtractor("javascript", options).extract_context_blocks(
+        file_content, [LineRange(9, 9)]
+    )
+    return next(block for block in blocks if not block.is_dependency)
+
+
+class TestJavaScriptNestingDepthLimit:
+    """콜백 피라미드의 scope_path 중첩 깊이 제한 테스트."""
+
+    def test_scope_path_includes_enclosing_closures(
+        self, sample_file_content: str
+    ) -> None:
+        """기본 제한에서는 감싸는 함수와 익명 콜백들이 모두 scope_path에 남는지 테스트."""
+        block = _callback_block(sample_file_content)
+
+        assert block.line_range == LineRange(8, 12)
+        assert block.scope_path == (
+            "loadDashboard",
+            "<anonymous>",
+            "<anonymous>",
+            "onProjects",
+        )
+        assert not block.depth_limited
+
+    def test_scope_path_is_truncated(self, sample_file_content: str) -> None:
+        """제한보다 깊은 콜백은 가장 안쪽 클로저만 남기고 표시되는지 테스트."""
+        options = ExtractionOptions(max_nesting_depth=2)
+
+        block = _callback_block(sample_file_content, options)
+
+        assert block.line_range == LineRange(8, 12)
+        assert block.scope_path == ("<anonymous>", "onProjects")
+        assert block.depth_limited
+        assert "[depth-limited]" in block.header(1)
+
+    @pytest.mark.parametrize("max_nesting_depth", [4, 5])
+    def test_limit_at_or_above_depth_has_no_effect(
+        self, sample_file_content: str, max_nesting_depth: int
+    ) -> None:
+        """중첩 깊이와 같거나 큰 제한에서는 scope_path가 잘리지 않는지 테스트."""
+        options = ExtractionOptions(max_nesting_depth=max_nesting_depth)
+
+        block = _callback_block(sample_file_content, options)
+
+        assert len(block.scope_path) == 4
+        assert not block.depth_limited
@@ -0,0 +1,33 @@
+module Geometry
+
+using LinearAlgebra
+
+abstract type Shape end
+
+struct Circle <: Shape
+    radius::Float64
+end
+
+struct Square <: Shape
+    side::Float64
+end
+
+function area(c::Circle)
+    return pi * c.radius^2
+end
+
+area(s::Square) = s.side^2
+
+macro checked(expr)
+    return :(isnothing($(esc(expr))) ? error("nothing") : $(esc(expr)))
+end
+
+function total_area(shapes)
+    areas = map(shapes) do shape
+        a = area(shape)
+        a < 0 ? 0.0 : a
+    end
+    return sum(areas)
+end
+
+end
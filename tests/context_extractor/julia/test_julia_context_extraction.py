@@ -0,0 +1,127 @@
+"""ContextExtractor Julia 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "julia"
+SAMPLE_FILE = "sample_geometry.jl"
+
+
+class TestJuliaScopeExtraction:
+    """Julia 정의와 모듈 추출 테스트."""
+
+    def test_function_with_enclosing_module(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수 안의 변경 시 시그니처를 포함한 함수 전체와 모듈 헤더가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(16, 16)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("Geometry", LineRange(1, 1), "enclosing-declaration"),
+            ("area", LineRange(15, 17), None),
+        ]
+        assert blocks[0].text == "module Geometry"
+        assert blocks[1].text.startswith("function area(c::Circle)")
+        assert blocks[1].scope_path == ("Geometry",)
+
+    def test_short_function_definition(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """짧은 형식 함수(`f(x) = ...`)가 함수 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(19, 19)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Geometry", LineRange(1, 1)),
+            ("area", LineRange(19, 19)),
+        ]
+
+    def test_dispatch_methods_are_grouped(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """같은 함수의 여러 메서드가 바뀌면 하나의 method_group 블록으로 묶이는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(16, 16), LineRange(19, 19)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [
+            ("module_definition", "Geometry", LineRange(1, 1)),
+            ("method_group", "area", LineRange(15, 19)),
+        ]
+        assert "end\n\narea(s::Square) = s.side^2" in blocks[1].text
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (8, "Circle", LineRange(7, 9)),
+            (5, "Shape", LineRange(5, 5)),
+            (22, "@checked", LineRange(21, 23)),
+        ],
+    )
+    def test_type_and_macro_definitions(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """struct, abstract type, 매크로 정의가 이름과 함께 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert blocks[0].name == "Geometry"
+        assert (blocks[-1].name, blocks[-1].line_range) == (
+            expected_name,
+            expected_range,
+        )
+
+    def test_do_block_as_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """do 블록 안의 변경 시 do 블록 호출과 함수/모듈 헤더가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(27, 27)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("Geometry", LineRange(1, 1), "enclosing-declaration"),
+            ("total_area", LineRange(25, 25), "enclosing-declaration"),
+            ("<do map>", LineRange(26, 29), None),
+        ]
+        assert blocks[1].text == "function total_area(shapes)"
+        assert blocks[2].scope_path == ("Geometry", "total_area")
+
+    def test_using_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """using 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(16, 16)])
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text == "using LinearAlgebra"
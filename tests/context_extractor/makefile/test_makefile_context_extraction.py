@@ -0,0 +1,84 @@
+"""ContextExtractor Makefile 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "makefile"
+SAMPLE_FILE = "Makefile"
+
+# 레시피를 탭 대신 공백으로 들여쓴 Makefile (make에서는 레시피가 아님)
+SPACE_INDENTED_MAKEFILE = "lint:\n    ruff check .\n"
+
+
+class TestMakefileRuleExtraction:
+    """Makefile 규칙/변수 대입 추출 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (8, "build", LineRange(6, 8)),
+            (11, "%.o", LineRange(10, 11)),
+            (14, "clean", LineRange(13, 14)),
+        ],
+    )
+    def test_recipe_line_returns_rule(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """레시피 라인 변경 시 타깃 라인을 포함한 규칙 전체가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("rule", expected_name, expected_range)]
+
+    def test_variable_assignment(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """변수 대입 변경 시 대입 하나만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(2, 2)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("CFLAGS", LineRange(2, 2))
+        ]
+        assert blocks[0].text == "CFLAGS = -O2 -Wall"
+
+    def test_include_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """include 지시어가 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(7, 7)])
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text == "include config.mk"
+
+    def test_space_indented_line_is_not_recipe(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """공백으로 들여쓴 라인은 레시피로 보지 않아 규칙 전체를 반환하지 않는지 테스트."""
+        blocks = extract_blocks(
+            SPACE_INDENTED_MAKEFILE, [LineRange(2, 2)], include_dependencies=False
+        )
+
+        assert ("lint", LineRange(1, 2)) not in [
+            (block.name, block.line_range) for block in blocks
+        ]
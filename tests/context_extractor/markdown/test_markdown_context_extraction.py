@@ -0,0 +1,118 @@
+"""ContextExtractor Markdown 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "markdown"
+SAMPLE_FILE = "sample_guide.md"
+SECTION_REASON = "enclosing-section"
+
+
+class TestMarkdownProseExtraction:
+    """Markdown 본문 변경 시 본문 블록과 헤딩 경로 추출 테스트."""
+
+    def test_paragraph_with_heading_path(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """문단 변경 시 헤딩 경로 블록과 문단을 함께 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(7, 7)])
+
+        assert [(block.text, block.reason) for block in blocks] == [
+            ("# Guide > ## Install", SECTION_REASON),
+            ("Install the CLI with pip:", None),
+        ]
+        assert blocks[0].line_range == LineRange(5, 5)
+        assert blocks[1].line_range == LineRange(7, 7)
+        assert blocks[1].scope_path == ("# Guide", "## Install")
+
+    def test_nested_list_item(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 목록 항목 변경 시 가장 안쪽 항목만 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(15, 15)])
+
+        assert [block.block_type for block in blocks] == ["atx_heading", "list_item"]
+        assert blocks[1].line_range == LineRange(15, 15)
+        assert blocks[1].text == "  - OpenAI, Anthropic or Google"
+
+    def test_list_item_includes_nested_items(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """하위 목록을 가진 항목 변경 시 하위 목록까지 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(14, 14)])
+
+        assert blocks[1].block_type == "list_item"
+        assert blocks[1].line_range == LineRange(14, 15)
+
+    def test_code_fence_is_returned_whole(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """코드 펜스 안의 변경은 펜스 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(10, 10)])
+
+        assert blocks[1].block_type == "fenced_code_block"
+        assert blocks[1].line_range == LineRange(9, 11)
+        assert blocks[1].scope_path == ("# Guide", "## Install")
+
+    def test_changed_heading(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """헤딩 자체의 변경은 헤딩 블록과 상위 헤딩 경로를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(5, 5)])
+
+        assert [(block.text, block.name) for block in blocks] == [
+            ("# Guide", "Guide"),
+            ("## Install", "Install"),
+        ]
+        assert blocks[0].reason == SECTION_REASON
+        assert blocks[1].scope_path == ("# Guide",)
+
+    def test_blank_line_extracts_nothing(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """블록 사이 빈 줄만의 변경은 아무것도 반환하지 않는지 테스트."""
+        assert extract_blocks(sample_file_content, [LineRange(16, 16)]) == []
+
+
+class TestMarkdownSetextHeadings:
+    """Setext 헤딩의 섹션 계층 계산 테스트."""
+
+    def test_setext_level_two_replaces_atx_sibling(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`---` 밑줄 헤딩이 앞의 `##` 섹션을 닫고 같은 레벨로 이어지는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(21, 21)])
+
+        assert blocks[0].text == "# Guide > ## Configuration"
+        assert blocks[0].line_range == LineRange(17, 17)
+        assert blocks[1].line_range == LineRange(20, 21)
+        assert blocks[1].scope_path == ("# Guide", "## Configuration")
+
+    def test_setext_level_one_starts_new_hierarchy(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`===` 밑줄 헤딩이 새 최상위 섹션을 시작하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(26, 26)])
+
+        assert blocks[0].text == "# Troubleshooting"
+        assert blocks[0].line_range == LineRange(23, 23)
+        assert blocks[1].scope_path == ("# Troubleshooting",)
@@ -0,0 +1,35 @@
+import std/[macros, tables]
+
+type
+  Item* = object
+    name*: string
+    quantity: int
+
+  Inventory* = ref object
+    items: Table[string, Item]
+
+proc newInventory*(): Inventory =
+  ## Creates an empty inventory.
+  Inventory(items: initTable[string, Item]())
+
+func totalQuantity*(inv: Inventory): int =
+  for item in inv.items.values:
+    result += item.quantity
+
+method describe(inv: Inventory): string {.base.} =
+  "inventory of " & $inv.items.len
+
+template withItem(inv: Inventory, key: string, body: untyped) =
+  if key in inv.items:
+    body
+
+macro log(msg: static string): untyped =
+  result = newCall("echo", newLit(msg))
+
+proc restock*(inv: Inventory, name: string, amount: int) =
+  proc clamp(value: int): int =
+    max(value, 0)
+
+  if name notin inv.items:
+    inv.items[name] = Item(name: name)
+  inv.items[name].quantity += clamp(amount)
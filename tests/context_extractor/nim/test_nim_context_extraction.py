@@ -0,0 +1,133 @@
+"""ContextExtractor Nim 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+LANGUAGE = "nim"
+SAMPLE_FILE = "sample_inventory.nim"
+
+
+class TestNimRoutineExtraction:
+    """proc/func/method/template/macro 추출 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "name", "expected_range", "first_line"),
+        [
+            (13, "newInventory", LineRange(11, 13), "proc newInventory*():"),
+            (17, "totalQuantity", LineRange(15, 17), "func totalQuantity*("),
+            (20, "describe", LineRange(19, 20), "method describe("),
+            (24, "withItem", LineRange(22, 24), "template withItem("),
+            (27, "log", LineRange(26, 27), "macro log("),
+        ],
+    )
+    def test_routine_with_signature(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        name: str,
+        expected_range: LineRange,
+        first_line: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """본문 변경 시 시그니처를 포함한 루틴 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [block.name for block in blocks] == [name]
+        assert blocks[0].line_range == expected_range
+        assert blocks[0].text.startswith(first_line)
+
+    def test_nested_proc(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 proc 안의 변경은 중첩 proc만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(31, 31)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["clamp"]
+        assert blocks[0].line_range == LineRange(30, 31)
+
+    def test_outer_proc_after_nested_proc(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 proc 뒤 들여쓰기 본문의 변경은 바깥 proc을 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(35, 35)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["restock"]
+        assert blocks[0].line_range == LineRange(29, 35)
+
+    def test_import_is_dependency(self, sample_file_content: str) -> None:
+        """import 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = ContextExtractor("nim").extract_context_blocks(
+            sample_file_content, [LineRange(13, 13)]
+        )
+
+        dependency_blocks = [block for block in blocks if block.is_dependency]
+        assert [block.text for block in dependency_blocks] == [
+            "import std/[macros, tables]"
+        ]
+
+
+class TestNimTypeExtraction:
+    """type 섹션의 object 정의 추출 테스트."""
+
+    def test_object_field(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """object 필드 변경 시 해당 타입 정의만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(6, 6)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["Item"]
+        assert blocks[0].line_range == LineRange(4, 6)
+        assert blocks[0].text.startswith("Item* = object")
+
+    def test_ref_object_field(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """ref object 필드 변경 시 해당 타입 정의를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(9, 9)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["Inventory"]
+        assert blocks[0].line_range == LineRange(8, 9)
+
+
+class TestNimMinimalBlock:
+    """Nim minimal_block 모드 테스트."""
+
+    def test_indented_block_includes_header(self, sample_file_content: str) -> None:
+        """들여쓰기 블록이 블록을 여는 헤더 라인부터 반환되는지 테스트."""
+        extractor = ContextExtractor("nim", ExtractionOptions(minimal_block=True))
+
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(34, 34)]
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(33, 34)]
@@ -0,0 +1,48 @@
+#import <Foundation/Foundation.h>
+#import "SampleCalculator.h"
+
+@interface SampleCalculator : NSObject
+
+@property (nonatomic, assign) NSInteger value;
+@property (nonatomic, strong) NSMutableArray<NSString *> *history;
+
+- (NSInteger)add:(NSInteger)a to:(NSInteger)b;
++ (instancetype)sharedCalculator;
+
+@end
+
+@interface SampleCalculator (Formatting)
+
+- (NSString *)formattedValue;
+
+@end
+
+static NSInteger clampValue(NSInteger value, NSInteger limit) {
+    return value > limit ? limit : value;
+}
+
+@implementation SampleCalculator
+
+- (NSInteger)add:(NSInteger)a to:(NSInteger)b {
+    NSInteger result = a + b;
+    [self.history addObject:[NSString stringWithFormat:@"%ld", (long)result]];
+    return clampValue(result, 1000);
+}
+
++ (instancetype)sharedCalculator {
+    static SampleCalculator *instance = nil;
+    if (instance == nil) {
+        instance = [[SampleCalculator alloc] init];
+    }
+    return instance;
+}
+
+@end
+
+@implementation SampleCalculator (Formatting)
+
+- (NSString *)formattedValue {
+    return [NSString stringWithFormat:@"Value: %ld", (long)self.value];
+}
+
+@end
@@ -0,0 +1,142 @@
+"""ContextExtractor Objective-C 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "objc"
+SAMPLE_FILE = "SampleCalculator.m"
+OBJCPP_SOURCE = """#import <Foundation/Foundation.h>
+#include <vector>
+
+@implementation Bridge
+
+- (void)push:(NSInteger)value {
+    std::vector<int> values;
+    values.push_back((int)value);
+}
+
+@end
+"""
+
+
+class TestObjcMethodExtraction:
+    """메서드 추출 테스트."""
+
+    def test_instance_method_includes_implementation_header(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드 내부 변경 시 @implementation 헤더와 selector 시그니처 포함 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(28, 28)], include_dependencies=False
+        )
+        header_block, method_block = blocks
+
+        assert header_block.text == "@implementation SampleCalculator"
+        assert header_block.line_range == LineRange(24, 24)
+        assert header_block.reason == "enclosing-declaration"
+        assert method_block.name == "-[SampleCalculator add:to:]"
+        assert method_block.line_range == LineRange(26, 30)
+        assert method_block.text.startswith(
+            "- (NSInteger)add:(NSInteger)a to:(NSInteger)b {"
+        )
+
+    def test_class_method_selector(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """+ 클래스 메서드의 selector 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(35, 35)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "+[SampleCalculator sharedCalculator]"
+
+    def test_category_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """카테고리 구현 안의 메서드 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(45, 45)], include_dependencies=False
+        )
+        header_block, method_block = blocks
+
+        assert header_block.text == "@implementation SampleCalculator (Formatting)"
+        assert method_block.name == "-[SampleCalculator (Formatting) formattedValue]"
+
+    def test_dependencies_are_collected(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """#import 의존성 블록 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(28, 28)])
+
+        assert blocks[0].is_dependency
+        assert "#import <Foundation/Foundation.h>" in blocks[0].text
+        assert '#import "SampleCalculator.h"' in blocks[0].text
+
+
+class TestObjcDeclarationExtraction:
+    """프로퍼티/인터페이스/C 함수 추출 테스트."""
+
+    def test_property_declaration(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """@property 변경 시 프로퍼티와 @interface 헤더 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(7, 7)], include_dependencies=False
+        )
+        header_block, property_block = blocks
+
+        assert header_block.text == "@interface SampleCalculator : NSObject"
+        assert property_block.name == "history"
+        assert property_block.line_range == LineRange(7, 7)
+
+    def test_interface_method_declaration(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """@interface 안의 메서드 선언 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(16, 16)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "-[SampleCalculator (Formatting) formattedValue]"
+
+    def test_c_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """C 함수 변경 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(21, 21)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(20, 22)
+
+
+class TestObjectiveCpp:
+    """Objective-C++(.mm) 처리 테스트."""
+
+    def test_mixed_source_does_not_crash(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """C++ 구문이 섞인 소스에서도 예외 없이 추출되는지 테스트."""
+        blocks = extract_blocks(
+            OBJCPP_SOURCE, [LineRange(8, 8)], include_dependencies=False
+        )
+
+        assert blocks
@@ -0,0 +1,52 @@
+unit Inventory;
+
+interface
+
+uses
+  SysUtils, Classes;
+
+type
+  TStockItem = record
+    Sku: string;
+    Quantity: Integer;
+  end;
+
+  TStockList = class(TObject)
+  private
+    FItems: array of TStockItem;
+  public
+    procedure Add(const Item: TStockItem);
+    function Total: Integer;
+  end;
+
+function FormatSku(const Sku: string): string;
+
+implementation
+
+procedure TStockList.Add(const Item: TStockItem);
+begin
+  SetLength(FItems, Length(FItems) + 1);
+  FItems[High(FItems)] := Item;
+end;
+
+function TStockList.Total: Integer;
+var
+  I: Integer;
+
+  procedure Accumulate(const Item: TStockItem);
+  begin
+    Result := Result + Item.Quantity;
+  end;
+
+begin
+  Result := 0;
+  for I := Low(FItems) to High(FItems) do
+    Accumulate(FItems[I]);
+end;
+
+function FormatSku(const Sku: string): string;
+begin
+  Result := UpperCase(Trim(Sku));
+end;
+
+end.
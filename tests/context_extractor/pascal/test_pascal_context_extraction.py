@@ -0,0 +1,92 @@
+"""ContextExtractor Pascal/Delphi 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "pascal"
+SAMPLE_FILE = "Inventory.pas"
+CONTAINER_REASON = "enclosing-declaration"
+
+
+class TestPascalScopeExtraction:
+    """Pascal 루틴, 클래스/레코드와 unit 섹션 추출 테스트."""
+
+    def test_method_with_enclosing_class(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드 본문의 변경 시 메서드 전체와 클래스 선언 라인이 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(28, 28)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("TStockList", LineRange(14, 14), CONTAINER_REASON),
+            ("Add", LineRange(26, 30), None),
+        ]
+        assert blocks[0].text == "TStockList = class(TObject)"
+        assert blocks[1].scope_path == ("Inventory", "TStockList")
+        assert blocks[1].unit_section == "implementation"
+
+    def test_nested_routine_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 루틴 안의 변경은 안쪽 루틴만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(38, 38)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "Accumulate"
+        assert blocks[-1].line_range == LineRange(36, 39)
+        assert blocks[-1].scope_path == ("Inventory", "TStockList", "Total")
+
+    def test_record_in_interface_section(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """interface 섹션 레코드의 변경 시 타입 선언과 unit 라인이 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(10, 10)], include_dependencies=False
+        )
+
+        assert [(block.line_range, block.reason) for block in blocks] == [
+            (LineRange(1, 1), CONTAINER_REASON),
+            (LineRange(9, 12), None),
+        ]
+        assert blocks[0].text == "unit Inventory;"
+        assert blocks[1].name == "TStockItem"
+        assert blocks[1].unit_section == "interface"
+
+    def test_free_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """클래스 밖 함수의 변경 시 함수 전체가 unit 경로와 함께 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(49, 49)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "FormatSku"
+        assert blocks[-1].line_range == LineRange(47, 50)
+        assert blocks[-1].scope_path == ("Inventory",)
+        assert blocks[-1].unit_section == "implementation"
+
+    def test_uses_clause_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`uses` 절이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(49, 49)])
+
+        assert [block.line_range for block in blocks if block.is_dependency] == [
+            LineRange(5, 6)
+        ]
@@ -0,0 +1,47 @@
+#!/usr/bin/perl
+use strict;
+use warnings;
+
+package Inventory::Store;
+
+use List::Util qw(sum);
+
+BEGIN {
+    our $VERSION = '1.02';
+}
+
+# 새 재고 저장소를 만든다
+sub new {
+    my ($class, %args) = @_;
+    my $self = { items => {}, owner => $args{owner} };
+    return bless $self, $class;
+}
+
+sub add_item {
+    my ($self, $name, $quantity) = @_;
+    $self->{items}{$name} += $quantity;
+    return $self->{items}{$name};
+}
+
+sub total {
+    my ($self) = @_;
+    return sum(values %{ $self->{items} }) // 0;
+}
+
+my $formatter = sub {
+    my ($name, $quantity) = @_;
+    return sprintf("%s: %d", $name, $quantity);
+};
+
+package Inventory::Report;
+
+sub render {
+    my ($store) = @_;
+    return join("\n", map { $formatter->($_, $store->{items}{$_}) } sort keys %{ $store->{items} });
+}
+
+END {
+    print "report finished\n";
+}
+
+1;
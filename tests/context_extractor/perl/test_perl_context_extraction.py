@@ -0,0 +1,95 @@
+"""ContextExtractor Perl 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "perl"
+SAMPLE_FILE = "SampleInventory.pm"
+
+
+class TestPerlSubroutineExtraction:
+    """Perl 서브루틴과 package 추출 테스트."""
+
+    def test_sub_with_enclosing_package(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """서브루틴 내부 변경 시 서브루틴 전체와 package 헤더 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(22, 22)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == [
+            "Inventory::Store",
+            "Inventory::Store::add_item",
+        ]
+        header, sub_block = blocks
+        assert header.text == "package Inventory::Store;"
+        assert header.reason == "enclosing-declaration"
+        assert header.line_range == LineRange(5, 5)
+        assert sub_block.line_range == LineRange(20, 24)
+        assert sub_block.text.startswith("sub add_item {")
+        assert sub_block.text.endswith("}")
+
+    def test_later_package_applies(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """두 번째 package 문 뒤의 서브루틴은 해당 package로 한정되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(39, 39)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == [
+            "Inventory::Report",
+            "Inventory::Report::render",
+        ]
+        assert blocks[0].text == "package Inventory::Report;"
+
+    def test_anonymous_sub(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """이름 없는 서브루틴이 `__ANON__` 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(33, 33)], include_dependencies=False
+        )
+
+        anonymous = blocks[-1]
+        assert anonymous.name == "Inventory::Store::__ANON__"
+        assert anonymous.line_range == LineRange(31, 34)
+        assert anonymous.text.startswith("sub {")
+
+    def test_begin_and_end_blocks(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """BEGIN/END 블록이 블록 단위로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(10, 10), LineRange(44, 44)],
+            include_dependencies=False,
+        )
+
+        names = [block.name for block in blocks if block.reason is None]
+        assert names == ["Inventory::Store::BEGIN", "Inventory::Report::END"]
+
+    def test_use_statements_are_dependencies(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """use 문이 의존성 블록으로 수집되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(28, 28)])
+
+        dependency = blocks[0]
+        assert dependency.is_dependency
+        assert "use strict;" in dependency.text
+        assert "use List::Util qw(sum);" in dependency.text
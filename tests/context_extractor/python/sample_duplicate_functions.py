@@ -0,0 +1,24 @@
+from decimal import Decimal
+
+
+def order_total(items, discount_rate):
+    """주문 항목 합계에 할인을 적용한다."""
+    subtotal = Decimal("0")
+    for item in items:
+        subtotal += item.price * item.quantity
+    discount = subtotal * Decimal(discount_rate)
+    return (subtotal - discount).quantize(Decimal("0.01"))
+
+
+def invoice_total(items, discount_rate):
+    # 청구서 항목 합계에 할인을 적용한다
+    subtotal = Decimal("0")
+    for item in items:
+        subtotal += item.price * item.quantity
+    discount = subtotal * Decimal(discount_rate)
+    return (subtotal - discount).quantize(Decimal("0.01"))
+
+
+def format_receipt(order_id, total):
+    header = f"Receipt #{order_id}"
+    return "\n".join([header, "-" * len(header), f"Total: {total}"])
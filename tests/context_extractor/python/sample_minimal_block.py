@@ -0,0 +1,15 @@
+"""minimal_block 모드 테스트용 샘플."""
+
+LIMIT = 10
+
+
+def classify(value: int) -> str:
+    if value < 0:
+        label = "negative"
+        return label
+    elif value > LIMIT:
+        return "large"
+    for step in range(value):
+        if step % 2:
+            continue
+    return "small"
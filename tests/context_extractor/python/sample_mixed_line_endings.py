@@ -0,0 +1,9 @@
+import os
+
+def first():
+    return os.sep
+
+
+def second(value):
+    total = value + 1
+    return total
@@ -0,0 +1,48 @@
+"""데코레이터와 중첩 함수의 블록 경계 테스트에 사용되는 샘플."""
+
+import functools
+
+
+def retry(times: int):
+    """호출을 times번까지 재시도하는 데코레이터"""
+
+    def decorator(func):
+        @functools.wraps(
+            func,
+        )
+        def wrapper(*args, **kwargs):
+            for attempt in range(times):
+                try:
+                    return func(*args, **kwargs)
+                except ValueError:
+                    if attempt == times - 1:
+                        raise
+            return None
+
+        return wrapper
+
+    return decorator
+
+
+class Pipeline:
+    """단계들을 순서대로 실행한다."""
+
+    @retry(
+        times=3,
+    )
+    def run(
+        self,
+        values: list[int],
+    ) -> int:
+        total = sum(
+            value
+            for value in values
+            if value > 0
+        )
+
+        def scale(value: int,
+                  factor: int = 2) -> int:
+            return (value
+                    * factor)
+
+        return scale(total)
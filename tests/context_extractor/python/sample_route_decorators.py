@@ -0,0 +1,20 @@
+"""인자가 있는 라우트 데코레이터 샘플."""
+
+from flask import Flask, request
+
+app = Flask(__name__)
+
+
+@app.route(
+    "/users",
+    methods=["POST"],
+)
+@require_role("admin")
+def create_user():
+    payload = request.get_json()
+    return save(payload), 201
+
+
+@app.route("/users/<int:user_id>", methods=["GET"])
+def get_user(user_id):
+    return load(user_id)
@@ -6,7 +6,7 @@ from pathlib import Path
 
 import pytest
 
-from selvage.src.context_extractor import ContextExtractor, LineRange
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
 
 
 class TestBasicFunctionExtraction:
@@ -657,3 +657,50 @@ class TestEdgeCases:
 
         # 빈 라인 범위에서도 적절히 처리되어야 함
         assert len(contexts) >= 0
+
+
+class TestStructuredBlockExtraction:
+    """extract_context_blocks() 구조화 결과 테스트."""
+
+    @pytest.fixture
+    def sample_file_content(self) -> str:
+        """테스트용 샘플 파일 내용을 반환합니다."""
+        file_path = Path(__file__).parent / "sample_class.py"
+        return file_path.read_text(encoding="utf-8")
+
+    @pytest.fixture
+    def extractor(self) -> ContextExtractor:
+        """Python용 ContextExtractor 인스턴스를 반환합니다."""
+        return ContextExtractor("python")
+
+    def test_blocks_keep_symbol_information(
+        self,
+        extractor: ContextExtractor,
+        sample_file_content: str,
+    ) -> None:
+        """블록에 라인 범위, 노드 타입, 심볼 이름이 보존되는지 테스트."""
+        changed_ranges = [LineRange(17, 17)]
+        blocks = extractor.extract_context_blocks(sample_file_content, changed_ranges)
+
+        assert len(blocks) == 2
+        dependency_block, class_block = blocks
+        assert dependency_block.is_dependency
+        assert dependency_block.text == "import json\nfrom typing import Any"
+        assert dependency_block.line_range == LineRange(3, 4)
+        assert not class_block.is_dependency
+        assert class_block.name == "SampleCalculator"
+        assert class_block.block_type == "class_definition"
+        assert class_block.line_range == LineRange(17, 97)
+
+    def test_blocks_format_to_extract_contexts_output(
+        self,
+        extractor: ContextExtractor,
+        sample_file_content: str,
+    ) -> None:
+        """구조화 블록 포맷팅 결과가 extract_contexts 결과와 같은지 테스트."""
+        changed_ranges = [LineRange(17, 17), LineRange(100, 102)]
+        blocks = extractor.extract_context_blocks(sample_file_content, changed_ranges)
+
+        assert ContextBlock.format_blocks(blocks) == extractor.extract_contexts(
+            sample_file_content, changed_ranges
+        )
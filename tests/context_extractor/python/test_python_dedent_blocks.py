@@ -0,0 +1,60 @@
+"""dedent_blocks 옵션 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SOURCE = """class Pipeline:
+    def build(self, values):
+        def multiply_recursive(index=0):
+            if index >= len(values):
+                return 1
+            return values[index] * multiply_recursive(index + 1)
+
+        return multiply_recursive()
+"""
+
+NESTED_FUNCTION = (
+    "def multiply_recursive(index=0):\n"
+    "    if index >= len(values):\n"
+    "        return 1\n"
+    "    return values[index] * multiply_recursive(index + 1)"
+)
+
+
+def _block(options: ExtractionOptions | None = None) -> ContextBlock:
+    """중첩 함수 본문 한 줄을 바꿨을 때의 multiply_recursive 블록을 반환한다."""
+    blocks = ContextExtractor("python", options=options).extract_context_blocks(
+        SOURCE, [LineRange(5, 5)]
+    )
+    return next(block for block in blocks if block.name == "multiply_recursive")
+
+
+class TestDedentBlocks:
+    """dedent_blocks 옵션 테스트."""
+
+    def test_removes_shared_indentation(self) -> None:
+        """중첩 함수의 공통 들여쓰기가 제거되고 상대 들여쓰기는 유지되는지 테스트."""
+        block = _block(ExtractionOptions(dedent_blocks=True))
+
+        assert block.text == NESTED_FUNCTION
+
+    def test_keeps_undedented_text_and_line_range(self) -> None:
+        """원본 텍스트와 파일 기준 라인 범위가 유지되는지 테스트."""
+        original = _block()
+        block = _block(ExtractionOptions(dedent_blocks=True))
+
+        assert block.undedented_text == original.text
+        assert block.line_range == original.line_range == LineRange(3, 6)
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 파일 기준 들여쓰기가 그대로인지 테스트."""
+        block = _block()
+
+        assert block.undedented_text is None
+        assert block.text.splitlines()[1] == "            if index >= len(values):"
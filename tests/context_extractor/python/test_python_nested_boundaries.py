@@ -0,0 +1,108 @@
+"""Python 데코레이터/중첩 함수/여러 줄 식의 블록 경계 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "python"
+SAMPLE_FILE = "sample_nested_boundaries.py"
+
+
+class TestPythonNestedBoundaries:
+    """데코레이터와 중첩 함수의 블록 경계 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected"),
+        [
+            # 두 단계 중첩된 데코레이터 함수 본문
+            (16, ("wrapper", LineRange(10, 20))),
+            # 여러 줄 데코레이터 인자
+            (11, ("wrapper", LineRange(10, 20))),
+            # 중첩 함수 뒤의 바깥 함수 본문
+            (22, ("decorator", LineRange(9, 22))),
+            # 메서드 본문의 여러 줄 제너레이터 식
+            (39, ("run", LineRange(30, 48))),
+            # 메서드의 여러 줄 데코레이터 인자
+            (31, ("run", LineRange(30, 48))),
+            # 메서드 안 중첩 함수의 이어지는 시그니처
+            (44, ("scale", LineRange(43, 46))),
+            # 중첩 함수 본문의 괄호 안 암묵적 줄 이음
+            (46, ("scale", LineRange(43, 46))),
+        ],
+    )
+    def test_change_resolves_to_innermost_definition(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected: tuple[str, LineRange],
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """변경 라인이 이를 감싸는 가장 안쪽 정의(데코레이터 포함)로 해석되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [expected]
+
+    def test_decorated_block_text_starts_at_decorator(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩된 데코레이터 함수 블록이 데코레이터 라인부터 시작하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(16, 16)])
+        wrapper = next(block for block in blocks if block.name == "wrapper")
+
+        assert wrapper.text.lstrip().startswith("@functools.wraps(")
+        assert wrapper.text.rstrip().endswith("return None")
+
+    @pytest.mark.parametrize(
+        ("ancestor_depth", "expected"),
+        [
+            (1, ("decorator", LineRange(9, 22))),
+            (2, ("retry", LineRange(6, 24))),
+        ],
+    )
+    def test_decorator_is_not_a_separate_ancestor(
+        self,
+        sample_file_content: str,
+        ancestor_depth: int,
+        expected: tuple[str, LineRange],
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """데코레이터가 붙은 정의를 조상 단계 하나로 세는지 테스트."""
+        options = ExtractionOptions(ancestor_depth=ancestor_depth)
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(16, 16)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [expected]
+
+    def test_decorator_line_change_counts_definition_once(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """데코레이터 라인의 변경에서 한 단계 바깥이 감싸는 함수인지 테스트."""
+        options = ExtractionOptions(ancestor_depth=1)
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(31, 31)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("Pipeline", LineRange(27, 48))
+        ]
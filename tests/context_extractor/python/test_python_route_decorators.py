@@ -0,0 +1,64 @@
+"""인자가 있는 데코레이터(라우트 설정) 보존 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "python"
+SAMPLE_FILE = "sample_route_decorators.py"
+
+CREATE_USER_HEADER = (
+    "@app.route(\n"
+    '    "/users",\n'
+    '    methods=["POST"],\n'
+    ")\n"
+    '@require_role("admin")\n'
+    "def create_user():\n"
+)
+
+
+class TestRouteDecorators:
+    """데코레이터 인자 보존 테스트."""
+
+    def test_body_change_keeps_multiline_decorator(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수 본문 변경 시 여러 줄 데코레이터가 인자와 함께 원문 그대로 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(14, 14)], include_dependencies=False
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(8, 15)]
+        assert blocks[0].text.startswith(CREATE_USER_HEADER)
+
+    def test_decorator_argument_change(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """데코레이터 인자의 변경이 데코레이터가 붙은 함수 전체로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(10, 10)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("create_user", LineRange(8, 15))
+        ]
+
+    def test_single_line_decorator(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """한 줄 데코레이터의 경로와 메서드 인자가 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(20, 20)], include_dependencies=False
+        )
+
+        assert blocks[0].text.splitlines()[0] == (
+            '@app.route("/users/<int:user_id>", methods=["GET"])'
+        )
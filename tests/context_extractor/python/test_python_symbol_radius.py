@@ -0,0 +1,94 @@
+"""Python 주변 심볼(symbol_radius) 컨텍스트 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+NEIGHBOR_REASON = "neighbor-symbol"
+
+PYTHON_SOURCE = '''def parse(text):
+    return text.split(",")
+
+
+def validate(items):
+    return all(items)
+
+
+def normalize(items):
+    cleaned = [item.strip() for item in items]
+    return [item.lower() for item in cleaned]
+
+
+def render(items):
+    lines = []
+    for item in items:
+        lines.append(f"- {item}")
+    return "\\n".join(lines)
+
+
+def main(text):
+    return render(normalize(parse(text)))
+'''
+
+
+def _neighbors(options: ExtractionOptions | None = None) -> list[ContextBlock]:
+    """normalize 본문 변경 시 포함된 주변 심볼 블록들을 라인 순으로 반환한다."""
+    blocks = ContextExtractor("python", options=options).extract_context_blocks(
+        PYTHON_SOURCE, [LineRange(10, 10)]
+    )
+    return [block for block in blocks if block.reason == NEIGHBOR_REASON]
+
+
+class TestSymbolRadius:
+    """symbol_radius 옵션 테스트."""
+
+    def test_signatures_of_nearest_symbols(self) -> None:
+        """앞뒤로 가장 가까운 심볼의 시그니처만 포함되는지 테스트."""
+        blocks = _neighbors(ExtractionOptions(symbol_radius=1))
+
+        assert [(block.name, block.line_range, block.text) for block in blocks] == [
+            ("validate", LineRange(5, 5), "def validate(items):"),
+            ("render", LineRange(14, 14), "def render(items):"),
+        ]
+
+    def test_bodies_are_symmetric(self) -> None:
+        """본문 포함 시 거리 2까지 앞뒤 심볼이 대칭으로 포함되는지 테스트."""
+        blocks = _neighbors(
+            ExtractionOptions(symbol_radius=2, symbol_radius_bodies=True)
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("parse", LineRange(1, 2)),
+            ("validate", LineRange(5, 6)),
+            ("render", LineRange(14, 18)),
+            ("main", LineRange(21, 22)),
+        ]
+
+    def test_line_budget_prefers_nearest(self) -> None:
+        """라인 상한 안에서 가까운 심볼부터 포함하고 긴 심볼은 건너뛰는지 테스트."""
+        blocks = _neighbors(
+            ExtractionOptions(
+                symbol_radius=2, symbol_radius_bodies=True, max_symbol_radius_lines=4
+            )
+        )
+
+        # 5줄짜리 render는 남은 라인 수를 넘어 건너뜀
+        assert [block.name for block in blocks] == ["parse", "validate"]
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 주변 심볼 블록이 없는지 테스트."""
+        assert _neighbors() == []
+
+    def test_negative_values_are_rejected(self) -> None:
+        """음수 값이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="symbol_radius"):
+            ExtractionOptions(symbol_radius=-1)
+        with pytest.raises(ValueError, match="max_symbol_radius_lines"):
+            ExtractionOptions(max_symbol_radius_lines=-1)
@@ -0,0 +1,107 @@
+"""ContextExtractor R 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "r"
+SAMPLE_FILE = "sample_pipeline.R"
+
+
+class TestRFunctionExtraction:
+    """R 함수 대입문 추출 테스트."""
+
+    def test_function_assignment(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """파이프 체인 안의 변경 시 감싸는 함수 대입문 전체 반환 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(7, 7)])
+
+        assert [block.name for block in blocks] == ["summarise_sales"]
+        assert blocks[0].line_range == LineRange(5, 10)
+        assert blocks[0].text.startswith("summarise_sales <- function(")
+
+    def test_nested_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 함수 안의 변경은 중첩 함수만 반환하고 바깥 함수를 경로로 기록."""
+        blocks = extract_blocks(sample_file_content, [LineRange(14, 14)])
+
+        assert [block.name for block in blocks] == ["format_row"]
+        assert blocks[0].line_range == LineRange(13, 15)
+        assert blocks[0].scope_path == ("build_report",)
+
+    def test_anonymous_function_uses_enclosing_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """lapply 익명 함수 안의 변경은 감싸는 이름 있는 함수를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(17, 17)])
+
+        assert [block.name for block in blocks] == ["build_report"]
+        assert blocks[0].line_range == LineRange(12, 20)
+
+    def test_top_level_pipe_chain(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수 밖 파이프 체인의 변경은 체인 전체 문장을 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(41, 41)])
+
+        assert [block.line_range for block in blocks] == [LineRange(40, 42)]
+        assert blocks[0].text.startswith("cleaned <- raw_sales |>")
+
+
+class TestRClassMethodExtraction:
+    """R6/S4 클래스 메서드 추출 테스트."""
+
+    def test_r6_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """R6 메서드가 `Class$method` 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(26, 26)])
+
+        assert [block.name for block in blocks] == ["Account$deposit"]
+        assert blocks[0].line_range == LineRange(25, 28)
+        assert blocks[0].text.startswith("deposit = function(amount) {")
+
+    def test_r6_field_returns_class_statement(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """메서드 밖 R6 필드 변경은 클래스 정의 문장 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(24, 24)])
+
+        assert [block.line_range for block in blocks] == [LineRange(22, 30)]
+
+    def test_s4_method(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """S4 setMethod 호출이 `Class$generic` 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(37, 37)])
+
+        assert [block.name for block in blocks] == ["Circle$area"]
+        assert blocks[0].line_range == LineRange(36, 38)
+
+    def test_s4_generic(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """S4 setGeneric 호출이 generic 이름으로 반환되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(34, 34)])
+
+        assert [block.name for block in blocks] == ["area"]
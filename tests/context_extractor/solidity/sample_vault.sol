@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "./Ownable.sol";
+
+interface IVault {
+    function deposit() external payable;
+}
+
+contract Vault is
+    IVault,
+    Ownable
+{
+    struct Account {
+        uint256 balance;
+        uint64 lastDeposit;
+    }
+
+    uint256 public totalDeposits;
+    mapping(address => Account) private accounts;
+    bool internal paused;
+
+    event Deposited(address indexed owner, uint256 amount);
+
+    modifier whenNotPaused() {
+        require(!paused, "paused");
+        _;
+    }
+
+    function deposit() external payable override whenNotPaused {
+        accounts[msg.sender].balance += msg.value;
+        accounts[msg.sender].lastDeposit = uint64(block.timestamp);
+        totalDeposits += msg.value;
+        emit Deposited(msg.sender, msg.value);
+    }
+
+    function pause() external onlyOwner {
+        paused = true;
+    }
+}
@@ -0,0 +1,117 @@
+"""ContextExtractor Solidity 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "solidity"
+SAMPLE_FILE = "sample_vault.sol"
+
+
+class TestSolidityContractExtraction:
+    """Solidity contract 멤버 추출 테스트."""
+
+    def test_function_with_contract_and_modifier(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수 안의 변경 시 함수, contract 헤더, 적용된 modifier가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(32, 32)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("Vault", LineRange(10, 10), "enclosing-declaration"),
+            ("whenNotPaused", LineRange(25, 28), "applied-modifier"),
+            ("deposit", LineRange(30, 35), None),
+        ]
+        assert blocks[0].text == "contract Vault is IVault, Ownable {"
+        assert blocks[2].scope_path == ("Vault",)
+        assert blocks[2].text.startswith(
+            "function deposit() external payable override whenNotPaused {"
+        )
+
+    def test_external_modifier_is_not_resolved(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """다른 파일에 정의된 modifier는 참고 블록 없이 함수만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(38, 38)], include_dependencies=False
+        )
+
+        assert [(block.name, block.reason) for block in blocks] == [
+            ("Vault", "enclosing-declaration"),
+            ("pause", None),
+        ]
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_text"),
+        [
+            (19, "uint256 public totalDeposits;"),
+            (20, "mapping(address => Account) private accounts;"),
+            (21, "bool internal paused;"),
+        ],
+    )
+    def test_state_variable_with_visibility(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_text: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """상태 변수 변경 시 가시성을 포함한 선언만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [block.text for block in blocks if block.reason is None] == [
+            expected_text
+        ]
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (7, "deposit", LineRange(7, 7)),
+            (15, "Account", LineRange(14, 17)),
+            (23, "Deposited", LineRange(23, 23)),
+        ],
+    )
+    def test_members_of_interface_struct_and_event(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """interface 함수, struct, event 선언 추출 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.name, block.line_range) for block in blocks if block.reason is None
+        ] == [(expected_name, expected_range)]
+
+    def test_pragma_and_import_are_dependencies(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """pragma와 import가 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(38, 38)])
+
+        assert blocks[0].is_dependency
+        assert "pragma solidity ^0.8.20;" in blocks[0].text
+        assert 'import "./Ownable.sol";' in blocks[0].text
@@ -0,0 +1,95 @@
+"""ContextExtractor Starlark(Bazel) 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "starlark"
+SAMPLE_FILE = "BUILD.bazel"
+
+
+class TestStarlarkRuleExtraction:
+    """Bazel 규칙 호출/def/대입 추출 테스트."""
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (9, "codec", LineRange(7, 12)),
+            (17, "codec_test", LineRange(14, 18)),
+        ],
+    )
+    def test_changed_attribute_returns_rule(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """규칙 속성 변경 시 `name` 속성으로 이름 붙은 규칙 전체가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("rule", expected_name, expected_range)]
+
+    def test_rule_without_name_uses_callee(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`name` 속성이 없는 호출은 호출한 함수 이름을 블록 이름으로 쓰는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(3, 3)], include_dependencies=False
+        )
+
+        assert [(block.block_type, block.name) for block in blocks] == [
+            ("rule", "package")
+        ]
+
+    def test_top_level_assignment(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """최상위 대입 변경 시 대입 하나만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(5, 5)], include_dependencies=False
+        )
+
+        assert [(block.block_type, block.name, block.text) for block in blocks] == [
+            ("assignment", "COPTS", 'COPTS = ["-Wall", "-Werror"]')
+        ]
+
+    def test_macro_def_returns_whole_function(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """def 안의 규칙 호출 변경은 함수 정의 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(24, 24)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("codec_fuzzer", LineRange(20, 25))
+        ]
+
+    def test_load_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """load 문이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(9, 9)])
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text.startswith('load("@rules_cc//cc:defs.bzl"')
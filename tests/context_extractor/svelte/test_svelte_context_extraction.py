@@ -0,0 +1,102 @@
+"""SvelteContextExtractor 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    LineRange,
+    SvelteContextExtractor,
+)
+from selvage.src.context_extractor.svelte_component_resolver import (
+    SvelteComponentResolver,
+)
+from selvage.src.exceptions import UnsupportedLanguageError
+
+SAMPLE_FILE = "Counter.svelte"
+
+
+def _context_blocks(
+    file_content: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """의존성 블록을 제외한 추출 블록들을 반환한다."""
+    blocks = SvelteContextExtractor().extract_context_blocks(
+        file_content, changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestSvelteComponentResolver:
+    """Svelte 컴포넌트 영역 분리 테스트."""
+
+    def test_sections(self, sample_file_content: str) -> None:
+        """module/instance script와 style 영역이 언어와 라인 범위로 나뉘는지 테스트."""
+        sections = SvelteComponentResolver().sections(sample_file_content)
+
+        assert [
+            (section.kind, section.language, section.line_range)
+            for section in sections
+        ] == [
+            ("module", "javascript", LineRange(1, 7)),
+            ("instance", "typescript", LineRange(9, 26)),
+            ("style", "css", LineRange(37, 41)),
+        ]
+
+
+class TestSvelteExtraction:
+    """Svelte 영역별 추출 테스트."""
+
+    def test_reactive_assignment(self, sample_file_content: str) -> None:
+        """`$:` 대입의 변경이 이름 있는 반응형 선언 블록으로 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(15, 15)])
+
+        assert [(block.block_type, block.name, block.line_range) for block in blocks] == [
+            ("reactive_declaration", "doubled", LineRange(15, 15))
+        ]
+        assert blocks[0].text == "\t$: doubled = count * 2;"
+
+    def test_reactive_block_statement(self, sample_file_content: str) -> None:
+        """`$: { ... }` 안의 변경이 선언 전체로 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(17, 17)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            (None, LineRange(16, 19))
+        ]
+        assert blocks[0].changed_lines == (17,)
+
+    def test_function_in_script(self, sample_file_content: str) -> None:
+        """script 함수 안의 변경이 파일 기준 라인의 함수 블록으로 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(22, 22)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("increment", LineRange(21, 23))
+        ]
+
+    def test_module_script_is_separate(self, sample_file_content: str) -> None:
+        """module script의 함수 블록이 scope_path로 구분되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(5, 5)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("restore", LineRange(4, 6))
+        ]
+        assert blocks[0].scope_path == ("module",)
+
+    def test_markup_control_block(self, sample_file_content: str) -> None:
+        """마크업의 변경이 감싸는 `{#if}` 블록 전체로 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(30, 30)])
+
+        assert [(block.block_type, block.line_range) for block in blocks] == [
+            ("if", LineRange(29, 33))
+        ]
+
+    def test_markup_outside_block(self, sample_file_content: str) -> None:
+        """블록 밖 마크업의 변경은 앞뒤 라인 윈도우로 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(34, 34)])
+
+        assert [block.line_range for block in blocks] == [LineRange(29, 39)]
+
+    def test_unsupported_language(self) -> None:
+        """svelte가 아닌 언어는 UnsupportedLanguageError가 발생하는지 테스트."""
+        with pytest.raises(UnsupportedLanguageError):
+            SvelteContextExtractor("vue")
@@ -0,0 +1,41 @@
+# 창고 재고를 관리하는 레거시 도구
+package require Tcl 8.6
+source [file join [file dirname [info script]] util.tcl]
+
+namespace eval ::inventory {
+    variable items [dict create]
+
+    proc add {name count} {
+        variable items
+        dict incr items $name $count
+        return [dict get $items $name]
+    }
+
+    proc report {} {
+        variable items
+        proc format_line {name count} {
+            return [format "%-10s %5d" $name $count]
+        }
+        dict for {name count} $items {
+            puts [format_line $name $count]
+        }
+    }
+
+    proc restock {threshold} {
+        variable items
+        set low [lmap name [dict keys $items] {
+            apply {{name threshold} {
+                variable items
+                expr {[dict get $items $name] < $threshold ? $name : ""}
+            } ::inventory} $name $threshold
+        }]
+        return [lsearch -all -inline -not $low ""]
+    }
+}
+
+set config {
+    warehouse main
+    currency KRW
+}
+
+inventory::add widget 3
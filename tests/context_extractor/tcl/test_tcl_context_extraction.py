@@ -0,0 +1,100 @@
+"""ContextExtractor Tcl 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "tcl"
+SAMPLE_FILE = "inventory.tcl"
+CONTAINER_REASON = "enclosing-declaration"
+
+
+class TestTclScopeExtraction:
+    """Tcl proc, apply 람다와 namespace 추출 테스트."""
+
+    def test_proc_with_enclosing_namespace(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """proc 안의 변경 시 proc 전체와 namespace 여는 라인이 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(10, 10)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("::inventory", LineRange(5, 5), CONTAINER_REASON),
+            ("add", LineRange(8, 12), None),
+        ]
+        assert blocks[0].text == "namespace eval ::inventory {"
+        assert blocks[1].block_type == "procedure"
+        assert blocks[1].scope_path == ("::inventory",)
+
+    def test_nested_proc_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩 proc 안의 변경은 안쪽 proc만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(17, 17)], include_dependencies=False
+        )
+
+        assert blocks[-1].name == "format_line"
+        assert blocks[-1].line_range == LineRange(16, 18)
+        assert blocks[-1].scope_path == ("::inventory", "report")
+
+    def test_apply_lambda_is_inner_scope(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """apply 람다 본문의 변경은 람다 호출만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(29, 29)], include_dependencies=False
+        )
+
+        assert blocks[-1].line_range == LineRange(27, 30)
+        assert blocks[-1].scope_path == ("::inventory", "restock")
+
+    def test_namespace_statement_is_not_whole_namespace(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """namespace 본문의 문장 변경은 namespace 전체가 아닌 문장만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(6, 6)], include_dependencies=False
+        )
+
+        assert [block.line_range for block in blocks] == [
+            LineRange(5, 5),
+            LineRange(6, 6),
+        ]
+
+    def test_braced_data_returns_enclosing_statement(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중괄호 데이터 안의 변경이 단어 단위로 잘리지 않고 문장 전체로 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(38, 38)], include_dependencies=False
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(36, 39)]
+
+    def test_package_require_and_source_are_dependencies(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`package require`와 `source` 명령이 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(41, 41)])
+
+        assert [block.line_range for block in blocks if block.is_dependency] == [
+            LineRange(2, 2),
+            LineRange(3, 3),
+        ]
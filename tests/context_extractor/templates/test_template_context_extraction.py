@@ -0,0 +1,154 @@
+"""TemplateContextExtractor(Go 템플릿/Jinja/ERB) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    LineRange,
+    TemplateContextExtractor,
+)
+from selvage.src.exceptions import UnsupportedLanguageError
+
+
+def _read(file_name: str) -> str:
+    """템플릿 fixture 파일 내용을 반환한다."""
+    return (Path(__file__).parent / file_name).read_text(encoding="utf-8")
+
+
+def _context_blocks(
+    language: str, file_name: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """의존성 블록을 제외한 추출 블록들을 반환한다."""
+    blocks = TemplateContextExtractor(language).extract_context_blocks(
+        _read(file_name), changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestGoTemplateExtraction:
+    """Go html/template 블록 추출 테스트."""
+
+    def test_innermost_named_block(self) -> None:
+        """`{{block}}` 안의 변경은 바깥 `{{define}}`이 아닌 해당 블록을 반환하는지 테스트."""
+        blocks = _context_blocks("gotemplate", "layout.gohtml", [LineRange(11, 11)])
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(10, 12)
+        assert (blocks[0].block_type, blocks[0].name) == ("block", "content")
+        assert blocks[0].scope_path == ("layout",)
+        assert blocks[0].changed_lines == (11,)
+
+    def test_named_block_preferred_over_control_block(self) -> None:
+        """`range` 안의 변경은 이를 감싸는 `{{define}}` 전체를 표현식과 함께 반환하는지 테스트."""
+        blocks = _context_blocks("gotemplate", "layout.gohtml", [LineRange(20, 20)])
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(17, 23), "items")
+        ]
+        assert '<li class="{{if .Done}}done{{end}}">{{.Name | html}}</li>' in (
+            blocks[0].text
+        )
+
+    def test_template_calls_are_dependencies(self) -> None:
+        """`{{template}}` 호출이 의존성 블록으로 수집되는지 테스트."""
+        blocks = TemplateContextExtractor("gotemplate").extract_context_blocks(
+            _read("layout.gohtml"), [LineRange(6, 6)]
+        )
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text == '{{template "styles" .}}\n{{template "footer" .}}'
+        assert blocks[1].name == "layout"
+
+    def test_change_outside_blocks_returns_nearby_lines(self) -> None:
+        """블록 밖의 변경은 앞뒤 라인을 반환하는지 테스트."""
+        blocks = _context_blocks("gotemplate", "layout.gohtml", [LineRange(25, 25)])
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(20, 25), None)
+        ]
+
+
+class TestJinjaExtraction:
+    """Jinja 블록/매크로 추출 테스트."""
+
+    def test_block_with_nested_loop(self) -> None:
+        """`{% for %}` 안의 변경은 감싸는 `{% block %}`을 반환하는지 테스트."""
+        blocks = _context_blocks("jinja", "page.html.j2", [LineRange(10, 10)])
+
+        assert [(block.line_range, block.name) for block in blocks] == [
+            (LineRange(7, 12), "content")
+        ]
+        assert "{{ order.total | round(2) }}" in blocks[0].text
+
+    def test_macro_and_single_line_block(self) -> None:
+        """매크로와 한 줄 블록이 각각의 이름으로 반환되는지 테스트."""
+        blocks = _context_blocks(
+            "jinja", "page.html.j2", [LineRange(5, 5), LineRange(15, 15)]
+        )
+
+        assert [(block.block_type, block.name, block.line_range) for block in blocks] == [
+            ("block", "title", LineRange(5, 5)),
+            ("macro", "order_row", LineRange(14, 16)),
+        ]
+
+    def test_commented_tags_are_ignored(self) -> None:
+        """`{# #}` 주석 안의 태그는 블록으로 보지 않는지 테스트."""
+        blocks = _context_blocks("jinja", "page.html.j2", [LineRange(4, 4)])
+
+        assert all(block.name != "ignored" for block in blocks)
+
+    def test_extends_and_imports_are_dependencies(self) -> None:
+        """extends/import 태그가 의존성 블록으로 수집되는지 테스트."""
+        blocks = TemplateContextExtractor("jinja").extract_context_blocks(
+            _read("page.html.j2"), [LineRange(8, 8)]
+        )
+
+        assert blocks[0].is_dependency
+        assert blocks[0].line_range == LineRange(1, 2)
+
+    def test_script_region_uses_host_extractor(self) -> None:
+        """script 영역의 변경은 JavaScript 추출기로 함수 단위를 반환하는지 테스트."""
+        blocks = TemplateContextExtractor(
+            "jinja", extract_scripts=True
+        ).extract_context_blocks(_read("page.html.j2"), [LineRange(20, 20)])
+
+        function_blocks = [block for block in blocks if block.name == "formatTotal"]
+        assert len(function_blocks) == 1
+        assert function_blocks[0].line_range == LineRange(19, 21)
+
+
+class TestErbExtraction:
+    """ERB 블록 추출 테스트."""
+
+    def test_content_for_block(self) -> None:
+        """`content_for :x do` 블록이 이름과 함께 반환되는지 테스트."""
+        blocks = _context_blocks("erb", "show.html.erb", [LineRange(5, 5)])
+
+        assert [(block.block_type, block.name, block.line_range) for block in blocks] == [
+            ("content_for", "sidebar", LineRange(4, 6))
+        ]
+
+    def test_do_block(self) -> None:
+        """이름 없는 `do` 블록 안의 변경은 제어 블록을 반환하는지 테스트."""
+        blocks = _context_blocks("erb", "show.html.erb", [LineRange(10, 10)])
+
+        assert [(block.block_type, block.line_range) for block in blocks] == [
+            ("do", LineRange(9, 11))
+        ]
+
+
+class TestTemplateExtractorErrors:
+    """지원 언어와 입력 검증 테스트."""
+
+    def test_unsupported_language(self) -> None:
+        """템플릿 언어가 아니면 예외가 발생하는지 테스트."""
+        with pytest.raises(UnsupportedLanguageError):
+            TemplateContextExtractor("python")
+
+    def test_empty_changed_ranges(self) -> None:
+        """변경 범위가 없으면 빈 리스트를 반환하는지 테스트."""
+        assert TemplateContextExtractor("jinja").extract_context_blocks("x", []) == []
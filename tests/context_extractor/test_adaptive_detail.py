@@ -0,0 +1,99 @@
+"""ExtractionOptions.adaptive_detail(함수 크기별 상세 수준) 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+LANGUAGE = "go"
+SAMPLE_FILE = "go/SampleCalculator.go"
+
+PYTHON_SOURCE = """def long_function(values):
+    total = 0
+    for value in values:
+        total += value
+    total *= 2
+    total -= 1
+    return total
+"""
+
+
+class TestAdaptiveDetail:
+    """함수 크기에 따른 전체/요약 블록 테스트."""
+
+    def test_small_function_is_included_in_full(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """기본 임계값 이하인 SampleCalculator.go 메서드는 전체가 포함되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(126, 126)],
+            include_dependencies=False,
+            options=ExtractionOptions(adaptive_detail=True),
+        )
+
+        assert blocks[0].name == "MultiplyAndFormat"
+        assert blocks[0].text == "\n".join(sample_file_content.split("\n")[83:133])
+
+    def test_long_function_keeps_signature_and_window(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """임계값을 넘는 함수는 시그니처, 변경 주변 윈도우, 닫는 라인만 남는지 테스트."""
+        options = ExtractionOptions(
+            adaptive_detail=True,
+            adaptive_detail_max_lines=20,
+            adaptive_detail_window_lines=2,
+        )
+        lines = sample_file_content.split("\n")
+
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(126, 126)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert blocks[0].line_range == LineRange(84, 133)
+        assert blocks[0].text.split("\n") == [
+            lines[83],
+            "\t... [lines 85-123 omitted]",
+            *lines[123:128],
+            "\t... [lines 129-132 omitted]",
+            "}",
+        ]
+
+    def test_indented_function_has_no_closing_line(self) -> None:
+        """들여쓰기 언어는 본문 시작 전까지를 시그니처로 보고 끝까지 생략하는지 테스트."""
+        options = ExtractionOptions(
+            adaptive_detail=True,
+            adaptive_detail_max_lines=3,
+            adaptive_detail_window_lines=0,
+        )
+
+        blocks = ContextExtractor("python", options).extract_context_blocks(
+            PYTHON_SOURCE, [LineRange(4, 4)]
+        )
+
+        assert blocks[0].text == (
+            "def long_function(values):\n"
+            "    ... [lines 2-3 omitted]\n"
+            "        total += value\n"
+            "    ... [lines 5-7 omitted]"
+        )
+
+    def test_invalid_threshold_is_rejected(self) -> None:
+        """0 이하의 임계값에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="adaptive_detail_max_lines"):
+            ExtractionOptions(adaptive_detail_max_lines=0)
@@ -0,0 +1,118 @@
+"""사용자 정의 앵커 쿼리 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    AnchorQueryRegistry,
+    ContextBlock,
+    ExtractionOptions,
+    LineRange,
+)
+
+LANGUAGE = "python"
+
+HANDLER_QUERY = """
+(class_definition
+  name: (identifier) @name
+  (#match? @name "Handler$")) @anchor
+"""
+
+SOURCE = """class OrderHandler:
+    def get(self, order_id):
+        return self.store.find(order_id)
+
+    def post(self, order):
+        self.store.save(order)
+        return order
+
+
+class OrderStore:
+    def save(self, order):
+        self.items.append(order)
+"""
+
+
+class TestAnchorRegistration:
+    """앵커 쿼리 등록 시 검증 테스트."""
+
+    def test_registers_valid_query(self) -> None:
+        """올바른 쿼리가 언어별로 등록되는지 테스트."""
+        registry = AnchorQueryRegistry()
+
+        registry.register("python", HANDLER_QUERY)
+
+        assert registry.languages() == ["python"]
+
+    def test_rejects_unknown_node_type(self) -> None:
+        """문법에 없는 노드 타입을 쓰면 등록 시 오류가 발생하는지 테스트."""
+        registry = AnchorQueryRegistry()
+
+        with pytest.raises(ValueError, match="unknown_node_type"):
+            registry.register("python", "(class_definitoin) @anchor")
+
+    def test_rejects_query_without_anchor_capture(self) -> None:
+        """`@anchor` 캡처가 없는 쿼리는 등록되지 않는지 테스트."""
+        registry = AnchorQueryRegistry()
+
+        with pytest.raises(ValueError, match="@anchor"):
+            registry.register("python", "(class_definition) @handler")
+        assert registry.languages() == []
+
+    def test_rejects_unsupported_language(self) -> None:
+        """지원하지 않는 언어의 쿼리는 등록되지 않는지 테스트."""
+        registry = AnchorQueryRegistry()
+
+        with pytest.raises(ValueError, match="cobol"):
+            registry.register("cobol", "(program) @anchor")
+
+
+class TestAnchorExtraction:
+    """앵커 노드 포함 테스트."""
+
+    def test_method_change_without_anchor(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """앵커가 없으면 변경된 메서드만 반환하는지 테스트."""
+        blocks = extract_blocks(SOURCE, [LineRange(6, 6)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("post", LineRange(5, 7))
+        ]
+
+    def test_anchor_replaces_enclosed_symbol(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """앵커 안의 변경은 심볼 대신 앵커 전체를 반환하는지 테스트."""
+        registry = AnchorQueryRegistry()
+        registry.register("python", HANDLER_QUERY)
+
+        blocks = extract_blocks(
+            SOURCE,
+            [LineRange(6, 6)],
+            options=ExtractionOptions(anchor_queries=registry),
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("OrderHandler", LineRange(1, 7))
+        ]
+
+    def test_change_outside_anchor_is_unaffected(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """앵커 밖의 변경은 기존 심볼 단위로 반환하는지 테스트."""
+        registry = AnchorQueryRegistry()
+        registry.register("python", HANDLER_QUERY)
+
+        blocks = extract_blocks(
+            SOURCE,
+            [LineRange(12, 12)],
+            options=ExtractionOptions(anchor_queries=registry),
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("save", LineRange(11, 12))
+        ]
@@ -0,0 +1,60 @@
+"""CallGraphOrderer 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor.call_graph_orderer import CallGraphOrderer
+
+
+class TestCallGraphOrderer:
+    """이름 기반 호출 그래프 정렬 테스트."""
+
+    def test_caller_before_callee(self) -> None:
+        """호출하는 심볼이 소스 순서와 관계없이 호출 대상보다 앞에 오는지 테스트."""
+        order = CallGraphOrderer().order(
+            [
+                ({"NewSampleCalculator"}, set()),
+                ({"AdvancedCalculatorFactory"}, {"NewSampleCalculator"}),
+            ]
+        )
+
+        assert order == [1, 0]
+
+    def test_unrelated_symbols_keep_source_order(self) -> None:
+        """호출 관계가 없는 심볼들은 소스 순서를 유지하는지 테스트."""
+        order = CallGraphOrderer().order(
+            [({"a"}, {"print"}), ({"b"}, set()), ({"c"}, {"len"})]
+        )
+
+        assert order == [0, 1, 2]
+
+    def test_call_chain(self) -> None:
+        """호출 체인이 호출 방향대로 정렬되는지 테스트."""
+        order = CallGraphOrderer().order(
+            [({"leaf"}, set()), ({"middle"}, {"leaf"}), ({"root"}, {"middle"})]
+        )
+
+        assert order == [2, 1, 0]
+
+    def test_cycle_falls_back_to_source_order(self) -> None:
+        """순환 호출은 소스 순서로 배치되는지 테스트."""
+        order = CallGraphOrderer().order(
+            [({"is_even"}, {"is_odd"}), ({"is_odd"}, {"is_even"}), ({"main"}, set())]
+        )
+
+        assert order == [0, 1, 2]
+
+    def test_recursion_is_ignored(self) -> None:
+        """자기 자신을 호출하는 심볼은 순서 제약이 생기지 않는지 테스트."""
+        order = CallGraphOrderer().order(
+            [({"helper"}, set()), ({"walk"}, {"walk", "helper"})]
+        )
+
+        assert order == [1, 0]
+
+    def test_cycle_is_placed_after_its_caller(self) -> None:
+        """순환 묶음을 호출하는 심볼이 묶음보다 앞에 오는지 테스트."""
+        order = CallGraphOrderer().order(
+            [({"ping"}, {"pong"}), ({"pong"}, {"ping"}), ({"main"}, {"ping"})]
+        )
+
+        assert order == [2, 0, 1]
@@ -0,0 +1,224 @@
+"""언어별 주석 연결 전략 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Generator
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+from selvage.src.context_extractor.comment_association import (
+    CommentStrategyRegistry,
+    LeadingCommentStrategy,
+)
+
+FIXTURE_DIR = Path(__file__).parent
+
+GO_LEADING_COMMENT_SOURCE = """package main
+
+// Add는 두 수를 더한다.
+// 결과는 int로 반환된다.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Sub는 빈 줄로 떨어져 있어 연결되지 않는다.
+
+func Sub(a, b int) int {
+	return a - b
+}
+"""
+
+JAVA_SOURCE = """public class Calculator {
+    /**
+     * 두 수를 더한다.
+     */
+    @Override
+    public int add(int a, int b) {
+        return a + b;
+    }
+}
+"""
+
+TYPESCRIPT_SOURCE = """/** 두 수를 더한다. */
+export function add(a: number, b: number): number {
+  return a + b;
+}
+"""
+
+KOTLIN_SOURCE = """class Calculator {
+    // 두 수를 더한다.
+    fun add(a: Int, b: Int): Int {
+        return a + b
+    }
+}
+"""
+
+
+def _context_blocks(
+    language: str, source: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """주석 연결 옵션을 켜고 추출한 컨텍스트 블록(의존성 제외)을 반환한다."""
+    extractor = ContextExtractor(language, ExtractionOptions(include_comments=True))
+    blocks = extractor.extract_context_blocks(source, changed_ranges)
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestGoCommentAssociation:
+    """Go 주석 연결 테스트."""
+
+    def test_leading_comments_are_attached(self) -> None:
+        """선언 위 연속 // 주석이 블록에 포함되는지 테스트."""
+        blocks = _context_blocks("go", GO_LEADING_COMMENT_SOURCE, [LineRange(6, 6)])
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(3, 7)
+        assert blocks[0].doc_comment == (
+            "// Add는 두 수를 더한다.\n// 결과는 int로 반환된다."
+        )
+        assert blocks[0].text.startswith("// Add는 두 수를 더한다.\n")
+
+    def test_comment_separated_by_blank_line_is_not_attached(self) -> None:
+        """빈 줄로 떨어진 주석은 기본 거리 규칙에서 연결되지 않는지 테스트."""
+        blocks = _context_blocks("go", GO_LEADING_COMMENT_SOURCE, [LineRange(12, 12)])
+
+        assert blocks[0].doc_comment is None
+        assert blocks[0].line_range == LineRange(11, 13)
+
+    def test_body_doc_comment_convention(self) -> None:
+        """NewSampleCalculator의 /** ... */ 문서 주석이 연결되는지 테스트."""
+        source = (FIXTURE_DIR / "go" / "SampleCalculator.go").read_text(
+            encoding="utf-8"
+        )
+        blocks = _context_blocks("go", source, [LineRange(46, 50)])
+
+        assert blocks[0].name == "NewSampleCalculator"
+        assert blocks[0].doc_comment == "/**\n\t * 계산기 초기화\n\t */"
+
+
+class TestCustomDistance:
+    """언어별 주석 연결 거리 설정 테스트."""
+
+    @pytest.fixture
+    def relaxed_go_strategy(self) -> Generator[None, None, None]:
+        """빈 줄 1개까지 허용하는 Go 전략을 등록하고 테스트 후 복원합니다."""
+        original = CommentStrategyRegistry.get("go")
+        CommentStrategyRegistry.register(
+            "go", LeadingCommentStrategy(frozenset({"comment"}), max_blank_lines=1)
+        )
+        yield
+        CommentStrategyRegistry.register("go", original)
+
+    def test_registered_strategy_is_used(self, relaxed_go_strategy: None) -> None:
+        """등록한 전략의 빈 줄 허용치가 적용되는지 테스트."""
+        blocks = _context_blocks("go", GO_LEADING_COMMENT_SOURCE, [LineRange(12, 12)])
+
+        assert blocks[0].doc_comment == "// Sub는 빈 줄로 떨어져 있어 연결되지 않는다."
+        assert blocks[0].line_range == LineRange(9, 13)
+
+    def test_negative_distance_is_rejected(self) -> None:
+        """음수 빈 줄 허용치에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="max_blank_lines"):
+            LeadingCommentStrategy(frozenset({"comment"}), max_blank_lines=-1)
+
+
+class TestPythonCommentAssociation:
+    """Python docstring 연결 테스트."""
+
+    def test_docstring_is_attached_without_changing_text(self) -> None:
+        """docstring이 연결되고 블록 텍스트와 범위는 그대로인지 테스트."""
+        source = (FIXTURE_DIR / "python" / "sample_class.py").read_text(
+            encoding="utf-8"
+        )
+        blocks = _context_blocks("python", source, [LineRange(31, 31)])
+
+        assert blocks[0].name == "validate_inputs"
+        assert blocks[0].doc_comment == '"""내부 함수: 입력값 검증"""'
+        assert blocks[0].line_range == LineRange(29, 31)
+
+
+class TestJavaCommentAssociation:
+    """Java 주석 연결 테스트."""
+
+    def test_javadoc_is_attached(self) -> None:
+        """메서드 위 Javadoc이 연결되는지 테스트."""
+        blocks = _context_blocks("java", JAVA_SOURCE, [LineRange(7, 7)])
+
+        assert blocks[0].name == "add"
+        assert blocks[0].doc_comment == "/**\n     * 두 수를 더한다.\n     */"
+        assert blocks[0].line_range == LineRange(2, 8)
+
+
+class TestTypeScriptCommentAssociation:
+    """TypeScript 주석 연결 테스트."""
+
+    def test_comment_above_export_is_attached(self) -> None:
+        """export 선언 위 주석이 연결되는지 테스트."""
+        blocks = _context_blocks("typescript", TYPESCRIPT_SOURCE, [LineRange(3, 3)])
+
+        assert blocks[0].doc_comment == "/** 두 수를 더한다. */"
+        assert blocks[0].line_range.start_line == 1
+
+
+class TestKotlinCommentAssociation:
+    """Kotlin 주석 연결 테스트."""
+
+    def test_line_comment_is_attached(self) -> None:
+        """함수 위 // 주석이 연결되는지 테스트."""
+        blocks = _context_blocks("kotlin", KOTLIN_SOURCE, [LineRange(4, 4)])
+
+        assert blocks[0].doc_comment == "// 두 수를 더한다."
+        assert blocks[0].line_range == LineRange(2, 5)
+
+
+class TestCommentAssociationDisabled:
+    """옵션이 꺼진 기본 동작 테스트."""
+
+    def test_no_comment_by_default(self) -> None:
+        """기본 옵션에서는 주석이 연결되지 않는지 테스트."""
+        extractor = ContextExtractor("go")
+        blocks = extractor.extract_context_blocks(
+            GO_LEADING_COMMENT_SOURCE, [LineRange(6, 6)]
+        )
+
+        assert all(block.doc_comment is None for block in blocks)
+        assert blocks[-1].line_range == LineRange(5, 7)
+
+
+class TestExportedDocComments:
+    """공개 심볼 문서 주석 연결 옵션 테스트."""
+
+    def _blocks(self, source: str, changed_line: int) -> list[ContextBlock]:
+        """공개 심볼 문서 주석 옵션만 켜고 추출한 컨텍스트 블록을 반환한다."""
+        extractor = ContextExtractor(
+            "go", ExtractionOptions(include_exported_doc_comments=True)
+        )
+        blocks = extractor.extract_context_blocks(
+            source, [LineRange(changed_line, changed_line)]
+        )
+        return [block for block in blocks if not block.is_dependency]
+
+    def test_exported_symbol_doc_comment_is_attached(self) -> None:
+        """주석 연결이 꺼져 있어도 공개 함수의 /** ... */ 문서 주석이 연결되는지 테스트."""
+        source = (FIXTURE_DIR / "go" / "SampleCalculator.go").read_text(
+            encoding="utf-8"
+        )
+        blocks = self._blocks(source, 42)
+
+        assert blocks[0].name == "NewSampleCalculator"
+        assert blocks[0].doc_comment == "/**\n\t * 계산기 초기화\n\t */"
+
+    def test_unexported_symbol_follows_comment_setting(self) -> None:
+        """비공개 함수는 include_comments 설정을 따라 주석이 연결되지 않는지 테스트."""
+        source = GO_LEADING_COMMENT_SOURCE.replace("Add", "add")
+        blocks = self._blocks(source, 6)
+
+        assert blocks[0].name == "add"
+        assert blocks[0].doc_comment is None
+        assert blocks[0].line_range == LineRange(5, 7)
@@ -0,0 +1,381 @@
+"""ContextRenderer 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    IndentStyle,
+    LineRange,
+    RenderOptions,
+    render_context,
+)
+
+
+@pytest.fixture
+def results() -> list[ExtractedFileContext]:
+    """두 파일의 추출 결과를 반환합니다."""
+    return [
+        ExtractedFileContext(
+            file_path="calc/sample.py",
+            language="python",
+            blocks=[
+                ContextBlock(
+                    text="def add(a, b):\n    return a + b",
+                    line_range=LineRange(10, 11),
+                    block_type="function_definition",
+                    name="add",
+                ),
+                ContextBlock(
+                    text="import json",
+                    line_range=LineRange(1, 1),
+                    is_dependency=True,
+                ),
+            ],
+        ),
+        ExtractedFileContext(
+            file_path="calc/util.py",
+            language="python",
+            blocks=[
+                ContextBlock(
+                    text="def sub(a, b):\n\n    return a - b",
+                    line_range=LineRange(98, 100),
+                    block_type="function_definition",
+                    name="sub",
+                ),
+            ],
+        ),
+    ]
+
+
+class TestRenderContext:
+    """render_context() 기본 렌더링 테스트."""
+
+    def test_render_with_file_and_symbol_headers(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """파일 헤더, 의존성 블록, 심볼 헤더가 순서대로 렌더링되는지 테스트."""
+        rendered = render_context(results)
+
+        expected = (
+            "==== File: calc/sample.py (python) ====\n"
+            "---- Dependencies/Imports ----\n"
+            "import json\n"
+            "---- Context Block 1 (Lines 10-11): add ----\n"
+            "def add(a, b):\n"
+            "    return a + b\n"
+            "==== File: calc/util.py (python) ====\n"
+            "---- Context Block 1 (Lines 98-100): sub ----\n"
+            "def sub(a, b):\n"
+            "\n"
+            "    return a - b"
+        )
+        assert rendered == expected
+
+    def test_render_is_deterministic(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """같은 입력에 대해 항상 같은 출력이 나오는지 테스트."""
+        assert render_context(results) == render_context(results)
+
+    def test_render_with_line_number_gutter(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """라인 번호 gutter가 원본 라인 번호로 우측 정렬되는지 테스트."""
+        rendered = render_context(
+            results[1:], RenderOptions(include_line_numbers=True)
+        )
+
+        assert rendered == (
+            "==== File: calc/util.py (python) ====\n"
+            "---- Context Block 1 (Lines 98-100): sub ----\n"
+            " 98 | def sub(a, b):\n"
+            " 99 |\n"
+            "100 |     return a - b"
+        )
+
+    def test_gutter_width_is_shared_within_file(self) -> None:
+        """같은 파일의 블록들이 가장 큰 라인 번호 너비로 정렬되는지 테스트."""
+        result = ExtractedFileContext(
+            file_path="calc/units.py",
+            language="python",
+            blocks=[
+                ContextBlock(
+                    text="# 단위 변환\nKM = 1000", line_range=LineRange(8, 9), name="KM"
+                ),
+                ContextBlock(
+                    text="def km(m):\n    return m / KM",
+                    line_range=LineRange(120, 121),
+                    name="km",
+                ),
+            ],
+        )
+
+        rendered = render_context([result], RenderOptions(include_line_numbers=True))
+
+        assert rendered.split("\n")[2:4] == ["  8 | # 단위 변환", "  9 | KM = 1000"]
+        assert rendered.split("\n")[5:] == [
+            "120 | def km(m):",
+            "121 |     return m / KM",
+        ]
+        assert result.context_blocks[0].text == "# 단위 변환\nKM = 1000"
+
+    def test_render_without_dependencies(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """의존성 블록 제외 옵션 테스트."""
+        rendered = render_context(results, RenderOptions(include_dependencies=False))
+        assert "Dependencies/Imports" not in rendered
+
+    def test_render_empty_results(self) -> None:
+        """빈 결과는 빈 문자열로 렌더링되는지 테스트."""
+        assert render_context([]) == ""
+
+    def test_render_with_indent_normalization(self) -> None:
+        """탭 파일의 공백 들여쓰기가 gutter와 함께 탭으로 통일되는지 테스트."""
+        block = ContextBlock(
+            text="func f() {\n    x := 1\n\treturn x\n}",
+            line_range=LineRange(3, 6),
+            name="f",
+        )
+        result = ExtractedFileContext(
+            file_path="main.go",
+            language="go",
+            blocks=[block],
+            indent_style=IndentStyle.TABS,
+        )
+
+        rendered = render_context(
+            [result], RenderOptions(include_line_numbers=True, tab_width=4)
+        )
+
+        assert rendered.split("\n")[2:] == [
+            "3 | func f() {",
+            "4 | \tx := 1",
+            "5 | \treturn x",
+            "6 | }",
+        ]
+        assert block.text == "func f() {\n    x := 1\n\treturn x\n}"
+
+    def test_invalid_tab_width(self) -> None:
+        """잘못된 탭 너비에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="tab_width"):
+            RenderOptions(tab_width=0)
+
+
+@pytest.fixture
+def go_result() -> ExtractedFileContext:
+    """구조체, 메서드, 최상위 함수가 섞인 Go 추출 결과를 반환합니다."""
+    receiver = ("SampleCalculator",)
+    return ExtractedFileContext(
+        file_path="calc/SampleCalculator.go",
+        language="go",
+        blocks=[
+            ContextBlock(
+                text="type SampleCalculator struct {}",
+                line_range=LineRange(33, 33),
+                name="SampleCalculator",
+            ),
+            ContextBlock(
+                text="func NewSampleCalculator() {}",
+                line_range=LineRange(42, 42),
+                name="NewSampleCalculator",
+            ),
+            ContextBlock(
+                text="func (calc *SampleCalculator) AddNumbers() {}",
+                line_range=LineRange(53, 53),
+                name="AddNumbers",
+                scope_path=receiver,
+            ),
+            ContextBlock(
+                text="func HelperFunction() {}",
+                line_range=LineRange(154, 154),
+                name="HelperFunction",
+            ),
+            ContextBlock(
+                text="func (calc *SampleCalculator) CalculateCircleArea() {}",
+                line_range=LineRange(135, 135),
+                name="CalculateCircleArea",
+                scope_path=receiver,
+            ),
+        ],
+    )
+
+
+class TestRenderGrouping:
+    """최상위 선언별 묶음 렌더링 테스트."""
+
+    def test_methods_are_grouped_under_declaration(
+        self, go_result: ExtractedFileContext
+    ) -> None:
+        """메서드가 구조체 아래로 묶이고 최상위 함수는 그대로 표시되는지 테스트."""
+        rendered = render_context(
+            [go_result], RenderOptions(group_by_declaration=True)
+        )
+
+        assert rendered == (
+            "==== File: calc/SampleCalculator.go (go) ====\n"
+            "== Declaration: SampleCalculator ==\n"
+            "---- Context Block 1 (Lines 33-33): SampleCalculator ----\n"
+            "type SampleCalculator struct {}\n"
+            "---- Context Block 2 (Lines 53-53): AddNumbers ----\n"
+            "func (calc *SampleCalculator) AddNumbers() {}\n"
+            "---- Context Block 3 (Lines 135-135): CalculateCircleArea ----\n"
+            "func (calc *SampleCalculator) CalculateCircleArea() {}\n"
+            "---- Context Block 4 (Lines 42-42): NewSampleCalculator ----\n"
+            "func NewSampleCalculator() {}\n"
+            "---- Context Block 5 (Lines 154-154): HelperFunction ----\n"
+            "func HelperFunction() {}"
+        )
+
+    def test_group_without_declaration_block(
+        self, go_result: ExtractedFileContext
+    ) -> None:
+        """선언 블록 없이 메서드만 있어도 선언 헤더 아래로 묶이는지 테스트."""
+        go_result.blocks = go_result.blocks[1:]
+
+        rendered = render_context(
+            [go_result], RenderOptions(group_by_declaration=True)
+        )
+
+        assert rendered.split("\n")[1:4] == [
+            "---- Context Block 1 (Lines 42-42): NewSampleCalculator ----",
+            "func NewSampleCalculator() {}",
+            "== Declaration: SampleCalculator ==",
+        ]
+
+    def test_flat_is_default(self, go_result: ExtractedFileContext) -> None:
+        """기본값은 라인 순서의 평면 목록인지 테스트."""
+        rendered = render_context([go_result])
+
+        assert "Declaration" not in rendered
+        assert [
+            line.split(": ")[-1]
+            for line in rendered.split("\n")
+            if line.startswith("---- Context Block")
+        ] == [
+            "SampleCalculator ----",
+            "NewSampleCalculator ----",
+            "AddNumbers ----",
+            "CalculateCircleArea ----",
+            "HelperFunction ----",
+        ]
+
+
+class TestRenderBudget:
+    """렌더링 예산(max_chars) 처리 테스트."""
+
+    def test_truncates_and_notes_omitted_blocks(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """예산 초과 시 이후 블록이 생략되고 안내가 추가되는지 테스트."""
+        max_chars = 260
+        rendered = render_context(results, RenderOptions(max_chars=max_chars))
+
+        assert len(rendered) <= max_chars
+        assert "calc/sample.py" in rendered
+        assert "calc/util.py" not in rendered
+        assert rendered.endswith(
+            "[... 1 block(s) omitted: context budget of 260 chars exceeded ...]"
+        )
+
+    def test_dropped_block_frees_budget_for_later_block(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """렌더링 전에 제거된 블록의 예산이 이후 블록에 쓰이는지 테스트."""
+        max_chars = 280
+        assert "calc/util.py" not in render_context(
+            results, RenderOptions(max_chars=max_chars)
+        )
+
+        sample, util = results
+        trimmed = ExtractedFileContext(
+            file_path=sample.file_path,
+            language=sample.language,
+            blocks=sample.dependency_blocks,
+        )
+
+        rendered = render_context([trimmed, util], RenderOptions(max_chars=max_chars))
+
+        assert len(rendered) <= max_chars
+        assert "calc/util.py" in rendered
+        assert "omitted" not in rendered
+
+    def test_no_truncation_note_when_within_budget(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """예산 안에 들어오면 생략 안내가 없는지 테스트."""
+        rendered = render_context(results, RenderOptions(max_chars=10_000))
+        assert "omitted" not in rendered
+        assert rendered == render_context(results)
+
+    def test_invalid_budget(self) -> None:
+        """잘못된 예산 값에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="max_chars"):
+            RenderOptions(max_chars=0)
+
+
+def _go_imports_result(file_path: str, imports: str) -> ExtractedFileContext:
+    """import 블록과 함수 블록 하나를 가진 Go 추출 결과를 만든다."""
+    return ExtractedFileContext(
+        file_path=file_path,
+        language="go",
+        blocks=[
+            ContextBlock(
+                text=f"import (\n{imports}\n)",
+                line_range=LineRange(3, 3 + imports.count("\n") + 2),
+                is_dependency=True,
+            ),
+            ContextBlock(
+                text="func F() {}",
+                line_range=LineRange(20, 20),
+                name="F",
+            ),
+        ],
+    )
+
+
+class TestRenderSharedImports:
+    """여러 파일 공통 import 공유 렌더링 테스트."""
+
+    def test_shared_lines_move_to_preamble(self) -> None:
+        """공통 import가 맨 앞에 한 번만 표시되고 파일별 블록에서 빠지는지 테스트."""
+        results = [
+            _go_imports_result("a.go", '\t"fmt"\n\t"os"'),
+            _go_imports_result("b.go", '\t"fmt"\n\t"strings"'),
+            _go_imports_result("c.go", '\t"fmt"'),
+        ]
+
+        rendered = render_context(results, RenderOptions(share_imports=True))
+
+        assert rendered.startswith(
+            '==== Shared Imports ====\n---- Used by: a.go, b.go, c.go ----\n"fmt"\n'
+        )
+        assert rendered.count('"fmt"') == 1
+        assert 'import (\n\t"os"\n)' in rendered
+        assert 'import (\n\t"strings"\n)' in rendered
+        # 공유 라인만 있던 c.go는 의존성 블록 없이 심볼 블록만 남는다
+        c_section = rendered.split("==== File: c.go (go) ====\n")[1]
+        assert c_section.startswith("---- Context Block 1")
+
+    def test_whitespace_is_normalized(self) -> None:
+        """들여쓰기와 연속 공백만 다른 import 라인을 같은 라인으로 보는지 테스트."""
+        results = [
+            _go_imports_result("a.go", '\tlog  "github.com/sirupsen/logrus"'),
+            _go_imports_result("b.go", '    log "github.com/sirupsen/logrus"'),
+        ]
+
+        rendered = render_context(results, RenderOptions(share_imports=True))
+
+        assert rendered.count("logrus") == 1
+        assert "---- Used by: a.go, b.go ----" in rendered
+
+    def test_single_file_is_unaffected(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """공유할 import가 없으면 옵션이 꺼진 경우와 출력이 같은지 테스트."""
+        assert render_context(
+            results, RenderOptions(share_imports=True)
+        ) == render_context(results)
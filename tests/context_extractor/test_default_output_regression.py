@@ -0,0 +1,86 @@
+"""기본 옵션의 extract_contexts 출력이 옵션 도입 이전 출력과 같은지 확인하는 테스트.
+
+ExtractionOptions 도입 이전 버전에서 얻은 블록 라인 범위를 고정해, 기본값으로
+추가된 동작이 기존 언어의 블록 선택이나 헤더 형식을 바꾸지 않는지 확인한다.
+"""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextExtractor, LineRange
+
+SAMPLES = Path(__file__).parent
+
+DEPENDENCY_HEADER = "---- Dependencies/Imports ----"
+
+# (언어, 샘플 파일, 변경 범위들, 이전 버전의 컨텍스트 블록 라인 범위들)
+CASES = [
+    ("python", "python/sample_class.py", [(20, 21)], [(20, 24)]),
+    ("python", "python/sample_class.py", [(30, 32)], [(26, 46)]),
+    ("python", "python/sample_class.py", [(77, 80), (64, 84)], [(48, 84)]),
+    ("python", "python/sample_class.py", [(55, 57)], [(51, 62)]),
+    ("python", "python/sample_class.py", [(26, 26)], [(26, 46)]),
+    ("python", "python/sample_class.py", [(100, 100)], [(100, 111)]),
+    ("python", "python/sample_class.py", [(7, 8)], [(7, 8)]),
+    (
+        "python",
+        "python/sample_class.py",
+        [(7, 8), (89, 91), (135, 136)],
+        [(7, 8), (89, 91), (135, 136)],
+    ),
+    ("java", "java/SampleCalculator.java", [(33, 40)], [(33, 40)]),
+    ("java", "java/SampleCalculator.java", [(42, 44)], [(42, 73)]),
+    ("java", "java/SampleCalculator.java", [(75, 78), (120, 125)], [(75, 127)]),
+    ("java", "java/SampleCalculator.java", [(88, 95)], [(88, 95)]),
+    ("java", "java/SampleCalculator.java", [(155, 160)], [(155, 170)]),
+    ("java", "java/SampleCalculator.java", [(182, 190)], [(182, 207)]),
+    ("java", "java/SampleCalculator.java", [(9, 10)], [(8, 18)]),
+    ("java", "java/SampleCalculator.java", [(212, 213)], [(211, 217)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(31, 37)], [(23, 130)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(39, 66)], [(39, 66)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(80, 85)], [(80, 85)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(132, 148)], [(132, 148)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(150, 172)], [(150, 172)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(6, 8)], [(6, 8)]),
+    ("kotlin", "kotlin/SampleCalculator.kt", [(175, 179)], [(175, 179)]),
+]
+
+
+class TestDefaultOutputRegression:
+    """기본 옵션 출력 회귀 테스트."""
+
+    @pytest.mark.parametrize(
+        ("language", "sample_file", "changed_ranges", "expected_spans"), CASES
+    )
+    def test_default_output_matches_previous_version(
+        self,
+        language: str,
+        sample_file: str,
+        changed_ranges: list[tuple[int, int]],
+        expected_spans: list[tuple[int, int]],
+    ) -> None:
+        """기본 옵션의 블록 헤더와 본문이 이전 버전 출력과 같은지 테스트.
+
+        헤더에 `[changed: ...]` 같은 표시가 붙지 않고, 각 블록 본문이 샘플
+        파일의 해당 라인들과 같아야 한다.
+        """
+        content = (SAMPLES / sample_file).read_text(encoding="utf-8")
+        lines = content.split("\n")
+
+        contexts = ContextExtractor(language).extract_contexts(
+            content, [LineRange(start, end) for start, end in changed_ranges]
+        )
+
+        assert [context.split("\n", 1)[0] for context in contexts] == [
+            DEPENDENCY_HEADER,
+            *(
+                f"---- Context Block {number} (Lines {start}-{end}) ----"
+                for number, (start, end) in enumerate(expected_spans, 1)
+            ),
+        ]
+        for context, (start, end) in zip(contexts[1:], expected_spans):
+            expected_body = "\n".join(lines[start - 1 : end]).lstrip()
+            assert context.split("\n", 1)[1] == expected_body
@@ -0,0 +1,94 @@
+"""추출/렌더링 결과가 실행마다 같은지 확인하는 테스트 케이스."""
+
+from __future__ import annotations
+
+import os
+import subprocess
+import sys
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    ExtractedFileContext,
+    ExtractionOptions,
+    LineRange,
+    render_context,
+)
+
+SAMPLES = Path(__file__).parent
+
+# (언어, 샘플 파일, 변경 범위들)
+CASES = [
+    (
+        "go",
+        SAMPLES / "go" / "SampleCalculator.go",
+        [LineRange(33, 33), LineRange(50, 52), LineRange(85, 105), LineRange(204, 207)],
+    ),
+    (
+        "python",
+        SAMPLES / "python" / "sample_class.py",
+        [LineRange(10, 12), LineRange(40, 60), LineRange(120, 130)],
+    ),
+]
+
+# 집합으로 노드를 모으는 추가 컨텍스트 옵션들을 모두 켬
+OPTIONS = ExtractionOptions(
+    include_signature_types=True,
+    include_call_sites=True,
+    symbol_radius=1,
+    order_by_call_graph=True,
+    include_sibling_overloads=True,
+    include_file_outline=True,
+)
+
+# 다른 해시 시드의 프로세스에서 CASES[argv[1]]을 렌더링하는 스크립트
+RENDER_SCRIPT = """
+import sys
+
+from tests.context_extractor.test_deterministic_output import CASES, render
+
+sys.stdout.write(render(CASES[int(sys.argv[1])]))
+"""
+
+
+def render(case: tuple[str, Path, list[LineRange]]) -> str:
+    """새 추출기로 샘플을 추출해 렌더링한 문서를 반환한다."""
+    language, path, changed_ranges = case
+    extractor = ContextExtractor(language, OPTIONS)
+    blocks = extractor.extract_context_blocks(
+        path.read_text(encoding="utf-8"), changed_ranges
+    )
+    return render_context(
+        [ExtractedFileContext(file_path=path.name, language=language, blocks=blocks)]
+    )
+
+
+class TestDeterministicOutput:
+    """같은 입력의 추출 결과가 바이트 단위로 같은지 테스트."""
+
+    @pytest.mark.parametrize("case_index", range(len(CASES)))
+    def test_repeated_extraction_is_identical(self, case_index: int) -> None:
+        """같은 프로세스에서 여러 번 추출해도 결과가 같은지 테스트."""
+        outputs = {render(CASES[case_index]).encode("utf-8") for _ in range(5)}
+
+        assert len(outputs) == 1
+
+    @pytest.mark.parametrize("case_index", range(len(CASES)))
+    def test_extraction_is_identical_across_hash_seeds(self, case_index: int) -> None:
+        """해시 시드가 다른 프로세스들에서도 결과가 같은지 테스트."""
+        repo_root = Path(__file__).resolve().parents[2]
+        outputs = set()
+        for seed in ("0", "1", "12345"):
+            completed = subprocess.run(
+                [sys.executable, "-c", RENDER_SCRIPT, str(case_index)],
+                cwd=repo_root,
+                env={**os.environ, "PYTHONHASHSEED": seed},
+                capture_output=True,
+                check=True,
+            )
+            outputs.add(completed.stdout)
+
+        assert len(outputs) == 1
+        assert outputs != {b""}
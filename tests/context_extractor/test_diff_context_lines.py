@@ -0,0 +1,53 @@
+"""diff 컨텍스트 라인과 실제 변경 라인 구분 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import ContextExtractor, LineRange
+from selvage.src.diff_parser.models.hunk import Hunk
+
+SOURCE = """def first(value):
+    return value + 1
+
+
+def only_in_context(value):
+    return value * 2
+
+
+def last(value):
+    return value - 1
+"""
+
+# first와 last의 본문만 바뀌고 only_in_context는 diff 컨텍스트에만 등장하는 hunk
+HUNK_TEXT = """@@ -1,10 +1,10 @@
+ def first(value):
+-    return value
++    return value + 1
+ 
+ 
+ def only_in_context(value):
+     return value * 2
+ 
+ 
+ def last(value):
+-    return value
++    return value - 1"""
+
+
+class TestDiffContextLines:
+    """hunk의 실제 변경 라인만으로 변경된 심볼을 판단하는지 테스트."""
+
+    def test_change_ranges_exclude_context_lines(self) -> None:
+        """hunk 위치 범위는 전체를, 변경 범위는 실제 변경 라인만 포함하는지 테스트."""
+        hunk = Hunk.from_hunk_text(HUNK_TEXT)
+
+        assert hunk.change_line == LineRange(2, 10)
+        assert hunk.get_change_ranges() == [LineRange(2, 2), LineRange(10, 10)]
+
+    def test_symbol_only_in_context_is_excluded(self) -> None:
+        """diff 컨텍스트에만 등장하는 심볼은 추출되지 않는지 테스트."""
+        hunk = Hunk.from_hunk_text(HUNK_TEXT)
+        blocks = ContextExtractor("python").extract_context_blocks(
+            SOURCE, hunk.get_change_ranges()
+        )
+
+        assert [block.name for block in blocks] == ["first", "last"]
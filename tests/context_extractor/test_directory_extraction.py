@@ -0,0 +1,182 @@
+"""DirectoryContextExtractor(디렉토리 전체 심볼 수집) 테스트 케이스."""
+
+from __future__ import annotations
+
+import os
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    DirectoryContextExtractor,
+    ExtractedFileContext,
+    LanguageFilter,
+    extract_tree,
+)
+
+CALCULATOR_SOURCE = """def add(a, b):
+    return a + b
+
+
+class Calculator:
+    def multiply(self, a, b):
+        return a * b
+"""
+
+
+def _write(path: Path, content: str) -> None:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content, encoding="utf-8")
+
+
+@pytest.fixture
+def project(tmp_path: Path) -> Path:
+    """제외 파일과 생성 파일이 섞인 테스트 디렉토리를 생성합니다."""
+    _write(tmp_path / "src" / "calculator.py", CALCULATOR_SOURCE)
+    _write(tmp_path / "src" / "generated_pb2.py", "x = 1\n")
+    _write(tmp_path / "build" / "out.py", "y = 2\n")
+    _write(tmp_path / "README.txt", "not code\n")
+    _write(tmp_path / ".selvageignore", "build/\n")
+    _write(tmp_path / ".gitattributes", "*_pb2.py linguist-generated\n")
+    return tmp_path
+
+
+class TestDirectoryExtraction:
+    """디렉토리 순회와 파일별 심볼 수집 테스트."""
+
+    def test_collects_symbols_keyed_by_path(self, project: Path) -> None:
+        """지원 언어 파일의 모든 심볼이 루트 기준 경로로 수집되는지 테스트."""
+        results = extract_tree(project)
+
+        assert list(results) == ["src/calculator.py"]
+        result = results["src/calculator.py"]
+        assert result.extraction_mode == ExtractedFileContext.OUTLINE_MODE
+        assert [block.name for block in result.blocks] == [
+            "add",
+            "Calculator",
+            "multiply",
+        ]
+
+    def test_too_large_file(self, project: Path) -> None:
+        """크기 제한을 넘는 파일은 읽지 않고 too-large로 기록되는지 테스트."""
+        results = DirectoryContextExtractor(max_file_bytes=10).extract(project)
+
+        assert results["src/calculator.py"].status == (
+            ExtractedFileContext.TOO_LARGE_STATUS
+        )
+
+    def test_undecodable_file_is_per_file_error(self, tmp_path: Path) -> None:
+        """읽을 수 없는 파일은 전체 실패 대신 파일별 오류로 기록되는지 테스트."""
+        (tmp_path / "broken.py").write_bytes(b"\xff\xfe\x00def")
+
+        results = extract_tree(tmp_path)
+
+        assert results["broken.py"].status == ExtractedFileContext.ERROR_STATUS
+        assert results["broken.py"].error_message
+
+    @pytest.mark.skipif(not hasattr(os, "symlink"), reason="심볼릭 링크 미지원")
+    def test_symlink_loop_terminates(self, tmp_path: Path) -> None:
+        """디렉토리 심볼릭 링크 순환이 있어도 순회가 끝나고 링크가 기록되는지 테스트."""
+        _write(tmp_path / "pkg" / "empty.py", "")
+        os.symlink(tmp_path, tmp_path / "pkg" / "loop", target_is_directory=True)
+
+        results = extract_tree(tmp_path)
+
+        assert list(results) == ["pkg/empty.py", "pkg/loop"]
+        assert results["pkg/empty.py"].blocks == []
+        assert results["pkg/loop"].status == ExtractedFileContext.SYMLINK_LOOP_STATUS
+
+    @pytest.mark.skipif(not hasattr(os, "symlink"), reason="심볼릭 링크 미지원")
+    def test_symlink_outside_root_is_not_followed(self, tmp_path: Path) -> None:
+        """루트 밖을 가리키는 링크는 기본적으로 따라가지 않고 기록되는지 테스트."""
+        root = tmp_path / "repo"
+        _write(root / "main.py", "")
+        _write(tmp_path / "vendor" / "lib.py", "")
+        os.symlink(tmp_path / "vendor", root / "vendor", target_is_directory=True)
+        os.symlink(tmp_path / "vendor" / "lib.py", root / "lib.py")
+
+        results = extract_tree(root)
+
+        assert list(results) == ["lib.py", "main.py", "vendor"]
+        assert results["lib.py"].status == (
+            ExtractedFileContext.SYMLINK_OUTSIDE_ROOT_STATUS
+        )
+        assert results["vendor"].status == (
+            ExtractedFileContext.SYMLINK_OUTSIDE_ROOT_STATUS
+        )
+
+    @pytest.mark.skipif(not hasattr(os, "symlink"), reason="심볼릭 링크 미지원")
+    def test_follow_external_symlinks(self, tmp_path: Path) -> None:
+        """follow_external_symlinks가 켜지면 루트 밖 링크도 따라가는지 테스트."""
+        root = tmp_path / "repo"
+        _write(root / "main.py", "")
+        _write(tmp_path / "vendor" / "lib.py", "")
+        os.symlink(tmp_path / "vendor", root / "vendor", target_is_directory=True)
+
+        results = extract_tree(root, follow_external_symlinks=True)
+
+        assert list(results) == ["main.py", "vendor/lib.py"]
+        assert all(
+            result.status == ExtractedFileContext.OK_STATUS
+            for result in results.values()
+        )
+
+    def test_code_owners(self, project: Path) -> None:
+        """include_code_owners가 켜지면 파일별 결과에 소유자가 기록되는지 테스트."""
+        _write(project / "CODEOWNERS", "*.py @acme/core\n")
+
+        results = DirectoryContextExtractor(include_code_owners=True).extract(project)
+
+        assert [owner.name for owner in results["src/calculator.py"].owners] == [
+            "@acme/core"
+        ]
+
+    def test_not_a_directory(self, tmp_path: Path) -> None:
+        """디렉토리가 아닌 경로면 예외가 발생하는지 테스트."""
+        with pytest.raises(NotADirectoryError):
+            extract_tree(tmp_path / "missing")
+
+    def test_invalid_worker_count(self) -> None:
+        """스레드 수가 1 미만이면 예외가 발생하는지 테스트."""
+        with pytest.raises(ValueError):
+            DirectoryContextExtractor(max_workers=0)
+
+
+class TestLanguageFilter:
+    """언어 허용/거부 목록 테스트."""
+
+    def test_filtered_language_is_reported(self, project: Path) -> None:
+        """허용 목록에 없는 언어의 파일은 language-filtered로 기록되는지 테스트."""
+        _write(project / "cmd" / "main.go", "package main\n")
+
+        results = extract_tree(
+            project, language_filter=LanguageFilter(allow_languages=frozenset({"go"}))
+        )
+
+        assert results["src/calculator.py"].status == (
+            ExtractedFileContext.LANGUAGE_FILTERED_STATUS
+        )
+        assert results["src/calculator.py"].blocks == []
+
+    def test_deny_list_takes_precedence(self) -> None:
+        """같은 언어가 양쪽 목록에 있으면 거부 목록이 우선하는지 테스트."""
+        language_filter = LanguageFilter(
+            allow_languages=frozenset({"go", "python"}),
+            deny_languages=frozenset({"python"}),
+        )
+
+        assert language_filter.allows("go")
+        assert not language_filter.allows("python")
+        assert not language_filter.allows("java")
+
+    def test_empty_allow_list_allows_all(self) -> None:
+        """허용 목록이 비어 있으면 거부되지 않은 모든 언어를 허용하는지 테스트."""
+        language_filter = LanguageFilter(deny_languages=frozenset({"java"}))
+
+        assert language_filter.allows("kotlin")
+        assert not language_filter.allows("java")
+
+    def test_unknown_language_is_rejected(self) -> None:
+        """지원하지 않는 언어 이름이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="golang"):
+            LanguageFilter(allow_languages=frozenset({"golang"}))
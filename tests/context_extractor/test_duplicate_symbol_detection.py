@@ -0,0 +1,108 @@
+"""DuplicateSymbolDetector(유사 심볼 탐지) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    DuplicateSymbolDetector,
+    ExtractedFileContext,
+    ExtractionSummary,
+    LineRange,
+)
+
+ORDER_TOTAL = LineRange(6, 6)
+INVOICE_TOTAL = LineRange(15, 15)
+FORMAT_RECEIPT = LineRange(23, 23)
+
+
+@pytest.fixture
+def sample_file_content() -> str:
+    """거의 같은 두 함수가 있는 테스트용 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "python" / "sample_duplicate_functions.py"
+    return file_path.read_text(encoding="utf-8")
+
+
+def _extract(
+    file_path: str, file_content: str, changed_ranges: list[LineRange]
+) -> ExtractedFileContext:
+    """Python 파일 하나의 추출 결과를 반환한다."""
+    return ContextExtractor("python").extract_file_context(
+        file_path, file_content, changed_ranges
+    )
+
+
+class TestDuplicateSymbolDetection:
+    """토큰 유사도 기반 유사 심볼 탐지 테스트."""
+
+    def test_near_duplicate_functions_across_files(
+        self, sample_file_content: str
+    ) -> None:
+        """이름과 주석만 다른 두 함수가 파일을 넘어 한 쌍으로 탐지되는지 테스트."""
+        results = [
+            _extract("billing/orders.py", sample_file_content, [ORDER_TOTAL]),
+            _extract(
+                "billing/invoices.py",
+                sample_file_content,
+                [INVOICE_TOTAL, FORMAT_RECEIPT],
+            ),
+        ]
+
+        pairs = DuplicateSymbolDetector().detect(results)
+
+        assert len(pairs) == 1
+        pair = pairs[0]
+        assert (pair.first_block.name, pair.second_block.name) == (
+            "order_total",
+            "invoice_total",
+        )
+        assert pair.is_cross_file
+        assert pair.similarity >= DuplicateSymbolDetector.DEFAULT_THRESHOLD
+
+    def test_threshold_filters_pairs(self, sample_file_content: str) -> None:
+        """임계값이 1.0이면 완전히 같지 않은 쌍은 제외되는지 테스트."""
+        result = _extract(
+            "billing/orders.py", sample_file_content, [ORDER_TOTAL, INVOICE_TOTAL]
+        )
+
+        assert DuplicateSymbolDetector(threshold=1.0).detect([result]) == []
+
+    def test_max_symbols_caps_comparison(self, sample_file_content: str) -> None:
+        """max_symbols를 넘는 심볼은 비교하지 않는지 테스트."""
+        result = _extract(
+            "billing/orders.py", sample_file_content, [ORDER_TOTAL, INVOICE_TOTAL]
+        )
+
+        assert DuplicateSymbolDetector(max_symbols=1).detect([result]) == []
+
+    def test_summary_reports_pairs_when_requested(
+        self, sample_file_content: str
+    ) -> None:
+        """요청한 경우에만 요약 메타데이터에 유사 심볼 쌍이 기록되는지 테스트."""
+        result = _extract(
+            "billing/orders.py", sample_file_content, [ORDER_TOTAL, INVOICE_TOTAL]
+        )
+
+        default = ExtractionSummary.aggregate([result])
+        detected = ExtractionSummary.aggregate(
+            [result], duplicate_detector=DuplicateSymbolDetector()
+        )
+
+        assert default.duplicate_symbols == ()
+        duplicates = detected.to_dict()["duplicate_symbols"]
+        assert [(item["first"], item["second"]) for item in duplicates] == [
+            (
+                {"location": "billing/orders.py:4-10", "name": "order_total"},
+                {"location": "billing/orders.py:13-19", "name": "invoice_total"},
+            )
+        ]
+
+    def test_invalid_arguments(self) -> None:
+        """잘못된 임계값과 최대 심볼 수에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="threshold"):
+            DuplicateSymbolDetector(threshold=1.5)
+        with pytest.raises(ValueError, match="max_symbols"):
+            DuplicateSymbolDetector(max_symbols=0)
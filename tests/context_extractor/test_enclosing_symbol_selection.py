@@ -0,0 +1,96 @@
+"""변경 범위를 감싸는 가장 작은 심볼 선택 규칙 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "go"
+# AddNumbers(53-82) 안의 logOperation(64-70) 클로저를 포함한 Go 샘플
+SAMPLE_FILE = "go/SampleCalculator.go"
+
+
+class TestEnclosingSymbolSelection:
+    """hunk 전체를 감싸는 가장 작은 이름 있는 심볼 선택 테스트."""
+
+    def test_prefers_innermost_symbol(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """선언 라인을 포함해 logOperation만 바뀌면 AddNumbers 대신 logOperation을 반환."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(64, 70)], include_dependencies=False
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("func_literal", None, LineRange(64, 70))]
+
+    def test_ancestor_depth_widens_symbol(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """ancestor_depth만큼 바깥쪽 심볼로 넓히는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(64, 70)],
+            include_dependencies=False,
+            options=ExtractionOptions(ancestor_depth=1),
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("method_declaration", "AddNumbers", LineRange(53, 82))]
+
+    def test_ancestor_depth_stops_at_outermost(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """감싸는 심볼보다 큰 ancestor_depth는 가장 바깥쪽 심볼에서 멈추는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(66, 66)],
+            include_dependencies=False,
+            options=ExtractionOptions(ancestor_depth=5),
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("method_declaration", "AddNumbers", LineRange(53, 82))]
+
+    def test_widens_when_hunk_leaves_symbol(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """hunk가 logOperation 밖까지 걸치면 둘 다 감싸는 AddNumbers를 반환."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(69, 72)], include_dependencies=False
+        )
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("method_declaration", "AddNumbers", LineRange(53, 82))]
+
+    def test_no_single_symbol_keeps_line_blocks(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """하나의 심볼이 hunk를 감싸지 못하면 라인별 심볼을 각각 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(50, 54)], include_dependencies=False
+        )
+
+        assert [block.name for block in blocks] == ["NewSampleCalculator", "AddNumbers"]
+
+    def test_negative_ancestor_depth_raises(self) -> None:
+        """ancestor_depth가 음수이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError, match="ancestor_depth"):
+            ExtractionOptions(ancestor_depth=-1)
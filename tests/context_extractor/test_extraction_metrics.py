@@ -0,0 +1,135 @@
+"""컨텍스트 추출 계측(telemetry) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    ExtractionMetrics,
+    ExtractionMetricsSummary,
+    ExtractionOptions,
+    LineRange,
+)
+
+
+@pytest.fixture
+def sample_file_content() -> str:
+    """테스트용 Python 샘플 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "python" / "sample_class.py"
+    return file_path.read_text(encoding="utf-8")
+
+
+class TestExtractionMetrics:
+    """파일별 추출 계측 테스트."""
+
+    def test_disabled_by_default(self, sample_file_content: str) -> None:
+        """기본 옵션에서는 계측 결과가 기록되지 않는지 테스트."""
+        extractor = ContextExtractor("python")
+        context = extractor.extract_file_context(
+            "sample_class.py", sample_file_content, [LineRange(31, 31)]
+        )
+
+        assert extractor.last_metrics is None
+        assert context.metrics is None
+
+    def test_collects_tree_size_and_symbol_count(
+        self, sample_file_content: str
+    ) -> None:
+        """계측이 켜지면 파싱 시간, 노드 수, 심볼 수가 기록되는지 테스트."""
+        extractor = ContextExtractor("python", ExtractionOptions(collect_metrics=True))
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(31, 31)]
+        )
+        metrics = extractor.last_metrics
+
+        assert metrics is not None
+        assert metrics.line_count == len(sample_file_content.splitlines())
+        assert metrics.byte_count == len(sample_file_content.encode("utf-8"))
+        assert metrics.parse_seconds >= 0.0
+        assert metrics.total_seconds >= metrics.parse_seconds
+        assert metrics.node_count > 0
+        assert metrics.max_depth > 0
+        assert metrics.symbol_count == sum(
+            1 for block in blocks if not block.is_dependency
+        )
+        assert metrics.file_path is None
+
+    def test_callback_receives_metrics_with_file_path(
+        self, sample_file_content: str
+    ) -> None:
+        """콜백이 파일 경로가 포함된 계측 결과를 받는지 테스트."""
+        received: list[ExtractionMetrics] = []
+        extractor = ContextExtractor(
+            "python", ExtractionOptions(metrics_callback=received.append)
+        )
+        context = extractor.extract_file_context(
+            "sample_class.py", sample_file_content, [LineRange(31, 31)]
+        )
+
+        assert len(received) == 1
+        assert received[0].file_path == "sample_class.py"
+        assert context.metrics == received[0]
+
+    def test_whole_file_mode_skips_parsing(self) -> None:
+        """파일 전체 모드에서는 파싱 관련 값이 0인지 테스트."""
+        extractor = ContextExtractor(
+            "python",
+            ExtractionOptions(collect_metrics=True, whole_file_max_lines=10),
+        )
+        extractor.extract_context_blocks("x = 1\ny = 2\n", [LineRange(2, 2)])
+        metrics = extractor.last_metrics
+
+        assert metrics is not None
+        assert metrics.node_count == 0
+        assert metrics.parse_seconds == 0.0
+        assert metrics.symbol_count == 1
+
+
+class TestExtractionMetricsSummary:
+    """여러 파일 계측 결과 집계 테스트."""
+
+    def test_aggregates_totals_and_outliers(self) -> None:
+        """합계와 가장 느린/깊은 파일이 집계되는지 테스트."""
+        fast_deep = ExtractionMetrics(
+            line_count=10,
+            byte_count=100,
+            parse_seconds=0.1,
+            query_seconds=0.2,
+            total_seconds=0.4,
+            node_count=50,
+            max_depth=30,
+            symbol_count=2,
+            file_path="a.py",
+        )
+        slow_shallow = ExtractionMetrics(
+            line_count=20,
+            byte_count=200,
+            parse_seconds=0.5,
+            query_seconds=0.25,
+            total_seconds=1.0,
+            node_count=70,
+            max_depth=5,
+            symbol_count=3,
+            file_path="b.py",
+        )
+
+        summary = ExtractionMetricsSummary.aggregate([fast_deep, slow_shallow])
+
+        assert summary.file_count == 2
+        assert summary.total_parse_seconds == pytest.approx(0.6)
+        assert summary.total_query_seconds == pytest.approx(0.45)
+        assert summary.total_seconds == pytest.approx(1.4)
+        assert summary.total_node_count == 120
+        assert summary.total_symbol_count == 5
+        assert summary.slowest == slow_shallow
+        assert summary.deepest == fast_deep
+
+    def test_empty_input(self) -> None:
+        """입력이 비어 있으면 빈 요약을 반환하는지 테스트."""
+        summary = ExtractionMetricsSummary.aggregate([])
+
+        assert summary.file_count == 0
+        assert summary.slowest is None
@@ -0,0 +1,164 @@
+"""언어별 컨텍스트 추출 결과 요약 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    ExtractionSummary,
+    LineRange,
+)
+
+
+def _block(text: str, **kwargs: object) -> ContextBlock:
+    """테스트용 ContextBlock을 만든다."""
+    return ContextBlock(text=text, line_range=LineRange(1, 1), **kwargs)
+
+
+def _sample_results() -> list[ExtractedFileContext]:
+    """여러 언어와 건너뛴 파일이 섞인 추출 결과를 반환한다."""
+    return [
+        ExtractedFileContext(
+            file_path="app/service.py",
+            language="python",
+            blocks=[
+                _block("import os", is_dependency=True),
+                _block("def run():\n    pass"),
+                _block("class Config:\n    pass"),
+            ],
+        ),
+        ExtractedFileContext(file_path="app/empty.py", language="python"),
+        ExtractedFileContext(
+            file_path="app/huge.py",
+            language="python",
+            status=ExtractedFileContext.TIMEOUT_STATUS,
+        ),
+        ExtractedFileContext(
+            file_path="web/main.go",
+            language="go",
+            blocks=[
+                _block("func main() {}"),
+                _block("type Server struct{}", reason="referenced-type"),
+            ],
+        ),
+        ExtractedFileContext.skipped(
+            "lib/core.rs", "rust", ExtractedFileContext.UNSUPPORTED_STATUS
+        ),
+    ]
+
+
+class TestExtractionSummary:
+    """ExtractionSummary.aggregate 테스트."""
+
+    def test_empty_results(self) -> None:
+        """빈 입력에서 모든 합계가 0인지 테스트."""
+        summary = ExtractionSummary.aggregate([])
+
+        assert summary.languages == ()
+        assert summary.file_count == 0
+        assert summary.context_tokens == 0
+
+    def test_languages_are_sorted(self) -> None:
+        """언어별 요약이 언어 이름 순으로 정렬되는지 테스트."""
+        summary = ExtractionSummary.aggregate(_sample_results())
+
+        assert [item.language for item in summary.languages] == ["go", "python", "rust"]
+
+    def test_counts_files_symbols_and_skips(self) -> None:
+        """파일/심볼/건너뛴 파일 수와 사유를 정확히 집계하는지 테스트."""
+        summary = ExtractionSummary.aggregate(_sample_results())
+
+        python = summary.for_language("python")
+        assert python is not None
+        assert python.file_count == 3
+        assert python.extracted_file_count == 1
+        assert python.symbol_count == 2
+        assert python.skipped_count == 2
+        assert python.skip_reasons == {"no-context": 1, "timeout": 1}
+
+        go = summary.for_language("go")
+        assert go is not None
+        assert go.symbol_count == 1
+        assert go.skipped_count == 0
+
+        rust = summary.for_language("rust")
+        assert rust is not None
+        assert rust.skip_reasons == {"unsupported-language": 1}
+
+        assert summary.file_count == 5
+        assert summary.symbol_count == 3
+        assert summary.skipped_count == 3
+
+    def test_context_size(self) -> None:
+        """모든 블록의 바이트 수와 토큰 수를 합산하는지 테스트."""
+        summary = ExtractionSummary.aggregate(
+            _sample_results(), token_counter=lambda text: len(text.split())
+        )
+
+        python_texts = ["import os", "def run():\n    pass", "class Config:\n    pass"]
+        go_texts = ["func main() {}", "type Server struct{}"]
+        python = summary.for_language("python")
+        assert python is not None
+        assert python.context_bytes == sum(len(text) for text in python_texts)
+        assert python.context_tokens == 2 + 3 + 3
+        assert summary.context_bytes == sum(
+            len(text) for text in python_texts + go_texts
+        )
+
+    def test_context_bytes_use_utf8(self) -> None:
+        """멀티바이트 문자를 UTF-8 바이트 수로 계산하는지 테스트."""
+        result = ExtractedFileContext(
+            file_path="a.py", language="python", blocks=[_block("# 한글")]
+        )
+
+        summary = ExtractionSummary.aggregate([result])
+
+        assert summary.context_bytes == len("# 한글".encode())
+
+    def test_is_deterministic(self) -> None:
+        """같은 입력이면 순서와 관계없이 같은 요약을 만드는지 테스트."""
+        results = _sample_results()
+
+        first = ExtractionSummary.aggregate(results)
+        second = ExtractionSummary.aggregate(list(reversed(results)))
+
+        assert first.to_dict() == second.to_dict()
+
+    def test_to_dict(self) -> None:
+        """직렬화 결과에 전체 합계와 언어별 요약이 포함되는지 테스트."""
+        data = ExtractionSummary.aggregate(_sample_results()).to_dict()
+
+        assert data["totals"]["file_count"] == 5
+        assert data["languages"][0]["language"] == "go"
+        assert data["languages"][1]["skip_reasons"] == {"no-context": 1, "timeout": 1}
+
+    def test_parse_errors_are_listed(self) -> None:
+        """strict 모드 구문 오류 파일이 위치와 함께 경로 순으로 기록되는지 테스트."""
+        results = [
+            ExtractedFileContext(
+                file_path=path,
+                language="python",
+                status=ExtractedFileContext.PARSE_ERROR_STATUS,
+                parse_error_locations=locations,
+            )
+            for path, locations in [("b.py", ((9, 5),)), ("a.py", ((2, 1), (7, 3)))]
+        ]
+
+        summary = ExtractionSummary.aggregate([*_sample_results(), *results])
+
+        assert summary.has_parse_errors
+        assert list(summary.parse_errors) == ["a.py", "b.py"]
+        assert summary.to_dict()["parse_errors"] == {
+            "a.py": ["2:1", "7:3"],
+            "b.py": ["9:5"],
+        }
+        python = summary.for_language("python")
+        assert python is not None
+        assert python.skip_reasons["parse-error"] == 2
+
+    def test_no_parse_errors_by_default(self) -> None:
+        """구문 오류 파일이 없으면 실패로 표시되지 않는지 테스트."""
+        summary = ExtractionSummary.aggregate(_sample_results())
+
+        assert not summary.has_parse_errors
+        assert summary.to_dict()["parse_errors"] == {}
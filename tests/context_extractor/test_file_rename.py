@@ -0,0 +1,159 @@
+"""이름이 바뀐 파일의 컨텍스트 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractedFileContext,
+    ExtractionSummary,
+    FileRename,
+    LineRange,
+    render_context,
+)
+
+OLD_SOURCE = """def subtotal(items):
+    return sum(items)
+
+
+def tax(items):
+    return subtotal(items) * 0.1
+
+
+def total(items):
+    return subtotal(items) + tax(items)
+"""
+
+NEW_SOURCE = """def subtotal(items):
+    return sum(items)
+
+
+def total(items):
+    return subtotal(items)
+"""
+
+RENAME = FileRename("src/legacy/billing.py", "src/billing/invoice.py", 0.72)
+
+
+class TestFileRename:
+    """FileRename 값 객체 테스트."""
+
+    def test_from_similarity_index(self) -> None:
+        """git 유사도 퍼센트가 0.0~1.0으로 변환되는지 테스트."""
+        rename = FileRename.from_similarity_index("a.py", "b.py", 100)
+
+        assert rename.similarity == 1.0
+        assert rename.is_pure_rename
+
+    def test_describe(self) -> None:
+        """이전 경로와 유사도가 설명 문자열에 포함되는지 테스트."""
+        assert RENAME.describe() == (
+            "renamed from src/legacy/billing.py (similarity 72%)"
+        )
+        assert FileRename("a.py", "b.py").describe() == "renamed from a.py"
+
+    def test_similarity_out_of_range(self) -> None:
+        """0.0~1.0 범위를 벗어난 유사도에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="similarity"):
+            FileRename("a.py", "b.py", 1.5)
+
+
+class TestRenamedFileExtraction:
+    """ContextExtractor.extract_renamed_file_context 테스트."""
+
+    def test_changes_are_mapped_to_new_content(self) -> None:
+        """새 내용 기준으로 변경된 심볼만 추출하고 rename을 기록하는지 테스트."""
+        result = ContextExtractor("python").extract_renamed_file_context(
+            RENAME, OLD_SOURCE, NEW_SOURCE, [LineRange(6, 6)]
+        )
+
+        assert result.file_path == "src/billing/invoice.py"
+        assert result.rename == RENAME
+        assert [block.name for block in result.context_blocks] == ["total"]
+        assert result.previous_blocks == []
+
+    def test_deleted_symbol_is_surfaced_from_old_content(self) -> None:
+        """삭제된 코드는 이름 변경 전 내용의 심볼로 함께 반환되는지 테스트."""
+        result = ContextExtractor("python").extract_renamed_file_context(
+            RENAME,
+            OLD_SOURCE,
+            NEW_SOURCE,
+            [LineRange(6, 6)],
+            deleted_ranges=[LineRange(5, 6), LineRange(10, 10)],
+        )
+
+        assert [block.name for block in result.previous_blocks] == ["tax"]
+        previous = result.previous_blocks[0]
+        assert previous.line_range == LineRange(5, 6)
+        assert previous.reason == ContextExtractor.PRE_RENAME_REASON
+
+    def test_pure_rename_extracts_nothing(self) -> None:
+        """내용 변경이 없는 이름 변경은 심볼을 추출하지 않는지 테스트."""
+        rename = FileRename("old.py", "new.py", 1.0)
+
+        result = ContextExtractor("python").extract_renamed_file_context(
+            rename, NEW_SOURCE, NEW_SOURCE, []
+        )
+
+        assert result.blocks == []
+        assert result.previous_blocks == []
+        assert result.is_renamed
+
+
+class TestRenamedFileRendering:
+    """이름이 바뀐 파일 결과의 렌더링/집계 테스트."""
+
+    @staticmethod
+    def _result() -> ExtractedFileContext:
+        """새 블록과 이름 변경 전 블록을 가진 결과를 만든다."""
+        return ExtractedFileContext(
+            file_path="src/billing/invoice.py",
+            language="python",
+            blocks=[
+                ContextBlock(
+                    text="def total(items):\n    return subtotal(items)",
+                    line_range=LineRange(5, 6),
+                    name="total",
+                )
+            ],
+            rename=RENAME,
+            previous_blocks=[
+                ContextBlock(
+                    text="def tax(items):\n    return subtotal(items) * 0.1",
+                    line_range=LineRange(5, 6),
+                    name="tax",
+                    reason=ContextExtractor.PRE_RENAME_REASON,
+                )
+            ],
+        )
+
+    def test_header_and_previous_block(self) -> None:
+        """파일 헤더에 이전 경로가, 새 블록 뒤에 이전 블록이 표시되는지 테스트."""
+        rendered = render_context([self._result()])
+
+        lines = rendered.split("\n")
+        assert lines[0] == (
+            "==== File: src/billing/invoice.py (python) "
+            "[renamed from src/legacy/billing.py (similarity 72%)] ===="
+        )
+        assert lines[4] == (
+            "---- Previous Block 1 (src/legacy/billing.py, Lines 5-6): tax ----"
+        )
+        assert lines[5] == "def tax(items):"
+
+    def test_summary_counts_previous_blocks_as_context(self) -> None:
+        """이전 블록은 심볼 수에서 제외하되 컨텍스트 크기에는 포함하는지 테스트."""
+        result = self._result()
+
+        summary = ExtractionSummary.aggregate([result])
+
+        language = summary.for_language("python")
+        assert language is not None
+        assert language.symbol_count == 1
+        expected_bytes = sum(
+            len(block.text.encode("utf-8"))
+            for block in [*result.blocks, *result.previous_blocks]
+        )
+        assert language.context_bytes == expected_bytes
@@ -0,0 +1,160 @@
+"""식별자 익명화 옵션 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+SOURCE = """import json
+
+
+def _normalize(values):
+    cleaned = [value.strip() for value in values]
+    return cleaned
+
+
+def export_report(records, path):
+    rows = _normalize(records)
+    payload = json.dumps(rows)
+    with open(path, "w") as handle:
+        handle.write(payload)
+    return len(rows)
+
+
+class ReportWriter:
+    def write(self, rows: list[str]) -> int:
+        total = len(rows)
+        return total
+"""
+
+
+def _extract(
+    changed_ranges: list[LineRange], preserve_public_names: bool = False
+) -> list[ContextBlock]:
+    """익명화 옵션을 켜고 추출한 블록들을 반환한다."""
+    options = ExtractionOptions(
+        anonymize_identifiers=True, preserve_public_names=preserve_public_names
+    )
+    return ContextExtractor("python", options).extract_context_blocks(
+        SOURCE, changed_ranges
+    )
+
+
+class TestIdentifierAnonymization:
+    """사용자 식별자 익명화 테스트."""
+
+    def test_identifiers_are_replaced_consistently(self) -> None:
+        """같은 이름은 같은 토큰으로, 내장 이름과 멤버 이름은 그대로인지 테스트."""
+        blocks = _extract([LineRange(11, 11)])
+
+        block = blocks[-1]
+        assert block.text == (
+            "def fn1(v2, v3):\n"
+            "    v4 = fn5(v2)\n"
+            "    v6 = v7.dumps(v4)\n"
+            '    with open(v3, "w") as v8:\n'
+            "        v8.write(v6)\n"
+            "    return len(v4)"
+        )
+        assert block.name == "fn1"
+        assert block.anonymized_identifier_count == 8
+        assert block.line_range == LineRange(9, 14)
+
+    def test_dependency_block_is_unchanged(self) -> None:
+        """의존성(import) 블록은 익명화하지 않는지 테스트."""
+        blocks = _extract([LineRange(11, 11)])
+
+        assert blocks[0].is_dependency
+        assert blocks[0].text == "import json"
+
+    def test_types_and_self_are_kept(self) -> None:
+        """타입 어노테이션, self, 클래스 이름이 유지되는지 테스트."""
+        blocks = _extract([LineRange(19, 19)])
+
+        assert blocks[-1].text == (
+            "def fn1(self, v2: list[str]) -> int:\n"
+            "        v3 = len(v2)\n"
+            "        return v3"
+        )
+
+    def test_mapping_is_per_block(self) -> None:
+        """블록마다 독립된 매핑으로 번호가 매겨지는지 테스트."""
+        blocks = _extract([LineRange(5, 5), LineRange(11, 11)])
+
+        context_blocks = [block for block in blocks if not block.is_dependency]
+        assert [block.name for block in context_blocks] == ["fn1", "fn1"]
+        assert context_blocks[0].text.startswith("def fn1(v2):")
+
+    def test_public_names_are_preserved(self) -> None:
+        """공개 함수 이름은 유지하고 비공개 함수 이름만 바꾸는지 테스트."""
+        blocks = _extract([LineRange(11, 11)], preserve_public_names=True)
+
+        block = blocks[-1]
+        assert block.text.startswith("def export_report(v1, v2):\n    v3 = fn4(v1)")
+        assert block.name == "export_report"
+        assert block.anonymized_identifier_count == 7
+
+    def test_mapping_count_in_file_metadata(self) -> None:
+        """파일 결과에 익명화된 식별자 수 합계가 기록되는지 테스트."""
+        options = ExtractionOptions(anonymize_identifiers=True)
+
+        result = ContextExtractor("python", options).extract_file_context(
+            "report.py", SOURCE, [LineRange(5, 5), LineRange(11, 11)]
+        )
+
+        assert result.anonymized_identifier_count == 4 + 8
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 원본 텍스트를 반환하는지 테스트."""
+        blocks = ContextExtractor("python").extract_context_blocks(
+            SOURCE, [LineRange(11, 11)]
+        )
+
+        assert blocks[-1].text.startswith("def export_report(records, path):")
+        assert blocks[-1].anonymized_identifier_count == 0
+
+    def test_original_names_do_not_leak(self) -> None:
+        """헤더, 포맷 결과, 한정 이름, dedent 전 텍스트에 원래 이름이 없는지 테스트."""
+        options = ExtractionOptions(
+            anonymize_identifiers=True, dedent_blocks=True, include_cost=True
+        )
+
+        blocks = ContextExtractor("python", options).extract_context_blocks(
+            SOURCE, [LineRange(11, 11), LineRange(19, 19)]
+        )
+
+        original_names = (
+            "_normalize",
+            "export_report",
+            "records",
+            "path",
+            "rows",
+            "payload",
+            "handle",
+            "total",
+        )
+        for block in blocks:
+            if block.is_dependency:
+                continue
+            exposed = [
+                block.header(1, include_name=True),
+                block.format(1),
+                block.qualified_name or "",
+                block.undedented_text or "",
+            ]
+            assert not any(name in text for name in original_names for text in exposed)
+
+    def test_cost_is_measured_on_anonymized_text(self) -> None:
+        """비용의 바이트 수가 익명화된 본문 기준인지 테스트."""
+        options = ExtractionOptions(anonymize_identifiers=True, include_cost=True)
+
+        block = ContextExtractor("python", options).extract_context_blocks(
+            SOURCE, [LineRange(11, 11)]
+        )[-1]
+
+        assert block.cost is not None
+        assert block.cost.byte_size == len(block.body().encode("utf-8"))
@@ -0,0 +1,92 @@
+"""IndentStyle 감지/정규화 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    IndentStyle,
+    LineRange,
+    RenderOptions,
+    render_context,
+)
+
+
+@pytest.fixture
+def mixed_file_content() -> str:
+    """탭과 공백 들여쓰기가 섞인 JavaScript 샘플 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "javascript" / "SampleMixedIndentation.js"
+    return file_path.read_text(encoding="utf-8")
+
+
+class TestIndentStyleDetection:
+    """IndentStyle.detect() 테스트."""
+
+    def test_mixed_file_uses_majority(self, mixed_file_content: str) -> None:
+        """탭/공백이 섞인 파일에서 더 많이 쓰인 단위를 감지하는지 테스트."""
+        assert IndentStyle.detect(mixed_file_content) == IndentStyle.SPACES
+
+    def test_tab_indented_text(self) -> None:
+        """탭 들여쓰기가 많은 텍스트를 TABS로 감지하는지 테스트."""
+        text = "func f() {\n\tx := 1\n\treturn x\n /* note */\n}"
+
+        assert IndentStyle.detect(text) == IndentStyle.TABS
+
+    def test_block_comment_continuation_is_ignored(self) -> None:
+        """한 칸 공백으로 시작하는 주석 이어짐 라인은 세지 않는지 테스트."""
+        text = "/**\n * 설명\n */\nfunc f() {\n\treturn\n}"
+
+        assert IndentStyle.detect(text) == IndentStyle.TABS
+
+    def test_unindented_text(self) -> None:
+        """들여쓰기된 라인이 없으면 None을 반환하는지 테스트."""
+        assert IndentStyle.detect("a = 1\n\nb = 2") is None
+
+
+class TestIndentStyleNormalization:
+    """IndentStyle.normalize() 테스트."""
+
+    def test_tabs_to_spaces(self) -> None:
+        """선행 탭이 탭 정지 위치까지의 공백으로 바뀌는지 테스트."""
+        text = "\t\treturn 1;\n  \tx();"
+
+        assert IndentStyle.SPACES.normalize(text, 4) == "        return 1;\n    x();"
+
+    def test_spaces_to_tabs(self) -> None:
+        """탭 파일에서는 선행 공백이 탭으로, 남는 칸은 공백으로 바뀌는지 테스트."""
+        text = "    if x {\n\t      y()"
+
+        assert IndentStyle.TABS.normalize(text, 4) == "\tif x {\n\t\t  y()"
+
+    def test_inner_whitespace_and_line_count_are_kept(self) -> None:
+        """라인 중간의 탭과 빈 라인 수가 그대로 유지되는지 테스트."""
+        text = "\ta\tb\n\n\tc"
+
+        normalized = IndentStyle.SPACES.normalize(text, 2)
+
+        assert normalized == "  a\tb\n\n  c"
+        assert normalized.count("\n") == text.count("\n")
+
+
+class TestIndentNormalizationRendering:
+    """추출 결과 렌더링 시 들여쓰기 정규화 테스트."""
+
+    def test_display_only_normalization(self, mixed_file_content: str) -> None:
+        """렌더링만 정규화되고 블록 텍스트/라인 범위는 원본 그대로인지 테스트."""
+        result = ContextExtractor("javascript").extract_file_context(
+            "src/discount.js", mixed_file_content, [LineRange(5, 5)]
+        )
+        block = result.context_blocks[0]
+        original_text = block.text
+
+        rendered = render_context([result], RenderOptions(tab_width=4))
+
+        assert result.indent_style == IndentStyle.SPACES
+        assert "        return order.total * (1 - DEFAULT_RATE);" in rendered
+        assert "\n    return order.total;" in rendered
+        assert "Lines 3-8" in rendered
+        assert block.text == original_text
+        assert "\t\treturn order.total" in block.text
@@ -0,0 +1,103 @@
+"""변경 라인이 호출한 매크로 정의 포함(C `#define`, Rust `macro_rules!`) 테스트."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+from selvage.src.context_extractor.fallback_context_extractor import (
+    FallbackContextExtractor,
+)
+
+C_SOURCE = """#include <stdio.h>
+
+#define SQUARE(x) \\
+    ((x) * (x))
+#define MAX_ITEMS 16
+#define UNUSED 0
+
+static int counter = 0;
+
+static void reset(void) {
+    counter = 0;
+}
+
+int area(int side) {
+    printf("SQUARE(%d)\\n", side);
+    return SQUARE(side) + MAX_ITEMS;
+}
+"""
+
+RUST_SOURCE = """use std::fmt;
+
+#[macro_export]
+macro_rules! square {
+    ($x:expr) => {
+        $x * $x
+    };
+}
+
+struct Shape;
+
+impl Shape {
+    fn new() -> Self {
+        Shape
+    }
+}
+
+fn area(side: i32, square: i32) -> i32 {
+    let unrelated = square != side;
+    square!(side)
+}
+"""
+
+
+def _macro_blocks(source: str, changed_line: int) -> list[ContextBlock]:
+    """변경 라인 하나에 대해 포함된 매크로 정의 블록들을 반환한다."""
+    blocks = FallbackContextExtractor().extract_context_blocks(
+        source, [LineRange(changed_line, changed_line)]
+    )
+    return [
+        block
+        for block in blocks
+        if block.reason == FallbackContextExtractor.MACRO_DEFINITION_REASON
+    ]
+
+
+class TestMacroDefinitions:
+    """매크로 정의 참고용 블록 테스트."""
+
+    def test_c_defines_with_continuation(self) -> None:
+        """C 매크로 호출이 `\\` 연속 라인까지의 `#define` 정의를 포함하는지 테스트.
+
+        의존성 블록에 이미 모두 들어 있는 한 줄 `#define`(MAX_ITEMS)은
+        다시 포함하지 않는다.
+        """
+        blocks = _macro_blocks(C_SOURCE, 16)
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("SQUARE", LineRange(3, 4))
+        ]
+        assert blocks[0].text == "#define SQUARE(x) \\\n    ((x) * (x))"
+        assert blocks[0].block_type == "macro_definition"
+
+    def test_names_in_strings_are_ignored(self) -> None:
+        """문자열 리터럴 안의 매크로 이름은 호출로 보지 않는지 테스트."""
+        assert _macro_blocks(C_SOURCE, 15) == []
+
+    def test_rust_macro_rules_with_attribute(self) -> None:
+        """Rust `name!` 호출이 속성을 포함한 `macro_rules!` 정의를 포함하는지 테스트."""
+        blocks = _macro_blocks(RUST_SOURCE, 20)
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("square", LineRange(3, 8))
+        ]
+        assert blocks[0].text.startswith("#[macro_export]\nmacro_rules! square {")
+
+    def test_rust_name_without_bang_is_not_invocation(self) -> None:
+        """`!` 없이 같은 이름의 변수를 쓰면 매크로 호출로 보지 않는지 테스트."""
+        assert _macro_blocks(RUST_SOURCE, 19) == []
+
+    def test_definition_inside_context_is_not_repeated(self) -> None:
+        """정의가 이미 컨텍스트 범위 안에 있으면 따로 포함하지 않는지 테스트."""
+        source = "#define TWICE(x) ((x) * 2)\nint f(int v) {\n    return TWICE(v);\n}\n"
+
+        assert _macro_blocks(source, 3) == []
@@ -115,6 +115,15 @@ class TestMeaningfulLineDetection:
         assert filter_instance._is_meaningful_line("  print('hello')")
         assert filter_instance._is_meaningful_line("}")
 
+    def test_comment_patterns_are_meaningful_without_comment_detection(self):
+        """주석 감지를 끄면 주석 패턴 라인도 의미있다고 판단되는지 테스트."""
+        prose_filter = MeaninglessChangeFilter(detect_comments=False)
+
+        assert prose_filter._is_meaningful_line("## Install")
+        assert prose_filter._is_meaningful_line("* 목록 항목")
+        assert prose_filter._is_meaningful_line("-- 인용 출처")
+        assert not prose_filter._is_meaningful_line("   ")
+
 
 class TestSingleMeaninglessChange:
     """_is_single_meaningless_change() 메서드의 1줄 무의미한 변경 판단 테스트."""
@@ -0,0 +1,170 @@
+"""변경을 감싸는 가장 작은 블록만 추출하는 minimal_block 모드 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+MINIMAL_BLOCK = ExtractionOptions(minimal_block=True)
+
+
+class TestMinimalBlockOptions:
+    """minimal_block 옵션 기본값 테스트."""
+
+    def test_disabled_by_default(self) -> None:
+        """기본 옵션에서는 minimal_block 모드가 꺼져 있는지 테스트."""
+        assert ExtractionOptions().minimal_block is False
+
+
+class TestBraceDelimitedBlocks:
+    """중괄호로 구분되는 언어(Java)의 minimal_block 추출 테스트."""
+
+    LANGUAGE = "java"
+    SAMPLE_FILE = "java/SampleMinimalBlock.java"
+
+    def test_if_block_with_braces(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """if 블록 내부 변경 시 헤더와 여는/닫는 중괄호를 포함하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(14, 14)], options=MINIMAL_BLOCK
+        )
+
+        assert len(blocks) == 1
+        block = blocks[0]
+        assert block.line_range == LineRange(13, 15)
+        assert block.block_type == "block"
+        assert block.text.strip().startswith("if (items.isEmpty()) {")
+        assert block.text.endswith("}")
+        assert block.changed_lines == (14,)
+
+    def test_nested_block_is_smallest(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """중첩된 블록에서는 가장 안쪽 블록만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(18, 18)], options=MINIMAL_BLOCK
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(17, 19)]
+
+    def test_constructor_body(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """생성자 본문 변경 시 생성자 블록을 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(9, 9)], options=MINIMAL_BLOCK
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(8, 10)]
+        assert blocks[0].block_type == "constructor_body"
+
+    def test_no_dependency_blocks(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """minimal_block 모드에서는 import 의존성 블록을 포함하지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(14, 14)], options=MINIMAL_BLOCK
+        )
+
+        assert not any(block.is_dependency for block in blocks)
+
+    def test_contained_block_is_merged_into_outer(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """다른 블록에 포함되는 블록은 바깥 블록 하나로 합쳐지는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(14, 14), LineRange(21, 21)],
+            options=MINIMAL_BLOCK,
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(12, 22)]
+        assert blocks[0].changed_lines == (14, 21)
+
+
+class TestIndentDelimitedBlocks:
+    """들여쓰기로 구분되는 언어(Python)의 minimal_block 추출 테스트."""
+
+    LANGUAGE = "python"
+    SAMPLE_FILE = "python/sample_minimal_block.py"
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_range", "first_line"),
+        [
+            (8, LineRange(7, 9), "    if value < 0:"),
+            (11, LineRange(10, 11), "    elif value > LIMIT:"),
+            (14, LineRange(13, 14), "        if step % 2:"),
+        ],
+    )
+    def test_block_ends_at_dedent(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_range: LineRange,
+        first_line: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """헤더 라인부터 dedent 직전 라인까지의 블록을 반환하는지 테스트."""
+        changed_ranges = [LineRange(changed_line, changed_line)]
+        blocks = extract_blocks(
+            sample_file_content, changed_ranges, options=MINIMAL_BLOCK
+        )
+
+        assert [block.line_range for block in blocks] == [expected_range]
+        assert blocks[0].text.split("\n")[0] == first_line
+
+    def test_function_body_includes_def_line(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """함수 본문 직속 변경 시 def 라인부터 함수 끝까지 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(15, 15)], options=MINIMAL_BLOCK
+        )
+
+        assert [block.line_range for block in blocks] == [LineRange(6, 15)]
+        assert blocks[0].text.startswith("def classify(value: int) -> str:")
+
+    def test_top_level_statement(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """블록 밖의 최상위 변경은 해당 문장만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(3, 3)], options=MINIMAL_BLOCK
+        )
+
+        assert [block.text for block in blocks] == ["LIMIT = 10"]
+
+    def test_separate_blocks_are_sorted(self, sample_file_content: str) -> None:
+        """서로 다른 블록의 변경은 라인 순으로 각각 반환되는지 테스트."""
+        extractor = ContextExtractor(self.LANGUAGE, MINIMAL_BLOCK)
+        blocks = extractor.extract_context_blocks(
+            sample_file_content, [LineRange(14, 14), LineRange(8, 8)]
+        )
+
+        assert [block.line_range for block in blocks] == [
+            LineRange(7, 9),
+            LineRange(13, 14),
+        ]
@@ -0,0 +1,114 @@
+"""`\\n`/`\\r\\n`이 섞인 파일의 라인 번호 안정성 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextExtractor, LineRange
+from selvage.src.context_extractor.fallback_context_extractor import (
+    FallbackContextExtractor,
+)
+from selvage.src.context_extractor.text_lines import split_lines
+from selvage.src.diff_parser.models.hunk import Hunk
+from selvage.src.utils.file_utils import load_file_content
+
+FIXTURE_DIR = Path(__file__).parent / "python"
+FIXTURE_NAME = "sample_mixed_line_endings.py"
+
+
+@pytest.fixture
+def mixed_content() -> str:
+    """줄바꿈이 섞인 fixture를 변환 없이 읽어 반환합니다."""
+    return load_file_content(FIXTURE_NAME, str(FIXTURE_DIR))
+
+
+class TestSplitLines:
+    """split_lines() 라인 경계 테스트."""
+
+    @pytest.mark.parametrize(
+        ("text", "expected"),
+        [
+            ("a\r\nb\nc", ["a\r", "b", "c"]),
+            ("a\r\r\nb\n", ["a\r\r", "b"]),
+            ("a\x0cb\n\nc", ["a\x0cb", "", "c"]),
+            ("", []),
+            ("\n", [""]),
+        ],
+    )
+    def test_only_newline_is_boundary(self, text: str, expected: list[str]) -> None:
+        """`\\n`만 라인 경계로 보고 `\\r`은 라인 끝에 남기는지 테스트."""
+        assert split_lines(text) == expected
+        assert "\n".join(split_lines(text)) == text.removesuffix("\n")
+
+
+class TestMixedLineEndingHunk:
+    """단독 `\\r`과 form feed가 있는 hunk의 라인 번호 계산 테스트."""
+
+    # fixture 8번 라인을 바꾼 hunk (3번 라인은 `\r\r\n`, 6번 라인은 form feed)
+    HUNK_TEXT = (
+        "@@ -3,7 +3,7 @@\n"
+        " def first():\r\r\n"
+        "     return os.sep\n"
+        " \n"
+        " \x0c\n"
+        " def second(value):\r\n"
+        "-    total = value\n"
+        "+    total = value + 1\n"
+        "     return total\r\n"
+    )
+
+    def test_change_lines_match_git(self) -> None:
+        """hunk 안의 `\\r`과 form feed를 라인 경계로 세지 않는지 테스트."""
+        hunk = Hunk.from_hunk_text(self.HUNK_TEXT)
+
+        assert hunk.change_line == LineRange(8, 8)
+        assert hunk.get_change_ranges() == [LineRange(8, 8)]
+        assert hunk.get_line_changes().added_lines == frozenset({8})
+        assert hunk.get_deleted_original_ranges() == [LineRange(8, 8)]
+
+    def test_modified_text_matches_file(self, mixed_content: str) -> None:
+        """수정 후 hunk 텍스트가 파일의 같은 라인들과 바이트 단위로 같은지 테스트."""
+        hunk = Hunk.from_hunk_text(self.HUNK_TEXT)
+
+        assert split_lines(hunk.get_modified_text()) == split_lines(mixed_content)[
+            2:9
+        ]
+
+
+class TestMixedLineEndings:
+    """줄바꿈이 섞인 파일에서 git 기준 라인 번호와 원문 보존 테스트."""
+
+    def test_file_is_loaded_without_translation(self, mixed_content: str) -> None:
+        """파일을 읽을 때 `\\r\\n`과 `\\r\\r\\n`을 변환하지 않는지 테스트."""
+        raw = (FIXTURE_DIR / FIXTURE_NAME).read_bytes().decode("utf-8")
+
+        assert mixed_content == raw
+        assert len(split_lines(mixed_content)) == raw.count("\n") == 9
+
+    def test_symbol_line_numbers_match_git(self, mixed_content: str) -> None:
+        """`\\r\\r\\n`과 form feed 뒤의 심볼도 git 기준 라인 번호로 추출되는지 테스트."""
+        blocks = ContextExtractor("python").extract_context_blocks(
+            mixed_content, [LineRange(8, 8)]
+        )
+        symbol_blocks = [block for block in blocks if not block.is_dependency]
+
+        assert [(block.name, block.line_range) for block in symbol_blocks] == [
+            ("second", LineRange(7, 9))
+        ]
+        assert symbol_blocks[0].text == (
+            "def second(value):\r\n    total = value + 1\n    return total"
+        )
+
+    def test_fallback_text_is_byte_accurate(self, mixed_content: str) -> None:
+        """텍스트 기반 추출도 같은 라인 번호와 원문 그대로의 텍스트를 반환하는지 테스트."""
+        blocks = FallbackContextExtractor().extract_context_blocks(
+            mixed_content, [LineRange(8, 8)]
+        )
+        context_blocks = [block for block in blocks if not block.is_dependency]
+
+        assert context_blocks[0].line_range == LineRange(3, 9)
+        assert context_blocks[0].text == mixed_content.split("\n", 2)[2].removesuffix(
+            "\n"
+        )
@@ -0,0 +1,116 @@
+"""심볼별 package 선언 포함 옵션 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractedFileContext,
+    ExtractionOptions,
+    LineRange,
+    RenderOptions,
+    render_context,
+)
+
+JAVA_SOURCE = """package com.example.billing;
+
+import java.util.List;
+
+public class Invoice {
+    public int total(List<Integer> amounts) {
+        return amounts.stream().mapToInt(Integer::intValue).sum();
+    }
+}
+"""
+
+
+def _go_sample() -> str:
+    """테스트용 Go 샘플 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "go" / "SampleCalculator.go"
+    return file_path.read_text(encoding="utf-8")
+
+
+def _context_blocks(
+    language: str, file_content: str, line_range: LineRange, enabled: bool
+) -> list[ContextBlock]:
+    """옵션을 적용해 추출한 컨텍스트 블록(의존성 제외)을 반환한다."""
+    options = ExtractionOptions(include_package_declaration=enabled)
+    extractor = ContextExtractor(language, options)
+    blocks = extractor.extract_context_blocks(file_content, [line_range])
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestPackageDeclarationExtraction:
+    """package 선언 기록 테스트."""
+
+    def test_disabled_by_default(self) -> None:
+        """기본 옵션에서는 package 선언을 기록하지 않는지 테스트."""
+        blocks = _context_blocks("go", _go_sample(), LineRange(76, 76), False)
+
+        assert blocks
+        assert all(block.package_declaration is None for block in blocks)
+
+    def test_go_package_clause(self) -> None:
+        """Go 심볼 블록에 `package main`이 기록되는지 테스트."""
+        blocks = _context_blocks("go", _go_sample(), LineRange(76, 76), True)
+
+        assert [block.package_declaration for block in blocks] == ["package main"]
+        assert blocks[0].body().startswith("package main\nfunc (calc")
+
+    def test_java_package_declaration(self) -> None:
+        """Java 심볼 블록에 package 선언이 기록되는지 테스트."""
+        blocks = _context_blocks("java", JAVA_SOURCE, LineRange(7, 7), True)
+
+        assert blocks[0].package_declaration == "package com.example.billing;"
+
+    def test_format_includes_package_declaration(self) -> None:
+        """extract_contexts 출력에서 헤더 다음에 package 선언이 오는지 테스트."""
+        extractor = ContextExtractor(
+            "go", ExtractionOptions(include_package_declaration=True)
+        )
+        contexts = extractor.extract_contexts(_go_sample(), [LineRange(76, 76)])
+
+        context_block = next(
+            context for context in contexts if "Context Block 1" in context
+        )
+        assert context_block.split("\n")[1] == "package main"
+
+
+class TestPackageDeclarationRendering:
+    """package 선언이 기록된 블록의 렌더링 테스트."""
+
+    def test_dependency_block_is_unchanged(self) -> None:
+        """의존성 블록에는 package 선언을 붙이지 않는지 테스트."""
+        block = ContextBlock(
+            text='import "fmt"',
+            line_range=LineRange(3, 3),
+            is_dependency=True,
+            package_declaration="package main",
+        )
+
+        assert block.body() == 'import "fmt"'
+
+    def test_render_with_line_numbers(self) -> None:
+        """라인 번호 gutter 앞에 package 선언이 표시되는지 테스트."""
+        result = ExtractedFileContext(
+            file_path="main.go",
+            language="go",
+            blocks=[
+                ContextBlock(
+                    text="func main() {\n}",
+                    line_range=LineRange(10, 11),
+                    name="main",
+                    package_declaration="package main",
+                )
+            ],
+        )
+
+        rendered = render_context([result], RenderOptions(include_line_numbers=True))
+
+        assert rendered.split("\n")[2:] == [
+            "package main",
+            "10 | func main() {",
+            "11 | }",
+        ]
@@ -0,0 +1,136 @@
+"""파일 단위 파싱 시간 제한 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    ExtractionMetrics,
+    ExtractionMetricsSummary,
+    ExtractionOptions,
+    LineRange,
+)
+from selvage.src.context_extractor.parse_deadline import ParseDeadline
+from selvage.src.exceptions import ParseTimeoutError
+
+
+class FakeClock:
+    """테스트에서 직접 시간을 진행시키는 시계."""
+
+    def __init__(self) -> None:
+        self.now = 100.0
+
+    def __call__(self) -> float:
+        return self.now
+
+
+class EndlessParser:
+    """진행 콜백이 중단을 요청할 때까지 파싱을 계속하는 가짜 파서."""
+
+    def __init__(self, clock: FakeClock) -> None:
+        self._clock = clock
+        self.reset_count = 0
+
+    def parse(
+        self,
+        source: bytes,
+        progress_callback: Callable[[int, bool], bool] | None = None,
+    ) -> None:
+        assert progress_callback is not None
+        offset = 0
+        while not progress_callback(offset, False):
+            offset += 1
+            self._clock.now += 1.0
+        return None
+
+    def reset(self) -> None:
+        self.reset_count += 1
+
+
+def _use_clock(monkeypatch: pytest.MonkeyPatch, clock: FakeClock) -> None:
+    """추출기가 만드는 ParseDeadline이 가짜 시계를 쓰도록 바꾼다."""
+    monkeypatch.setattr(
+        "selvage.src.context_extractor.context_extractor.ParseDeadline",
+        lambda timeout_seconds: ParseDeadline(timeout_seconds, clock=clock),
+    )
+
+
+class TestParseDeadline:
+    """ParseDeadline 진행 콜백 테스트."""
+
+    def test_expires_after_timeout(self) -> None:
+        """제한 시간이 지나면 파싱 중단을 요청하는지 테스트."""
+        clock = FakeClock()
+        deadline = ParseDeadline(2.0, clock=clock)
+
+        assert deadline(0, False) is False
+        clock.now += 1.5
+        assert deadline(10, False) is False
+        clock.now += 0.5
+        assert deadline(20, False) is True
+        assert deadline.expired is True
+
+
+class TestParseTimeout:
+    """ContextExtractor 파싱 시간 제한 테스트."""
+
+    def test_rejects_non_positive_timeout(self) -> None:
+        """0 이하의 시간 제한을 거부하는지 테스트."""
+        with pytest.raises(ValueError):
+            ExtractionOptions(parse_timeout_seconds=0)
+
+    def test_raises_timeout_when_parse_exceeds_limit(
+        self, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """파싱이 제한 시간을 넘기면 중단하고 ParseTimeoutError를 던지는지 테스트."""
+        clock = FakeClock()
+        _use_clock(monkeypatch, clock)
+        extractor = ContextExtractor(
+            "python", ExtractionOptions(parse_timeout_seconds=3.0)
+        )
+        parser = EndlessParser(clock)
+        extractor._parser = parser
+
+        with pytest.raises(ParseTimeoutError) as exc_info:
+            extractor.extract_context_blocks("x = 1\n", [LineRange(1, 1)])
+
+        assert exc_info.value.timeout_seconds == 3.0
+        assert parser.reset_count == 1
+
+    def test_file_context_degrades_to_timeout_status(
+        self, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """시간 초과 시 빈 블록과 timeout 상태로 결과를 반환하는지 테스트."""
+        clock = FakeClock()
+        _use_clock(monkeypatch, clock)
+        extractor = ContextExtractor(
+            "python",
+            ExtractionOptions(parse_timeout_seconds=3.0, collect_metrics=True),
+        )
+        extractor._parser = EndlessParser(clock)
+
+        context = extractor.extract_file_context(
+            "slow.py", "x = 1\n", [LineRange(1, 1)]
+        )
+
+        assert context.status == "timeout"
+        assert context.timed_out is True
+        assert context.blocks == []
+        assert context.metrics is not None
+        assert context.metrics.timed_out is True
+
+    def test_summary_reports_timed_out_files(self) -> None:
+        """계측 요약이 시간 초과 파일을 보고하는지 테스트."""
+        summary = ExtractionMetricsSummary.aggregate(
+            [
+                ExtractionMetrics(line_count=1, byte_count=1, file_path="ok.py"),
+                ExtractionMetrics(
+                    line_count=1, byte_count=1, file_path="slow.py", timed_out=True
+                ),
+            ]
+        )
+
+        assert summary.timed_out_files == ("slow.py",)
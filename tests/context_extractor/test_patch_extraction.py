@@ -0,0 +1,113 @@
+"""PatchContextExtractor(patch 파일 컨텍스트 추출) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ExtractedFileContext, LineRange
+from selvage.src.context_extractor.patch_context_extractor import (
+    PatchContextExtractor,
+    extract_patch,
+)
+
+MULTI_FILE_PATCH = (
+    "diff --git a/src/calculator.py b/src/calculator.py\n"
+    "index 1234567..89abcde 100644\n"
+    "--- a/src/calculator.py\n"
+    "+++ b/src/calculator.py\n"
+    "@@ -40,5 +40,6 @@ class Calculator:\n"
+    "     def multiply(self, a, b):\n"
+    "-        return a * b\n"
+    "+        result = a * b\n"
+    "+        return result\n"
+    " \n"
+    "     def divide(self, a, b):\n"
+    "         return a / b\n"
+    "diff --git a/src/helpers.py b/src/helpers.py\n"
+    "new file mode 100644\n"
+    "index 0000000..1111111\n"
+    "--- /dev/null\n"
+    "+++ b/src/helpers.py\n"
+    "@@ -0,0 +1,5 @@\n"
+    "+import math\n"
+    "+\n"
+    "+\n"
+    "+def hypot(a, b):\n"
+    "+    return math.sqrt(a * a + b * b)\n"
+    "diff --git a/docs/notes.txt b/docs/notes.txt\n"
+    "index 2222222..3333333 100644\n"
+    "--- a/docs/notes.txt\n"
+    "+++ b/docs/notes.txt\n"
+    "@@ -1 +1 @@\n"
+    "-old note\n"
+    "+new note\n"
+    "diff --git a/src/legacy.py b/src/legacy.py\n"
+    "deleted file mode 100644\n"
+    "index 4444444..0000000\n"
+    "--- a/src/legacy.py\n"
+    "+++ /dev/null\n"
+    "@@ -1,2 +0,0 @@\n"
+    "-def legacy():\n"
+    "-    pass\n"
+)
+
+
+@pytest.fixture
+def results() -> dict[str, ExtractedFileContext]:
+    """여러 파일 patch의 추출 결과를 반환합니다."""
+    return PatchContextExtractor().extract(MULTI_FILE_PATCH)
+
+
+class TestPatchExtraction:
+    """patch 파일 컨텍스트 추출 테스트."""
+
+    def test_results_keyed_by_new_path(
+        self, results: dict[str, ExtractedFileContext]
+    ) -> None:
+        """삭제된 파일을 제외한 파일들이 b/ 경로로 반환되는지 테스트."""
+        assert list(results) == [
+            "src/calculator.py",
+            "src/helpers.py",
+            "docs/notes.txt",
+        ]
+
+    def test_modified_file_uses_fragment(
+        self, results: dict[str, ExtractedFileContext]
+    ) -> None:
+        """hunk만 있는 파일은 조각을 파싱하고 라인 번호를 파일 기준으로 옮기는지 테스트."""
+        result = results["src/calculator.py"]
+
+        assert result.extraction_mode == ExtractedFileContext.FRAGMENT_MODE
+        assert [(block.name, block.line_range) for block in result.context_blocks] == [
+            ("multiply", LineRange(40, 42))
+        ]
+
+    def test_new_file_uses_full_content(
+        self, results: dict[str, ExtractedFileContext]
+    ) -> None:
+        """새 파일은 patch로 복원한 전체 내용에서 추출되는지 테스트."""
+        result = results["src/helpers.py"]
+
+        assert result.extraction_mode != ExtractedFileContext.FRAGMENT_MODE
+        assert "import math" in result.dependency_blocks[0].text
+        assert [block.name for block in result.context_blocks] == ["hypot"]
+
+    def test_unsupported_language_is_skipped(
+        self, results: dict[str, ExtractedFileContext]
+    ) -> None:
+        """지원하지 않는 언어의 파일은 unsupported-language로 기록되는지 테스트."""
+        result = results["docs/notes.txt"]
+
+        assert result.status == ExtractedFileContext.UNSUPPORTED_STATUS
+        assert result.blocks == []
+
+    def test_extract_patch_file(self, tmp_path: Path) -> None:
+        """patch 파일 경로로 바로 추출하는지 테스트."""
+        patch_path = tmp_path / "change.patch"
+        patch_path.write_text(MULTI_FILE_PATCH, encoding="utf-8")
+
+        results = extract_patch(patch_path)
+
+        assert results["src/calculator.py"].language == "python"
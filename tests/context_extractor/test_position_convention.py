@@ -0,0 +1,77 @@
+"""PositionConvention(라인 번호/바이트 오프셋 표기 규칙) 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange, PositionConvention
+
+CONTENT = "import os\n\ndef 함수():\n    return 1\n"
+
+ZERO_BASED_EXCLUSIVE = PositionConvention(line_base=0, inclusive_end_line=False)
+
+
+def _block() -> ContextBlock:
+    return ContextBlock(
+        text="def 함수():\n    return 1",
+        line_range=LineRange(3, 4),
+        changed_lines=(4,),
+    )
+
+
+class TestPositionConvention:
+    """라인/바이트 표기 규칙 변환 테스트."""
+
+    def test_default_is_one_based_inclusive(self) -> None:
+        """기본 규칙이 현재 동작과 같은 1-based inclusive인지 테스트."""
+        block = _block()
+
+        assert block.line_span() == (3, 4)
+        assert block.changed_line_numbers() == (4,)
+
+    @pytest.mark.parametrize(
+        ("convention", "expected"),
+        [
+            (PositionConvention(line_base=0), (2, 3)),
+            (PositionConvention(inclusive_end_line=False), (3, 5)),
+            (ZERO_BASED_EXCLUSIVE, (2, 4)),
+        ],
+    )
+    def test_line_span(
+        self, convention: PositionConvention, expected: tuple[int, int]
+    ) -> None:
+        """라인 기준과 끝 라인 포함 여부에 따라 라인 범위가 바뀌는지 테스트."""
+        assert _block().line_span(convention) == expected
+
+    def test_changed_lines_follow_line_base(self) -> None:
+        """변경 라인 번호가 라인 기준만 따르는지 테스트."""
+        assert _block().changed_line_numbers(ZERO_BASED_EXCLUSIVE) == (3,)
+
+    def test_byte_span(self) -> None:
+        """바이트 범위가 UTF-8 기준 0-based exclusive로 계산되는지 테스트."""
+        start, end = _block().byte_span(CONTENT)
+
+        assert (start, end) == (11, 11 + len(_block().text.encode("utf-8")))
+        assert CONTENT.encode("utf-8")[start:end].decode("utf-8") == _block().text
+
+    def test_byte_span_with_convention(self) -> None:
+        """바이트 기준과 끝 오프셋 포함 여부에 따라 바이트 범위가 바뀌는지 테스트."""
+        start, end = _block().byte_span(CONTENT)
+        convention = PositionConvention(byte_base=1, inclusive_end_byte=True)
+
+        assert _block().byte_span(CONTENT, convention) == (start + 1, end)
+
+    def test_round_trip_to_line_range(self) -> None:
+        """다른 표기의 라인 범위를 LineRange로 되돌릴 수 있는지 테스트."""
+        assert ZERO_BASED_EXCLUSIVE.to_line_range(2, 4) == LineRange(3, 4)
+
+    def test_byte_span_requires_full_content(self) -> None:
+        """파일 내용이 블록보다 짧으면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError):
+            _block().byte_span("import os\n")
+
+    @pytest.mark.parametrize("options", [{"line_base": 2}, {"byte_base": -1}])
+    def test_invalid_base_is_rejected(self, options: dict[str, int]) -> None:
+        """기준이 0 또는 1이 아니면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError):
+            PositionConvention(**options)
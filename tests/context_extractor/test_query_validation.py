@@ -0,0 +1,123 @@
+"""사용자 정의 tree-sitter 쿼리 검증 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import QueryIssue, validate_query
+from selvage.src.context_extractor.query_validation import QueryValidator
+
+VALID_QUERY = """
+; 함수 정의와 이름
+(function_definition
+  name: (identifier) @symbol.name) @symbol
+"""
+
+
+class TestValidQuery:
+    """올바른 쿼리 검증 테스트."""
+
+    def test_valid_query_has_no_issues(self) -> None:
+        """올바른 쿼리는 오류와 경고가 없는지 테스트."""
+        result = validate_query("python", VALID_QUERY)
+
+        assert result.is_valid
+        assert result.errors == []
+        assert result.warnings == []
+
+    def test_missing_expected_capture_is_warned(self) -> None:
+        """기대 캡처가 빠진 경우 경고를 반환하는지 테스트."""
+        result = validate_query("python", "(function_definition) @symbol")
+
+        assert result.is_valid
+        assert [issue.kind for issue in result.warnings] == [
+            QueryIssue.MISSING_CAPTURE
+        ]
+        assert "@symbol.name" in result.warnings[0].message
+
+    def test_custom_expected_captures(self) -> None:
+        """기대 캡처 목록을 직접 지정할 수 있는지 테스트."""
+        result = validate_query(
+            "python", "(class_definition) @class.definition", ["class.definition"]
+        )
+
+        assert result.warnings == []
+
+
+class TestMalformedQuery:
+    """잘못된 쿼리 검증 테스트."""
+
+    def test_unclosed_parenthesis_reports_offset(self) -> None:
+        """닫히지 않은 괄호의 위치가 보고되는지 테스트."""
+        query = "(function_definition\n  name: (identifier @symbol.name"
+        result = validate_query("python", query)
+
+        assert not result.is_valid
+        issue = result.errors[0]
+        assert issue.kind == QueryIssue.SYNTAX_ERROR
+        assert issue.offset == query.index("(identifier")
+        assert (issue.row, issue.column) == (1, 8)
+
+    def test_unmatched_closing_parenthesis(self) -> None:
+        """여분의 닫는 괄호 테스트."""
+        result = validate_query("python", "(identifier) @symbol)")
+
+        assert result.error_kinds() == [QueryIssue.SYNTAX_ERROR]
+        assert result.errors[0].offset == 20
+
+    def test_unknown_node_type(self) -> None:
+        """문법에 없는 노드 타입 테스트."""
+        result = validate_query("python", "(function_definitoin) @symbol")
+
+        assert result.error_kinds() == [QueryIssue.UNKNOWN_NODE_TYPE]
+        assert "function_definitoin" in result.errors[0].message
+        assert result.errors[0].offset == 1
+
+    def test_unknown_field(self) -> None:
+        """문법에 없는 필드 이름 테스트."""
+        result = validate_query(
+            "python", "(function_definition nme: (identifier) @symbol.name) @symbol"
+        )
+
+        assert result.error_kinds() == [QueryIssue.UNKNOWN_FIELD]
+        assert result.errors[0].offset == 21
+
+    def test_bad_capture_name(self) -> None:
+        """잘못된 캡처 이름 테스트."""
+        result = validate_query("python", "(identifier) @1name")
+
+        assert result.error_kinds() == [QueryIssue.BAD_CAPTURE_NAME]
+
+    def test_predicate_with_undefined_capture(self) -> None:
+        """predicate가 정의되지 않은 캡처를 참조하는 경우 테스트."""
+        result = validate_query(
+            "python", '((identifier) @symbol.name (#eq? @name "main"))'
+        )
+
+        assert result.error_kinds() == [QueryIssue.UNDEFINED_CAPTURE]
+
+    def test_unknown_language(self) -> None:
+        """불러올 수 없는 언어 테스트."""
+        result = validate_query("no-such-language", VALID_QUERY)
+
+        assert result.error_kinds() == [QueryIssue.UNKNOWN_LANGUAGE]
+
+
+class TestCompilerErrorConversion:
+    """tree-sitter 컴파일 오류 변환 테스트."""
+
+    def test_offset_in_message_is_converted_to_position(self) -> None:
+        """오류 메시지의 오프셋이 행/열로 변환되는지 테스트."""
+        issue = QueryValidator()._issue_from_exception(
+            "(a)\n(b)", ValueError("Invalid syntax at offset 5")
+        )
+
+        assert issue.kind == QueryIssue.SYNTAX_ERROR
+        assert (issue.offset, issue.row, issue.column) == (5, 1, 1)
+
+    def test_message_without_position(self) -> None:
+        """위치 정보가 없는 오류 메시지 테스트."""
+        issue = QueryValidator()._issue_from_exception(
+            "(a)", ValueError("Impossible pattern")
+        )
+
+        assert issue.kind == QueryIssue.INVALID_QUERY
+        assert issue.offset is None
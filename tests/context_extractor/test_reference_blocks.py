@@ -0,0 +1,38 @@
+"""변경 블록(primary)과 참고용 블록(reference) 분리 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    LineRange,
+)
+
+
+class TestReferenceBlockSplit:
+    """ExtractedFileContext의 primary/reference 분리 테스트."""
+
+    def test_blocks_are_split_by_reason(self) -> None:
+        """reason에 따라 변경 블록과 참고용 블록이 나뉘는지 테스트."""
+        changed = ContextBlock(text="def f(): ...", line_range=LineRange(10, 12))
+        header = ContextBlock(
+            text="class Box:",
+            line_range=LineRange(1, 1),
+            reason="enclosing-declaration",
+        )
+        referenced = ContextBlock(
+            text="class Size: ...",
+            line_range=LineRange(3, 4),
+            reason="referenced-type",
+        )
+        dependency = ContextBlock(
+            text="import os", line_range=LineRange(1, 1), is_dependency=True
+        )
+        context = ExtractedFileContext(
+            file_path="box.py",
+            language="python",
+            blocks=[dependency, changed, referenced, header],
+        )
+
+        assert context.primary_blocks == [header, changed]
+        assert context.reference_blocks == [referenced]
@@ -0,0 +1,142 @@
+"""SarifLocationRenderer(SARIF location 직렬화) 테스트 케이스."""
+
+from __future__ import annotations
+
+import json
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    LineRange,
+    SymbolChangeStatus,
+    render_sarif_locations,
+)
+
+# 2번째 라인에 BMP 밖 문자(👋, UTF-16 2유닛/UTF-8 4바이트)와 é(1유닛/2바이트)가 있음
+SOURCE = (
+    "def greet(name):\n"
+    '    msg = "👋é"; handler = lambda: 1\n'
+    "    return msg + name\n"
+)
+
+
+@pytest.fixture
+def results() -> list[ExtractedFileContext]:
+    """함수 블록과 라인 중간에서 시작하는 블록이 있는 추출 결과를 반환합니다."""
+    return [
+        ExtractedFileContext(
+            file_path="src/hello world.py",
+            language="python",
+            blocks=[
+                ContextBlock(
+                    text="import json", line_range=LineRange(1, 1), is_dependency=True
+                ),
+                ContextBlock(
+                    text=SOURCE.rstrip("\n"),
+                    line_range=LineRange(1, 3),
+                    block_type="function_definition",
+                    name="greet",
+                    scope_path=("hello",),
+                ),
+                ContextBlock(
+                    text="handler = lambda: 1",
+                    line_range=LineRange(2, 2),
+                    block_type="assignment",
+                ),
+            ],
+        )
+    ]
+
+
+class TestRenderSarifLocations:
+    """render_sarif_locations() 테스트."""
+
+    def test_physical_location_per_block(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """의존성 블록을 제외한 블록마다 파일 URI와 region이 기록되는지 테스트."""
+        locations = render_sarif_locations(results, {"src/hello world.py": SOURCE})
+
+        assert len(locations) == 2
+        assert locations[0]["physicalLocation"] == {
+            "artifactLocation": {"uri": "src/hello%20world.py"},
+            "region": {
+                "startLine": 1,
+                "endLine": 3,
+                "startColumn": 1,
+                "endColumn": 22,
+                "byteOffset": 0,
+                "byteLength": len(SOURCE.rstrip("\n").encode("utf-8")),
+            },
+        }
+        assert locations[0]["logicalLocations"] == [
+            {
+                "name": "greet",
+                "fullyQualifiedName": "hello.greet",
+                "kind": "function_definition",
+            }
+        ]
+
+    def test_multibyte_line_uses_utf16_columns(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """멀티바이트 라인에서 컬럼은 UTF-16 코드 유닛, 오프셋은 UTF-8 바이트인지 테스트."""
+        locations = render_sarif_locations(results, {"src/hello world.py": SOURCE})
+
+        # `    msg = "👋é"; ` = 16문자, UTF-16 17유닛, UTF-8 20바이트
+        assert locations[1]["physicalLocation"]["region"] == {
+            "startLine": 2,
+            "endLine": 2,
+            "startColumn": 18,
+            "endColumn": 37,
+            "byteOffset": len("def greet(name):\n") + 20,
+            "byteLength": len("handler = lambda: 1"),
+        }
+        assert "logicalLocations" not in locations[1]
+
+    def test_without_file_content_only_lines(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """파일 내용이 없으면 region에 라인 범위만 기록되고 JSON으로 직렬화되는지 테스트."""
+        locations = render_sarif_locations(results)
+
+        assert [location["physicalLocation"]["region"] for location in locations] == [
+            {"startLine": 1, "endLine": 3},
+            {"startLine": 2, "endLine": 2},
+        ]
+        assert json.loads(json.dumps(locations)) == locations
+
+    def test_change_flags_in_properties(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """변경 상태가 분류된 블록만 properties에 추가/삭제 포함 여부가 기록되는지 테스트."""
+        block = results[0].context_blocks[0]
+        block.change_status = SymbolChangeStatus.ADDED
+        block.has_additions = True
+
+        locations = render_sarif_locations(results)
+
+        assert locations[0]["properties"] == {
+            "hasAdditions": True,
+            "hasDeletions": False,
+        }
+        assert "properties" not in locations[1]
+
+    def test_concurrency_flags_in_properties(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """동시성 구문을 사용하는 블록만 properties에 사용 여부가 기록되는지 테스트."""
+        block = results[0].context_blocks[0]
+        block.uses_goroutine = True
+        block.uses_channel = True
+
+        locations = render_sarif_locations(results)
+
+        assert locations[0]["properties"] == {
+            "usesGoroutine": True,
+            "usesChannel": True,
+            "usesMutex": False,
+        }
+        assert "properties" not in locations[1]
@@ -0,0 +1,57 @@
+"""언어별 스코프 resolver 레지스트리 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Generator
+from pathlib import Path
+
+import pytest
+from tree_sitter import Node
+
+from selvage.src.context_extractor import ContextExtractor, LineRange
+from selvage.src.context_extractor.go_scope_resolver import GoScopeResolver
+from selvage.src.context_extractor.scope_resolver_registry import (
+    ScopeResolverRegistry,
+)
+
+FIXTURE_DIR = Path(__file__).parent
+
+
+class PackageQualifiedGoResolver(GoScopeResolver):
+    """receiver 타입 앞에 package 이름을 붙이는 테스트용 resolver."""
+
+    def scope_path(self, node: Node, limit: int | None = None) -> tuple[str, ...]:
+        """package 이름과 receiver 타입 이름을 반환한다."""
+        return ("main", *super().scope_path(node, limit))
+
+
+class TestScopeResolverRegistry:
+    """언어별 스코프 resolver 등록/조회 테스트."""
+
+    @pytest.fixture
+    def package_qualified_go_resolver(self) -> Generator[None, None, None]:
+        """package 이름을 붙이는 Go resolver를 등록하고 테스트 후 복원합니다."""
+        original = ScopeResolverRegistry.get("go")
+        ScopeResolverRegistry.register("go", PackageQualifiedGoResolver())
+        yield
+        ScopeResolverRegistry.register("go", original)
+
+    def test_unregistered_language_has_no_resolver(self) -> None:
+        """전용 resolver가 없는 언어는 공통 규칙을 쓰도록 None을 반환하는지 테스트."""
+        assert ScopeResolverRegistry.get("python") is None
+        assert isinstance(ScopeResolverRegistry.get("go"), GoScopeResolver)
+
+    def test_registered_resolver_is_used(
+        self, package_qualified_go_resolver: None
+    ) -> None:
+        """등록한 resolver의 scope_path가 추출 블록에 적용되는지 테스트."""
+        source = (FIXTURE_DIR / "go" / "SampleCalculator.go").read_text(
+            encoding="utf-8"
+        )
+
+        blocks = ContextExtractor("go").extract_context_blocks(
+            source, [LineRange(76, 77)]
+        )
+
+        method_block = next(block for block in blocks if block.name == "AddNumbers")
+        assert method_block.scope_path == ("main", "SampleCalculator")
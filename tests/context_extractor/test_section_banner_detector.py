@@ -0,0 +1,55 @@
+"""SectionBannerDetector(배너/region 주석 판별) 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import ExtractionOptions, SectionBannerDetector
+
+
+class TestSectionBannerDetector:
+    """배너 주석 휴리스틱 테스트."""
+
+    @pytest.mark.parametrize(
+        ("comment", "label"),
+        [
+            ("// ===== Handlers =====", "Handlers"),
+            ("/* ---- Utils ---- */", "Utils"),
+            ("# Queries ----------", "Queries"),
+            ("// MARK: - Views", "Views"),
+            ("#pragma mark Lifecycle", "Lifecycle"),
+            ("// region Setup", "Setup"),
+            ("# PUBLIC API", "PUBLIC API"),
+        ],
+    )
+    def test_default_banners(self, comment: str, label: str) -> None:
+        """구분 문자, region 표시, 대문자 주석이 배너로 판별되는지 테스트."""
+        assert SectionBannerDetector().label(comment) == label
+
+    @pytest.mark.parametrize(
+        "comment",
+        ["// ==========", "// TODO", "// 합계를 계산한다", "/**\n * Docs\n */"],
+    )
+    def test_non_banners(self, comment: str) -> None:
+        """구분 문자만 있는 줄, 작업 표시, 일반/여러 줄 주석은 배너가 아닌지 테스트."""
+        assert SectionBannerDetector().label(comment) is None
+
+    def test_custom_patterns_with_label_group(self) -> None:
+        """설정한 패턴의 label 그룹이 구역 이름으로 쓰이는지 테스트."""
+        detector = SectionBannerDetector([r"^섹션:\s*(?P<label>.+)$"])
+
+        assert detector.label("// 섹션: 입력 검증") == "입력 검증"
+        assert detector.label("// ===== Handlers =====") is None
+
+    def test_section_for_nearest_preceding_banner(self) -> None:
+        """기준 라인과 그 앞에서 가장 가까운 배너가 선택되는지 테스트."""
+        banners = [(3, "Handlers"), (9, "Helpers")]
+
+        assert SectionBannerDetector.section_for(banners, 2) is None
+        assert SectionBannerDetector.section_for(banners, 5) == "Handlers"
+        assert SectionBannerDetector.section_for(banners, 9) == "Helpers"
+
+    def test_invalid_pattern_is_rejected(self) -> None:
+        """옵션의 배너 정규식이 올바르지 않으면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError):
+            ExtractionOptions(section_banner_patterns=("(unclosed",))
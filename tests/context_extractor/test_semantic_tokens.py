@@ -0,0 +1,100 @@
+"""시맨틱 토큰 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    LineRange,
+    SemanticToken,
+    SemanticTokenKind,
+    semantic_tokens,
+)
+from selvage.src.exceptions import UnsupportedLanguageError
+
+PYTHON_SOURCE = """import json
+
+
+class Report:
+    def render(self, rows: list[str]) -> str:
+        title = "보고서"; total = len(rows)
+        return json.dumps({"title": title, "total": total})
+"""
+
+GO_SOURCE = """package report
+
+// Summary는 보고서 요약이다
+func Summary(rows []string) int {
+\treturn len(rows) + 1
+}
+"""
+
+
+def _kinds(tokens: list[SemanticToken]) -> list[tuple[str, SemanticTokenKind]]:
+    return [(token.text, token.kind) for token in tokens]
+
+
+class TestSemanticTokens:
+    """semantic_tokens API 테스트."""
+
+    def test_classifies_tokens_on_changed_line(self) -> None:
+        """변경 라인의 토큰만 종류별로 분류되는지 테스트."""
+        tokens = semantic_tokens("report.py", PYTHON_SOURCE, [LineRange(5, 5)])
+
+        assert _kinds(tokens) == [
+            ("def", SemanticTokenKind.KEYWORD),
+            ("render", SemanticTokenKind.FUNCTION_NAME),
+            ("self", SemanticTokenKind.VARIABLE),
+            ("rows", SemanticTokenKind.VARIABLE),
+            ("list", SemanticTokenKind.TYPE),
+            ("str", SemanticTokenKind.TYPE),
+            ("str", SemanticTokenKind.TYPE),
+        ]
+        assert {token.start_line for token in tokens} == {5}
+
+    def test_member_call_and_strings(self) -> None:
+        """멤버 호출 이름은 function-name, 문자열은 리터럴 하나로 분류되는지 테스트."""
+        tokens = semantic_tokens("report.py", PYTHON_SOURCE, [LineRange(7, 7)])
+
+        assert _kinds(tokens)[:4] == [
+            ("return", SemanticTokenKind.KEYWORD),
+            ("json", SemanticTokenKind.VARIABLE),
+            ("dumps", SemanticTokenKind.FUNCTION_NAME),
+            ('"title"', SemanticTokenKind.STRING),
+        ]
+
+    def test_multibyte_columns(self) -> None:
+        """한글 문자열 뒤의 토큰 열이 바이트가 아닌 문자 단위인지 테스트."""
+        tokens = semantic_tokens("report.py", PYTHON_SOURCE, [LineRange(6, 6)])
+        by_text = {token.text: token for token in tokens}
+
+        assert (by_text['"보고서"'].start_column, by_text['"보고서"'].end_column) == (
+            16,
+            21,
+        )
+        assert by_text["total"].start_column == 23
+        assert by_text["len"].kind == SemanticTokenKind.FUNCTION_NAME
+        assert by_text["len"].start_column == 31
+
+    def test_go_comment_and_number(self) -> None:
+        """Go 주석과 숫자 리터럴, 함수 이름이 분류되는지 테스트."""
+        tokens = semantic_tokens("report.go", GO_SOURCE, [LineRange(3, 5)])
+
+        assert _kinds(tokens) == [
+            ("// Summary는 보고서 요약이다", SemanticTokenKind.COMMENT),
+            ("func", SemanticTokenKind.KEYWORD),
+            ("Summary", SemanticTokenKind.FUNCTION_NAME),
+            ("rows", SemanticTokenKind.VARIABLE),
+            ("string", SemanticTokenKind.TYPE),
+            ("int", SemanticTokenKind.TYPE),
+            ("return", SemanticTokenKind.KEYWORD),
+            ("len", SemanticTokenKind.FUNCTION_NAME),
+            ("rows", SemanticTokenKind.VARIABLE),
+            ("1", SemanticTokenKind.NUMBER),
+        ]
+        assert tokens[0].end_column == len("// Summary는 보고서 요약이다")
+
+    def test_unsupported_language(self) -> None:
+        """토큰 분류 쿼리가 없는 언어는 UnsupportedLanguageError인지 테스트."""
+        with pytest.raises(UnsupportedLanguageError):
+            semantic_tokens("notes.txt", "hello", [LineRange(1, 1)])
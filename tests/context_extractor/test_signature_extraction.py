@@ -0,0 +1,212 @@
+"""변경된 함수의 구조화된 시그니처(파라미터/반환 타입) 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    SignatureParameter,
+    SymbolSignature,
+)
+
+PYTHON_SOURCE = """import functools
+
+
+@functools.cache
+def load(path: str, *paths: str, retries: int = 3, **kwargs) -> dict[str, int]:
+    return {}
+
+
+def first[T](items: list[T], /, default=None) -> T | None:
+    return items[0] if items else default
+"""
+
+TYPESCRIPT_SOURCE = """export function merge<T, U extends object>(
+  target: T,
+  source?: U,
+  ...rest: U[]
+): T & U {
+  return Object.assign(target, source, ...rest);
+}
+"""
+
+JAVA_SOURCE = """public class Repository<E> {
+    public <K extends Comparable<K>> List<E> findAll(K key, String... tags) {
+        return List.of();
+    }
+
+    public Repository(int size) {
+        this.size = size;
+    }
+}
+"""
+
+KOTLIN_SOURCE = """fun <T> joinAll(vararg items: T, separator: String = ", "): String? {
+    return items.joinToString(separator)
+}
+"""
+
+GO_GENERIC_SOURCE = """package main
+
+func Map[K comparable, V any](values map[K]V, fns ...func(V) V) []V {
+	return nil
+}
+"""
+
+
+def _signature(
+    language: str, source: str, changed_line: int, name: str
+) -> SymbolSignature | None:
+    """시그니처 옵션을 켜고 추출한 블록 중 이름이 일치하는 블록의 시그니처를 반환한다."""
+    extractor = ContextExtractor(language, ExtractionOptions(include_signatures=True))
+    blocks = extractor.extract_context_blocks(
+        source, [LineRange(changed_line, changed_line)]
+    )
+    return next(block.signature for block in blocks if block.name == name)
+
+
+class TestGoSignature:
+    """Go 시그니처 테스트."""
+
+    def test_method_with_grouped_params_and_multiple_returns(self) -> None:
+        """묶음 파라미터와 다중 반환을 가진 메서드 시그니처 테스트."""
+        file_path = Path(__file__).parent / "go" / "SampleCalculator.go"
+        source = file_path.read_text(encoding="utf-8")
+
+        signature = _signature("go", source, 53, "AddNumbers")
+
+        assert signature is not None
+        assert signature.parameters == (
+            SignatureParameter("a", "int"),
+            SignatureParameter("b", "int"),
+        )
+        assert signature.returns == ("int", "error")
+        assert signature.receiver == SignatureParameter("calc", "*SampleCalculator")
+
+    def test_generic_function_with_variadic(self) -> None:
+        """타입 파라미터와 가변 인자 테스트."""
+        signature = _signature("go", GO_GENERIC_SOURCE, 4, "Map")
+
+        assert signature is not None
+        assert signature.type_parameters == ("K comparable", "V any")
+        assert signature.parameters == (
+            SignatureParameter("values", "map[K]V"),
+            SignatureParameter("fns", "func(V) V", is_variadic=True),
+        )
+        assert signature.returns == ("[]V",)
+
+
+class TestPythonSignature:
+    """Python 시그니처 테스트."""
+
+    def test_decorated_function(self) -> None:
+        """데코레이터가 있는 함수의 가변 인자/기본값 파라미터 테스트."""
+        signature = _signature("python", PYTHON_SOURCE, 6, "load")
+
+        assert signature is not None
+        assert signature.parameters == (
+            SignatureParameter("path", "str"),
+            SignatureParameter("paths", "str", is_variadic=True),
+            SignatureParameter("retries", "int", has_default=True),
+            SignatureParameter("kwargs", is_variadic=True),
+        )
+        assert signature.returns == ("dict[str, int]",)
+
+    def test_generic_function(self) -> None:
+        """PEP 695 타입 파라미터와 위치 전용 구분자 테스트."""
+        signature = _signature("python", PYTHON_SOURCE, 10, "first")
+
+        assert signature is not None
+        assert signature.type_parameters == ("T",)
+        assert [param.name for param in signature.parameters] == ["items", "default"]
+        assert signature.returns == ("T | None",)
+
+
+class TestTypeScriptSignature:
+    """TypeScript 시그니처 테스트."""
+
+    def test_generic_function_with_optional_and_rest(self) -> None:
+        """제네릭, 선택 파라미터, 나머지 파라미터 테스트."""
+        signature = _signature("typescript", TYPESCRIPT_SOURCE, 6, "merge")
+
+        assert signature is not None
+        assert signature.type_parameters == ("T", "U extends object")
+        assert signature.parameters == (
+            SignatureParameter("target", "T"),
+            SignatureParameter("source", "U", has_default=True),
+            SignatureParameter("rest", "U[]", is_variadic=True),
+        )
+        assert signature.returns == ("T & U",)
+
+
+class TestJavaSignature:
+    """Java 시그니처 테스트."""
+
+    def test_generic_method_with_varargs(self) -> None:
+        """제네릭 메서드와 가변 인자 테스트."""
+        signature = _signature("java", JAVA_SOURCE, 3, "findAll")
+
+        assert signature is not None
+        assert signature.type_parameters == ("K extends Comparable<K>",)
+        assert signature.parameters == (
+            SignatureParameter("key", "K"),
+            SignatureParameter("tags", "String", is_variadic=True),
+        )
+        assert signature.returns == ("List<E>",)
+
+    def test_constructor_has_no_return(self) -> None:
+        """생성자는 반환 타입이 없는지 테스트."""
+        signature = _signature("java", JAVA_SOURCE, 7, "Repository")
+
+        assert signature == SymbolSignature(
+            parameters=(SignatureParameter("size", "int"),)
+        )
+
+
+class TestKotlinSignature:
+    """Kotlin 시그니처 테스트."""
+
+    def test_vararg_and_default_parameter(self) -> None:
+        """vararg, 기본값 파라미터와 nullable 반환 타입 테스트."""
+        extractor = ContextExtractor(
+            "kotlin", ExtractionOptions(include_signatures=True)
+        )
+        blocks = extractor.extract_context_blocks(KOTLIN_SOURCE, [LineRange(2, 2)])
+        signature = next(block.signature for block in blocks if block.signature)
+
+        assert signature.type_parameters == ("T",)
+        assert signature.parameters == (
+            SignatureParameter("items", "T", is_variadic=True),
+            SignatureParameter("separator", "String", has_default=True),
+        )
+        assert signature.returns == ("String?",)
+
+
+class TestSignatureOption:
+    """시그니처 옵션 테스트."""
+
+    def test_signature_is_not_parsed_by_default(self) -> None:
+        """기본 옵션에서는 시그니처를 파싱하지 않는지 테스트."""
+        blocks = ContextExtractor("go").extract_context_blocks(
+            GO_GENERIC_SOURCE, [LineRange(4, 4)]
+        )
+
+        assert all(block.signature is None for block in blocks)
+
+    def test_to_dict(self) -> None:
+        """직렬화 결과 테스트."""
+        signature = SymbolSignature(
+            parameters=(SignatureParameter("a", "int"),), returns=("error",)
+        )
+
+        assert signature.to_dict() == {
+            "parameters": [
+                {"name": "a", "type": "int", "is_variadic": False, "has_default": False}
+            ],
+            "returns": ["error"],
+            "type_parameters": [],
+            "receiver": None,
+        }
@@ -0,0 +1,76 @@
+"""strict 모드(구문 오류 시 추출 실패) 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextExtractor,
+    ExtractedFileContext,
+    ExtractionOptions,
+    ExtractionSummary,
+    LineRange,
+)
+from selvage.src.exceptions import StrictParseError
+
+BROKEN_SOURCE = """import os
+
+
+def healthy(path):
+    return os.path.exists(path)
+
+
+def broken(items):
+    total = sum(items
+    return total
+"""
+
+STRICT = ExtractionOptions(strict=True)
+
+
+class TestStrictMode:
+    """strict 옵션의 구문 오류 처리 테스트."""
+
+    def test_error_in_changed_symbol_raises(self) -> None:
+        """변경된 심볼 안에 구문 오류가 있으면 위치와 함께 예외가 발생하는지 테스트."""
+        extractor = ContextExtractor("python", STRICT)
+
+        with pytest.raises(StrictParseError) as exc_info:
+            extractor.extract_context_blocks(BROKEN_SOURCE, [LineRange(10, 10)])
+
+        locations = exc_info.value.locations
+        assert locations
+        assert all(8 <= line <= 10 for line, _ in locations)
+
+    def test_error_outside_changed_symbol_is_ignored(self) -> None:
+        """변경되지 않은 심볼의 구문 오류는 strict 모드에서도 무시하는지 테스트."""
+        extractor = ContextExtractor("python", STRICT)
+
+        blocks = extractor.extract_context_blocks(BROKEN_SOURCE, [LineRange(5, 5)])
+
+        assert [block.name for block in blocks if not block.is_dependency] == [
+            "healthy"
+        ]
+
+    def test_non_strict_mode_is_best_effort(self) -> None:
+        """strict가 꺼져 있으면 구문 오류가 있어도 블록을 반환하는지 테스트."""
+        blocks = ContextExtractor("python").extract_context_blocks(
+            BROKEN_SOURCE, [LineRange(10, 10)]
+        )
+
+        assert blocks
+
+    def test_file_context_lists_error_locations(self) -> None:
+        """파일 결과와 요약에 오류 파일과 위치가 기록되는지 테스트."""
+        extractor = ContextExtractor("python", STRICT)
+
+        result = extractor.extract_file_context(
+            "app/broken.py", BROKEN_SOURCE, [LineRange(10, 10)]
+        )
+        summary = ExtractionSummary.aggregate([result])
+
+        assert result.status == ExtractedFileContext.PARSE_ERROR_STATUS
+        assert result.has_parse_errors
+        assert result.blocks == []
+        assert summary.has_parse_errors
+        assert summary.parse_errors == {"app/broken.py": result.parse_error_locations}
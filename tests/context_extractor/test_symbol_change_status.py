@@ -0,0 +1,132 @@
+"""심볼 변경 상태(added/modified/context) 분류 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    DiffLineChanges,
+    LineRange,
+    SymbolChangeClassifier,
+    SymbolChangeStatus,
+)
+
+PYTHON_SOURCE = """def existing(value):
+    total = value + 1
+    return total
+
+
+def brand_new(value):
+    return value * 2
+"""
+
+
+def _block(start_line: int, end_line: int) -> ContextBlock:
+    """테스트용 컨텍스트 블록을 생성한다."""
+    return ContextBlock(text="", line_range=LineRange(start_line, end_line))
+
+
+class TestSymbolChangeClassifier:
+    """diff 추가/삭제 라인 기반 분류 테스트."""
+
+    def test_all_added_lines_is_added(self) -> None:
+        """모든 라인이 추가된 심볼은 added로 분류되는지 테스트."""
+        block = _block(6, 7)
+        SymbolChangeClassifier(DiffLineChanges(frozenset({5, 6, 7}))).annotate(
+            [block]
+        )
+
+        assert block.change_status == SymbolChangeStatus.ADDED
+        assert block.added_line_count == 2
+        assert block.deleted_line_count == 0
+        assert (block.has_additions, block.has_deletions) == (True, False)
+
+    def test_mixed_changes_is_modified(self) -> None:
+        """일부 라인만 변경된 심볼은 modified로 분류되는지 테스트."""
+        block = _block(1, 3)
+        SymbolChangeClassifier(DiffLineChanges(frozenset({2}), (2, 3))).annotate(
+            [block]
+        )
+
+        assert block.change_status == SymbolChangeStatus.MODIFIED
+        assert block.added_line_count == 1
+        assert block.deleted_line_count == 2
+        assert (block.has_additions, block.has_deletions) == (True, True)
+
+    def test_deletion_only_is_modified(self) -> None:
+        """삭제만 있는 심볼도 modified로 분류되는지 테스트."""
+        classifier = SymbolChangeClassifier(DiffLineChanges(deleted_lines=(2,)))
+
+        assert classifier.classify(_block(1, 3)) == SymbolChangeStatus.MODIFIED
+
+    def test_deletion_only_flags(self) -> None:
+        """삭제만 있는 심볼은 has_deletions만 True인지 테스트."""
+        block = _block(1, 3)
+        SymbolChangeClassifier(DiffLineChanges(deleted_lines=(2,))).annotate([block])
+
+        assert (block.has_additions, block.has_deletions) == (False, True)
+
+    def test_unchanged_symbol_has_no_flags(self) -> None:
+        """변경 라인이 범위 밖에만 있으면 두 플래그 모두 False인지 테스트."""
+        block = _block(1, 3)
+        SymbolChangeClassifier(DiffLineChanges(frozenset({10}), (4,))).annotate(
+            [block]
+        )
+
+        assert (block.has_additions, block.has_deletions) == (False, False)
+
+    def test_unchanged_symbol_is_context(self) -> None:
+        """변경 라인이 없는 심볼은 context로 분류되는지 테스트."""
+        classifier = SymbolChangeClassifier(DiffLineChanges(frozenset({10})))
+
+        assert classifier.classify(_block(1, 3)) == SymbolChangeStatus.CONTEXT
+
+    def test_dependency_blocks_are_skipped(self) -> None:
+        """의존성 블록에는 변경 상태가 기록되지 않는지 테스트."""
+        block = ContextBlock(
+            text="import os", line_range=LineRange(1, 1), is_dependency=True
+        )
+        SymbolChangeClassifier(DiffLineChanges(frozenset({1}))).annotate([block])
+
+        assert block.change_status is None
+
+    def test_header_shows_status_and_counts(self) -> None:
+        """변경 상태와 추가/삭제 라인 수가 헤더에 표시되는지 테스트."""
+        block = _block(1, 3)
+        SymbolChangeClassifier(DiffLineChanges(frozenset({2}), (3,))).annotate([block])
+
+        assert block.header(1) == (
+            "---- Context Block 1 (Lines 1-3) [modified: +1/-1] ----"
+        )
+
+    def test_combine_merges_hunks(self) -> None:
+        """여러 hunk의 변경 정보가 합쳐지는지 테스트."""
+        combined = DiffLineChanges.combine(
+            [
+                DiffLineChanges(frozenset({1}), (5,)),
+                DiffLineChanges(frozenset({9}), (2,)),
+            ]
+        )
+
+        assert combined.added_lines == frozenset({1, 9})
+        assert combined.deleted_lines == (2, 5)
+
+
+class TestExtractFileContextChangeStatus:
+    """extract_file_context 연동 테스트."""
+
+    def test_brand_new_function_is_marked_added(self) -> None:
+        """diff에서 새로 추가된 함수가 added로 표시되는지 테스트."""
+        extractor = ContextExtractor("python")
+        context = extractor.extract_file_context(
+            "sample.py",
+            PYTHON_SOURCE,
+            [LineRange(2, 2), LineRange(5, 7)],
+            DiffLineChanges(frozenset({2, 5, 6, 7}), (2,)),
+        )
+
+        statuses = {block.name: block.change_status for block in context.context_blocks}
+        assert statuses == {
+            "existing": SymbolChangeStatus.MODIFIED,
+            "brand_new": SymbolChangeStatus.ADDED,
+        }
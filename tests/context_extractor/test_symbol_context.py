@@ -0,0 +1,98 @@
+"""정규화된 이름으로 심볼 하나의 컨텍스트를 추출하는 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import ContextExtractor, ExtractionOptions, LineRange
+from selvage.src.exceptions import AmbiguousSymbolError, SymbolNotFoundError
+
+SAMPLE_FILE = "python/sample_class.py"
+
+DUPLICATE_SOURCE = """class Circle:
+    def area(self):
+        return 3.14 * self.r * self.r
+
+
+class Square:
+    def area(self):
+        return self.side * self.side
+"""
+
+COMMENTED_SOURCE = """# 합계를 계산한다
+def total(values):
+    return sum(values)
+"""
+
+
+class TestFindSymbol:
+    """find_symbol() 이름 조회 테스트."""
+
+    def test_qualified_method_name(self, sample_file_content: str) -> None:
+        """클래스로 한정된 메서드 이름으로 메서드 블록을 찾는지 테스트."""
+        symbol = ContextExtractor("python").find_symbol(
+            sample_file_content, "SampleCalculator.add_numbers"
+        )
+
+        assert symbol.name == "add_numbers"
+        assert symbol.scope_path == ("SampleCalculator",)
+        assert symbol.line_range == LineRange(26, 46)
+
+    def test_unqualified_suffix_match(self, sample_file_content: str) -> None:
+        """정확히 일치하는 이름이 없으면 끝부분이 일치하는 심볼을 찾는지 테스트."""
+        symbol = ContextExtractor("python").find_symbol(
+            sample_file_content, "validate_inputs"
+        )
+
+        assert symbol.scope_path == ("SampleCalculator", "add_numbers")
+        assert symbol.line_range == LineRange(29, 31)
+
+    def test_not_found(self, sample_file_content: str) -> None:
+        """없는 이름은 SymbolNotFoundError를 발생시키는지 테스트."""
+        with pytest.raises(SymbolNotFoundError, match="SampleCalculator.divide"):
+            ContextExtractor("python").find_symbol(
+                sample_file_content, "SampleCalculator.divide"
+            )
+
+    def test_ambiguous_name(self) -> None:
+        """여러 클래스에 같은 이름이 있으면 후보와 함께 예외를 발생시키는지 테스트."""
+        extractor = ContextExtractor("python")
+
+        with pytest.raises(AmbiguousSymbolError) as exc_info:
+            extractor.find_symbol(DUPLICATE_SOURCE, "area")
+
+        assert exc_info.value.candidates == ("Circle.area@2", "Square.area@7")
+        assert extractor.find_symbol(DUPLICATE_SOURCE, "Square.area").line_range == (
+            LineRange(7, 8)
+        )
+
+
+class TestExtractSymbolContext:
+    """extract_symbol_context() 추출 테스트."""
+
+    def test_symbol_with_dependencies(self, sample_file_content: str) -> None:
+        """심볼 블록과 의존성 블록이 diff 추출과 같은 형식으로 반환되는지 테스트."""
+        result = ContextExtractor("python").extract_symbol_context(
+            "calc/sample_class.py", sample_file_content, "SampleCalculator.add_numbers"
+        )
+
+        assert result.file_path == "calc/sample_class.py"
+        assert [block.text for block in result.dependency_blocks] == [
+            "import json\nfrom typing import Any"
+        ]
+        assert [(block.name, block.line_range) for block in result.context_blocks] == [
+            ("add_numbers", LineRange(26, 46))
+        ]
+
+    def test_honors_extraction_options(self) -> None:
+        """주석 포함 옵션이 심볼 추출에도 적용되는지 테스트."""
+        extractor = ContextExtractor(
+            "python", options=ExtractionOptions(include_comments=True)
+        )
+
+        result = extractor.extract_symbol_context(
+            "calc/total.py", COMMENTED_SOURCE, "total"
+        )
+
+        assert [block.name for block in result.context_blocks] == ["total"]
+        assert result.context_blocks[0].text.startswith("# 합계를 계산한다\ndef total(")
@@ -0,0 +1,125 @@
+"""심볼 비용(바이트/토큰/복잡도 가중합) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    SymbolCostWeights,
+)
+
+COMPLEXITY_WEIGHTS = SymbolCostWeights(
+    byte_weight=0.1, token_weight=1.0, complexity_weight=5.0
+)
+
+GO_SOURCE = """package counter
+
+import "fmt"
+
+type Counter struct {
+\tvalue int
+}
+
+func (c *Counter) Value() int {
+\treturn c.value
+}
+
+func (c *Counter) Describe(limit int) string {
+\tif c.value > limit && limit > 0 {
+\t\treturn fmt.Sprintf("over: %d", c.value)
+\t}
+\tfor i := 0; i < limit; i++ {
+\t\tc.value++
+\t}
+\treturn fmt.Sprint(c.value)
+}
+"""
+
+
+def _context_blocks(
+    source: str, changed_ranges: list[LineRange], options: ExtractionOptions
+) -> list[ContextBlock]:
+    """Go 소스에서 추출한 블록들을 반환한다."""
+    return ContextExtractor("go", options).extract_context_blocks(
+        source, changed_ranges
+    )
+
+
+class TestSymbolCost:
+    """블록별 비용 계산 테스트."""
+
+    def test_metrics_and_weighted_value(self) -> None:
+        """MultiplyAndFormat의 바이트/토큰/복잡도와 가중합 비용을 계산하는지 테스트."""
+        source = (Path(__file__).parent / "go" / "SampleCalculator.go").read_text(
+            encoding="utf-8"
+        )
+        options = ExtractionOptions(include_cost=True, cost_weights=COMPLEXITY_WEIGHTS)
+
+        blocks = _context_blocks(source, [LineRange(126, 126)], options)
+        block = next(block for block in blocks if not block.is_dependency)
+
+        assert block.name == "MultiplyAndFormat"
+        assert block.cost is not None
+        assert block.cost.byte_size == len(block.text.encode("utf-8"))
+        assert block.cost.token_estimate == len(block.text) * 2 // 7
+        assert block.cost.complexity == 4
+        assert block.cost.value == pytest.approx(
+            block.cost.byte_size * 0.1 + block.cost.token_estimate + 4 * 5.0
+        )
+
+    def test_trivial_getter_costs_less(self) -> None:
+        """분기 없는 getter의 비용이 분기가 있는 메서드보다 낮은지 테스트."""
+        options = ExtractionOptions(include_cost=True, cost_weights=COMPLEXITY_WEIGHTS)
+
+        blocks = _context_blocks(
+            GO_SOURCE, [LineRange(10, 10), LineRange(18, 18)], options
+        )
+        costs = {block.name: block.cost for block in blocks if block.name}
+
+        assert costs["Value"].complexity == 1
+        assert costs["Describe"].complexity == 4
+        assert costs["Value"].value < costs["Describe"].value
+
+    def test_default_weights_skip_complexity(self) -> None:
+        """기본 가중치에서는 복잡도를 계산하지 않고 의존성 블록에는 비용이 없는지 테스트."""
+        blocks = _context_blocks(
+            GO_SOURCE, [LineRange(10, 10)], ExtractionOptions(include_cost=True)
+        )
+
+        dependency = next(block for block in blocks if block.is_dependency)
+        getter = next(block for block in blocks if block.name == "Value")
+        assert dependency.cost is None
+        assert getter.cost is not None
+        assert getter.cost.complexity is None
+        assert getter.cost.to_dict()["complexity"] is None
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 비용을 기록하지 않는지 테스트."""
+        blocks = _context_blocks(GO_SOURCE, [LineRange(10, 10)], ExtractionOptions())
+
+        assert all(block.cost is None for block in blocks)
+
+
+class TestSymbolCostWeights:
+    """비용 가중치 테스트."""
+
+    def test_combine(self) -> None:
+        """지표들을 가중합하고 복잡도가 없으면 제외하는지 테스트."""
+        weights = SymbolCostWeights(
+            byte_weight=0.5, token_weight=2.0, complexity_weight=3.0
+        )
+
+        assert weights.combine(100, 30, 4) == 50 + 60 + 12
+        assert weights.combine(100, 30) == 110
+        assert weights.includes_complexity
+
+    def test_negative_weight_rejected(self) -> None:
+        """음수 가중치는 ValueError인지 테스트."""
+        with pytest.raises(ValueError):
+            SymbolCostWeights(token_weight=-1.0)
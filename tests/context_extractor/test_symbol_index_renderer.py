@@ -0,0 +1,97 @@
+"""SymbolIndexRenderer(라인 단위 심볼 인덱스) 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    LineRange,
+    PositionConvention,
+    render_symbol_index,
+)
+
+
+def _block(
+    name: str | None, start: int, end: int, block_type: str = "function_definition"
+) -> ContextBlock:
+    return ContextBlock(
+        text="...", line_range=LineRange(start, end), block_type=block_type, name=name
+    )
+
+
+@pytest.fixture
+def outline() -> list[ExtractedFileContext]:
+    """중첩 심볼이 있는 outline 결과를 반환합니다."""
+    return [
+        ExtractedFileContext(
+            file_path="calc/sample.py",
+            language="python",
+            blocks=[
+                _block("multiply", 6, 7),
+                _block("add", 1, 2),
+                _block("Calculator", 5, 7, "class_definition"),
+                ContextBlock(
+                    text="import json", line_range=LineRange(1, 1), is_dependency=True
+                ),
+            ],
+            extraction_mode=ExtractedFileContext.OUTLINE_MODE,
+        ),
+        ExtractedFileContext(
+            file_path="calc/Shape.java",
+            language="java",
+            blocks=[
+                ContextBlock(
+                    text="...",
+                    line_range=LineRange(3, 4),
+                    block_type="method_declaration",
+                    name="area",
+                    scope_path=("Shape", "Circle"),
+                ),
+            ],
+        ),
+    ]
+
+
+class TestRenderSymbolIndex:
+    """render_symbol_index() 테스트."""
+
+    def test_one_line_per_symbol(self, outline: list[ExtractedFileContext]) -> None:
+        """심볼마다 경로, 라인 범위, 종류, 정규화된 이름이 탭으로 구분되는지 테스트."""
+        assert render_symbol_index(outline).split("\n") == [
+            "calc/sample.py\t1-2\tfunction_definition\tadd",
+            "calc/sample.py\t5-7\tclass_definition\tCalculator",
+            "calc/sample.py\t6-7\tfunction_definition\tCalculator.multiply",
+            "calc/Shape.java\t3-4\tmethod_declaration\tShape.Circle.area",
+        ]
+
+    def test_unnamed_blocks_are_skipped(self) -> None:
+        """이름 없는 블록은 인덱스에 포함하지 않는지 테스트."""
+        result = ExtractedFileContext(
+            file_path="notes.md", language="markdown", blocks=[_block(None, 1, 3)]
+        )
+
+        assert render_symbol_index([result]) == ""
+
+    def test_separators_in_fields_are_escaped(self) -> None:
+        """이름과 경로 안의 탭/줄바꿈/역슬래시가 이스케이프되는지 테스트."""
+        result = ExtractedFileContext(
+            file_path="dir\\with\ttab.py",
+            language="python",
+            blocks=[_block("odd\nname", 1, 1)],
+        )
+
+        assert render_symbol_index([result]) == (
+            "dir\\\\with\\ttab.py\t1-1\tfunction_definition\todd\\nname"
+        )
+
+    def test_zero_based_exclusive_convention(
+        self, outline: list[ExtractedFileContext]
+    ) -> None:
+        """0-based exclusive 규칙을 주면 라인 범위가 그 표기로 출력되는지 테스트."""
+        convention = PositionConvention(line_base=0, inclusive_end_line=False)
+
+        index = render_symbol_index(outline[:1], convention)
+
+        assert index.splitlines()[0] == "calc/sample.py\t0-2\tfunction_definition\tadd"
@@ -0,0 +1,93 @@
+"""심볼별 라인 지표(LOC, 주석 비율) 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+    SymbolLineMetrics,
+)
+
+LINE_METRICS = ExtractionOptions(include_line_metrics=True)
+
+PYTHON_SOURCE = """import os
+
+
+def load(path):
+    # 경로가 없으면 빈 문자열
+    if not os.path.exists(path):  # 존재 확인
+
+        return ""
+    return open(path).read()
+"""
+
+
+def _context_blocks(
+    language: str, source: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """라인 지표 옵션으로 추출한 의존성 외 블록들을 반환한다."""
+    blocks = ContextExtractor(language, LINE_METRICS).extract_context_blocks(
+        source, changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestSymbolLineMetrics:
+    """블록별 코드/주석/빈 라인 수 계산 테스트."""
+
+    def test_go_method_metrics(self) -> None:
+        """MultiplyAndFormat의 LOC와 주석/빈 라인 수를 계산하는지 테스트."""
+        source = (Path(__file__).parent / "go" / "SampleCalculator.go").read_text(
+            encoding="utf-8"
+        )
+
+        blocks = _context_blocks("go", source, [LineRange(126, 126)])
+
+        assert [block.name for block in blocks] == ["MultiplyAndFormat"]
+        assert blocks[0].line_metrics == SymbolLineMetrics(
+            code_lines=34, comment_lines=6, blank_lines=9
+        )
+        assert blocks[0].line_metrics.comment_ratio == 0.15
+
+    def test_trailing_comment_counts_as_code(self) -> None:
+        """코드 뒤에 주석이 붙은 라인은 코드 라인으로 세는지 테스트."""
+        blocks = _context_blocks("python", PYTHON_SOURCE, [LineRange(9, 9)])
+
+        assert blocks[0].line_metrics == SymbolLineMetrics(
+            code_lines=4, comment_lines=1, blank_lines=1
+        )
+
+    def test_metrics_shown_in_header(self) -> None:
+        """라인 지표가 있으면 헤더에 LOC와 주석 비율이 표시되는지 테스트."""
+        blocks = _context_blocks("python", PYTHON_SOURCE, [LineRange(9, 9)])
+
+        assert blocks[0].header(1).endswith("[loc: 4, comments: 20%] ----")
+
+    def test_disabled_by_default(self) -> None:
+        """옵션이 꺼져 있으면 라인 지표를 계산하지 않는지 테스트."""
+        blocks = ContextExtractor("python").extract_context_blocks(
+            PYTHON_SOURCE, [LineRange(9, 9)]
+        )
+
+        assert all(block.line_metrics is None for block in blocks)
+
+
+class TestSymbolLineMetricsData:
+    """SymbolLineMetrics 데이터 클래스 테스트."""
+
+    def test_comment_ratio_without_lines(self) -> None:
+        """빈 블록의 주석 비율은 0인지 테스트."""
+        assert SymbolLineMetrics(0, 0, 3).comment_ratio == 0.0
+
+    def test_to_dict(self) -> None:
+        """직렬화 결과에 반올림된 주석 비율이 포함되는지 테스트."""
+        assert SymbolLineMetrics(2, 1, 0).to_dict() == {
+            "code_lines": 2,
+            "comment_lines": 1,
+            "blank_lines": 0,
+            "comment_ratio": 0.333,
+        }
@@ -0,0 +1,185 @@
+"""같은 심볼의 이전/새 리비전 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    LineRange,
+    SymbolRevisionPair,
+)
+from selvage.src.context_extractor.symbol_revision_matcher import (
+    SymbolRevisionMatcher,
+)
+
+OLD_SOURCE = """def add(a, b):
+    return a + b
+
+
+def scale(values, factor):
+    result = []
+    for value in values:
+        result.append(value * factor)
+    return result
+"""
+
+NEW_SOURCE = """def add(a, b, c=0):
+    return a + b + c
+
+
+def multiply_all(values, factor):
+    result = []
+    for value in values:
+        result.append(value * factor)
+    return result
+
+
+def subtract(a, b):
+    return a - b
+"""
+
+
+def _block(
+    name: str | None, text: str, start_line: int, block_type: str = "function"
+) -> ContextBlock:
+    """테스트용 심볼 블록을 생성한다."""
+    end_line = start_line + text.count("\n")
+    return ContextBlock(
+        text=text,
+        line_range=LineRange(start_line, end_line),
+        block_type=block_type,
+        name=name,
+    )
+
+
+class TestSymbolRevisionMatcher:
+    """이전 리비전 심볼 매칭 테스트."""
+
+    def test_matches_by_name(self) -> None:
+        """같은 이름의 심볼 매칭 테스트."""
+        new_block = _block("add", "def add(a, b, c=0): ...", 1)
+        old_block = _block("add", "def add(a, b): ...", 1)
+
+        pair = SymbolRevisionMatcher().match(new_block, [old_block], {"add"})
+
+        assert pair.match_kind == SymbolRevisionPair.NAME_MATCH
+        assert pair.old_block is old_block
+        assert not pair.is_renamed
+
+    def test_same_name_prefers_nearest_position(self) -> None:
+        """같은 이름이 여러 개일 때 위치가 가까운 심볼을 선택하는지 테스트."""
+        new_block = _block("run", "def run(self): ...", 20)
+        far = _block("run", "def run(self): ...", 2)
+        near = _block("run", "def run(self): ...", 18)
+
+        pair = SymbolRevisionMatcher().match(new_block, [far, near], {"run"})
+
+        assert pair.old_block is near
+
+    def test_renamed_symbol_is_matched_by_similarity(self) -> None:
+        """이름이 바뀐 심볼을 내용 유사도로 찾는지 테스트."""
+        body = "\n    result = [v * factor for v in values]\n    return result"
+        new_block = _block("multiply_all", f"def multiply_all(values, factor):{body}", 5)
+        old_block = _block("scale", f"def scale(values, factor):{body}", 5)
+        unrelated = _block("add", "def add(a, b):\n    return a + b", 1)
+
+        pair = SymbolRevisionMatcher().match(
+            new_block, [unrelated, old_block], {"multiply_all", "add"}
+        )
+
+        assert pair.match_kind == SymbolRevisionPair.SIMILARITY_MATCH
+        assert pair.is_renamed
+        assert pair.old_block is old_block
+        assert pair.similarity is not None and pair.similarity >= 0.6
+
+    def test_symbol_still_present_is_not_rename_candidate(self) -> None:
+        """새 리비전에도 존재하는 이름은 이름 변경 후보에서 제외되는지 테스트."""
+        new_block = _block("subtract", "def subtract(a, b):\n    return a - b", 12)
+        old_block = _block("add", "def add(a, b):\n    return a + b", 1)
+
+        pair = SymbolRevisionMatcher().match(new_block, [old_block], {"subtract", "add"})
+
+        assert not pair.has_old_counterpart
+        assert pair.match_kind == SymbolRevisionPair.NO_MATCH
+        assert pair.old_text is None
+
+    def test_dissimilar_symbol_is_not_matched(self) -> None:
+        """유사도가 기준보다 낮으면 매칭하지 않는지 테스트."""
+        new_block = _block("render", "def render(page):\n    return template(page)", 1)
+        old_block = _block("parse", "class Parser:\n    tokens = []", 1)
+
+        pair = SymbolRevisionMatcher().match(new_block, [old_block], {"render"})
+
+        assert not pair.has_old_counterpart
+
+    def test_invalid_threshold(self) -> None:
+        """잘못된 유사도 기준에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="rename_threshold"):
+            SymbolRevisionMatcher(rename_threshold=1.5)
+
+
+class TestSymbolRevisionPairFormat:
+    """이전/이후 버전 포맷팅 테스트."""
+
+    def test_format_with_both_versions(self) -> None:
+        """두 버전이 헤더와 함께 포맷팅되는지 테스트."""
+        pair = SymbolRevisionPair(
+            new_block=_block("add", "def add(a, b, c=0): ...", 1),
+            old_block=_block("add", "def add(a, b): ...", 1),
+            match_kind=SymbolRevisionPair.NAME_MATCH,
+        )
+
+        assert pair.format() == (
+            "---- Before: add (Lines 1-1) ----\n"
+            "def add(a, b): ...\n"
+            "---- After: add (Lines 1-1) ----\n"
+            "def add(a, b, c=0): ..."
+        )
+
+    def test_format_without_old_version(self) -> None:
+        """이전 버전이 없을 때의 포맷팅 테스트."""
+        pair = SymbolRevisionPair(new_block=_block("sub", "def sub(): ...", 3))
+
+        assert pair.format().startswith("---- Before: (no previous version) ----\n")
+
+
+class TestExtractSymbolRevisions:
+    """ContextExtractor.extract_symbol_revisions 테스트."""
+
+    def test_modified_function(self) -> None:
+        """수정된 함수의 이전/이후 텍스트 반환 테스트."""
+        pair = ContextExtractor("python").extract_symbol_revisions(
+            OLD_SOURCE, NEW_SOURCE, "add"
+        )
+
+        assert pair.match_kind == SymbolRevisionPair.NAME_MATCH
+        assert pair.old_text == "def add(a, b):\n    return a + b"
+        assert pair.new_text == "def add(a, b, c=0):\n    return a + b + c"
+
+    def test_renamed_function(self) -> None:
+        """이름이 바뀐 함수를 이전 이름의 함수와 매칭하는지 테스트."""
+        pair = ContextExtractor("python").extract_symbol_revisions(
+            OLD_SOURCE, NEW_SOURCE, "multiply_all"
+        )
+
+        assert pair.is_renamed
+        assert pair.old_block is not None
+        assert pair.old_block.name == "scale"
+
+    def test_added_function_has_no_old_counterpart(self) -> None:
+        """새로 추가된 함수는 이전 버전이 없다고 표시되는지 테스트."""
+        pair = ContextExtractor("python").extract_symbol_revisions(
+            OLD_SOURCE, NEW_SOURCE, "subtract"
+        )
+
+        assert not pair.has_old_counterpart
+        assert pair.new_block.line_range == LineRange(12, 13)
+
+    def test_unknown_symbol_raises(self) -> None:
+        """새 리비전에 없는 심볼에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="missing"):
+            ContextExtractor("python").extract_symbol_revisions(
+                OLD_SOURCE, NEW_SOURCE, "missing"
+            )
@@ -0,0 +1,108 @@
+"""심볼별 크기 상한(max_symbol_lines/max_symbol_bytes) 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    ExtractionOptions,
+    LineRange,
+)
+
+LANGUAGE = "python"
+
+PYTHON_SOURCE = """def long_function(values):
+    total = 0
+    for value in values:
+        total += value
+    total *= 2
+    total -= 1
+    return total
+
+
+def short_function():
+    return 1
+"""
+
+SUMMARIZED = (
+    "def long_function(values):\n"
+    "    ... [lines 2-4 omitted]\n"
+    "    total *= 2\n"
+    "    ... [lines 6-7 omitted]"
+)
+
+
+class TestSymbolSizeCap:
+    """심볼별 크기 상한 테스트."""
+
+    def test_line_cap_keeps_signature_and_window(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """라인 상한을 넘는 심볼은 시그니처와 변경 라인만 남고 표시되는지 테스트."""
+        options = ExtractionOptions(adaptive_detail_window_lines=0, max_symbol_lines=5)
+
+        blocks = extract_blocks(
+            PYTHON_SOURCE,
+            [LineRange(5, 5)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert blocks[0].text == SUMMARIZED
+        assert blocks[0].size_capped
+        assert blocks[0].line_range == LineRange(1, 7)
+        assert "[size-capped]" in blocks[0].header(1)
+
+    def test_byte_cap(self, extract_blocks: Callable[..., list[ContextBlock]]) -> None:
+        """바이트 상한만 설정해도 큰 심볼이 줄어드는지 테스트."""
+        options = ExtractionOptions(adaptive_detail_window_lines=0, max_symbol_bytes=40)
+
+        blocks = extract_blocks(
+            PYTHON_SOURCE,
+            [LineRange(5, 5)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert blocks[0].text == SUMMARIZED
+
+    def test_small_symbol_is_not_capped(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """상한 이하인 심볼은 그대로 포함되고 표시되지 않는지 테스트."""
+        options = ExtractionOptions(adaptive_detail_window_lines=0, max_symbol_lines=5)
+
+        blocks = extract_blocks(
+            PYTHON_SOURCE,
+            [LineRange(11, 11)],
+            include_dependencies=False,
+            options=options,
+        )
+
+        assert blocks[0].text == "def short_function():\n    return 1"
+        assert not blocks[0].size_capped
+
+    def test_capped_blocks_are_reported(self) -> None:
+        """파일 결과에서 개별로 줄인 블록만 조회되는지 테스트."""
+        capped = ContextBlock(
+            text="def a(): ...", line_range=LineRange(1, 300), size_capped=True
+        )
+        full = ContextBlock(text="def b(): ...", line_range=LineRange(301, 305))
+        context = ExtractedFileContext(
+            file_path="a.py", language="python", blocks=[full, capped]
+        )
+
+        assert context.size_capped_blocks == [capped]
+
+    @pytest.mark.parametrize(
+        "options",
+        [{"max_symbol_lines": 0}, {"max_symbol_bytes": 0}],
+    )
+    def test_invalid_caps_are_rejected(self, options: dict[str, int]) -> None:
+        """상한이 1 미만이면 ValueError가 발생하는지 테스트."""
+        with pytest.raises(ValueError):
+            ExtractionOptions(**options)
@@ -0,0 +1,69 @@
+"""심볼 토큰 열(tokenize) 테스트 케이스."""
+
+from __future__ import annotations
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    LineRange,
+    SemanticTokenKind,
+    SymbolToken,
+    tokenize_symbol,
+)
+
+PYTHON_SOURCE = """import json
+
+
+def summarize(rows):
+    # 합계를 계산한다
+    total = len(rows) + 1
+    return json.dumps({"total": total})
+
+
+def unrelated():
+    return None
+"""
+
+SUMMARIZE = ContextBlock(text="def summarize(rows): ...", line_range=LineRange(4, 7))
+
+
+class TestSymbolTokenize:
+    """tokenize_symbol API 테스트."""
+
+    def test_flat_token_stream(self) -> None:
+        """심볼 블록 안의 토큰만 위치 순의 평탄한 열로 반환되는지 테스트."""
+        tokens = tokenize_symbol("report.py", PYTHON_SOURCE, SUMMARIZE)
+
+        assert [token.text for token in tokens] == [
+            "def",
+            "summarize",
+            "rows",
+            "total",
+            "len",
+            "rows",
+            "1",
+            "return",
+            "json",
+            "dumps",
+            '"total"',
+            "total",
+        ]
+        assert tokens[1] == SymbolToken(SemanticTokenKind.FUNCTION_NAME, "summarize")
+
+    def test_normalize_literals(self) -> None:
+        """리터럴 정규화 옵션이 문자열/숫자를 종류 자리표시자로 바꾸는지 테스트."""
+        tokens = tokenize_symbol(
+            "report.py", PYTHON_SOURCE, SUMMARIZE, normalize_literals=True
+        )
+        literals = [
+            token.text
+            for token in tokens
+            if token.kind in (SemanticTokenKind.STRING, SemanticTokenKind.NUMBER)
+        ]
+
+        assert literals == ["<number>", "<string>"]
+
+    def test_deterministic(self) -> None:
+        """같은 입력에 항상 같은 토큰 열을 반환하는지 테스트."""
+        first = tokenize_symbol("report.py", PYTHON_SOURCE, SUMMARIZE)
+
+        assert tokenize_symbol("report.py", PYTHON_SOURCE, SUMMARIZE) == first
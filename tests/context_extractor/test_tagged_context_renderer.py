@@ -0,0 +1,133 @@
+"""TaggedContextRenderer(`<file>` 태그 출력) 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ExtractedFileContext,
+    LineRange,
+    render_tagged_context,
+)
+
+
+@pytest.fixture
+def results() -> list[ExtractedFileContext]:
+    """의존성 블록과 중첩 메서드가 있는 추출 결과를 반환합니다."""
+    return [
+        ExtractedFileContext(
+            file_path="src/Box.java",
+            language="java",
+            blocks=[
+                ContextBlock(
+                    text="boolean less(int a, int b) {\n    return a < b && b > 0;\n}",
+                    line_range=LineRange(5, 7),
+                    block_type="method_declaration",
+                    name="less",
+                    scope_path=("Box",),
+                    qualified_name="Box.less",
+                ),
+                ContextBlock(
+                    text="import java.util.List;",
+                    line_range=LineRange(1, 1),
+                    is_dependency=True,
+                ),
+            ],
+        )
+    ]
+
+
+class TestRenderTaggedContext:
+    """render_tagged_context() 렌더링 테스트."""
+
+    def test_blocks_are_wrapped_with_escaped_body(
+        self, results: list[ExtractedFileContext]
+    ) -> None:
+        """의존성 블록 다음에 심볼 블록이 태그로 감싸지고 본문이 이스케이프되는지."""
+        assert render_tagged_context(results) == (
+            '<file path="src/Box.java" lang="java" symbol="" lines="1-1" '
+            'kind="dependencies">\n'
+            "import java.util.List;\n"
+            "</file>\n"
+            '<file path="src/Box.java" lang="java" symbol="Box.less" lines="5-7" '
+            'kind="method_declaration">\n'
+            "boolean less(int a, int b) {\n"
+            "    return a &lt; b &amp;&amp; b &gt; 0;\n"
+            "}\n"
+            "</file>"
+        )
+
+    def test_attribute_values_are_escaped(self) -> None:
+        """속성 값의 따옴표와 `<`, `&`가 이스케이프되는지 테스트."""
+        rendered = render_tagged_context(
+            [
+                ExtractedFileContext(
+                    file_path='a&b/"x".ts',
+                    language="typescript",
+                    blocks=[
+                        ContextBlock(
+                            text="type T = 1;",
+                            line_range=LineRange(2, 2),
+                            block_type="type_alias_declaration",
+                            name="T<U>",
+                            qualified_name="T<U>",
+                        )
+                    ],
+                )
+            ]
+        )
+
+        assert rendered.splitlines()[0] == (
+            '<file path="a&amp;b/&quot;x&quot;.ts" lang="typescript" '
+            'symbol="T&lt;U&gt;" lines="2-2" kind="type_alias_declaration">'
+        )
+
+    def test_cross_file_block_uses_source_path(self) -> None:
+        """다른 파일에서 가져온 블록은 원본 경로를 path로 쓰는지 테스트."""
+        rendered = render_tagged_context(
+            [
+                ExtractedFileContext(
+                    file_path="app.py",
+                    language="python",
+                    blocks=[
+                        ContextBlock(
+                            text="def helper(): ...",
+                            line_range=LineRange(3, 3),
+                            block_type="function_definition",
+                            name="helper",
+                            source_path="lib/util.py",
+                        )
+                    ],
+                )
+            ]
+        )
+
+        assert rendered.startswith('<file path="lib/util.py" lang="python"')
+
+    def test_symbol_is_qualified_name(self) -> None:
+        """symbol 속성이 scope_path가 아닌 블록의 qualified_name을 쓰는지 테스트."""
+        rendered = render_tagged_context(
+            [
+                ExtractedFileContext(
+                    file_path="calc.go",
+                    language="go",
+                    blocks=[
+                        ContextBlock(
+                            text="func (c *Calc) Add() {}",
+                            line_range=LineRange(7, 7),
+                            block_type="method_declaration",
+                            name="Add",
+                            scope_path=("Calc",),
+                            qualified_name="main.Calc.Add",
+                        )
+                    ],
+                )
+            ]
+        )
+
+        assert 'symbol="main.Calc.Add"' in rendered.splitlines()[0]
+
+    def test_empty_results(self) -> None:
+        """블록이 없으면 빈 문자열을 반환하는지 테스트."""
+        assert render_tagged_context([]) == ""
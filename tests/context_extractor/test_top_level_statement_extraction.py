@@ -0,0 +1,166 @@
+"""함수/클래스 밖 스크립트 코드의 최상위 문장 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractionOptions,
+    LineRange,
+)
+
+PYTHON_SCRIPT_SOURCE = """import sys
+
+
+def main():
+    print("main")
+
+
+for arg in sys.argv:
+    if arg.startswith("-"):
+        print(arg)
+
+if __name__ == "__main__":
+    main()
+"""
+
+JAVASCRIPT_SCRIPT_SOURCE = """const items = [1, 2, 3];
+
+for (const item of items) {
+  console.log(item);
+}
+
+function total() {
+  for (const item of items) {
+    console.log(item);
+  }
+}
+"""
+
+SHELL_SCRIPT_SOURCE = """#!/bin/bash
+set -e
+
+# 인자 출력
+print_args() {
+  echo "$@"
+}
+
+for file in *.txt; do
+  echo "$file"
+done
+
+print_args done
+"""
+
+
+def _context_blocks(
+    language: str,
+    source: str,
+    changed_ranges: list[LineRange],
+    options: ExtractionOptions | None = None,
+) -> list[ContextBlock]:
+    """추출 결과에서 컨텍스트 블록(의존성 제외)만 반환한다."""
+    extractor = ContextExtractor(language, options)
+    blocks = extractor.extract_context_blocks(source, changed_ranges)
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestPythonTopLevelStatement:
+    """Python 스크립트 코드 테스트."""
+
+    def test_change_inside_top_level_loop_returns_whole_loop(self) -> None:
+        """최상위 for 루프 내부 변경 시 루프 전체를 반환하는지 테스트."""
+        blocks = _context_blocks("python", PYTHON_SCRIPT_SOURCE, [LineRange(10, 10)])
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(8, 10)
+        assert blocks[0].text.startswith("for arg in sys.argv:")
+
+    def test_main_guard_is_returned(self) -> None:
+        """if __name__ == "__main__" 블록 내부 변경 테스트."""
+        blocks = _context_blocks("python", PYTHON_SCRIPT_SOURCE, [LineRange(13, 13)])
+
+        assert blocks[0].line_range == LineRange(12, 13)
+
+    def test_function_body_is_unaffected(self) -> None:
+        """함수 내부 변경은 기존처럼 함수 블록을 반환하는지 테스트."""
+        blocks = _context_blocks("python", PYTHON_SCRIPT_SOURCE, [LineRange(5, 5)])
+
+        assert blocks[0].name == "main"
+        assert blocks[0].line_range == LineRange(4, 5)
+
+    def test_line_limit_falls_back_to_inner_statement(self) -> None:
+        """최상위 문장이 라인 제한을 넘으면 제한 이내의 안쪽 문장을 반환하는지 테스트."""
+        blocks = _context_blocks(
+            "python",
+            PYTHON_SCRIPT_SOURCE,
+            [LineRange(10, 10)],
+            ExtractionOptions(max_top_level_statement_lines=2),
+        )
+
+        assert blocks[0].line_range == LineRange(9, 10)
+        assert blocks[0].text.startswith('if arg.startswith("-"):')
+
+    def test_disabled_with_zero_limit(self) -> None:
+        """라인 제한이 0이면 최상위 문장을 찾지 않는지 테스트."""
+        blocks = _context_blocks(
+            "python",
+            PYTHON_SCRIPT_SOURCE,
+            [LineRange(10, 10)],
+            ExtractionOptions(max_top_level_statement_lines=0),
+        )
+
+        assert blocks[0].line_range == LineRange(10, 10)
+
+    def test_negative_limit_is_rejected(self) -> None:
+        """음수 라인 제한에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match="max_top_level_statement_lines"):
+            ExtractionOptions(max_top_level_statement_lines=-1)
+
+
+class TestJavaScriptTopLevelStatement:
+    """JavaScript 스크립트 코드 테스트."""
+
+    def test_change_inside_top_level_loop_returns_whole_loop(self) -> None:
+        """최상위 for...of 루프 내부의 호출 변경 시 루프 전체 반환 테스트."""
+        blocks = _context_blocks(
+            "javascript", JAVASCRIPT_SCRIPT_SOURCE, [LineRange(4, 4)]
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(3, 5)
+
+    def test_loop_inside_function_returns_function(self) -> None:
+        """함수 안의 루프 변경은 함수 블록을 반환하는지 테스트."""
+        blocks = _context_blocks(
+            "javascript", JAVASCRIPT_SCRIPT_SOURCE, [LineRange(8, 10)]
+        )
+
+        assert blocks[0].name == "total"
+        assert blocks[0].line_range == LineRange(7, 11)
+
+
+class TestShellTopLevelStatement:
+    """Shell 스크립트 코드 테스트."""
+
+    def test_change_inside_top_level_loop_returns_whole_loop(self) -> None:
+        """최상위 for 루프 내부 변경 시 루프 전체 반환 테스트."""
+        blocks = _context_blocks("shell", SHELL_SCRIPT_SOURCE, [LineRange(10, 10)])
+
+        assert len(blocks) == 1
+        assert blocks[0].line_range == LineRange(9, 11)
+
+    def test_top_level_command_returns_whole_command(self) -> None:
+        """최상위 명령 변경 시 명령 전체를 반환하는지 테스트."""
+        blocks = _context_blocks("shell", SHELL_SCRIPT_SOURCE, [LineRange(13, 13)])
+
+        assert blocks[0].text == "print_args done"
+
+    def test_function_body_returns_function(self) -> None:
+        """함수 내부 변경은 함수 블록을 반환하는지 테스트."""
+        blocks = _context_blocks("shell", SHELL_SCRIPT_SOURCE, [LineRange(6, 6)])
+
+        assert blocks[0].name == "print_args"
+        assert blocks[0].line_range == LineRange(5, 7)
@@ -0,0 +1,60 @@
+"""블록 앞뒤 빈 라인 제거(trim_blank_lines) 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "python"
+
+PYTHON_SOURCE = "\n\nx = 1\n\ny = 2\n  \n"
+CHANGED_RANGES = [LineRange(3, 3), LineRange(5, 5)]
+
+
+class TestTrimBlankLines:
+    """trim_blank_lines 옵션 테스트."""
+
+    def test_trims_leading_and_trailing_blank_lines(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """앞뒤 빈 라인만 제거되고 라인 범위가 맞춰지는지 테스트."""
+        block = extract_blocks(
+            PYTHON_SOURCE,
+            CHANGED_RANGES,
+            options=ExtractionOptions(whole_file_max_lines=60, trim_blank_lines=True),
+        )[0]
+
+        assert block.text == "x = 1\n\ny = 2"
+        assert block.line_range == LineRange(3, 5)
+        assert block.changed_lines == (3, 5)
+
+    def test_keep_untrimmed_line_ranges(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """플래그가 켜지면 텍스트만 줄고 라인 범위는 유지되는지 테스트."""
+        block = extract_blocks(
+            PYTHON_SOURCE,
+            CHANGED_RANGES,
+            options=ExtractionOptions(
+                whole_file_max_lines=60,
+                trim_blank_lines=True,
+                keep_untrimmed_line_ranges=True,
+            ),
+        )[0]
+
+        assert block.text == "x = 1\n\ny = 2"
+        assert block.line_range == LineRange(1, 6)
+
+    def test_disabled_by_default(
+        self, extract_blocks: Callable[..., list[ContextBlock]]
+    ) -> None:
+        """옵션이 꺼져 있으면 원문 그대로 반환되는지 테스트."""
+        block = extract_blocks(
+            PYTHON_SOURCE,
+            CHANGED_RANGES,
+            options=ExtractionOptions(whole_file_max_lines=60),
+        )[0]
+
+        assert block.text == PYTHON_SOURCE.removesuffix("\n")
+        assert block.line_range == LineRange(1, 6)
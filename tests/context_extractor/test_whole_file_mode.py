@@ -0,0 +1,132 @@
+"""작은 파일에 대한 파일 전체 추출 모드 테스트 케이스."""
+
+from __future__ import annotations
+
+import pytest
+
+from selvage.src.context_extractor import (
+    ContextBlock,
+    ContextExtractor,
+    ExtractedFileContext,
+    ExtractionOptions,
+    LineRange,
+    render_context,
+)
+
+SMALL_GO_SOURCE = """package main
+
+import "fmt"
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func main() {
+	fmt.Println(Add(1, 2))
+}
+"""
+
+
+class TestWholeFileOptions:
+    """파일 전체 모드 옵션 검증 테스트."""
+
+    def test_disabled_by_default(self) -> None:
+        """기본 옵션에서는 파일 전체 모드가 꺼져 있는지 테스트."""
+        options = ExtractionOptions()
+
+        assert options.whole_file_enabled is False
+        assert options.allows_whole_file(1, 1) is False
+
+    def test_both_thresholds_must_be_satisfied(self) -> None:
+        """라인/바이트 기준이 모두 설정되면 둘 다 만족해야 하는지 테스트."""
+        options = ExtractionOptions(whole_file_max_lines=60, whole_file_max_bytes=100)
+
+        assert options.allows_whole_file(60, 100) is True
+        assert options.allows_whole_file(61, 100) is False
+        assert options.allows_whole_file(60, 101) is False
+
+    @pytest.mark.parametrize("field", ["whole_file_max_lines", "whole_file_max_bytes"])
+    def test_non_positive_threshold_is_rejected(self, field: str) -> None:
+        """0 이하의 기준값에 대한 예외 테스트."""
+        with pytest.raises(ValueError, match=field):
+            ExtractionOptions(**{field: 0})
+
+
+class TestWholeFileExtraction:
+    """파일 전체 모드 추출 테스트."""
+
+    def test_small_file_is_returned_whole(self) -> None:
+        """기준 이하 파일은 변경 라인 표시와 함께 통째로 반환되는지 테스트."""
+        extractor = ContextExtractor("go", ExtractionOptions(whole_file_max_lines=60))
+        blocks = extractor.extract_context_blocks(
+            SMALL_GO_SOURCE, [LineRange(6, 6), LineRange(10, 10)]
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].block_type == ContextExtractor.WHOLE_FILE_BLOCK_TYPE
+        assert blocks[0].line_range == LineRange(1, 11)
+        assert blocks[0].text == SMALL_GO_SOURCE.removesuffix("\n")
+        assert blocks[0].changed_lines == (6, 10)
+
+    def test_large_file_falls_back_to_symbol_extraction(self) -> None:
+        """기준을 넘는 파일은 심볼 단위로 추출되는지 테스트."""
+        extractor = ContextExtractor("go", ExtractionOptions(whole_file_max_lines=5))
+        context = extractor.extract_file_context(
+            "main.go", SMALL_GO_SOURCE, [LineRange(6, 6)]
+        )
+
+        assert context.extraction_mode == ExtractedFileContext.SYMBOL_MODE
+        assert [block.name for block in context.context_blocks] == ["Add"]
+
+    def test_metadata_reports_whole_file_mode(self) -> None:
+        """추출 결과 메타데이터에 파일 전체 모드가 기록되는지 테스트."""
+        extractor = ContextExtractor("go", ExtractionOptions(whole_file_max_bytes=1024))
+        context = extractor.extract_file_context(
+            "main.go", SMALL_GO_SOURCE, [LineRange(6, 6)]
+        )
+
+        assert context.extraction_mode == ExtractedFileContext.WHOLE_FILE_MODE
+        assert context.is_whole_file is True
+        assert context.dependency_blocks == []
+
+
+class TestChangedLinesHeader:
+    """변경 라인 표시 헤더 테스트."""
+
+    def test_changed_lines_are_grouped_into_spans(self) -> None:
+        """연속된 변경 라인이 구간으로 묶여 헤더에 표시되는지 테스트."""
+        block = ContextBlock(
+            text="a\nb", line_range=LineRange(1, 12), changed_lines=(3, 4, 5, 9)
+        )
+
+        assert block.header(1) == (
+            "---- Context Block 1 (Lines 1-12) [changed: 3-5, 9] ----"
+        )
+
+    def test_header_without_changed_lines_is_unchanged(self) -> None:
+        """변경 라인이 없으면 기존 헤더 형식이 유지되는지 테스트."""
+        block = ContextBlock(text="a", line_range=LineRange(2, 2))
+
+        assert block.format(1) == "---- Context Block 1 (Lines 2-2) ----\na"
+
+    def test_renderer_shows_changed_lines(self) -> None:
+        """렌더링 결과에 변경 라인 표시가 포함되는지 테스트."""
+        context = ExtractedFileContext(
+            file_path="main.go",
+            language="go",
+            blocks=[
+                ContextBlock(
+                    text="package main",
+                    line_range=LineRange(1, 1),
+                    block_type=ContextExtractor.WHOLE_FILE_BLOCK_TYPE,
+                    changed_lines=(1,),
+                )
+            ],
+            extraction_mode=ExtractedFileContext.WHOLE_FILE_MODE,
+        )
+
+        assert render_context([context]) == (
+            "==== File: main.go (go) ====\n"
+            "---- Context Block 1 (Lines 1-1) [changed: 1] ----\n"
+            "package main"
+        )
@@ -0,0 +1,145 @@
+"""ContextExtractor TOML 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+from selvage.src.context_extractor import ContextBlock, ExtractionOptions, LineRange
+
+LANGUAGE = "toml"
+SAMPLE_FILE = "sample_config.toml"
+
+
+class TestTomlSectionExtraction:
+    """TOML 섹션 추출 테스트."""
+
+    def test_changed_key_returns_enclosing_table(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """변경된 키를 감싸는 [table] 섹션과 키 경로 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(11, 11)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "tool.selvage"
+        assert blocks[0].line_range.start_line == 5
+        assert blocks[0].text.startswith("[tool.selvage]\nmodel = ")
+        assert blocks[0].key_paths == ("tool.selvage.max_tokens",)
+
+    def test_multiline_string_does_not_break_sections(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """여러 줄 문자열 안의 대괄호가 섹션으로 오인되지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(8, 9)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "tool.selvage"
+        assert "[not.a.section]" in blocks[0].text
+        assert blocks[0].key_paths == ("tool.selvage.description",)
+
+    def test_multiline_array_value(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """여러 줄 배열 값 변경 시 중첩 섹션 경로 반환 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(17, 17)], include_dependencies=False
+        )
+
+        assert blocks[0].name == "tool.selvage.paths"
+        assert blocks[0].key_paths == ("tool.selvage.paths.exclude",)
+
+    def test_array_of_tables_element(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """[[array-of-tables]] 요소 중 변경된 요소만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(23, 23)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "plugins"
+        assert blocks[0].line_range.start_line == 21
+        assert 'name = "linter"' not in blocks[0].text
+        assert blocks[0].key_paths == ("plugins.options",)
+
+
+class TestTomlTopLevelPairExtraction:
+    """섹션 밖 최상위 키 추출 테스트."""
+
+    def test_inline_table_returns_whole_pair(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """인라인 테이블 변경 시 인라인 테이블 전체를 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(3, 3)], include_dependencies=False
+        )
+
+        assert len(blocks) == 1
+        assert blocks[0].name == "owner"
+        assert blocks[0].line_range == LineRange(3, 3)
+        assert blocks[0].text == (
+            'owner = { name = "selvage-lab", email = "dev@example.com" }'
+        )
+
+
+class TestTomlCommentAssociation:
+    """TOML 주석 연결 테스트."""
+
+    def test_comment_above_table_header(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """섹션 헤더 위 주석이 옵션에 따라 연결되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(15, 15)],
+            include_dependencies=False,
+            options=ExtractionOptions(include_comments=True),
+        )
+
+        assert blocks[0].name == "tool.selvage.paths"
+        assert blocks[0].doc_comment == "# 리뷰 대상 경로 설정"
+        assert blocks[0].line_range.start_line == 13
+
+    def test_comment_above_top_level_key(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """최상위 키 위 주석 연결 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(2, 2)],
+            include_dependencies=False,
+            options=ExtractionOptions(include_comments=True),
+        )
+
+        assert blocks[0].doc_comment == "# 샘플 설정 파일"
+        assert blocks[0].line_range == LineRange(1, 2)
+
+    def test_comments_not_attached_by_default(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """기본 옵션에서는 주석이 연결되지 않는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(15, 15)], include_dependencies=False
+        )
+
+        assert blocks[0].doc_comment is None
+        assert blocks[0].line_range.start_line == 14
@@ -0,0 +1,21 @@
+// 선언 병합 테스트용 샘플 파일
+
+export interface ShippingOptions {
+    carrier: string;
+    express: boolean;
+}
+
+const DEFAULT_CARRIER = "post";
+
+export interface ShippingOptions {
+    trackingId?: string;
+}
+
+export function quote(options: ShippingOptions): number {
+    return options.express ? quote.EXPRESS_FEE : quote.BASE_FEE;
+}
+
+export namespace quote {
+    export const BASE_FEE = 3000;
+    export const EXPRESS_FEE = 5000;
+}
@@ -0,0 +1,20 @@
+import { Body, Controller, Get, HttpCode, Param, Post } from "@nestjs/common";
+
+@Controller({
+  path: "users",
+  version: "1",
+})
+export class UsersController {
+  @Post("/", {
+    transform: true,
+  })
+  @HttpCode(201)
+  create(@Body() payload: CreateUserDto): UserDto {
+    return this.service.create(payload);
+  }
+
+  @Get(":id")
+  findOne(@Param("id") id: string): UserDto {
+    return this.service.find(id);
+  }
+}
@@ -0,0 +1,66 @@
+"""TypeScript 선언 병합(같은 이름의 interface/namespace) 추출 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+
+
+@pytest.fixture
+def sample_file_content() -> str:
+    """테스트용 TypeScript 선언 병합 샘플 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "SampleMergedDeclarations.ts"
+    return file_path.read_text(encoding="utf-8")
+
+
+def _context_blocks(
+    file_content: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """의존성 블록을 제외한 TypeScript 추출 결과 블록들을 반환한다."""
+    blocks = ContextExtractor("typescript").extract_context_blocks(
+        file_content, changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestTypeScriptDeclarationMerging:
+    """TypeScript 선언 병합 그룹화 테스트."""
+
+    def test_merged_interface_returns_all_parts(self, sample_file_content: str) -> None:
+        """interface 하나의 변경 시 같은 이름의 interface 선언이 모두 묶이는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(5, 5)])
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("merged_declaration", "ShippingOptions", LineRange(3, 12))]
+        assert "express: boolean;" in blocks[0].text
+        assert "trackingId?: string;" in blocks[0].text
+        assert "... [lines 7-9 omitted]" in blocks[0].text
+        assert "DEFAULT_CARRIER" not in blocks[0].text
+
+    def test_change_in_later_part_returns_earlier_part(
+        self, sample_file_content: str
+    ) -> None:
+        """뒤쪽 interface 선언의 변경에도 앞쪽 선언이 함께 반환되는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(11, 11)])
+
+        assert [block.line_range for block in blocks] == [LineRange(3, 12)]
+
+    def test_namespace_merges_with_function(self, sample_file_content: str) -> None:
+        """namespace 변경 시 같은 이름의 function과 하나로 묶이는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(20, 20)])
+
+        assert [
+            (block.block_type, block.name, block.line_range) for block in blocks
+        ] == [("merged_declaration", "quote", LineRange(14, 21))]
+        assert "omitted" not in blocks[0].text
+
+    def test_unmerged_declaration_is_unchanged(self, sample_file_content: str) -> None:
+        """병합 대상이 아닌 선언의 변경은 그룹화되지 않는지 테스트."""
+        blocks = _context_blocks(sample_file_content, [LineRange(8, 8)])
+
+        assert all(block.block_type != "merged_declaration" for block in blocks)
+        assert [block.line_range for block in blocks] == [LineRange(8, 8)]
@@ -0,0 +1,66 @@
+"""TypeScript 인자가 있는 데코레이터(라우트 설정) 보존 테스트 케이스."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, ContextExtractor, LineRange
+
+CREATE_HEADER = (
+    '@Post("/", {\n'
+    "    transform: true,\n"
+    "  })\n"
+    "  @HttpCode(201)\n"
+    "  create(@Body() payload: CreateUserDto): UserDto {\n"
+)
+
+
+@pytest.fixture
+def sample_file_content() -> str:
+    """테스트용 라우트 데코레이터 샘플 파일 내용을 반환합니다."""
+    file_path = Path(__file__).parent / "SampleRouteDecorators.ts"
+    return file_path.read_text(encoding="utf-8")
+
+
+def _symbol_blocks(
+    file_content: str, changed_ranges: list[LineRange]
+) -> list[ContextBlock]:
+    """의존성 블록을 제외한 TypeScript 추출 결과 블록들을 반환한다."""
+    blocks = ContextExtractor("typescript").extract_context_blocks(
+        file_content, changed_ranges
+    )
+    return [block for block in blocks if not block.is_dependency]
+
+
+class TestTypeScriptRouteDecorators:
+    """TypeScript 데코레이터 인자 보존 테스트."""
+
+    def test_method_body_change_keeps_decorators(
+        self, sample_file_content: str
+    ) -> None:
+        """메서드 본문 변경 시 앞에 붙은 데코레이터들이 인자와 함께 포함되는지 테스트."""
+        blocks = _symbol_blocks(sample_file_content, [LineRange(13, 13)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("create", LineRange(8, 14))
+        ]
+        assert blocks[0].text.startswith(CREATE_HEADER)
+
+    def test_decorator_argument_change(self, sample_file_content: str) -> None:
+        """메서드 데코레이터 인자의 변경이 데코레이터가 붙은 메서드로 반환되는지 테스트."""
+        blocks = _symbol_blocks(sample_file_content, [LineRange(9, 9)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("create", LineRange(8, 14))
+        ]
+
+    def test_class_decorator_argument_change(self, sample_file_content: str) -> None:
+        """클래스 데코레이터 인자의 변경이 데코레이터를 포함한 클래스로 반환되는지 테스트."""
+        blocks = _symbol_blocks(sample_file_content, [LineRange(4, 4)])
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("UsersController", LineRange(3, 20))
+        ]
+        assert blocks[0].text.startswith('@Controller({\n  path: "users",')
@@ -0,0 +1,33 @@
+`include "defs.vh"
+
+module counter #(parameter WIDTH = 8) (
+    input  logic             clk,
+    input  logic             rst_n,
+    input  logic             enable,
+    output logic [WIDTH-1:0] count
+);
+  import util_pkg::*;
+
+  logic [WIDTH-1:0] next_count;
+
+  assign next_count = saturate(count);
+
+  always_ff @(posedge clk or negedge rst_n) begin
+    if (!rst_n) begin
+      count <= '0;
+    end else if (enable) begin
+      count <= next_count;
+    end
+  end
+
+  function automatic logic [WIDTH-1:0] saturate(input logic [WIDTH-1:0] value);
+    if (value == {WIDTH{1'b1}}) begin
+      return value;
+    end
+    return value + 1'b1;
+  endfunction
+
+  task automatic reset_count();
+    count = '0;
+  endtask
+endmodule
@@ -0,0 +1,103 @@
+"""ContextExtractor Verilog/SystemVerilog 테스트 케이스."""
+
+from __future__ import annotations
+
+from collections.abc import Callable
+
+import pytest
+
+from selvage.src.context_extractor import ContextBlock, LineRange
+
+LANGUAGE = "verilog"
+SAMPLE_FILE = "sample_counter.sv"
+
+
+class TestVerilogModuleExtraction:
+    """Verilog 모듈 항목 추출 테스트."""
+
+    def test_always_block_with_enclosing_module(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """always 블록 안의 변경 시 always 블록과 모듈 헤더가 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(19, 19)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range, block.reason) for block in blocks] == [
+            ("counter", LineRange(3, 3), "enclosing-declaration"),
+            (None, LineRange(15, 22), None),
+        ]
+        assert blocks[0].text == "module counter #(parameter WIDTH = 8) ("
+        assert blocks[1].text.startswith("always_ff @(posedge clk or negedge rst_n)")
+        assert blocks[1].scope_path == ("counter",)
+
+    def test_port_declaration_returns_module_header(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """모듈 헤더의 포트 선언 변경 시 헤더만 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(6, 6)], include_dependencies=False
+        )
+
+        assert [(block.name, block.line_range) for block in blocks] == [
+            ("counter", LineRange(3, 8))
+        ]
+        assert blocks[0].text.startswith("module counter #(parameter WIDTH = 8) (")
+        assert blocks[0].text.endswith(");")
+
+    @pytest.mark.parametrize(
+        ("changed_line", "expected_name", "expected_range"),
+        [
+            (27, "saturate", LineRange(24, 29)),
+            (32, "reset_count", LineRange(31, 33)),
+        ],
+    )
+    def test_function_and_task(
+        self,
+        sample_file_content: str,
+        changed_line: int,
+        expected_name: str,
+        expected_range: LineRange,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """function/task 안의 변경 시 선언 전체가 이름과 함께 반환되는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content,
+            [LineRange(changed_line, changed_line)],
+            include_dependencies=False,
+        )
+
+        assert [
+            (block.name, block.line_range, block.scope_path)
+            for block in blocks
+            if block.reason is None
+        ] == [(expected_name, expected_range, ("counter",))]
+
+    def test_module_item_outside_always(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """always/function/task 밖의 assign 변경은 해당 항목만 반환하는지 테스트."""
+        blocks = extract_blocks(
+            sample_file_content, [LineRange(13, 13)], include_dependencies=False
+        )
+
+        assert [block.text for block in blocks if block.reason is None] == [
+            "assign next_count = saturate(count);"
+        ]
+
+    def test_include_is_dependency(
+        self,
+        sample_file_content: str,
+        extract_blocks: Callable[..., list[ContextBlock]],
+    ) -> None:
+        """`include 지시어가 의존성 블록으로 포함되는지 테스트."""
+        blocks = extract_blocks(sample_file_content, [LineRange(19, 19)])
+
+        assert blocks[0].is_dependency
+        assert '`include "defs.vh"' in blocks[0].text
@@ -2,9 +2,10 @@ from unittest.mock import patch
 
 import pytest
 
+from selvage.src.context_extractor.line_range import LineRange
 from selvage.src.diff_parser.constants import DELETED_FILE_PLACEHOLDER
 from selvage.src.diff_parser.models.file_diff import FileDiff
-from selvage.src.diff_parser.parser import parse_git_diff
+from selvage.src.diff_parser.parser import parse_git_diff, parse_patch
 from selvage.src.exceptions.diff_parsing_error import DiffParsingError
 
 
@@ -130,6 +131,26 @@ def code_with_null_path_text():
     )
 
 
+@pytest.fixture
+def renamed_file_diff_text():
+    """내용 수정과 함께 이름이 바뀐 파일의 diff fixture"""
+    return (
+        "diff --git a/src/legacy/billing.py b/src/billing/invoice.py\n"
+        "similarity index 87%\n"
+        "rename from src/legacy/billing.py\n"
+        "rename to src/billing/invoice.py\n"
+        "index 1234567..89abcde 100644\n"
+        "--- a/src/legacy/billing.py\n"
+        "+++ b/src/billing/invoice.py\n"
+        "@@ -10,4 +10,3 @@ def total(items):\n"
+        "     subtotal = sum(items)\n"
+        "-    tax = subtotal * 0.1\n"
+        "-    return subtotal + tax\n"
+        "+    return subtotal\n"
+        " \n"
+    )
+
+
 def test_parse_git_diff_empty():
     with pytest.raises(DiffParsingError) as excinfo:
         parse_git_diff("", repo_path=".")
@@ -360,6 +381,143 @@ def test_parse_git_diff_with_null_path_in_code(
     )
 
 
+@patch("selvage.src.diff_parser.parser.load_file_content")
+def test_parse_git_diff_renamed_file(mock_load_file_content, renamed_file_diff_text):
+    """이름이 바뀐 파일의 rename 정보와 원본 기준 삭제 범위를 검증하는 테스트"""
+    mock_load_file_content.return_value = "def total(items):\n    return 0\n"
+
+    result = parse_git_diff(renamed_file_diff_text, repo_path=".")
+
+    file_diff = result.files[0]
+    assert file_diff.filename == "src/billing/invoice.py"
+    assert file_diff.is_renamed
+    assert file_diff.rename is not None
+    assert file_diff.rename.old_path == "src/legacy/billing.py"
+    assert file_diff.rename.new_path == "src/billing/invoice.py"
+    assert file_diff.rename.similarity == 0.87
+    assert file_diff.get_deleted_original_ranges() == [LineRange(11, 12)]
+    mock_load_file_content.assert_called_once_with("src/billing/invoice.py", ".")
+
+
+@patch("selvage.src.diff_parser.parser.load_file_content")
+def test_parse_git_diff_without_rename(mock_load_file_content, one_file_one_diff_text):
+    """이름 변경이 아닌 파일은 rename이 None인지 검증하는 테스트"""
+    mock_load_file_content.return_value = "파일 전체 내용"
+
+    result = parse_git_diff(one_file_one_diff_text, repo_path=".")
+
+    assert result.files[0].rename is None
+    assert not result.files[0].is_renamed
+
+
+@pytest.fixture
+def format_patch_text():
+    """`git format-patch`로 만든 여러 파일 patch fixture"""
+    return (
+        "From 1a2b3c4d Mon Sep 17 00:00:00 2001\n"
+        "Subject: [PATCH] Add greeting\n"
+        "\n"
+        "---\n"
+        " src/app.py     | 3 ++-\n"
+        " src/greet.go   | 5 +++++\n"
+        " 2 files changed\n"
+        "\n"
+        "diff --git a/src/app.py b/src/app.py\n"
+        "index 1234567..89abcde 100644\n"
+        "--- a/src/app.py\n"
+        "+++ b/src/app.py\n"
+        "@@ -20,3 +20,4 @@ class App:\n"
+        "     def run(self):\n"
+        "-        return 1\n"
+        "+        code = 2\n"
+        "+        return code\n"
+        " \n"
+        "diff --git a/src/greet.go b/src/greet.go\n"
+        "new file mode 100644\n"
+        "index 0000000..1111111\n"
+        "--- /dev/null\n"
+        "+++ b/src/greet.go\n"
+        "@@ -0,0 +1,5 @@\n"
+        "+package main\n"
+        "+\n"
+        "+func Greet() string {\n"
+        '+\treturn "hi"\n'
+        "+}\n"
+        "-- \n"
+        "2.39.0\n"
+    )
+
+
+def test_parse_patch_empty():
+    with pytest.raises(DiffParsingError) as excinfo:
+        parse_patch("")
+    assert "빈 patch가 제공되었습니다." in str(excinfo.value)
+
+
+def test_parse_patch_invalid():
+    with pytest.raises(DiffParsingError) as excinfo:
+        parse_patch("patch가 아닌 텍스트")
+    assert "유효하지 않은 patch 형식입니다." in str(excinfo.value)
+
+
+def test_parse_patch_multiple_files(format_patch_text):
+    """format-patch 결과의 파일들이 b/ 경로와 언어로 파싱되는지 검증하는 테스트"""
+    result = parse_patch(format_patch_text)
+
+    assert [(file.filename, file.language) for file in result.files] == [
+        ("src/app.py", "python"),
+        ("src/greet.go", "go"),
+    ]
+
+
+def test_parse_patch_modified_file_has_only_hunks(format_patch_text):
+    """수정된 파일은 전체 내용 없이 hunk의 수정 후 코드만 갖는지 검증하는 테스트"""
+    file_diff = parse_patch(format_patch_text).files[0]
+
+    assert not file_diff.has_full_content
+    assert file_diff.file_content == ""
+    assert file_diff.get_change_ranges() == [LineRange(21, 22)]
+    assert file_diff.hunks[0].get_modified_text() == (
+        "    def run(self):\n        code = 2\n        return code\n"
+    )
+
+
+def test_parse_patch_new_file_content(format_patch_text):
+    """새 파일은 추가 라인으로 전체 내용이 복원되고 서명은 무시되는지 검증하는 테스트"""
+    file_diff = parse_patch(format_patch_text).files[1]
+
+    assert file_diff.has_full_content
+    assert file_diff.file_content == (
+        'package main\n\nfunc Greet() string {\n\treturn "hi"\n}\n'
+    )
+    assert file_diff.line_count == 5
+    assert file_diff.additions == 5
+    assert file_diff.deletions == 0
+
+
+def test_parse_patch_plain_unified_diff():
+    """diff --git 헤더 없는 unified diff와 줄 수가 생략된 hunk 헤더를 검증하는 테스트"""
+    patch_text = (
+        "--- a/lib/util.py\t2024-01-01 00:00:00\n"
+        "+++ b/lib/util.py\t2024-01-02 00:00:00\n"
+        "@@ -3 +3 @@\n"
+        "-VALUE = 1\n"
+        "+VALUE = 2\n"
+        "--- a/old.py\n"
+        "+++ /dev/null\n"
+        "@@ -1,2 +0,0 @@\n"
+        "-import os\n"
+        "-print(os.name)\n"
+    )
+
+    result = parse_patch(patch_text)
+
+    assert [file.filename for file in result.files] == ["lib/util.py", "old.py"]
+    assert result.files[0].get_change_ranges() == [LineRange(3, 3)]
+    assert result.files[0].hunks[0].start_line_modified == 3
+    assert result.files[1].file_content == DELETED_FILE_PLACEHOLDER
+
+
 class TestFileDiffCalculateLineCount:
     """FileDiff.calculate_line_count() 메서드 단위 테스트"""
 
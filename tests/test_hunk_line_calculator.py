@@ -1,5 +1,6 @@
 """HunkLineCalculator 클래스 테스트 모듈"""
 
+from selvage.src.context_extractor.line_range import LineRange
 from selvage.src.diff_parser.utils.hunk_line_calculator import HunkLineCalculator
 
 
@@ -129,3 +130,83 @@ class TestHunkLineCalculator:
         # 1번째 라인 삭제하고 추가: 1
         assert result.start_line == 1
         assert result.end_line == 1
+
+
+class TestHunkLineChanges:
+    """HunkLineCalculator.calculate_line_changes 메서드의 동작을 검증하는 테스트 클래스"""
+
+    def test_added_and_deleted_line_positions(self):
+        """추가 라인 번호와 삭제 위치가 수정 후 파일 기준으로 계산되는지 확인"""
+        content = """ context line 1
+-deleted line 1
++new line 1
++new line 2
+ context line 2
+-deleted line 2"""
+        start_line_modified = 10
+
+        result = HunkLineCalculator.calculate_line_changes(
+            content, start_line_modified
+        )
+
+        assert result.added_lines == frozenset({11, 12})
+        # 삭제 라인은 삭제가 일어난 위치의 다음 라인 번호로 기록
+        assert result.deleted_lines == (11, 14)
+
+
+class TestDeletedOriginalRanges:
+    """HunkLineCalculator.calculate_deleted_original_ranges 메서드 테스트 클래스"""
+
+    def test_deleted_lines_grouped_by_original_line_numbers(self):
+        """삭제 라인이 원본 파일 기준 연속 범위로 묶이는지 확인"""
+        content = """ context line 1
+-deleted line 1
+-deleted line 2
++new line 1
+ context line 2
++new line 2
+ context line 3
+-deleted line 3"""
+        start_line_original = 20
+
+        result = HunkLineCalculator.calculate_deleted_original_ranges(
+            content, start_line_original
+        )
+
+        assert result == [LineRange(21, 22), LineRange(25, 25)]
+
+    def test_addition_only_has_no_deleted_ranges(self):
+        """추가만 있는 hunk는 삭제 범위가 없는지 확인"""
+        content = """ context line 1
++new line 1"""
+
+        assert HunkLineCalculator.calculate_deleted_original_ranges(content, 1) == []
+
+
+class TestChangeRanges:
+    """HunkLineCalculator.calculate_change_ranges 메서드 테스트 클래스"""
+
+    def test_context_lines_between_changes_are_excluded(self):
+        """변경 사이의 컨텍스트 라인이 범위에서 제외되는지 확인"""
+        content = """ context line 1
+-deleted line 1
++new line 1
++new line 2
+ context line 2
+ context line 3
++new line 3
+ context line 4
+-deleted line 2"""
+
+        result = HunkLineCalculator.calculate_change_ranges(content, 10)
+
+        assert result == [LineRange(11, 12), LineRange(15, 15), LineRange(17, 17)]
+
+    def test_no_change_falls_back_to_hunk_start(self):
+        """변경 라인이 없으면 기존 변경 범위 계산과 같은 기본 범위를 반환하는지 확인"""
+        content = """ context line 1
+ context line 2"""
+
+        assert HunkLineCalculator.calculate_change_ranges(content, 5) == [
+            LineRange(5, 5)
+        ]
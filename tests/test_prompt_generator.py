@@ -769,6 +769,102 @@ class TestPromptGeneratorContextTypes:
         # context 내용 검증
         assert user_prompt.file_context.context == "fallback context"
 
+    @patch(
+        "selvage.src.utils.prompts.prompt_generator.SmartContextUtils.use_smart_context"
+    )
+    @patch.object(
+        PromptGenerator,
+        "_get_code_review_system_prompt",
+        return_value="Mock system prompt",
+    )
+    def test_go_file_uses_ast_context(
+        self,
+        mock_system_prompt,
+        mock_use_smart_context,
+        review_request: ReviewRequest,
+    ):
+        """Go 파일은 범용 추출 대신 AST 기반 스마트 컨텍스트로 추출되는지 테스트"""
+        # Given
+        mock_use_smart_context.return_value = True
+        file = review_request.processed_diff.files[0]
+        file.filename = "calc/calc.go"
+        file.language = "go"
+        file.file_content = (
+            "package calc\n"
+            "\n"
+            'import "fmt"\n'
+            "\n"
+            "// Add는 두 수를 더한다.\n"
+            "func Add(a, b int) int {\n"
+            "\treturn a + b\n"
+            "}\n"
+            "\n"
+            "func Describe(n int) string {\n"
+            '\treturn fmt.Sprintf("n=%d", n)\n'
+            "}\n"
+        )
+        file.hunks = [
+            Hunk(
+                header="@@ -6,3 +6,3 @@",
+                content=" func Add(a, b int) int {\n-\treturn a - b\n+\treturn a + b\n }\n",
+                before_code="func Add(a, b int) int {\n\treturn a - b\n}\n",
+                after_code="func Add(a, b int) int {\n\treturn a + b\n}\n",
+                start_line_original=6,
+                line_count_original=3,
+                start_line_modified=6,
+                line_count_modified=3,
+                change_line=LineRange(start_line=7, end_line=7),
+            )
+        ]
+
+        generator = PromptGenerator()
+
+        # When
+        review_prompt = generator.create_code_review_prompt(review_request)
+
+        # Then
+        user_prompt = review_prompt.user_prompts[0]
+        assert user_prompt.file_context.context_type == ContextType.SMART_CONTEXT
+        assert "func Add(a, b int) int {" in user_prompt.file_context.context
+        assert "func Describe" not in user_prompt.file_context.context
+
+    @patch(
+        "selvage.src.utils.prompts.prompt_generator.SmartContextUtils.use_smart_context"
+    )
+    @patch("selvage.src.utils.prompts.prompt_generator.ContextExtractor")
+    @patch.object(
+        PromptGenerator,
+        "_get_code_review_system_prompt",
+        return_value="Mock system prompt",
+    )
+    def test_template_context_scenario(
+        self,
+        mock_system_prompt,
+        mock_context_extractor,
+        mock_use_smart_context,
+        review_request: ReviewRequest,
+    ):
+        """템플릿 언어 파일은 템플릿 추출기로 스마트 컨텍스트를 만드는지 테스트"""
+        # Given
+        mock_use_smart_context.return_value = True
+        file = review_request.processed_diff.files[0]
+        file.filename = "templates/page.html.j2"
+        file.language = "jinja"
+        file.file_content = (
+            "{% block content %}\n<h1>{{ title }}</h1>\n{% endblock %}\n"
+        )
+
+        generator = PromptGenerator()
+
+        # When
+        review_prompt = generator.create_code_review_prompt(review_request)
+
+        # Then
+        user_prompt = review_prompt.user_prompts[0]
+        assert user_prompt.file_context.context_type == ContextType.SMART_CONTEXT
+        assert "{% block content %}" in user_prompt.file_context.context
+        mock_context_extractor.assert_not_called()
+
     @patch(
         "selvage.src.utils.prompts.prompt_generator.SmartContextUtils.use_smart_context"
     )
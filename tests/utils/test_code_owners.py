@@ -0,0 +1,110 @@
+"""CodeOwners 클래스에 대한 유닛 테스트."""
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.context_extractor import CodeOwnerAnnotator, ExtractedFileContext
+from selvage.src.context_extractor.context_block import ContextBlock
+from selvage.src.context_extractor.line_range import LineRange
+from selvage.src.utils.code_owners import CodeOwner, CodeOwners
+
+
+def _write(path: Path, content: str) -> None:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content, encoding="utf-8")
+
+
+@pytest.fixture
+def repo(tmp_path: Path) -> Path:
+    """`CODEOWNERS`가 여러 단계에 있는 테스트 저장소를 생성합니다."""
+    _write(
+        tmp_path / ".github" / "CODEOWNERS",
+        "# 기본 소유자\n"
+        "*       @acme/platform\n"
+        "*.go    @gopher  ops@acme.dev # Go 코드\n"
+        "/docs/* @acme/docs\n"
+        "apps/   @acme/apps\n"
+        "/apps/generated/\n",
+    )
+    _write(tmp_path / "services" / "billing" / "CODEOWNERS", "*.py @acme/billing\n")
+    return tmp_path
+
+
+def _names(owners: tuple[CodeOwner, ...]) -> list[str]:
+    return [owner.name for owner in owners]
+
+
+class TestCodeOwners:
+    """CodeOwners 클래스에 대한 테스트 클래스."""
+
+    @pytest.mark.parametrize(
+        "file_path,expected",
+        [
+            ("README.md", ["@acme/platform"]),
+            ("cmd/server/main.go", ["@gopher", "ops@acme.dev"]),
+            ("docs/index.md", ["@acme/docs"]),
+            ("docs/guides/setup.md", ["@acme/platform"]),
+            ("apps/web/app.ts", ["@acme/apps"]),
+            ("lib/apps/util.ts", ["@acme/apps"]),
+            ("apps/generated/client.ts", []),
+        ],
+    )
+    def test_last_match_wins(
+        self, repo: Path, file_path: str, expected: list[str]
+    ) -> None:
+        """같은 파일 안에서 마지막으로 일치한 줄의 소유자를 반환하는지 테스트합니다."""
+        assert _names(CodeOwners(repo).owners_for(file_path)) == expected
+
+    def test_nested_codeowners_takes_precedence(self, repo: Path) -> None:
+        """하위 디렉토리의 `CODEOWNERS`가 루트 설정보다 우선하는지 테스트합니다."""
+        owners = CodeOwners(repo)
+
+        assert _names(owners.owners_for("services/billing/api/invoice.py")) == [
+            "@acme/billing"
+        ]
+        assert _names(owners.owners_for("services/billing/README.md")) == [
+            "@acme/platform"
+        ]
+
+    def test_root_codeowners_location(self, tmp_path: Path) -> None:
+        """`.github/CODEOWNERS`가 없으면 루트 `CODEOWNERS`를 사용하는지 테스트합니다."""
+        _write(tmp_path / "CODEOWNERS", "* @solo\n")
+
+        assert _names(CodeOwners(tmp_path).owners_for("main.py")) == ["@solo"]
+
+    def test_missing_codeowners(self, tmp_path: Path) -> None:
+        """`CODEOWNERS`가 없는 저장소에서는 소유자가 없는지 테스트합니다."""
+        assert CodeOwners(tmp_path).owners_for("main.py") == ()
+
+    def test_owner_kinds(self) -> None:
+        """팀, 사용자, 이메일 소유자를 구분하는지 테스트합니다."""
+        team, user, email = (
+            CodeOwner("@acme/platform"),
+            CodeOwner("@gopher"),
+            CodeOwner("ops@acme.dev"),
+        )
+
+        assert (team.is_team, team.is_user, team.is_email) == (True, False, False)
+        assert (user.is_team, user.is_user, user.is_email) == (False, True, False)
+        assert (email.is_team, email.is_user, email.is_email) == (False, False, True)
+
+
+class TestCodeOwnerAnnotator:
+    """CodeOwnerAnnotator에 대한 테스트 클래스."""
+
+    def test_annotates_files_with_blocks(self, repo: Path) -> None:
+        """블록이 있는 파일 결과에만 소유자가 기록되는지 테스트합니다."""
+        extracted = ExtractedFileContext(
+            file_path="cmd/server/main.go",
+            language="go",
+            blocks=[ContextBlock(text="func main() {}", line_range=LineRange(3, 3))],
+        )
+        skipped = ExtractedFileContext.skipped(
+            "docs/index.md", "markdown", ExtractedFileContext.TOO_LARGE_STATUS
+        )
+
+        CodeOwnerAnnotator(CodeOwners(repo)).annotate([extracted, skipped])
+
+        assert _names(extracted.owners) == ["@gopher", "ops@acme.dev"]
+        assert skipped.owners == ()
@@ -0,0 +1,118 @@
+"""GitAttributes 클래스에 대한 유닛 테스트."""
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.diff_parser.models.file_diff import FileDiff
+from selvage.src.utils.git_attributes import GitAttributes
+
+
+def _write(path: Path, content: str) -> None:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content, encoding="utf-8")
+
+
+@pytest.fixture
+def repo(tmp_path: Path) -> Path:
+    """`.gitattributes`가 여러 단계에 있는 테스트 저장소를 생성합니다."""
+    _write(
+        tmp_path / ".gitattributes",
+        "# linguist 설정\n"
+        "*.inc linguist-language=PHP\n"
+        "*.tpl linguist-language=Python\n"
+        "scripts/* linguist-language=Shell\n"
+        "/gen/** linguist-generated\n"
+        "*.pb.go linguist-generated=true\n"
+        "docs/*.tpl -linguist-language\n",
+    )
+    _write(
+        tmp_path / "gen" / ".gitattributes",
+        "keep.py -linguist-generated\n",
+    )
+    _write(
+        tmp_path / "scripts" / ".gitattributes",
+        "build linguist-language=Python\n",
+    )
+    return tmp_path
+
+
+class TestGitAttributes:
+    """GitAttributes 클래스에 대한 테스트 클래스."""
+
+    @pytest.mark.parametrize(
+        "file_path,expected",
+        [
+            ("lib/helpers.inc", "php"),
+            ("templates/page.tpl", "python"),
+            ("scripts/deploy", "shell"),
+            ("scripts/nested/deploy", None),
+            ("docs/readme.tpl", None),
+            ("main.py", None),
+        ],
+    )
+    def test_linguist_language(
+        self, repo: Path, file_path: str, expected: str | None
+    ) -> None:
+        """linguist-language 패턴 매칭과 언어 이름 변환을 테스트합니다."""
+        assert GitAttributes(repo).linguist_language(file_path) == expected
+
+    def test_deeper_attributes_file_takes_precedence(self, repo: Path) -> None:
+        """하위 디렉토리의 `.gitattributes`가 상위 설정을 덮어쓰는지 테스트합니다."""
+        assert GitAttributes(repo).linguist_language("scripts/build") == "python"
+
+    @pytest.mark.parametrize(
+        "file_path,expected",
+        [
+            ("gen/api/client.py", True),
+            ("gen/keep.py", False),
+            ("api/service.pb.go", True),
+            ("src/gen/client.py", False),
+            ("src/main.py", False),
+        ],
+    )
+    def test_is_generated(self, repo: Path, file_path: str, expected: bool) -> None:
+        """linguist-generated 설정과 해제를 테스트합니다."""
+        assert GitAttributes(repo).is_generated(file_path) is expected
+
+    def test_info_attributes_has_highest_precedence(self, repo: Path) -> None:
+        """`.git/info/attributes`가 가장 높은 우선순위를 갖는지 테스트합니다."""
+        _write(repo / ".git" / "info" / "attributes", "*.inc linguist-language=Go\n")
+
+        assert GitAttributes(repo).linguist_language("lib/helpers.inc") == "go"
+
+    def test_missing_attributes_file(self, tmp_path: Path) -> None:
+        """`.gitattributes`가 없는 저장소에서는 속성이 없는지 테스트합니다."""
+        attributes = GitAttributes(tmp_path)
+
+        assert attributes.attributes_for("main.py") == {}
+        assert attributes.linguist_language("main.py") is None
+        assert attributes.is_generated("main.py") is False
+
+
+class TestFileDiffDetectLanguage:
+    """FileDiff.detect_language의 `.gitattributes` 처리 테스트 클래스."""
+
+    def test_language_from_gitattributes(self, repo: Path) -> None:
+        """linguist-language 설정이 확장자보다 우선하는지 테스트합니다."""
+        file_diff = FileDiff(filename="templates/page.tpl", file_content="")
+        file_diff.detect_language(GitAttributes(repo))
+
+        assert file_diff.language == "python"
+        assert file_diff.is_language_from_gitattributes
+
+    def test_language_from_extension(self, repo: Path) -> None:
+        """설정이 없으면 확장자로 언어를 감지하는지 테스트합니다."""
+        file_diff = FileDiff(filename="src/main.py", file_content="")
+        file_diff.detect_language(GitAttributes(repo))
+
+        assert file_diff.language == "python"
+        assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_EXTENSION
+        assert not file_diff.is_generated
+
+    def test_generated_file_is_marked(self, repo: Path) -> None:
+        """linguist-generated 파일이 표시되는지 테스트합니다."""
+        file_diff = FileDiff(filename="gen/api/client.py", file_content="")
+        file_diff.detect_language(GitAttributes(repo))
+
+        assert file_diff.is_generated
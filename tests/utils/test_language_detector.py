@@ -0,0 +1,208 @@
+"""language_detector 모듈 테스트."""
+
+from collections.abc import Generator
+
+import pytest
+
+from selvage.src.diff_parser.models.file_diff import FileDiff
+from selvage.src.utils.language_detector import (
+    CONTENT_SNIFF_BYTES,
+    UNKNOWN_LANGUAGE,
+    detect_language_from_content,
+    detect_language_from_filename,
+    detect_language_from_shebang,
+    detect_template_language,
+    register_content_classifier,
+    unregister_content_classifier,
+)
+
+
+@pytest.mark.parametrize(
+    ("filename", "expected"),
+    [
+        ("scripts/deploy.pl", "perl"),
+        ("lib/Inventory/Store.pm", "perl"),
+        ("analysis/clean_sales.R", "r"),
+        ("analysis/helpers.r", "r"),
+        ("src/inventory.nim", "nim"),
+        ("config.nims", "nim"),
+        ("arch/x86/checksum.S", "assembly"),
+        ("boot/exit.asm", "assembly"),
+        ("Dockerfile", "dockerfile"),
+        ("services/api/dockerfile", "dockerfile"),
+        ("docker/api.Dockerfile", "dockerfile"),
+        ("web/templates/layout.gohtml", "gotemplate"),
+        ("charts/app/templates/service.tmpl", "gotemplate"),
+        ("templates/page.html.j2", "jinja"),
+        ("templates/email.jinja2", "jinja"),
+        ("app/views/orders/show.html.erb", "erb"),
+        ("src/lib/Counter.svelte", "svelte"),
+        ("src/inventory/core.clj", "clojure"),
+        ("src/inventory/ui.cljs", "clojure"),
+        ("src/inventory/shared.cljc", "clojure"),
+        ("resources/config.edn", "clojure"),
+        ("src/solver/geometry.f90", "fortran"),
+        ("src/solver/legacy.f08", "fortran"),
+        ("src/solver/legacy_kernel.f", "fortranfixed"),
+        ("src/solver/blas.f77", "fortranfixed"),
+        ("contracts/Vault.sol", "solidity"),
+        ("rtl/counter.v", "verilog"),
+        ("rtl/fifo_ctrl.sv", "verilog"),
+        ("src/Geometry.jl", "julia"),
+        ("CMakeLists.txt", "cmake"),
+        ("cmake/Warnings.cmake", "cmake"),
+        ("Makefile", "makefile"),
+        ("build/GNUmakefile", "makefile"),
+        ("build/rules.mk", "makefile"),
+        (".github/workflows/ci.yml", "githubactions"),
+        ("services/api/.github/workflows/release.yaml", "githubactions"),
+        (".github/workflows/templates/build.yml", "yaml"),
+        (".github/dependabot.yml", "yaml"),
+        ("src/cache_server.erl", "erlang"),
+        ("include/cache.hrl", "erlang"),
+        ("services/api/BUILD", "starlark"),
+        ("services/api/BUILD.bazel", "starlark"),
+        ("WORKSPACE", "starlark"),
+        ("MODULE.bazel", "starlark"),
+        ("tools/build_defs.bzl", "starlark"),
+        ("tools/inventory.tcl", "tcl"),
+        ("src/Inventory.pas", "pascal"),
+        ("Inventory.dpr", "pascal"),
+        ("src/game/Inventory.hx", "haxe"),
+        ("src/inventory.gleam", "gleam"),
+        ("scripts/build", "text"),
+        ("docs/user-guide.adoc", "asciidoc"),
+        ("docs/index.rst", "rst"),
+        ("notes.txt", "text"),
+        ("templates/index.html", "html"),
+        ("main.py", "python"),
+        ("README", "text"),
+    ],
+)
+def test_detect_language_from_filename(filename: str, expected: str) -> None:
+    """확장자 기반 언어 감지 테스트"""
+    assert detect_language_from_filename(filename) == expected
+
+
+@pytest.mark.parametrize(
+    ("content", "expected"),
+    [
+        ("#!/usr/bin/perl\nprint 1;", "perl"),
+        ("#!/usr/bin/perl -w\n", "perl"),
+        ("#!/usr/bin/env perl\n", "perl"),
+        ("#! /usr/local/bin/perl5.36\n", "perl"),
+        ("#!/usr/bin/env python3\n", "python"),
+        ("#!/bin/bash\n", "shell"),
+        ("#!/usr/bin/env ruby\n", None),
+        ("print 1;\n", None),
+        ("", None),
+    ],
+)
+def test_detect_language_from_shebang(content: str, expected: str | None) -> None:
+    """shebang 기반 언어 감지 테스트"""
+    assert detect_language_from_shebang(content) == expected
+
+
+def test_file_diff_uses_shebang_without_extension() -> None:
+    """확장자가 없는 스크립트는 shebang으로 언어를 감지하는지 테스트"""
+    file_diff = FileDiff(filename="bin/report", file_content="#!/usr/bin/perl\n1;\n")
+
+    file_diff.detect_language()
+
+    assert file_diff.language == "perl"
+    assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_SHEBANG
+
+
+def test_file_diff_prefers_extension_over_shebang() -> None:
+    """확장자로 언어를 알 수 있으면 shebang보다 우선하는지 테스트"""
+    file_diff = FileDiff(filename="tool.py", file_content="#!/usr/bin/perl\n")
+
+    file_diff.detect_language()
+
+    assert file_diff.language == "python"
+    assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_EXTENSION
+
+
+@pytest.mark.parametrize(
+    ("content", "expected"),
+    [
+        ("<ul><% items.each do |item| %>", "erb"),
+        ("{% extends 'base.html' %}", "jinja"),
+        ("{# 주석 #}\n<p>{{ name }}</p>", "jinja"),
+        ('{{define "layout"}}<p>{{.Title}}</p>{{end}}', "gotemplate"),
+        ("<p>{{ .Name }}</p>", "gotemplate"),
+        ("<p>{{ name }}</p>", None),
+        ("<p>plain</p>", None),
+    ],
+)
+def test_detect_template_language(content: str, expected: str | None) -> None:
+    """템플릿 구분자 기반 언어 감지 테스트"""
+    assert detect_template_language(content) == expected
+
+
+def test_file_diff_detects_template_in_html() -> None:
+    """HTML 파일은 템플릿 구분자가 있으면 템플릿 언어로 감지하는지 테스트"""
+    file_diff = FileDiff(
+        filename="templates/index.html",
+        file_content="{% block content %}{% endblock %}\n",
+    )
+
+    file_diff.detect_language()
+
+    assert file_diff.language == "jinja"
+    assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_TEMPLATE_DELIMITERS
+
+
+def _rust_main_classifier(head: bytes) -> str:
+    """`fn main()`이 있는 파일을 Rust로 분류하는 테스트용 분류기"""
+    return "rust" if b"fn main()" in head else UNKNOWN_LANGUAGE
+
+
+@pytest.fixture
+def rust_main_classifier() -> Generator[None, None, None]:
+    """Rust 분류기를 등록하고 테스트 후 제거합니다."""
+    register_content_classifier(_rust_main_classifier)
+    yield
+    unregister_content_classifier(_rust_main_classifier)
+
+
+def test_content_classifier_detects_language(rust_main_classifier: None) -> None:
+    """확장자 없는 파일은 등록된 내용 분류기로 언어를 감지하는지 테스트"""
+    file_diff = FileDiff(
+        filename="tools/polyglot",
+        file_content="#!/bin/sh\n//usr/bin/true; exec cargo run\nfn main() {\n}\n",
+    )
+
+    file_diff.detect_language()
+
+    assert file_diff.language == "rust"
+    assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_CONTENT_CLASSIFIER
+
+
+def test_unknown_defers_to_shebang(rust_main_classifier: None) -> None:
+    """분류기가 unknown을 반환하면 shebang 감지로 넘어가는지 테스트"""
+    file_diff = FileDiff(filename="bin/report", file_content="#!/usr/bin/perl\n1;\n")
+
+    file_diff.detect_language()
+
+    assert detect_language_from_content(b"#!/usr/bin/perl\n") is None
+    assert file_diff.language == "perl"
+    assert file_diff.language_source == FileDiff.LANGUAGE_SOURCE_SHEBANG
+
+
+def test_extension_takes_precedence_over_classifier(
+    rust_main_classifier: None,
+) -> None:
+    """확장자로 언어를 알 수 있으면 내용 분류기를 호출하지 않는지 테스트"""
+    file_diff = FileDiff(filename="main.py", file_content="# fn main()\n")
+
+    file_diff.detect_language()
+
+    assert file_diff.language == "python"
+
+
+def test_classifier_receives_only_head(rust_main_classifier: None) -> None:
+    """분류기에는 파일 앞부분 CONTENT_SNIFF_BYTES 바이트만 전달되는지 테스트"""
+    content = b" " * CONTENT_SNIFF_BYTES + b"fn main()"
+
+    assert detect_language_from_content(content) is None
@@ -0,0 +1,65 @@
+"""SelvageIgnore 클래스에 대한 유닛 테스트."""
+
+from pathlib import Path
+
+import pytest
+
+from selvage.src.utils.selvage_ignore import SelvageIgnore
+
+
+def _write(path: Path, content: str) -> None:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content, encoding="utf-8")
+
+
+@pytest.fixture
+def root(tmp_path: Path) -> Path:
+    """`.selvageignore`가 여러 단계에 있는 테스트 디렉토리를 생성합니다."""
+    _write(
+        tmp_path / ".selvageignore",
+        "# 인덱싱 제외\n*.min.js\nbuild/\n/vendor\n!keep.min.js\n",
+    )
+    _write(tmp_path / "src" / ".selvageignore", "fixtures/*.py\n")
+    return tmp_path
+
+
+class TestSelvageIgnore:
+    """SelvageIgnore 패턴 해석 테스트."""
+
+    def test_basename_pattern(self, root: Path) -> None:
+        """`/`가 없는 패턴은 모든 디렉토리의 파일 이름에 일치하는지 테스트."""
+        ignore = SelvageIgnore(root)
+
+        assert ignore.is_ignored("app.min.js")
+        assert ignore.is_ignored("static/js/app.min.js")
+        assert not ignore.is_ignored("static/js/app.js")
+
+    def test_negation_overrides_earlier_rule(self, root: Path) -> None:
+        """`!pattern`이 앞의 제외 규칙을 취소하는지 테스트."""
+        assert not SelvageIgnore(root).is_ignored("static/keep.min.js")
+
+    def test_directory_only_pattern(self, root: Path) -> None:
+        """`/`로 끝나는 패턴은 디렉토리와 그 아래 경로에만 일치하는지 테스트."""
+        ignore = SelvageIgnore(root)
+
+        assert ignore.is_ignored("build", is_dir=True)
+        assert ignore.is_ignored("build/out.py")
+        assert not ignore.is_ignored("build")
+
+    def test_anchored_pattern(self, root: Path) -> None:
+        """`/`로 시작하는 패턴은 루트 기준 경로에만 일치하는지 테스트."""
+        ignore = SelvageIgnore(root)
+
+        assert ignore.is_ignored("vendor/lib.go")
+        assert not ignore.is_ignored("src/vendor/lib.go")
+
+    def test_nested_ignore_file(self, root: Path) -> None:
+        """하위 디렉토리의 `.selvageignore`는 해당 디렉토리 기준으로 적용되는지 테스트."""
+        ignore = SelvageIgnore(root)
+
+        assert ignore.is_ignored("src/fixtures/sample.py")
+        assert not ignore.is_ignored("fixtures/sample.py")
+
+    def test_missing_ignore_file(self, tmp_path: Path) -> None:
+        """`.selvageignore`가 없으면 아무 경로도 제외하지 않는지 테스트."""
+        assert not SelvageIgnore(tmp_path).is_ignored("src/app.py")